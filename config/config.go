@@ -12,6 +12,7 @@ type Configuration struct {
 	DemoMode               *bool
 	UploadPathArea         *string
 	UploadPathCrop         *string
+	UploadPathTask         *string
 	TaniaPersistenceEngine *string
 	SqlitePath             *string
 	MysqlHost              *string
@@ -21,4 +22,19 @@ type Configuration struct {
 	MysqlPassword          *string
 	RedirectURI            *string
 	ClientID               *string
+	BlockInsufficientSeed  *bool
+	MqttBrokerURL          *string
+	MqttClientID           *string
+	MqttUsername           *string
+	MqttPassword           *string
+	MqttTopics             *string
+	MqttPayloadTemplate    *string
+	SmtpHost               *string
+	SmtpPort               *string
+	SmtpUsername           *string
+	SmtpPassword           *string
+	SmtpFrom               *string
+	WeatherProvider        *string
+	WeatherAPIURL          *string
+	WeatherFixturePath     *string
 }
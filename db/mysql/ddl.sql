@@ -19,7 +19,8 @@ CREATE TABLE IF NOT EXISTS `FARM_READ` (
     `COUNTRY` VARCHAR(255),
     `CITY` VARCHAR(255),
     `IS_ACTIVE` INT,
-    `CREATED_DATE` DATETIME
+    `CREATED_DATE` DATETIME,
+    `BATCH_ID_TEMPLATE` VARCHAR(255)
 ) ENGINE=InnoDB;
 
 CREATE UNIQUE INDEX `FARM_READ_UID_UNIQUE_INDEX` ON `FARM_READ` (`UID`);
@@ -43,7 +44,8 @@ CREATE TABLE IF NOT EXISTS `RESERVOIR_READ` (
     `WATERSOURCE_CAPACITY` FLOAT,
     `FARM_UID` BINARY(16),
     `FARM_NAME` VARCHAR(255),
-    `CREATED_DATE` DATETIME
+    `CREATED_DATE` DATETIME,
+    `VOLUME` FLOAT DEFAULT 0
 ) ENGINE=InnoDB;
 
 CREATE INDEX `RESERVOIR_READ_UID_UNIQUE_INDEX` ON `RESERVOIR_READ` (`UID`);
@@ -59,6 +61,48 @@ CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_NOTES` (
 CREATE UNIQUE INDEX `RESERVOIR_READ_NOTES_UID_UNIQUE_INDEX` ON `RESERVOIR_READ_NOTES` (`UID`);
 CREATE INDEX `RESERVOIR_READ_NOTES_RESERVOIR_UID_INDEX` ON `RESERVOIR_READ_NOTES` (`RESERVOIR_UID`);
 
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_MEASUREMENT` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `RESERVOIR_UID` BINARY(16),
+    `PH` FLOAT,
+    `EC` FLOAT,
+    `TEMPERATURE` FLOAT,
+    `DISSOLVED_OXYGEN` FLOAT,
+    `SOURCE` VARCHAR(20),
+    `MEASURED_DATE` DATETIME,
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `RESERVOIR_READ_MEASUREMENT_RESERVOIR_UID_INDEX` ON `RESERVOIR_READ_MEASUREMENT` (`RESERVOIR_UID`, `MEASURED_DATE`);
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_NUTRIENT` (
+    `RESERVOIR_UID` BINARY(16),
+    `MATERIAL_UID` BINARY(16),
+    `MATERIAL_NAME` VARCHAR(255),
+    `AMOUNT` FLOAT,
+    `UNIT` VARCHAR(255),
+    `CONCENTRATION` FLOAT,
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `RESERVOIR_READ_NUTRIENT_RESERVOIR_UID_INDEX` ON `RESERVOIR_READ_NUTRIENT` (`RESERVOIR_UID`);
+
+CREATE TABLE IF NOT EXISTS `RESERVOIR_READ_OPERATION` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `RESERVOIR_UID` BINARY(16),
+    `TYPE` VARCHAR(20),
+    `VOLUME` FLOAT,
+    `MATERIAL_UID` BINARY(16),
+    `MATERIAL_NAME` VARCHAR(255),
+    `AMOUNT` FLOAT,
+    `UNIT` VARCHAR(255),
+    `VOLUME_AFTER` FLOAT,
+    `OPERATION_DATE` DATETIME,
+    FOREIGN KEY(`RESERVOIR_UID`) REFERENCES `RESERVOIR_READ`(`UID`)
+) ENGINE=InnoDB;
+
+CREATE INDEX `RESERVOIR_READ_OPERATION_RESERVOIR_UID_INDEX` ON `RESERVOIR_READ_OPERATION` (`RESERVOIR_UID`, `OPERATION_DATE`);
+
 -- AREA --
 
 CREATE TABLE IF NOT EXISTS `AREA_EVENT` (
@@ -87,12 +131,15 @@ CREATE TABLE IF NOT EXISTS `AREA_READ` (
     `RESERVOIR_UID` BINARY(16),
     `RESERVOIR_NAME` VARCHAR(255),
     `FARM_UID` BINARY(16),
-    `FARM_NAME` VARCHAR(255)
+    `FARM_NAME` VARCHAR(255),
+    `CLIMATE_ZONE_UID` BINARY(16),
+    `CLIMATE_ZONE_NAME` VARCHAR(255)
 ) ENGINE=InnoDB;
 
 CREATE UNIQUE INDEX `AREA_READ_UID_UNIQUE_INDEX` ON `AREA_READ` (`UID`);
 CREATE INDEX `AREA_READ_RESERVOIR_UID_INDEX` ON `AREA_READ` (`RESERVOIR_UID`);
 CREATE INDEX `AREA_READ_FARM_UID_INDEX` ON `AREA_READ` (`FARM_UID`);
+CREATE INDEX `AREA_READ_CLIMATE_ZONE_UID_INDEX` ON `AREA_READ` (`CLIMATE_ZONE_UID`);
 
 CREATE TABLE IF NOT EXISTS `AREA_READ_NOTES` (
     `UID` BINARY(16) PRIMARY KEY,
@@ -105,6 +152,37 @@ CREATE TABLE IF NOT EXISTS `AREA_READ_NOTES` (
 CREATE UNIQUE INDEX `AREA_READ_NOTES_UID_UNIQUE_INDEX` ON `AREA_READ_NOTES` (`UID`);
 CREATE INDEX `AREA_READ_NOTES_AREA_UID_INDEX` ON `AREA_READ_NOTES` (`AREA_UID`);
 
+-- CLIMATE ZONE --
+
+CREATE TABLE IF NOT EXISTS `CLIMATE_ZONE_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `CLIMATE_ZONE_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+) ENGINE=InnoDB;
+
+CREATE INDEX `CLIMATE_ZONE_EVENT_CLIMATE_ZONE_UID_INDEX` ON `CLIMATE_ZONE_EVENT` (`CLIMATE_ZONE_UID`);
+
+CREATE TABLE IF NOT EXISTS `CLIMATE_ZONE_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `TYPE` VARCHAR(255),
+    `FARM_UID` BINARY(16),
+    `FARM_NAME` VARCHAR(255),
+    `TEMPERATURE_MIN` FLOAT,
+    `TEMPERATURE_MAX` FLOAT,
+    `HUMIDITY_MIN` FLOAT,
+    `HUMIDITY_MAX` FLOAT,
+    `CO2_MIN` FLOAT,
+    `CO2_MAX` FLOAT,
+    `LIGHT_MIN` FLOAT,
+    `LIGHT_MAX` FLOAT,
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `CLIMATE_ZONE_READ_FARM_UID_INDEX` ON `CLIMATE_ZONE_READ` (`FARM_UID`);
+
 -- MATERIAL --
 
 CREATE TABLE IF NOT EXISTS `MATERIAL_EVENT` (
@@ -129,11 +207,33 @@ CREATE TABLE IF NOT EXISTS `MATERIAL_READ` (
     `EXPIRATION_DATE` VARCHAR(255),
     `NOTES` VARCHAR(255),
     `PRODUCED_BY` VARCHAR(255),
-    `CREATED_DATE` DATETIME
+    `CREATED_DATE` DATETIME,
+    `PRE_HARVEST_INTERVAL` INT,
+    `RE_ENTRY_INTERVAL` INT,
+    `CROP_PLAN_DAYS_TO_GERMINATION` INT,
+    `CROP_PLAN_DAYS_TO_TRANSPLANT` INT,
+    `CROP_PLAN_DAYS_TO_FIRST_HARVEST` INT,
+    `CROP_PLAN_DAYS_TO_END_OF_HARVEST` INT,
+    `SEEDS_PER_UNIT` FLOAT,
+    `CROP_PLAN_BASE_TEMPERATURE` FLOAT,
+    `CROP_PLAN_GDD_TO_FIRST_HARVEST` FLOAT
 );
 
 CREATE INDEX `MATERIAL_READ_UID_UNIQUE_INDEX` ON `MATERIAL_READ` (`UID`);
 
+CREATE TABLE IF NOT EXISTS `MATERIAL_READ_CONSUMPTION` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `MATERIAL_UID` BINARY(16),
+    `TASK_UID` BINARY(16),
+    `QUANTITY` FLOAT,
+    `QUANTITY_UNIT` VARCHAR(255),
+    `CONSUMED_DATE` DATETIME,
+    `CROP_UID` BINARY(16),
+    `RESERVOIR_UID` BINARY(16)
+);
+
+CREATE INDEX `MATERIAL_READ_CONSUMPTION_MATERIAL_UID_INDEX` ON `MATERIAL_READ_CONSUMPTION` (`MATERIAL_UID`);
+
 -- CROP --
 
 CREATE TABLE IF NOT EXISTS `CROP_EVENT` (
@@ -146,6 +246,13 @@ CREATE TABLE IF NOT EXISTS `CROP_EVENT` (
 
 CREATE INDEX `CROP_EVENT_CROP_UID_INDEX` ON `CROP_EVENT` (`CROP_UID`);
 
+CREATE TABLE IF NOT EXISTS `CROP_BATCH_ID` (
+    `BATCH_ID` VARCHAR(255),
+    `CREATED_DATE` DATETIME
+);
+
+CREATE UNIQUE INDEX `CROP_BATCH_ID_BATCH_ID_UNIQUE_INDEX` ON `CROP_BATCH_ID` (`BATCH_ID`);
+
 CREATE TABLE IF NOT EXISTS `CROP_READ` (
     `UID` BINARY(16) PRIMARY KEY,
     `BATCH_ID` VARCHAR(255),
@@ -171,7 +278,9 @@ CREATE TABLE IF NOT EXISTS `CROP_READ` (
     `INITIAL_AREA_LAST_PESTICIDED` DATETIME,
     `INITIAL_AREA_LAST_PRUNED` DATETIME,
     `INITIAL_AREA_CREATED_DATE` DATETIME,
-    `INITIAL_AREA_LAST_UPDATED` DATETIME
+    `INITIAL_AREA_LAST_UPDATED` DATETIME,
+    `SAFE_HARVEST_DATE` DATETIME,
+    `STAGE` VARCHAR(255)
 );
 
 CREATE TABLE IF NOT EXISTS `CROP_READ_PHOTO` (
@@ -214,6 +323,22 @@ CREATE TABLE IF NOT EXISTS `CROP_READ_HARVESTED_STORAGE` (
     FOREIGN KEY(`CROP_UID`) REFERENCES `CROP_READ`(`UID`)
 );
 
+CREATE TABLE IF NOT EXISTS `CROP_READ_HARVEST_LOT` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `CROP_UID` BINARY(16),
+    `CODE` VARCHAR(255),
+    `SOURCE_AREA_UID` BINARY(16),
+    `SOURCE_AREA_NAME` VARCHAR(255),
+    `HARVEST_TYPE` VARCHAR(50),
+    `QUANTITY` INT,
+    `PRODUCED_GRAM_QUANTITY` FLOAT,
+    `GRADE` VARCHAR(50),
+    `DESTINATION` VARCHAR(255),
+    `HARVEST_DATE` DATETIME,
+    `CORRECTED_DATE` DATETIME,
+    FOREIGN KEY(`CROP_UID`) REFERENCES `CROP_READ`(`UID`)
+);
+
 CREATE TABLE IF NOT EXISTS `CROP_READ_TRASH` (
     `ID` INT PRIMARY KEY AUTO_INCREMENT,
     `CROP_UID` BINARY(16),
@@ -277,7 +402,249 @@ CREATE TABLE IF NOT EXISTS `TASK_READ` (
     `DOMAIN_DATA_CROP_ID` BINARY(16),
     `CATEGORY` VARCHAR(255),
     `IS_DUE` TINYINT(1),
-    `ASSET_ID` BINARY(16)
+    `ASSET_ID` BINARY(16),
+    `ASSIGNEE_UID` BINARY(16)
+);
+
+CREATE INDEX `TASK_READ_UID_UNIQUE_INDEX` ON `TASK_READ` (`UID`);
+CREATE INDEX `TASK_READ_ASSIGNEE_UID_INDEX` ON `TASK_READ` (`ASSIGNEE_UID`);
+
+CREATE TABLE IF NOT EXISTS `TASK_READ_COMMENT` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `TASK_UID` BINARY(16),
+    `PARENT_UID` BINARY(16),
+    `AUTHOR_UID` BINARY(16),
+    `CONTENT` TEXT,
+    `CREATED_DATE` DATETIME,
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
+);
+
+CREATE INDEX `TASK_READ_COMMENT_TASK_UID_INDEX` ON `TASK_READ_COMMENT` (`TASK_UID`);
+
+CREATE TABLE IF NOT EXISTS `TASK_READ_CHECKLIST` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `TASK_UID` BINARY(16),
+    `NAME` VARCHAR(255),
+    `IS_COMPLETED` TINYINT(1),
+    `COMPLETED_DATE` DATETIME,
+    `CREATED_DATE` DATETIME,
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
+);
+
+CREATE INDEX `TASK_READ_CHECKLIST_TASK_UID_INDEX` ON `TASK_READ_CHECKLIST` (`TASK_UID`);
+
+CREATE TABLE IF NOT EXISTS `TASK_READ_ATTACHMENT` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `TASK_UID` BINARY(16),
+    `FILENAME` VARCHAR(255),
+    `MIMETYPE` VARCHAR(255),
+    `SIZE` INT,
+    `CREATED_DATE` DATETIME,
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
 );
 
-CREATE INDEX `TASK_READ_UID_UNIQUE_INDEX` ON `TASK_READ` (`UID`);
\ No newline at end of file
+CREATE INDEX `TASK_READ_ATTACHMENT_TASK_UID_INDEX` ON `TASK_READ_ATTACHMENT` (`TASK_UID`);
+
+CREATE TABLE IF NOT EXISTS `TASK_READ_PREREQUISITE` (
+    `TASK_UID` BINARY(16),
+    `PREREQUISITE_UID` BINARY(16),
+    PRIMARY KEY (`TASK_UID`, `PREREQUISITE_UID`),
+    FOREIGN KEY(`TASK_UID`) REFERENCES `TASK_READ`(`UID`)
+);
+
+-- DEVICE --
+
+CREATE TABLE IF NOT EXISTS `DEVICE_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `DEVICE_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+) ENGINE=InnoDB;
+
+CREATE INDEX `DEVICE_EVENT_DEVICE_UID_INDEX` ON `DEVICE_EVENT` (`DEVICE_UID`);
+
+CREATE TABLE IF NOT EXISTS `DEVICE_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `SENSOR_TYPE` VARCHAR(255),
+    `ATTACHMENT_TYPE` VARCHAR(255),
+    `ATTACHMENT_UID` BINARY(16),
+    `ATTACHMENT_NAME` VARCHAR(255),
+    `FARM_UID` BINARY(16),
+    `TOKEN_HASH` VARCHAR(64),
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `DEVICE_READ_ATTACHMENT_UID_INDEX` ON `DEVICE_READ` (`ATTACHMENT_UID`);
+
+CREATE TABLE IF NOT EXISTS `DEVICE_READING` (
+    `ID` BIGINT PRIMARY KEY AUTO_INCREMENT,
+    `DEVICE_UID` BINARY(16),
+    `SENSOR_TYPE` VARCHAR(255),
+    `ATTACHMENT_TYPE` VARCHAR(255),
+    `ATTACHMENT_UID` BINARY(16),
+    `VALUE` FLOAT,
+    `RECORDED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `DEVICE_READING_DEVICE_UID_RECORDED_DATE_INDEX` ON `DEVICE_READING` (`DEVICE_UID`, `RECORDED_DATE`);
+CREATE INDEX `DEVICE_READING_ATTACHMENT_UID_RECORDED_DATE_INDEX` ON `DEVICE_READING` (`ATTACHMENT_UID`, `RECORDED_DATE`);
+
+-- ALERT --
+
+CREATE TABLE IF NOT EXISTS `RULE_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `RULE_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+) ENGINE=InnoDB;
+
+CREATE INDEX `RULE_EVENT_RULE_UID_INDEX` ON `RULE_EVENT` (`RULE_UID`);
+
+CREATE TABLE IF NOT EXISTS `RULE_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `SOURCE` VARCHAR(255),
+    `TARGET_UID` BINARY(16),
+    `PARAMETER` VARCHAR(255),
+    `OPERATOR` VARCHAR(255),
+    `THRESHOLD` FLOAT,
+    `DURATION` INT,
+    `NOTIFIERS` VARCHAR(255),
+    `WEBHOOK_URL` VARCHAR(2048),
+    `EMAIL` VARCHAR(255),
+    `TASK_DOMAIN` VARCHAR(255),
+    `TASK_CATEGORY` VARCHAR(255),
+    `IS_ACTIVE` TINYINT(1),
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `RULE_READ_SOURCE_INDEX` ON `RULE_READ` (`SOURCE`);
+
+CREATE TABLE IF NOT EXISTS `ALERT_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `ALERT_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+) ENGINE=InnoDB;
+
+CREATE INDEX `ALERT_EVENT_ALERT_UID_INDEX` ON `ALERT_EVENT` (`ALERT_UID`);
+
+CREATE TABLE IF NOT EXISTS `ALERT_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `RULE_UID` BINARY(16),
+    `RULE_NAME` VARCHAR(255),
+    `SOURCE` VARCHAR(255),
+    `TARGET_UID` BINARY(16),
+    `TARGET_NAME` VARCHAR(255),
+    `VALUE` FLOAT,
+    `MESSAGE` TEXT,
+    `STATUS` VARCHAR(255),
+    `TRIGGERED_DATE` DATETIME,
+    `ACKNOWLEDGED_DATE` DATETIME,
+    `RESOLVED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `ALERT_READ_RULE_UID_TARGET_UID_INDEX` ON `ALERT_READ` (`RULE_UID`, `TARGET_UID`);
+CREATE INDEX `ALERT_READ_STATUS_INDEX` ON `ALERT_READ` (`STATUS`);
+
+-- IRRIGATION --
+
+CREATE TABLE IF NOT EXISTS `IRRIGATION_PROGRAM_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `PROGRAM_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+) ENGINE=InnoDB;
+
+CREATE INDEX `IRRIGATION_PROGRAM_EVENT_PROGRAM_UID_INDEX` ON `IRRIGATION_PROGRAM_EVENT` (`PROGRAM_UID`);
+
+CREATE TABLE IF NOT EXISTS `IRRIGATION_PROGRAM_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `NAME` VARCHAR(255),
+    `AREA_UID` BINARY(16),
+    `AREA_NAME` VARCHAR(255),
+    `FARM_UID` BINARY(16),
+    `START_TIMES` VARCHAR(1024),
+    `DURATION` INT,
+    `DAYS` VARCHAR(255),
+    `IS_ACTIVE` TINYINT(1),
+    `IS_RUNNING` TINYINT(1),
+    `LAST_RUN_DATE` DATETIME,
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `IRRIGATION_PROGRAM_READ_AREA_UID_INDEX` ON `IRRIGATION_PROGRAM_READ` (`AREA_UID`);
+
+CREATE TABLE IF NOT EXISTS `IRRIGATION_RUN_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `PROGRAM_UID` BINARY(16),
+    `AREA_UID` BINARY(16),
+    `DURATION` INT,
+    `STATUS` VARCHAR(255),
+    `REASON` TEXT,
+    `STARTED_DATE` DATETIME,
+    `ENDED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `IRRIGATION_RUN_READ_PROGRAM_UID_INDEX` ON `IRRIGATION_RUN_READ` (`PROGRAM_UID`);
+
+-- WEATHER --
+
+CREATE TABLE IF NOT EXISTS `WEATHER_READ` (
+    `FARM_UID` BINARY(16),
+    `DATE` DATETIME,
+    `KIND` VARCHAR(20),
+    `TEMPERATURE_MIN` FLOAT,
+    `TEMPERATURE_MAX` FLOAT,
+    `PRECIPITATION` FLOAT,
+    `HUMIDITY` FLOAT,
+    `WIND_SPEED_MAX` FLOAT,
+    `EVAPOTRANSPIRATION` FLOAT,
+    `RADIATION` FLOAT,
+    `FETCHED_DATE` DATETIME,
+    PRIMARY KEY (`FARM_UID`, `DATE`)
+) ENGINE=InnoDB;
+
+-- WEBHOOK --
+
+CREATE TABLE IF NOT EXISTS `WEBHOOK_EVENT` (
+    `ID` INT PRIMARY KEY AUTO_INCREMENT,
+    `WEBHOOK_UID` BINARY(16),
+    `VERSION` INT,
+    `CREATED_DATE` DATETIME,
+    `EVENT` JSON
+) ENGINE=InnoDB;
+
+CREATE INDEX `WEBHOOK_EVENT_WEBHOOK_UID_INDEX` ON `WEBHOOK_EVENT` (`WEBHOOK_UID`);
+
+CREATE TABLE IF NOT EXISTS `WEBHOOK_READ` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `URL` VARCHAR(2048),
+    `SECRET` VARCHAR(255),
+    `EVENTS` TEXT,
+    `IS_ACTIVE` TINYINT(1),
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE TABLE IF NOT EXISTS `WEBHOOK_DELIVERY` (
+    `UID` BINARY(16) PRIMARY KEY,
+    `WEBHOOK_UID` BINARY(16),
+    `EVENT_NAME` VARCHAR(255),
+    `PAYLOAD` MEDIUMTEXT,
+    `STATUS` VARCHAR(20),
+    `ATTEMPTS` INT,
+    `NEXT_ATTEMPT_DATE` DATETIME,
+    `LAST_ATTEMPT_DATE` DATETIME,
+    `RESPONSE_STATUS` INT,
+    `RESPONSE_BODY` TEXT,
+    `ERROR_MESSAGE` TEXT,
+    `CREATED_DATE` DATETIME
+) ENGINE=InnoDB;
+
+CREATE INDEX `WEBHOOK_DELIVERY_WEBHOOK_UID_INDEX` ON `WEBHOOK_DELIVERY` (`WEBHOOK_UID`, `CREATED_DATE`);
+CREATE INDEX `WEBHOOK_DELIVERY_STATUS_INDEX` ON `WEBHOOK_DELIVERY` (`STATUS`, `NEXT_ATTEMPT_DATE`);
\ No newline at end of file
@@ -19,7 +19,8 @@ CREATE TABLE IF NOT EXISTS "FARM_READ" (
     "COUNTRY" TEXT,
     "CITY" TEXT,
     "IS_ACTIVE" INTEGER,
-    "CREATED_DATE" TEXT
+    "CREATED_DATE" TEXT,
+    "BATCH_ID_TEMPLATE" TEXT
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS "FARM_READ_UID_UNIQUE_INDEX" ON "FARM_READ" ("UID");
@@ -52,12 +53,15 @@ CREATE TABLE IF NOT EXISTS "AREA_READ" (
     "RESERVOIR_UID" BLOB,
     "RESERVOIR_NAME" TEXT,
     "FARM_UID" BLOB,
-    "FARM_NAME" TEXT
+    "FARM_NAME" TEXT,
+    "CLIMATE_ZONE_UID" BLOB,
+    "CLIMATE_ZONE_NAME" TEXT
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS "AREA_READ_UID_UNIQUE_INDEX" ON "AREA_READ" ("UID");
 CREATE INDEX IF NOT EXISTS "AREA_READ_RESERVOIR_UID_INDEX" ON "AREA_READ" ("RESERVOIR_UID");
 CREATE INDEX IF NOT EXISTS "AREA_READ_FARM_UID_INDEX" ON "AREA_READ" ("FARM_UID");
+CREATE INDEX IF NOT EXISTS "AREA_READ_CLIMATE_ZONE_UID_INDEX" ON "AREA_READ" ("CLIMATE_ZONE_UID");
 
 CREATE TABLE IF NOT EXISTS "AREA_READ_NOTES" (
     "UID" BLOB PRIMARY KEY,
@@ -70,6 +74,37 @@ CREATE TABLE IF NOT EXISTS "AREA_READ_NOTES" (
 CREATE UNIQUE INDEX IF NOT EXISTS "AREA_READ_NOTES_UID_UNIQUE_INDEX" ON "AREA_READ_NOTES" ("UID");
 CREATE INDEX IF NOT EXISTS "AREA_READ_NOTES_AREA_UID_INDEX" ON "AREA_READ_NOTES" ("AREA_UID");
 
+-- CLIMATE ZONE --
+
+CREATE TABLE IF NOT EXISTS "CLIMATE_ZONE_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "CLIMATE_ZONE_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "CLIMATE_ZONE_EVENT_CLIMATE_ZONE_UID_INDEX" ON "CLIMATE_ZONE_EVENT" ("CLIMATE_ZONE_UID");
+
+CREATE TABLE IF NOT EXISTS "CLIMATE_ZONE_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "TYPE" TEXT,
+    "FARM_UID" BLOB,
+    "FARM_NAME" TEXT,
+    "TEMPERATURE_MIN" REAL,
+    "TEMPERATURE_MAX" REAL,
+    "HUMIDITY_MIN" REAL,
+    "HUMIDITY_MAX" REAL,
+    "CO2_MIN" REAL,
+    "CO2_MAX" REAL,
+    "LIGHT_MIN" REAL,
+    "LIGHT_MAX" REAL,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "CLIMATE_ZONE_READ_FARM_UID_INDEX" ON "CLIMATE_ZONE_READ" ("FARM_UID");
+
 -- RESERVOIR --
 
 CREATE TABLE IF NOT EXISTS "RESERVOIR_EVENT" (
@@ -89,7 +124,8 @@ CREATE TABLE IF NOT EXISTS "RESERVOIR_READ" (
     "WATERSOURCE_CAPACITY" REAL,
     "FARM_UID" BLOB,
     "FARM_NAME" TEXT,
-    "CREATED_DATE" TEXT
+    "CREATED_DATE" TEXT,
+    "VOLUME" REAL DEFAULT 0
 );
 
 CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_UID_UNIQUE_INDEX" ON "RESERVOIR_READ" ("UID");
@@ -105,6 +141,48 @@ CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_NOTES" (
 CREATE UNIQUE INDEX IF NOT EXISTS "RESERVOIR_READ_NOTES_UID_UNIQUE_INDEX" ON "RESERVOIR_READ_NOTES" ("UID");
 CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_NOTES_RESERVOIR_UID_INDEX" ON "RESERVOIR_READ_NOTES" ("RESERVOIR_UID");
 
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_MEASUREMENT" (
+    "UID" BLOB PRIMARY KEY,
+    "RESERVOIR_UID" BLOB,
+    "PH" REAL,
+    "EC" REAL,
+    "TEMPERATURE" REAL,
+    "DISSOLVED_OXYGEN" REAL,
+    "SOURCE" TEXT,
+    "MEASURED_DATE" TEXT,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_MEASUREMENT_RESERVOIR_UID_INDEX" ON "RESERVOIR_READ_MEASUREMENT" ("RESERVOIR_UID", "MEASURED_DATE");
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_NUTRIENT" (
+    "RESERVOIR_UID" BLOB,
+    "MATERIAL_UID" BLOB,
+    "MATERIAL_NAME" TEXT,
+    "AMOUNT" REAL,
+    "UNIT" TEXT,
+    "CONCENTRATION" REAL,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_NUTRIENT_RESERVOIR_UID_INDEX" ON "RESERVOIR_READ_NUTRIENT" ("RESERVOIR_UID");
+
+CREATE TABLE IF NOT EXISTS "RESERVOIR_READ_OPERATION" (
+    "UID" BLOB PRIMARY KEY,
+    "RESERVOIR_UID" BLOB,
+    "TYPE" TEXT,
+    "VOLUME" REAL,
+    "MATERIAL_UID" BLOB,
+    "MATERIAL_NAME" TEXT,
+    "AMOUNT" REAL,
+    "UNIT" TEXT,
+    "VOLUME_AFTER" REAL,
+    "OPERATION_DATE" TEXT,
+    FOREIGN KEY("RESERVOIR_UID") REFERENCES "RESERVOIR_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "RESERVOIR_READ_OPERATION_RESERVOIR_UID_INDEX" ON "RESERVOIR_READ_OPERATION" ("RESERVOIR_UID", "OPERATION_DATE");
+
 -- MATERIAL --
 
 CREATE TABLE IF NOT EXISTS "MATERIAL_EVENT" (
@@ -129,11 +207,33 @@ CREATE TABLE IF NOT EXISTS "MATERIAL_READ" (
     "EXPIRATION_DATE" TEXT,
     "NOTES" TEXT,
     "PRODUCED_BY" TEXT,
-    "CREATED_DATE" TEXT
+    "CREATED_DATE" TEXT,
+    "PRE_HARVEST_INTERVAL" INTEGER,
+    "RE_ENTRY_INTERVAL" INTEGER,
+    "CROP_PLAN_DAYS_TO_GERMINATION" INTEGER,
+    "CROP_PLAN_DAYS_TO_TRANSPLANT" INTEGER,
+    "CROP_PLAN_DAYS_TO_FIRST_HARVEST" INTEGER,
+    "CROP_PLAN_DAYS_TO_END_OF_HARVEST" INTEGER,
+    "SEEDS_PER_UNIT" REAL,
+    "CROP_PLAN_BASE_TEMPERATURE" REAL,
+    "CROP_PLAN_GDD_TO_FIRST_HARVEST" REAL
 );
 
 CREATE INDEX IF NOT EXISTS "MATERIAL_READ_UID_UNIQUE_INDEX" ON "MATERIAL_READ" ("UID");
 
+CREATE TABLE IF NOT EXISTS "MATERIAL_READ_CONSUMPTION" (
+    "ID" INTEGER PRIMARY KEY,
+    "MATERIAL_UID" BLOB,
+    "TASK_UID" BLOB,
+    "QUANTITY" REAL,
+    "QUANTITY_UNIT" TEXT,
+    "CONSUMED_DATE" TEXT,
+    "CROP_UID" BLOB,
+    "RESERVOIR_UID" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "MATERIAL_READ_CONSUMPTION_MATERIAL_UID_INDEX" ON "MATERIAL_READ_CONSUMPTION" ("MATERIAL_UID");
+
 -- CROP --
 
 CREATE TABLE IF NOT EXISTS "CROP_EVENT" (
@@ -146,6 +246,13 @@ CREATE TABLE IF NOT EXISTS "CROP_EVENT" (
 
 CREATE INDEX IF NOT EXISTS "CROP_EVENT_CROP_UID_INDEX" ON "CROP_EVENT" ("CROP_UID");
 
+CREATE TABLE IF NOT EXISTS "CROP_BATCH_ID" (
+    "BATCH_ID" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "CROP_BATCH_ID_BATCH_ID_UNIQUE_INDEX" ON "CROP_BATCH_ID" ("BATCH_ID");
+
 CREATE TABLE IF NOT EXISTS "CROP_READ" (
     "UID" BLOB PRIMARY KEY,
     "BATCH_ID" TEXT,
@@ -171,7 +278,9 @@ CREATE TABLE IF NOT EXISTS "CROP_READ" (
     "INITIAL_AREA_LAST_PESTICIDED" TEXT,
     "INITIAL_AREA_LAST_PRUNED" TEXT,
     "INITIAL_AREA_CREATED_DATE" TEXT,
-    "INITIAL_AREA_LAST_UPDATED" TEXT
+    "INITIAL_AREA_LAST_UPDATED" TEXT,
+    "SAFE_HARVEST_DATE" TEXT,
+    "STAGE" TEXT
 );
 
 CREATE TABLE IF NOT EXISTS "CROP_READ_PHOTO" (
@@ -214,6 +323,22 @@ CREATE TABLE IF NOT EXISTS "CROP_READ_HARVESTED_STORAGE" (
     FOREIGN KEY("CROP_UID") REFERENCES "CROP_READ"("UID")
 );
 
+CREATE TABLE IF NOT EXISTS "CROP_READ_HARVEST_LOT" (
+    "UID" BLOB PRIMARY KEY,
+    "CROP_UID" BLOB,
+    "CODE" TEXT,
+    "SOURCE_AREA_UID" BLOB,
+    "SOURCE_AREA_NAME" TEXT,
+    "HARVEST_TYPE" TEXT,
+    "QUANTITY" INTEGER,
+    "PRODUCED_GRAM_QUANTITY" REAL,
+    "GRADE" TEXT,
+    "DESTINATION" TEXT,
+    "HARVEST_DATE" TEXT,
+    "CORRECTED_DATE" TEXT,
+    FOREIGN KEY("CROP_UID") REFERENCES "CROP_READ"("UID")
+);
+
 CREATE TABLE IF NOT EXISTS "CROP_READ_TRASH" (
     "ID" INTEGER PRIMARY KEY,
     "CROP_UID" BLOB,
@@ -276,10 +401,55 @@ CREATE TABLE IF NOT EXISTS "TASK_READ" (
     "DOMAIN_DATA_AREA_ID" TEXT,
     "CATEGORY" TEXT,
     "IS_DUE" BOOLEAN,
-    "ASSET_ID" TEXT
+    "ASSET_ID" TEXT,
+    "ASSIGNEE_UID" TEXT
 );
 
 CREATE INDEX IF NOT EXISTS "TASK_READ_UID_UNIQUE_INDEX" ON "TASK_READ" ("UID");
+CREATE INDEX IF NOT EXISTS "TASK_READ_ASSIGNEE_UID_INDEX" ON "TASK_READ" ("ASSIGNEE_UID");
+
+CREATE TABLE IF NOT EXISTS "TASK_READ_COMMENT" (
+    "UID" BLOB PRIMARY KEY,
+    "TASK_UID" BLOB,
+    "PARENT_UID" BLOB,
+    "AUTHOR_UID" BLOB,
+    "CONTENT" TEXT,
+    "CREATED_DATE" TEXT,
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "TASK_READ_COMMENT_TASK_UID_INDEX" ON "TASK_READ_COMMENT" ("TASK_UID");
+
+CREATE TABLE IF NOT EXISTS "TASK_READ_CHECKLIST" (
+    "UID" BLOB PRIMARY KEY,
+    "TASK_UID" BLOB,
+    "NAME" TEXT,
+    "IS_COMPLETED" BOOLEAN,
+    "COMPLETED_DATE" TEXT,
+    "CREATED_DATE" TEXT,
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "TASK_READ_CHECKLIST_TASK_UID_INDEX" ON "TASK_READ_CHECKLIST" ("TASK_UID");
+
+CREATE TABLE IF NOT EXISTS "TASK_READ_ATTACHMENT" (
+    "UID" BLOB PRIMARY KEY,
+    "TASK_UID" BLOB,
+    "FILENAME" TEXT,
+    "MIMETYPE" TEXT,
+    "SIZE" INTEGER,
+    "CREATED_DATE" TEXT,
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
+
+CREATE INDEX IF NOT EXISTS "TASK_READ_ATTACHMENT_TASK_UID_INDEX" ON "TASK_READ_ATTACHMENT" ("TASK_UID");
+
+CREATE TABLE IF NOT EXISTS "TASK_READ_PREREQUISITE" (
+    "TASK_UID" BLOB,
+    "PREREQUISITE_UID" BLOB,
+    PRIMARY KEY ("TASK_UID", "PREREQUISITE_UID"),
+    FOREIGN KEY("TASK_UID") REFERENCES "TASK_READ"("UID")
+);
 
 -- USER --
 
@@ -312,4 +482,209 @@ CREATE TABLE IF NOT EXISTS "USER_AUTH" (
 );
 
 CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_USER_UID_UNIQUE_INDEX" ON "USER_AUTH" ("USER_UID");
-CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX" ON "USER_AUTH" ("ACCESS_TOKEN");
\ No newline at end of file
+CREATE UNIQUE INDEX IF NOT EXISTS "USER_AUTH_ACCESS_TOKEN_UNIQUE_INDEX" ON "USER_AUTH" ("ACCESS_TOKEN");
+
+CREATE TABLE IF NOT EXISTS "USER_CALENDAR_TOKEN" (
+    "USER_UID" BLOB PRIMARY KEY,
+    "TOKEN_HASH" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "USER_CALENDAR_TOKEN_TOKEN_HASH_UNIQUE_INDEX" ON "USER_CALENDAR_TOKEN" ("TOKEN_HASH");
+
+-- DEVICE --
+
+CREATE TABLE IF NOT EXISTS "DEVICE_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "DEVICE_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "DEVICE_EVENT_DEVICE_UID_INDEX" ON "DEVICE_EVENT" ("DEVICE_UID");
+
+CREATE TABLE IF NOT EXISTS "DEVICE_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "SENSOR_TYPE" TEXT,
+    "ATTACHMENT_TYPE" TEXT,
+    "ATTACHMENT_UID" BLOB,
+    "ATTACHMENT_NAME" TEXT,
+    "FARM_UID" BLOB,
+    "TOKEN_HASH" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "DEVICE_READ_ATTACHMENT_UID_INDEX" ON "DEVICE_READ" ("ATTACHMENT_UID");
+
+CREATE TABLE IF NOT EXISTS "DEVICE_READING" (
+    "ID" INTEGER PRIMARY KEY,
+    "DEVICE_UID" BLOB,
+    "SENSOR_TYPE" TEXT,
+    "ATTACHMENT_TYPE" TEXT,
+    "ATTACHMENT_UID" BLOB,
+    "VALUE" REAL,
+    "RECORDED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "DEVICE_READING_DEVICE_UID_RECORDED_DATE_INDEX" ON "DEVICE_READING" ("DEVICE_UID", "RECORDED_DATE");
+CREATE INDEX IF NOT EXISTS "DEVICE_READING_ATTACHMENT_UID_RECORDED_DATE_INDEX" ON "DEVICE_READING" ("ATTACHMENT_UID", "RECORDED_DATE");
+
+-- ALERT --
+
+CREATE TABLE IF NOT EXISTS "RULE_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "RULE_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "RULE_EVENT_RULE_UID_INDEX" ON "RULE_EVENT" ("RULE_UID");
+
+CREATE TABLE IF NOT EXISTS "RULE_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "SOURCE" TEXT,
+    "TARGET_UID" BLOB,
+    "PARAMETER" TEXT,
+    "OPERATOR" TEXT,
+    "THRESHOLD" REAL,
+    "DURATION" INTEGER,
+    "NOTIFIERS" TEXT,
+    "WEBHOOK_URL" TEXT,
+    "EMAIL" TEXT,
+    "TASK_DOMAIN" TEXT,
+    "TASK_CATEGORY" TEXT,
+    "IS_ACTIVE" BOOLEAN,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "RULE_READ_SOURCE_INDEX" ON "RULE_READ" ("SOURCE");
+
+CREATE TABLE IF NOT EXISTS "ALERT_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "ALERT_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "ALERT_EVENT_ALERT_UID_INDEX" ON "ALERT_EVENT" ("ALERT_UID");
+
+CREATE TABLE IF NOT EXISTS "ALERT_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "RULE_UID" BLOB,
+    "RULE_NAME" TEXT,
+    "SOURCE" TEXT,
+    "TARGET_UID" BLOB,
+    "TARGET_NAME" TEXT,
+    "VALUE" REAL,
+    "MESSAGE" TEXT,
+    "STATUS" TEXT,
+    "TRIGGERED_DATE" TEXT,
+    "ACKNOWLEDGED_DATE" TEXT,
+    "RESOLVED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "ALERT_READ_RULE_UID_TARGET_UID_INDEX" ON "ALERT_READ" ("RULE_UID", "TARGET_UID");
+CREATE INDEX IF NOT EXISTS "ALERT_READ_STATUS_INDEX" ON "ALERT_READ" ("STATUS");
+
+-- IRRIGATION --
+
+CREATE TABLE IF NOT EXISTS "IRRIGATION_PROGRAM_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "PROGRAM_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "IRRIGATION_PROGRAM_EVENT_PROGRAM_UID_INDEX" ON "IRRIGATION_PROGRAM_EVENT" ("PROGRAM_UID");
+
+CREATE TABLE IF NOT EXISTS "IRRIGATION_PROGRAM_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "NAME" TEXT,
+    "AREA_UID" BLOB,
+    "AREA_NAME" TEXT,
+    "FARM_UID" BLOB,
+    "START_TIMES" TEXT,
+    "DURATION" INTEGER,
+    "DAYS" TEXT,
+    "IS_ACTIVE" BOOLEAN,
+    "IS_RUNNING" BOOLEAN,
+    "LAST_RUN_DATE" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "IRRIGATION_PROGRAM_READ_AREA_UID_INDEX" ON "IRRIGATION_PROGRAM_READ" ("AREA_UID");
+
+CREATE TABLE IF NOT EXISTS "IRRIGATION_RUN_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "PROGRAM_UID" BLOB,
+    "AREA_UID" BLOB,
+    "DURATION" INTEGER,
+    "STATUS" TEXT,
+    "REASON" TEXT,
+    "STARTED_DATE" TEXT,
+    "ENDED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "IRRIGATION_RUN_READ_PROGRAM_UID_INDEX" ON "IRRIGATION_RUN_READ" ("PROGRAM_UID");
+
+-- WEATHER --
+
+CREATE TABLE IF NOT EXISTS "WEATHER_READ" (
+    "FARM_UID" BLOB,
+    "DATE" TEXT,
+    "KIND" TEXT,
+    "TEMPERATURE_MIN" REAL,
+    "TEMPERATURE_MAX" REAL,
+    "PRECIPITATION" REAL,
+    "HUMIDITY" REAL,
+    "WIND_SPEED_MAX" REAL,
+    "EVAPOTRANSPIRATION" REAL,
+    "RADIATION" REAL,
+    "FETCHED_DATE" TEXT,
+    PRIMARY KEY ("FARM_UID", "DATE")
+);
+
+-- WEBHOOK --
+
+CREATE TABLE IF NOT EXISTS "WEBHOOK_EVENT" (
+    "ID" INTEGER PRIMARY KEY,
+    "WEBHOOK_UID" BLOB,
+    "VERSION" INTEGER,
+    "CREATED_DATE" TEXT,
+    "EVENT" BLOB
+);
+
+CREATE INDEX IF NOT EXISTS "WEBHOOK_EVENT_WEBHOOK_UID_INDEX" ON "WEBHOOK_EVENT" ("WEBHOOK_UID");
+
+CREATE TABLE IF NOT EXISTS "WEBHOOK_READ" (
+    "UID" BLOB PRIMARY KEY,
+    "URL" TEXT,
+    "SECRET" TEXT,
+    "EVENTS" TEXT,
+    "IS_ACTIVE" BOOLEAN,
+    "CREATED_DATE" TEXT
+);
+
+CREATE TABLE IF NOT EXISTS "WEBHOOK_DELIVERY" (
+    "UID" BLOB PRIMARY KEY,
+    "WEBHOOK_UID" BLOB,
+    "EVENT_NAME" TEXT,
+    "PAYLOAD" TEXT,
+    "STATUS" TEXT,
+    "ATTEMPTS" INTEGER,
+    "NEXT_ATTEMPT_DATE" TEXT,
+    "LAST_ATTEMPT_DATE" TEXT,
+    "RESPONSE_STATUS" INTEGER,
+    "RESPONSE_BODY" TEXT,
+    "ERROR_MESSAGE" TEXT,
+    "CREATED_DATE" TEXT
+);
+
+CREATE INDEX IF NOT EXISTS "WEBHOOK_DELIVERY_WEBHOOK_UID_INDEX" ON "WEBHOOK_DELIVERY" ("WEBHOOK_UID", "CREATED_DATE");
+CREATE INDEX IF NOT EXISTS "WEBHOOK_DELIVERY_STATUS_INDEX" ON "WEBHOOK_DELIVERY" ("STATUS", "NEXT_ATTEMPT_DATE");
\ No newline at end of file
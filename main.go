@@ -14,14 +14,25 @@ import (
 	"github.com/go-sql-driver/mysql"
 
 	"github.com/Tanibox/tania-core/config"
+	alertsserver "github.com/Tanibox/tania-core/src/alerts/server"
+	alertstorage "github.com/Tanibox/tania-core/src/alerts/storage"
 	assetsserver "github.com/Tanibox/tania-core/src/assets/server"
 	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	devicesserver "github.com/Tanibox/tania-core/src/devices/server"
+	devicestorage "github.com/Tanibox/tania-core/src/devices/storage"
 	growthserver "github.com/Tanibox/tania-core/src/growth/server"
 	growthstorage "github.com/Tanibox/tania-core/src/growth/storage"
+	irrigationserver "github.com/Tanibox/tania-core/src/irrigation/server"
+	irrigationstorage "github.com/Tanibox/tania-core/src/irrigation/storage"
 	locationserver "github.com/Tanibox/tania-core/src/location/server"
 	tasksserver "github.com/Tanibox/tania-core/src/tasks/server"
 	taskstorage "github.com/Tanibox/tania-core/src/tasks/storage"
+	userdomain "github.com/Tanibox/tania-core/src/user/domain"
 	userserver "github.com/Tanibox/tania-core/src/user/server"
+	weatherserver "github.com/Tanibox/tania-core/src/weather/server"
+	weatherstorage "github.com/Tanibox/tania-core/src/weather/storage"
+	webhooksserver "github.com/Tanibox/tania-core/src/webhooks/server"
+	webhookstorage "github.com/Tanibox/tania-core/src/webhooks/storage"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
@@ -61,11 +72,17 @@ func main() {
 		inMem.farmReadStorage,
 		inMem.areaEventStorage,
 		inMem.areaReadStorage,
+		inMem.climateZoneEventStorage,
+		inMem.climateZoneReadStorage,
 		inMem.reservoirEventStorage,
 		inMem.reservoirReadStorage,
+		inMem.reservoirMeasurementStorage,
+		inMem.reservoirOperationStorage,
 		inMem.materialEventStorage,
 		inMem.materialReadStorage,
+		inMem.materialConsumptionStorage,
 		inMem.cropReadStorage,
+		inMem.deviceReadingStorage,
 		bus,
 	)
 	if err != nil {
@@ -92,10 +109,76 @@ func main() {
 		inMem.cropEventStorage,
 		inMem.cropReadStorage,
 		inMem.cropActivityStorage,
+		inMem.cropBatchIDStorage,
 		inMem.areaReadStorage,
 		inMem.materialReadStorage,
 		inMem.farmReadStorage,
 		inMem.taskReadStorage,
+		inMem.weatherReadStorage,
+		inMem.deviceReadingStorage,
+	)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	deviceServer, err := devicesserver.NewDeviceServer(
+		db,
+		bus,
+		inMem.areaReadStorage,
+		inMem.reservoirReadStorage,
+		inMem.climateZoneReadStorage,
+		inMem.deviceEventStorage,
+		inMem.deviceReadStorage,
+		inMem.deviceReadingStorage,
+	)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	alertServer, err := alertsserver.NewAlertServer(
+		db,
+		bus,
+		inMem.reservoirReadStorage,
+		inMem.materialReadStorage,
+		inMem.taskReadStorage,
+		inMem.climateZoneReadStorage,
+		inMem.areaReadStorage,
+		inMem.ruleEventStorage,
+		inMem.ruleReadStorage,
+		inMem.alertEventStorage,
+		inMem.alertReadStorage,
+	)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	irrigationServer, err := irrigationserver.NewIrrigationServer(
+		db,
+		bus,
+		inMem.areaReadStorage,
+		inMem.programEventStorage,
+		inMem.programReadStorage,
+		inMem.programRunStorage,
+	)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	weatherServer, err := weatherserver.NewWeatherServer(
+		db,
+		inMem.farmReadStorage,
+		inMem.weatherReadStorage,
+	)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	webhookServer, err := webhooksserver.NewWebhookServer(
+		db,
+		bus,
+		inMem.webhookEventStorage,
+		inMem.webhookReadStorage,
+		inMem.webhookDeliveryStorage,
 	)
 	if err != nil {
 		e.Logger.Fatal(err)
@@ -120,7 +203,8 @@ func main() {
 	err = initUser(authServer)
 
 	// Initialize Echo Middleware
-	e.Use(middleware.Logger())
+	// The calendar feeds have their token in the URL, so they are logged without the query string
+	e.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{Skipper: isFeedRequest}))
 	e.Use(middleware.Recover())
 	e.Use(headerNoCache)
 
@@ -129,6 +213,15 @@ func main() {
 		APIMiddlewares = append(APIMiddlewares, tokenValidationWithConfig(db))
 	}
 
+	FeedMiddlewares := []echo.MiddlewareFunc{
+		middleware.LoggerWithConfig(middleware.LoggerConfig{
+			Format: strings.Replace(middleware.DefaultLoggerConfig.Format, "${uri}", "${path}", 1),
+		}),
+	}
+	if !*config.Config.DemoMode {
+		FeedMiddlewares = append(FeedMiddlewares, calendarTokenValidation(db))
+	}
+
 	// HTTP routing
 	API := e.Group("api")
 	API.Use(middleware.CORS())
@@ -147,11 +240,44 @@ func main() {
 	taskGroup := API.Group("/tasks", APIMiddlewares...)
 	taskServer.Mount(taskGroup)
 
+	// Calendar applications subscribe to the feeds with the calendar token instead of the user access token
+	taskServer.MountFeed(API, FeedMiddlewares...)
+
+	deviceGroup := API.Group("/devices", APIMiddlewares...)
+	deviceServer.Mount(deviceGroup)
+
+	// Devices send their readings with their own token instead of the user access token
+	ingestionGroup := API.Group("/ingestion")
+	deviceServer.MountIngestion(ingestionGroup)
+
+	alertGroup := API.Group("/alerts", APIMiddlewares...)
+	alertServer.Mount(alertGroup)
+
+	irrigationGroup := API.Group("/irrigation", APIMiddlewares...)
+	irrigationServer.Mount(irrigationGroup)
+
+	weatherGroup := API.Group("/weather", APIMiddlewares...)
+	weatherServer.Mount(weatherGroup)
+
+	webhookGroup := API.Group("/webhooks", APIMiddlewares...)
+	webhookServer.Mount(webhookGroup)
+
 	userGroup := API.Group("/user", APIMiddlewares...)
 	userServer.Mount(userGroup)
 
 	e.Static("/", "public")
 
+	// Start the background jobs once every route is mounted
+	irrigationServer.StartScheduler()
+	weatherServer.StartScheduler()
+	webhookServer.StartRetryScheduler()
+	taskServer.StartDueScheduler()
+
+	err = deviceServer.StartMQTTBridge()
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
 	// Start Server
 	e.Logger.Fatal(e.Start(":8080"))
 }
@@ -190,6 +316,7 @@ func initConfig() {
 	configuration := config.Configuration{
 		UploadPathArea:         conf.String("upload_path_area", "tania-uploads/area", "Upload path for the Area photo"),
 		UploadPathCrop:         conf.String("upload_path_crop", "tania-uploads/crop", "Upload path for the Crop photo"),
+		UploadPathTask:         conf.String("upload_path_task", "tania-uploads/task", "Upload path for the Task attachment"),
 		DemoMode:               conf.Bool("demo_mode", true, "Switch for the demo mode"),
 		TaniaPersistenceEngine: conf.String("tania_persistence_engine", "sqlite", "The persistance engine of Tania. Options are inmemory, sqlite, inmemory"),
 		SqlitePath:             conf.String("sqlite_path", "tania.db", "Path of sqlite file db"),
@@ -200,6 +327,21 @@ func initConfig() {
 		MysqlPassword:          conf.String("mysql_password", "root", "Mysql password"),
 		RedirectURI:            conf.String("redirect_uri", "http://localhost:8080/oauth2_implicit_callback", "URI for redirection after authorization server grants access token"),
 		ClientID:               conf.String("client_id", "f0ece679-3f53-463e-b624-73e83049d6ac", "OAuth2 Implicit Grant Client ID for frontend"),
+		BlockInsufficientSeed:  conf.Bool("block_insufficient_seed", false, "Reject new crop batches when the seed inventory is insufficient instead of only warning"),
+		MqttBrokerURL:          conf.String("mqtt_broker_url", "", "MQTT broker URL of the sensor readings bridge, like tcp://localhost:1883. Empty disables the bridge"),
+		MqttClientID:           conf.String("mqtt_client_id", "tania", "MQTT client ID"),
+		MqttUsername:           conf.String("mqtt_username", "", "MQTT username"),
+		MqttPassword:           conf.String("mqtt_password", "", "MQTT password"),
+		MqttTopics:             conf.String("mqtt_topics", "tania/devices/{device_id}/readings", "Comma separated MQTT topic patterns, {device_id} marks the topic level holding the device ID"),
+		MqttPayloadTemplate:    conf.String("mqtt_payload_template", `{"value": "{value}", "recorded_date": "{recorded_date}"}`, "JSON template of the MQTT payload with {device_id}, {value} and {recorded_date} placeholders. Empty means the payload is the value"),
+		SmtpHost:               conf.String("smtp_host", "", "SMTP host used to send the alert emails. Empty disables the email notifier"),
+		SmtpPort:               conf.String("smtp_port", "587", "SMTP port"),
+		SmtpUsername:           conf.String("smtp_username", "", "SMTP username"),
+		SmtpPassword:           conf.String("smtp_password", "", "SMTP password"),
+		SmtpFrom:               conf.String("smtp_from", "", "Sender address of the alert emails"),
+		WeatherProvider:        conf.String("weather_provider", "openmeteo", "The weather provider of the farms. Options are openmeteo, fixture"),
+		WeatherAPIURL:          conf.String("weather_api_url", "https://api.open-meteo.com/v1/forecast", "URL of the Open-Meteo compatible forecast API"),
+		WeatherFixturePath:     conf.String("weather_fixture_path", "src/weather/provider/fixtures/open_meteo_daily.json", "Path of the weather file replayed by the fixture provider"),
 	}
 
 	// This config will read the first configuration.
@@ -244,19 +386,39 @@ func headerNoCache(next echo.HandlerFunc) echo.HandlerFunc {
 }
 
 type InMemory struct {
-	farmEventStorage      *assetsstorage.FarmEventStorage
-	farmReadStorage       *assetsstorage.FarmReadStorage
-	areaEventStorage      *assetsstorage.AreaEventStorage
-	areaReadStorage       *assetsstorage.AreaReadStorage
-	reservoirEventStorage *assetsstorage.ReservoirEventStorage
-	reservoirReadStorage  *assetsstorage.ReservoirReadStorage
-	materialEventStorage  *assetsstorage.MaterialEventStorage
-	materialReadStorage   *assetsstorage.MaterialReadStorage
-	cropEventStorage      *growthstorage.CropEventStorage
-	cropReadStorage       *growthstorage.CropReadStorage
-	cropActivityStorage   *growthstorage.CropActivityStorage
-	taskEventStorage      *taskstorage.TaskEventStorage
-	taskReadStorage       *taskstorage.TaskReadStorage
+	farmEventStorage            *assetsstorage.FarmEventStorage
+	farmReadStorage             *assetsstorage.FarmReadStorage
+	areaEventStorage            *assetsstorage.AreaEventStorage
+	areaReadStorage             *assetsstorage.AreaReadStorage
+	climateZoneEventStorage     *assetsstorage.ClimateZoneEventStorage
+	climateZoneReadStorage      *assetsstorage.ClimateZoneReadStorage
+	reservoirEventStorage       *assetsstorage.ReservoirEventStorage
+	reservoirReadStorage        *assetsstorage.ReservoirReadStorage
+	reservoirMeasurementStorage *assetsstorage.ReservoirMeasurementStorage
+	reservoirOperationStorage   *assetsstorage.ReservoirOperationStorage
+	materialEventStorage        *assetsstorage.MaterialEventStorage
+	materialReadStorage         *assetsstorage.MaterialReadStorage
+	materialConsumptionStorage  *assetsstorage.MaterialConsumptionStorage
+	cropEventStorage            *growthstorage.CropEventStorage
+	cropReadStorage             *growthstorage.CropReadStorage
+	cropActivityStorage         *growthstorage.CropActivityStorage
+	cropBatchIDStorage          *growthstorage.CropBatchIDStorage
+	taskEventStorage            *taskstorage.TaskEventStorage
+	taskReadStorage             *taskstorage.TaskReadStorage
+	deviceEventStorage          *devicestorage.DeviceEventStorage
+	deviceReadStorage           *devicestorage.DeviceReadStorage
+	deviceReadingStorage        *devicestorage.DeviceReadingStorage
+	ruleEventStorage            *alertstorage.RuleEventStorage
+	ruleReadStorage             *alertstorage.RuleReadStorage
+	alertEventStorage           *alertstorage.AlertEventStorage
+	alertReadStorage            *alertstorage.AlertReadStorage
+	programEventStorage         *irrigationstorage.IrrigationProgramEventStorage
+	programReadStorage          *irrigationstorage.IrrigationProgramReadStorage
+	programRunStorage           *irrigationstorage.IrrigationRunReadStorage
+	weatherReadStorage          *weatherstorage.WeatherReadStorage
+	webhookEventStorage         *webhookstorage.WebhookEventStorage
+	webhookReadStorage          *webhookstorage.WebhookReadStorage
+	webhookDeliveryStorage      *webhookstorage.WebhookDeliveryStorage
 }
 
 func initInMemory() *InMemory {
@@ -267,18 +429,47 @@ func initInMemory() *InMemory {
 		areaEventStorage: assetsstorage.CreateAreaEventStorage(),
 		areaReadStorage:  assetsstorage.CreateAreaReadStorage(),
 
+		climateZoneEventStorage: assetsstorage.CreateClimateZoneEventStorage(),
+		climateZoneReadStorage:  assetsstorage.CreateClimateZoneReadStorage(),
+
 		reservoirEventStorage: assetsstorage.CreateReservoirEventStorage(),
 		reservoirReadStorage:  assetsstorage.CreateReservoirReadStorage(),
 
+		reservoirMeasurementStorage: assetsstorage.CreateReservoirMeasurementStorage(),
+		reservoirOperationStorage:   assetsstorage.CreateReservoirOperationStorage(),
+
 		materialEventStorage: assetsstorage.CreateMaterialEventStorage(),
 		materialReadStorage:  assetsstorage.CreateMaterialReadStorage(),
 
+		materialConsumptionStorage: assetsstorage.CreateMaterialConsumptionStorage(),
+
 		cropEventStorage:    growthstorage.CreateCropEventStorage(),
 		cropReadStorage:     growthstorage.CreateCropReadStorage(),
 		cropActivityStorage: growthstorage.CreateCropActivityStorage(),
+		cropBatchIDStorage:  growthstorage.CreateCropBatchIDStorage(),
 
 		taskEventStorage: taskstorage.CreateTaskEventStorage(),
 		taskReadStorage:  taskstorage.CreateTaskReadStorage(),
+
+		deviceEventStorage:   devicestorage.CreateDeviceEventStorage(),
+		deviceReadStorage:    devicestorage.CreateDeviceReadStorage(),
+		deviceReadingStorage: devicestorage.CreateDeviceReadingStorage(),
+
+		ruleEventStorage: alertstorage.CreateRuleEventStorage(),
+		ruleReadStorage:  alertstorage.CreateRuleReadStorage(),
+
+		alertEventStorage: alertstorage.CreateAlertEventStorage(),
+		alertReadStorage:  alertstorage.CreateAlertReadStorage(),
+
+		programEventStorage: irrigationstorage.CreateIrrigationProgramEventStorage(),
+		programReadStorage:  irrigationstorage.CreateIrrigationProgramReadStorage(),
+		programRunStorage:   irrigationstorage.CreateIrrigationRunReadStorage(),
+
+		weatherReadStorage: weatherstorage.CreateWeatherReadStorage(),
+
+		webhookEventStorage:    webhookstorage.CreateWebhookEventStorage(),
+		webhookReadStorage:     webhookstorage.CreateWebhookReadStorage(),
+		webhookDeliveryStorage: webhookstorage.CreateWebhookDeliveryStorage(),
 	}
 }
 
@@ -402,3 +593,34 @@ func tokenValidationWithConfig(db *sql.DB) echo.MiddlewareFunc {
 		}
 	}
 }
+
+func calendarTokenValidation(db *sql.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := c.QueryParam("token")
+			if token == "" {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+			}
+
+			uid := ""
+			err := db.QueryRow(`SELECT USER_UID
+				FROM USER_CALENDAR_TOKEN WHERE TOKEN_HASH = ?`, userdomain.HashCalendarToken(token)).Scan(&uid)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+			}
+
+			userUID, err := uuid.FromString(uid)
+			if err != nil {
+				return c.JSON(http.StatusInternalServerError, map[string]error{"data": err})
+			}
+
+			c.Set("USER_UID", userUID)
+
+			return next(c)
+		}
+	}
+}
+
+func isFeedRequest(c echo.Context) bool {
+	return c.Request().URL.Path == "/api/tasks/calendar.ics"
+}
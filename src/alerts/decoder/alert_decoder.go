@@ -0,0 +1,65 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/mitchellh/mapstructure"
+)
+
+type AlertEventWrapper EventWrapper
+
+func (w *AlertEventWrapper) UnmarshalJSON(b []byte) error {
+	wrapper := EventWrapper{}
+
+	err := json.Unmarshal(b, &wrapper)
+	if err != nil {
+		return err
+	}
+
+	mapped, ok := wrapper.EventData.(map[string]interface{})
+	if !ok {
+		return errors.New("Error type assertion")
+	}
+
+	f := mapstructure.ComposeDecodeHookFunc(
+		UIDHook(),
+		TimeHook(time.RFC3339),
+	)
+
+	switch wrapper.EventName {
+	case "AlertTriggered":
+		e := domain.AlertTriggered{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "AlertAcknowledged":
+		e := domain.AlertAcknowledged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "AlertResolved":
+		e := domain.AlertResolved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+	}
+
+	return nil
+}
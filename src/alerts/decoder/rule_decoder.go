@@ -0,0 +1,75 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/mitchellh/mapstructure"
+)
+
+type RuleEventWrapper EventWrapper
+
+func (w *RuleEventWrapper) UnmarshalJSON(b []byte) error {
+	wrapper := EventWrapper{}
+
+	err := json.Unmarshal(b, &wrapper)
+	if err != nil {
+		return err
+	}
+
+	mapped, ok := wrapper.EventData.(map[string]interface{})
+	if !ok {
+		return errors.New("Error type assertion")
+	}
+
+	f := mapstructure.ComposeDecodeHookFunc(
+		UIDHook(),
+		TimeHook(time.RFC3339),
+	)
+
+	switch wrapper.EventName {
+	case "RuleCreated":
+		e := domain.RuleCreated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "RuleChanged":
+		e := domain.RuleChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "RuleActivated":
+		e := domain.RuleActivated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "RuleDeactivated":
+		e := domain.RuleDeactivated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Alert is raised by a rule. It stays open until someone acknowledges it and then resolves it.
+type Alert struct {
+	UID              uuid.UUID
+	RuleUID          uuid.UUID
+	RuleName         string
+	Source           string
+	TargetUID        uuid.UUID
+	TargetName       string
+	Value            float32
+	Message          string
+	Status           string
+	TriggeredDate    time.Time
+	AcknowledgedDate *time.Time
+	ResolvedDate     *time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+const (
+	AlertStatusOpen         = "OPEN"
+	AlertStatusAcknowledged = "ACKNOWLEDGED"
+	AlertStatusResolved     = "RESOLVED"
+)
+
+// AlertTarget is the device, reservoir, material, task or climate zone which met the rule condition.
+// TargetMin and TargetMax are the target range of the parameter in the climate zone.
+type AlertTarget struct {
+	UID        uuid.UUID
+	Name       string
+	Parameter  string
+	AssetType  string
+	AssetUID   uuid.UUID
+	Value      float32
+	TargetMin  *float32
+	TargetMax  *float32
+	ObservedAt time.Time
+
+	// SourceUID is the device which observed the value, when a target has several
+	SourceUID uuid.UUID
+}
+
+func (state *Alert) TrackChange(event interface{}) {
+	state.UncommittedChanges = append(state.UncommittedChanges, event)
+	state.Transition(event)
+}
+
+func (state *Alert) Transition(event interface{}) {
+	switch e := event.(type) {
+	case AlertTriggered:
+		state.UID = e.UID
+		state.RuleUID = e.RuleUID
+		state.RuleName = e.RuleName
+		state.Source = e.Source
+		state.TargetUID = e.TargetUID
+		state.TargetName = e.TargetName
+		state.Value = e.Value
+		state.Message = e.Message
+		state.Status = AlertStatusOpen
+		state.TriggeredDate = e.TriggeredDate
+
+	case AlertAcknowledged:
+		state.Status = AlertStatusAcknowledged
+		state.AcknowledgedDate = &e.AcknowledgedDate
+
+	case AlertResolved:
+		state.Status = AlertStatusResolved
+		state.ResolvedDate = &e.ResolvedDate
+
+	}
+}
+
+// TriggerAlert raises an alert of the rule for the target
+func TriggerAlert(rule Rule, target AlertTarget) (*Alert, error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &Alert{}
+
+	initial.TrackChange(AlertTriggered{
+		UID:           uid,
+		RuleUID:       rule.UID,
+		RuleName:      rule.Name,
+		Source:        rule.Condition.Source,
+		TargetUID:     target.UID,
+		TargetName:    target.Name,
+		AssetType:     target.AssetType,
+		AssetUID:      target.AssetUID,
+		Value:         target.Value,
+		Message:       alertMessage(rule, target),
+		Notifiers:     rule.Action.Notifiers,
+		WebhookURL:    rule.Action.WebhookURL,
+		Email:         rule.Action.Email,
+		TaskDomain:    rule.Action.TaskDomain,
+		TaskCategory:  rule.Action.TaskCategory,
+		TriggeredDate: time.Now(),
+	})
+
+	return initial, nil
+}
+
+func (a *Alert) Acknowledge() error {
+	if a.Status != AlertStatusOpen {
+		return AlertError{AlertErrorNotOpenCode}
+	}
+
+	a.TrackChange(AlertAcknowledged{
+		AlertUID:         a.UID,
+		AcknowledgedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// Resolve closes the alert. An open alert can be resolved without being acknowledged first.
+func (a *Alert) Resolve() error {
+	if a.Status == AlertStatusResolved {
+		return AlertError{AlertErrorAlreadyResolvedCode}
+	}
+
+	a.TrackChange(AlertResolved{
+		AlertUID:     a.UID,
+		ResolvedDate: time.Now(),
+	})
+
+	return nil
+}
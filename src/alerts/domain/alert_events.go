@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// AlertTriggered carries the rule action, so the notifiers and the task module
+// don't have to look up the rule, which may have changed since.
+type AlertTriggered struct {
+	UID           uuid.UUID
+	RuleUID       uuid.UUID
+	RuleName      string
+	Source        string
+	TargetUID     uuid.UUID
+	TargetName    string
+	AssetType     string
+	AssetUID      uuid.UUID
+	Value         float32
+	Message       string
+	Notifiers     []string
+	WebhookURL    string
+	Email         string
+	TaskDomain    string
+	TaskCategory  string
+	TriggeredDate time.Time
+}
+
+type AlertAcknowledged struct {
+	AlertUID         uuid.UUID
+	AcknowledgedDate time.Time
+}
+
+type AlertResolved struct {
+	AlertUID     uuid.UUID
+	ResolvedDate time.Time
+}
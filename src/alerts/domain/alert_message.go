@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+var operatorTexts = map[string]string{
+	RuleOperatorGreaterThan:        "above",
+	RuleOperatorGreaterThanOrEqual: "at or above",
+	RuleOperatorLessThan:           "below",
+	RuleOperatorLessThanOrEqual:    "at or below",
+}
+
+func alertMessage(rule Rule, target AlertTarget) string {
+	if rule.Condition.Source == RuleSourceTaskDue {
+		return fmt.Sprintf("%s: task %s is due", rule.Name, target.Name)
+	}
+
+	message := fmt.Sprintf("%s: %s %s is %g, %s %g",
+		rule.Name, target.Name, target.Parameter, target.Value,
+		operatorTexts[rule.Condition.Operator], rule.Condition.Threshold)
+
+	if rule.Condition.Operator == RuleOperatorOutsideTarget {
+		message = fmt.Sprintf("%s: %s %s is %g, outside the target range %s",
+			rule.Name, target.Name, target.Parameter, target.Value, targetRangeText(target))
+	}
+
+	if rule.Condition.Duration > 0 {
+		message += fmt.Sprintf(" for %s", time.Duration(rule.Condition.Duration)*time.Minute)
+	}
+
+	return message
+}
+
+func targetRangeText(target AlertTarget) string {
+	switch {
+	case target.TargetMin != nil && target.TargetMax != nil:
+		return fmt.Sprintf("%g to %g", *target.TargetMin, *target.TargetMax)
+	case target.TargetMin != nil:
+		return fmt.Sprintf("of at least %g", *target.TargetMin)
+	case target.TargetMax != nil:
+		return fmt.Sprintf("of at most %g", *target.TargetMax)
+	}
+
+	return "not set"
+}
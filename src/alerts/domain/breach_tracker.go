@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// BreachTracker remembers since when the condition of each rule is met for each target and source,
+// to raise the alert only when it is met for the whole rule duration. The readings of each device
+// of a climate zone are tracked apart, so a sensor back in range doesn't restart another one's breach.
+// It is kept in memory, so a restart starts the durations over.
+type BreachTracker struct {
+	lock  sync.Mutex
+	since map[breachKey]time.Time
+}
+
+type breachKey struct {
+	RuleUID   uuid.UUID
+	TargetUID uuid.UUID
+	SourceUID uuid.UUID
+}
+
+func NewBreachTracker() *BreachTracker {
+	return &BreachTracker{since: map[breachKey]time.Time{}}
+}
+
+// Observe records the value observed at the date and tells whether the rule condition
+// has been met long enough. The tracking restarts once it has, so a condition which
+// keeps being met raises again after another duration.
+func (t *BreachTracker) Observe(rule Rule, targetUID, sourceUID uuid.UUID, value float32, observedDate time.Time) bool {
+	return t.ObserveBreach(rule, targetUID, sourceUID, rule.Condition.IsMet(value), observedDate)
+}
+
+// ObserveBreach records whether the rule condition is met at the date, for the conditions which
+// don't only depend on the value, and tells whether it has been met long enough.
+func (t *BreachTracker) ObserveBreach(rule Rule, targetUID, sourceUID uuid.UUID, breached bool, observedDate time.Time) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := breachKey{RuleUID: rule.UID, TargetUID: targetUID, SourceUID: sourceUID}
+
+	if !breached {
+		delete(t.since, key)
+		return false
+	}
+
+	since, ok := t.since[key]
+	if !ok {
+		since = observedDate
+		t.since[key] = since
+	}
+
+	if observedDate.Sub(since) < time.Duration(rule.Condition.Duration)*time.Minute {
+		return false
+	}
+
+	delete(t.since, key)
+	return true
+}
@@ -0,0 +1,337 @@
+package domain
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/validationhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Rule is a condition over the farm data which raises an alert when it is met.
+type Rule struct {
+	UID         uuid.UUID
+	Name        string
+	Condition   RuleCondition
+	Action      RuleAction
+	IsActive    bool
+	CreatedDate time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+// Sources of the data a rule watches
+const (
+	RuleSourceSensorReading        = "SENSOR_READING"
+	RuleSourceReservoirMeasurement = "RESERVOIR_MEASUREMENT"
+	RuleSourceMaterialStock        = "MATERIAL_STOCK"
+	RuleSourceTaskDue              = "TASK_DUE"
+	RuleSourceClimateZone          = "CLIMATE_ZONE"
+)
+
+// Reservoir measurement parameters a rule can watch
+const (
+	RuleParameterPH              = "PH"
+	RuleParameterEC              = "EC"
+	RuleParameterTemperature     = "TEMPERATURE"
+	RuleParameterDissolvedOxygen = "DISSOLVED_OXYGEN"
+)
+
+// Climate parameters of a climate zone a rule can watch
+const (
+	RuleParameterHumidity = "HUMIDITY"
+	RuleParameterCO2      = "CO2"
+	RuleParameterLight    = "LIGHT"
+)
+
+const (
+	RuleOperatorGreaterThan        = "GT"
+	RuleOperatorGreaterThanOrEqual = "GTE"
+	RuleOperatorLessThan           = "LT"
+	RuleOperatorLessThanOrEqual    = "LTE"
+
+	// RuleOperatorOutsideTarget compares the value with the target range of the climate zone
+	// instead of a threshold
+	RuleOperatorOutsideTarget = "OUTSIDE_TARGET"
+)
+
+// Notifiers an alert can be dispatched through
+const (
+	NotifierInApp   = "IN_APP"
+	NotifierWebhook = "WEBHOOK"
+	NotifierEmail   = "EMAIL"
+)
+
+type RuleSource struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+func RuleSources() []RuleSource {
+	return []RuleSource{
+		{Code: RuleSourceSensorReading, Name: "Sensor Reading"},
+		{Code: RuleSourceReservoirMeasurement, Name: "Reservoir Measurement"},
+		{Code: RuleSourceMaterialStock, Name: "Material Stock"},
+		{Code: RuleSourceTaskDue, Name: "Task Due"},
+		{Code: RuleSourceClimateZone, Name: "Climate Zone"},
+	}
+}
+
+// RuleCondition is what the rule watches.
+//
+// TargetUID narrows the rule to one device, reservoir, material, task or climate zone,
+// and is nil to watch all of them. Parameter is the sensor type of a sensor reading
+// rule, the measured parameter of a reservoir measurement rule or the climate
+// parameter of a climate zone rule.
+// The condition raises an alert when the value stays beyond the threshold
+// for Duration minutes. Task due rules have no threshold, and the climate zone
+// rules may use the target range of the zone instead of a threshold.
+type RuleCondition struct {
+	Source    string     `json:"source"`
+	TargetUID *uuid.UUID `json:"target_uid"`
+	Parameter string     `json:"parameter"`
+	Operator  string     `json:"operator"`
+	Threshold float32    `json:"threshold"`
+	Duration  int        `json:"duration"`
+}
+
+// RuleAction is what happens when the rule raises an alert.
+// An empty TaskDomain means no task is created for the alert.
+type RuleAction struct {
+	Notifiers    []string `json:"notifiers"`
+	WebhookURL   string   `json:"webhook_url"`
+	Email        string   `json:"email"`
+	TaskDomain   string   `json:"task_domain"`
+	TaskCategory string   `json:"task_category"`
+}
+
+// IsMet tells whether the value is beyond the threshold of the condition
+func (c RuleCondition) IsMet(value float32) bool {
+	switch c.Operator {
+	case RuleOperatorGreaterThan:
+		return value > c.Threshold
+	case RuleOperatorGreaterThanOrEqual:
+		return value >= c.Threshold
+	case RuleOperatorLessThan:
+		return value < c.Threshold
+	case RuleOperatorLessThanOrEqual:
+		return value <= c.Threshold
+	}
+
+	return false
+}
+
+// IsBreachedBy tells whether the value observed on the target is beyond the threshold of the
+// condition, or outside the target range of the target when the condition has no threshold.
+// A target range without bounds is never breached.
+func (c RuleCondition) IsBreachedBy(target AlertTarget) bool {
+	if c.Operator != RuleOperatorOutsideTarget {
+		return c.IsMet(target.Value)
+	}
+
+	if target.TargetMin != nil && target.Value < *target.TargetMin {
+		return true
+	}
+
+	return target.TargetMax != nil && target.Value > *target.TargetMax
+}
+
+// Matches tells whether the rule watches the target
+func (c RuleCondition) Matches(source, parameter string, targetUID uuid.UUID) bool {
+	if c.Source != source {
+		return false
+	}
+
+	if c.Parameter != "" && c.Parameter != parameter {
+		return false
+	}
+
+	return c.TargetUID == nil || *c.TargetUID == targetUID
+}
+
+func (state *Rule) TrackChange(event interface{}) {
+	state.UncommittedChanges = append(state.UncommittedChanges, event)
+	state.Transition(event)
+}
+
+func (state *Rule) Transition(event interface{}) {
+	switch e := event.(type) {
+	case RuleCreated:
+		state.UID = e.UID
+		state.Name = e.Name
+		state.Condition = e.Condition
+		state.Action = e.Action
+		state.IsActive = true
+		state.CreatedDate = e.CreatedDate
+
+	case RuleChanged:
+		state.Name = e.Name
+		state.Condition = e.Condition
+		state.Action = e.Action
+
+	case RuleActivated:
+		state.IsActive = true
+
+	case RuleDeactivated:
+		state.IsActive = false
+
+	}
+}
+
+// CreateRule registers a new active rule
+func CreateRule(name string, condition RuleCondition, action RuleAction) (*Rule, error) {
+	err := validateRule(name, condition, action)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &Rule{}
+
+	initial.TrackChange(RuleCreated{
+		UID:         uid,
+		Name:        name,
+		Condition:   condition,
+		Action:      action,
+		CreatedDate: time.Now(),
+	})
+
+	return initial, nil
+}
+
+// Change replaces the name, condition and action of the rule
+func (r *Rule) Change(name string, condition RuleCondition, action RuleAction) error {
+	err := validateRule(name, condition, action)
+	if err != nil {
+		return err
+	}
+
+	r.TrackChange(RuleChanged{
+		RuleUID:   r.UID,
+		Name:      name,
+		Condition: condition,
+		Action:    action,
+	})
+
+	return nil
+}
+
+func (r *Rule) Activate() error {
+	if r.IsActive {
+		return RuleError{RuleErrorAlreadyActiveCode}
+	}
+
+	r.TrackChange(RuleActivated{RuleUID: r.UID})
+
+	return nil
+}
+
+func (r *Rule) Deactivate() error {
+	if !r.IsActive {
+		return RuleError{RuleErrorAlreadyInactiveCode}
+	}
+
+	r.TrackChange(RuleDeactivated{RuleUID: r.UID})
+
+	return nil
+}
+
+func validateRule(name string, condition RuleCondition, action RuleAction) error {
+	err := validateRuleName(name)
+	if err != nil {
+		return err
+	}
+
+	err = validateRuleCondition(condition)
+	if err != nil {
+		return err
+	}
+
+	return validateRuleAction(action)
+}
+
+func validateRuleName(name string) error {
+	if name == "" {
+		return RuleError{RuleErrorNameEmptyCode}
+	}
+	if !validationhelper.IsAlphanumSpaceHyphenUnderscore(name) {
+		return RuleError{RuleErrorNameAlphanumericOnlyCode}
+	}
+	if len(name) > 100 {
+		return RuleError{RuleErrorNameExceedMaximumCharacterCode}
+	}
+
+	return nil
+}
+
+func validateRuleCondition(condition RuleCondition) error {
+	switch condition.Source {
+	case RuleSourceSensorReading, RuleSourceMaterialStock:
+	case RuleSourceReservoirMeasurement:
+		switch condition.Parameter {
+		case RuleParameterPH, RuleParameterEC, RuleParameterTemperature, RuleParameterDissolvedOxygen:
+		default:
+			return RuleError{RuleErrorInvalidParameterCode}
+		}
+	case RuleSourceClimateZone:
+		switch condition.Parameter {
+		case RuleParameterTemperature, RuleParameterHumidity, RuleParameterCO2, RuleParameterLight:
+		default:
+			return RuleError{RuleErrorInvalidParameterCode}
+		}
+	case RuleSourceTaskDue:
+		// A task is due or not, there is nothing to compare
+		return nil
+	default:
+		return RuleError{RuleErrorInvalidSourceCode}
+	}
+
+	switch condition.Operator {
+	case RuleOperatorGreaterThan, RuleOperatorGreaterThanOrEqual, RuleOperatorLessThan, RuleOperatorLessThanOrEqual:
+	case RuleOperatorOutsideTarget:
+		// Only the climate zones have a target range
+		if condition.Source != RuleSourceClimateZone {
+			return RuleError{RuleErrorInvalidOperatorCode}
+		}
+	default:
+		return RuleError{RuleErrorInvalidOperatorCode}
+	}
+
+	if condition.Duration < 0 {
+		return RuleError{RuleErrorInvalidDurationCode}
+	}
+
+	return nil
+}
+
+func validateRuleAction(action RuleAction) error {
+	for _, v := range action.Notifiers {
+		switch v {
+		case NotifierInApp:
+		case NotifierWebhook:
+			if !strings.HasPrefix(action.WebhookURL, "http://") && !strings.HasPrefix(action.WebhookURL, "https://") {
+				return RuleError{RuleErrorInvalidWebhookURLCode}
+			}
+		case NotifierEmail:
+			address, err := mail.ParseAddress(action.Email)
+			if err != nil || address.Address != action.Email {
+				return RuleError{RuleErrorInvalidEmailCode}
+			}
+		default:
+			return RuleError{RuleErrorInvalidNotifierCode}
+		}
+	}
+
+	if action.TaskDomain != "" && action.TaskCategory == "" {
+		return RuleError{RuleErrorTaskCategoryRequiredCode}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+const (
+	RuleErrorNameEmptyCode = iota
+	RuleErrorNameAlphanumericOnlyCode
+	RuleErrorNameExceedMaximumCharacterCode
+	RuleErrorInvalidSourceCode
+	RuleErrorInvalidParameterCode
+	RuleErrorInvalidOperatorCode
+	RuleErrorInvalidDurationCode
+	RuleErrorInvalidNotifierCode
+	RuleErrorInvalidWebhookURLCode
+	RuleErrorInvalidEmailCode
+	RuleErrorTaskCategoryRequiredCode
+	RuleErrorAlreadyActiveCode
+	RuleErrorAlreadyInactiveCode
+)
+
+// RuleError is a custom error from Go built-in error
+type RuleError struct {
+	Code int
+}
+
+func (e RuleError) Error() string {
+	switch e.Code {
+	case RuleErrorNameEmptyCode:
+		return "Rule name is required"
+	case RuleErrorNameAlphanumericOnlyCode:
+		return "Rule name should be alphanumeric, space, hypen, or underscore"
+	case RuleErrorNameExceedMaximumCharacterCode:
+		return "Rule name cannot more than 100 characters"
+	case RuleErrorInvalidSourceCode:
+		return "Invalid rule source"
+	case RuleErrorInvalidParameterCode:
+		return "Invalid parameter for the rule source"
+	case RuleErrorInvalidOperatorCode:
+		return "Invalid rule operator"
+	case RuleErrorInvalidDurationCode:
+		return "Rule duration cannot be negative"
+	case RuleErrorInvalidNotifierCode:
+		return "Invalid notifier"
+	case RuleErrorInvalidWebhookURLCode:
+		return "Webhook URL should be a HTTP or HTTPS URL"
+	case RuleErrorInvalidEmailCode:
+		return "Invalid notification email"
+	case RuleErrorTaskCategoryRequiredCode:
+		return "Task category is required to create a task for the alert"
+	case RuleErrorAlreadyActiveCode:
+		return "Rule is already active"
+	case RuleErrorAlreadyInactiveCode:
+		return "Rule is already inactive"
+	default:
+		return "Unrecognized Rule Error Code"
+	}
+}
+
+const (
+	AlertErrorNotOpenCode = iota
+	AlertErrorAlreadyResolvedCode
+)
+
+// AlertError is a custom error from Go built-in error
+type AlertError struct {
+	Code int
+}
+
+func (e AlertError) Error() string {
+	switch e.Code {
+	case AlertErrorNotOpenCode:
+		return "Only an open alert can be acknowledged"
+	case AlertErrorAlreadyResolvedCode:
+		return "Alert is already resolved"
+	default:
+		return "Unrecognized Alert Error Code"
+	}
+}
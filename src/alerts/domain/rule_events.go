@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleCreated struct {
+	UID         uuid.UUID
+	Name        string
+	Condition   RuleCondition
+	Action      RuleAction
+	CreatedDate time.Time
+}
+
+type RuleChanged struct {
+	RuleUID   uuid.UUID
+	Name      string
+	Condition RuleCondition
+	Action    RuleAction
+}
+
+type RuleActivated struct {
+	RuleUID uuid.UUID
+}
+
+type RuleDeactivated struct {
+	RuleUID uuid.UUID
+}
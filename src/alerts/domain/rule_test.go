@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRule(t *testing.T) {
+	// Given
+	condition := RuleCondition{
+		Source:    RuleSourceReservoirMeasurement,
+		Parameter: RuleParameterEC,
+		Operator:  RuleOperatorGreaterThan,
+		Threshold: 2.5,
+		Duration:  30,
+	}
+
+	// When
+	rule, err := CreateRule("Reservoir EC high", condition, RuleAction{Notifiers: []string{NotifierInApp}})
+	_, errParameter := CreateRule("Reservoir EC high", RuleCondition{Source: RuleSourceReservoirMeasurement, Operator: RuleOperatorGreaterThan}, RuleAction{})
+	_, errWebhook := CreateRule("Reservoir EC high", condition, RuleAction{Notifiers: []string{NotifierWebhook}, WebhookURL: "ftp://example.com"})
+	_, errEmail := CreateRule("Reservoir EC high", condition, RuleAction{Notifiers: []string{NotifierEmail}, Email: "grower@"})
+	_, errEmailName := CreateRule("Reservoir EC high", condition, RuleAction{Notifiers: []string{NotifierEmail}, Email: "Grower <grower@example.com>"})
+	_, errEmailValid := CreateRule("Reservoir EC high", condition, RuleAction{Notifiers: []string{NotifierEmail}, Email: "grower@example.com"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, rule.IsActive)
+	assert.Equal(t, RuleError{RuleErrorInvalidParameterCode}, errParameter)
+	assert.Equal(t, RuleError{RuleErrorInvalidWebhookURLCode}, errWebhook)
+	assert.Equal(t, RuleError{RuleErrorInvalidEmailCode}, errEmail)
+	assert.Equal(t, RuleError{RuleErrorInvalidEmailCode}, errEmailName)
+	assert.Nil(t, errEmailValid)
+
+	event, ok := rule.UncommittedChanges[0].(RuleCreated)
+	assert.True(t, ok)
+	assert.Equal(t, rule.UID, event.UID)
+
+	// When
+	errDeactivate := rule.Deactivate()
+	errDeactivateAgain := rule.Deactivate()
+
+	// Then
+	assert.Nil(t, errDeactivate)
+	assert.False(t, rule.IsActive)
+	assert.Equal(t, RuleError{RuleErrorAlreadyInactiveCode}, errDeactivateAgain)
+}
+
+func TestBreachTracker(t *testing.T) {
+	// Given
+	rule, _ := CreateRule("Reservoir EC high", RuleCondition{
+		Source:    RuleSourceReservoirMeasurement,
+		Parameter: RuleParameterEC,
+		Operator:  RuleOperatorGreaterThan,
+		Threshold: 2.5,
+		Duration:  30,
+	}, RuleAction{})
+
+	tracker := NewBreachTracker()
+	reservoirUID, _ := uuid.NewV4()
+	start := time.Now()
+
+	// When
+	first := tracker.Observe(*rule, reservoirUID, uuid.UUID{}, 2.8, start)
+	normal := tracker.Observe(*rule, reservoirUID, uuid.UUID{}, 2.1, start.Add(10*time.Minute))
+	restarted := tracker.Observe(*rule, reservoirUID, uuid.UUID{}, 2.9, start.Add(20*time.Minute))
+	tooSoon := tracker.Observe(*rule, reservoirUID, uuid.UUID{}, 3.0, start.Add(40*time.Minute))
+	breached := tracker.Observe(*rule, reservoirUID, uuid.UUID{}, 3.1, start.Add(50*time.Minute))
+
+	// Then
+	assert.False(t, first)
+	assert.False(t, normal)
+	assert.False(t, restarted)
+	assert.False(t, tooSoon)
+	assert.True(t, breached)
+
+	// When
+	deviceAUID, _ := uuid.NewV4()
+	deviceBUID, _ := uuid.NewV4()
+	firstA := tracker.Observe(*rule, reservoirUID, deviceAUID, 2.8, start)
+	normalB := tracker.Observe(*rule, reservoirUID, deviceBUID, 2.1, start.Add(10*time.Minute))
+	breachedA := tracker.Observe(*rule, reservoirUID, deviceAUID, 2.9, start.Add(30*time.Minute))
+
+	// Then a device in range doesn't restart the breach of another one
+	assert.False(t, firstA)
+	assert.False(t, normalB)
+	assert.True(t, breachedA)
+}
+
+func TestClimateZoneRule(t *testing.T) {
+	// Given
+	condition := RuleCondition{
+		Source:    RuleSourceClimateZone,
+		Parameter: RuleParameterTemperature,
+		Operator:  RuleOperatorOutsideTarget,
+	}
+
+	min, max := float32(18), float32(26)
+	zoneUID, _ := uuid.NewV4()
+
+	// When
+	_, err := CreateRule("Greenhouse too hot", condition, RuleAction{})
+	_, errParameter := CreateRule("Greenhouse too hot", RuleCondition{Source: RuleSourceClimateZone, Parameter: RuleParameterEC, Operator: RuleOperatorOutsideTarget}, RuleAction{})
+	_, errOperator := CreateRule("Reservoir EC high", RuleCondition{Source: RuleSourceReservoirMeasurement, Parameter: RuleParameterEC, Operator: RuleOperatorOutsideTarget}, RuleAction{})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, RuleError{RuleErrorInvalidParameterCode}, errParameter)
+	assert.Equal(t, RuleError{RuleErrorInvalidOperatorCode}, errOperator)
+
+	assert.False(t, condition.IsBreachedBy(AlertTarget{UID: zoneUID, Value: 22, TargetMin: &min, TargetMax: &max}))
+	assert.True(t, condition.IsBreachedBy(AlertTarget{UID: zoneUID, Value: 30, TargetMin: &min, TargetMax: &max}))
+	assert.True(t, condition.IsBreachedBy(AlertTarget{UID: zoneUID, Value: 12, TargetMin: &min}))
+	assert.False(t, condition.IsBreachedBy(AlertTarget{UID: zoneUID, Value: 12}))
+}
+
+func TestAlertLifecycle(t *testing.T) {
+	// Given
+	rule, _ := CreateRule("Seed stock low", RuleCondition{
+		Source:    RuleSourceMaterialStock,
+		Operator:  RuleOperatorLessThan,
+		Threshold: 100,
+	}, RuleAction{TaskDomain: "INVENTORY", TaskCategory: "INVENTORY"})
+
+	materialUID, _ := uuid.NewV4()
+
+	// When
+	alert, err := TriggerAlert(*rule, AlertTarget{UID: materialUID, Name: "Tomato seeds", Parameter: "stock", Value: 80})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, AlertStatusOpen, alert.Status)
+	assert.Equal(t, "Seed stock low: Tomato seeds stock is 80, below 100", alert.Message)
+
+	event, ok := alert.UncommittedChanges[0].(AlertTriggered)
+	assert.True(t, ok)
+	assert.Equal(t, "INVENTORY", event.TaskDomain)
+
+	// When
+	errAcknowledge := alert.Acknowledge()
+	errAcknowledgeAgain := alert.Acknowledge()
+	errResolve := alert.Resolve()
+	errResolveAgain := alert.Resolve()
+
+	// Then
+	assert.Nil(t, errAcknowledge)
+	assert.Equal(t, AlertError{AlertErrorNotOpenCode}, errAcknowledgeAgain)
+	assert.Nil(t, errResolve)
+	assert.Equal(t, AlertError{AlertErrorAlreadyResolvedCode}, errResolveAgain)
+	assert.Equal(t, AlertStatusResolved, alert.Status)
+}
@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertEventQueryInMemory struct {
+	Storage *storage.AlertEventStorage
+}
+
+func NewAlertEventQueryInMemory(s *storage.AlertEventStorage) query.AlertEventQuery {
+	return &AlertEventQueryInMemory{Storage: s}
+}
+
+func (f *AlertEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.AlertEvent{}
+		for _, v := range f.Storage.AlertEvents {
+			if v.AlertUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
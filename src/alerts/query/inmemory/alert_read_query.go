@@ -0,0 +1,84 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertReadQueryInMemory struct {
+	Storage *storage.AlertReadStorage
+}
+
+func NewAlertReadQueryInMemory(s *storage.AlertReadStorage) query.AlertReadQuery {
+	return &AlertReadQueryInMemory{Storage: s}
+}
+
+func (f *AlertReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.AlertReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *AlertReadQueryInMemory) FindAll(status string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		alerts := []storage.AlertRead{}
+		for _, v := range f.Storage.AlertReadMap {
+			if status != "" && v.Status != status {
+				continue
+			}
+
+			alerts = append(alerts, v)
+		}
+
+		sort.Slice(alerts, func(i, j int) bool {
+			return alerts[i].TriggeredDate.After(alerts[j].TriggeredDate)
+		})
+
+		result <- query.QueryResult{Result: alerts}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *AlertReadQueryInMemory) FindUnresolved(ruleUID, targetUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		alert := storage.AlertRead{}
+		for _, v := range f.Storage.AlertReadMap {
+			if v.RuleUID == ruleUID && v.TargetUID == targetUID && v.Status != domain.AlertStatusResolved {
+				alert = v
+				break
+			}
+		}
+
+		result <- query.QueryResult{Result: alert}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,75 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	assetsdomain "github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneQueryInMemory struct {
+	Storage     *storage.ClimateZoneReadStorage
+	AreaStorage *storage.AreaReadStorage
+}
+
+func NewClimateZoneQueryInMemory(s *storage.ClimateZoneReadStorage, areaStorage *storage.AreaReadStorage) query.ClimateZoneQuery {
+	return ClimateZoneQueryInMemory{Storage: s, AreaStorage: areaStorage}
+}
+
+func (s ClimateZoneQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		climateZone := query.ClimateZoneQueryResult{}
+		if val, ok := s.Storage.ClimateZoneReadMap[uid]; ok {
+			climateZone = climateZoneQueryResult(val)
+		}
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s ClimateZoneQueryInMemory) FindByAreaID(areaUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.AreaStorage.Lock.RLock()
+		area := s.AreaStorage.AreaReadMap[areaUID]
+		s.AreaStorage.Lock.RUnlock()
+
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		climateZone := query.ClimateZoneQueryResult{}
+		if val, ok := s.Storage.ClimateZoneReadMap[area.ClimateZone.UID]; ok {
+			climateZone = climateZoneQueryResult(val)
+		}
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func climateZoneQueryResult(climateZone storage.ClimateZoneRead) query.ClimateZoneQueryResult {
+	targets := map[string]query.ClimateTargetQueryResult{}
+	for _, parameter := range assetsdomain.ClimateParameters() {
+		r, _ := assetsdomain.ClimateTargets(climateZone.Targets).Range(parameter)
+		targets[parameter] = query.ClimateTargetQueryResult{Min: r.Min, Max: r.Max}
+	}
+
+	return query.ClimateZoneQueryResult{
+		UID:     climateZone.UID,
+		Name:    climateZone.Name,
+		Targets: targets,
+	}
+}
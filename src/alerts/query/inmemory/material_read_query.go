@@ -0,0 +1,38 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type MaterialQueryInMemory struct {
+	Storage *storage.MaterialReadStorage
+}
+
+func NewMaterialQueryInMemory(s *storage.MaterialReadStorage) query.MaterialQuery {
+	return MaterialQueryInMemory{Storage: s}
+}
+
+func (s MaterialQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		material := query.AlertTargetQueryResult{}
+		if val, ok := s.Storage.MaterialReadMap[uid]; ok {
+			material.UID = val.UID
+			material.Name = val.Name
+			material.Quantity = val.Quantity.Value
+			material.QuantityUnit = val.Quantity.Unit.Code
+		}
+
+		result <- query.QueryResult{Result: material}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleEventQueryInMemory struct {
+	Storage *storage.RuleEventStorage
+}
+
+func NewRuleEventQueryInMemory(s *storage.RuleEventStorage) query.RuleEventQuery {
+	return &RuleEventQueryInMemory{Storage: s}
+}
+
+func (f *RuleEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.RuleEvent{}
+		for _, v := range f.Storage.RuleEvents {
+			if v.RuleUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,82 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleReadQueryInMemory struct {
+	Storage *storage.RuleReadStorage
+}
+
+func NewRuleReadQueryInMemory(s *storage.RuleReadStorage) query.RuleReadQuery {
+	return &RuleReadQueryInMemory{Storage: s}
+}
+
+func (f *RuleReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.RuleReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *RuleReadQueryInMemory) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		rules := []storage.RuleRead{}
+		for _, v := range f.Storage.RuleReadMap {
+			rules = append(rules, v)
+		}
+
+		sort.Slice(rules, func(i, j int) bool {
+			return rules[i].CreatedDate.Before(rules[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: rules}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *RuleReadQueryInMemory) FindAllActiveBySource(source string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		rules := []storage.RuleRead{}
+		for _, v := range f.Storage.RuleReadMap {
+			if v.IsActive && v.Condition.Source == source {
+				rules = append(rules, v)
+			}
+		}
+
+		sort.Slice(rules, func(i, j int) bool {
+			return rules[i].CreatedDate.Before(rules[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: rules}
+
+		close(result)
+	}()
+
+	return result
+}
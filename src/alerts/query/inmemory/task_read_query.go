@@ -0,0 +1,40 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskQueryInMemory struct {
+	Storage *storage.TaskReadStorage
+}
+
+func NewTaskQueryInMemory(s *storage.TaskReadStorage) query.TaskQuery {
+	return TaskQueryInMemory{Storage: s}
+}
+
+func (s TaskQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		task := query.AlertTargetQueryResult{}
+		if val, ok := s.Storage.TaskReadMap[uid]; ok {
+			task.UID = val.UID
+			task.Name = val.Title
+			task.AssetType = val.Domain
+			if val.AssetID != nil {
+				task.AssetUID = *val.AssetID
+			}
+		}
+
+		result <- query.QueryResult{Result: task}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/decoder"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAlertEventQueryMysql(db *sql.DB) query.AlertEventQuery {
+	return &AlertEventQueryMysql{DB: db}
+}
+
+func (f *AlertEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.AlertEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM ALERT_EVENT WHERE ALERT_UID = ? ORDER BY VERSION ASC", uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			AlertUID    []byte
+			Version     int
+			CreatedDate time.Time
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.AlertUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.AlertEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			alertUID, err := uuid.FromBytes(rowsData.AlertUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate := rowsData.CreatedDate
+
+			events = append(events, storage.AlertEvent{
+				AlertUID:    alertUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,190 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAlertReadQueryMysql(db *sql.DB) query.AlertReadQuery {
+	return &AlertReadQueryMysql{DB: db}
+}
+
+type alertReadResult struct {
+	UID              []byte
+	RuleUID          []byte
+	RuleName         string
+	Source           string
+	TargetUID        []byte
+	TargetName       string
+	Value            float32
+	Message          string
+	Status           string
+	TriggeredDate    time.Time
+	AcknowledgedDate *time.Time
+	ResolvedDate     *time.Time
+}
+
+func (f *AlertReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM ALERT_READ WHERE UID = ?`, uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alerts, err := scanAlertReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alert := storage.AlertRead{}
+		if len(alerts) > 0 {
+			alert = alerts[0]
+		}
+
+		result <- query.QueryResult{Result: alert}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *AlertReadQueryMysql) FindAll(status string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := "SELECT * FROM ALERT_READ WHERE 1 = 1"
+		params := []interface{}{}
+
+		if status != "" {
+			sql += " AND STATUS = ?"
+			params = append(params, status)
+		}
+
+		sql += " ORDER BY TRIGGERED_DATE DESC"
+
+		rows, err := f.DB.Query(sql, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alerts, err := scanAlertReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: alerts}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *AlertReadQueryMysql) FindUnresolved(ruleUID, targetUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM ALERT_READ
+			WHERE RULE_UID = ? AND TARGET_UID = ? AND STATUS != ?
+			LIMIT 1`, ruleUID.Bytes(), targetUID.Bytes(), domain.AlertStatusResolved)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alerts, err := scanAlertReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alert := storage.AlertRead{}
+		if len(alerts) > 0 {
+			alert = alerts[0]
+		}
+
+		result <- query.QueryResult{Result: alert}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanAlertReads(rows *sql.Rows) ([]storage.AlertRead, error) {
+	defer rows.Close()
+
+	alerts := []storage.AlertRead{}
+	for rows.Next() {
+		rowsData := alertReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.RuleUID,
+			&rowsData.RuleName,
+			&rowsData.Source,
+			&rowsData.TargetUID,
+			&rowsData.TargetName,
+			&rowsData.Value,
+			&rowsData.Message,
+			&rowsData.Status,
+			&rowsData.TriggeredDate,
+			&rowsData.AcknowledgedDate,
+			&rowsData.ResolvedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleUID, err := uuid.FromBytes(rowsData.RuleUID)
+		if err != nil {
+			return nil, err
+		}
+
+		targetUID, err := uuid.FromBytes(rowsData.TargetUID)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, storage.AlertRead{
+			UID:              uid,
+			RuleUID:          ruleUID,
+			RuleName:         rowsData.RuleName,
+			Source:           rowsData.Source,
+			TargetUID:        targetUID,
+			TargetName:       rowsData.TargetName,
+			Value:            rowsData.Value,
+			Message:          rowsData.Message,
+			Status:           rowsData.Status,
+			TriggeredDate:    rowsData.TriggeredDate,
+			AcknowledgedDate: rowsData.AcknowledgedDate,
+			ResolvedDate:     rowsData.ResolvedDate,
+		})
+	}
+
+	return alerts, rows.Err()
+}
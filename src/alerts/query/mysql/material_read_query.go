@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type MaterialQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewMaterialQueryMysql(db *sql.DB) query.MaterialQuery {
+	return MaterialQueryMysql{DB: db}
+}
+
+func (s MaterialQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID          []byte
+			Name         string
+			Quantity     float32
+			QuantityUnit string
+		}{}
+		material := query.AlertTargetQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, QUANTITY, QUANTITY_UNIT
+			FROM MATERIAL_READ WHERE UID = ?`, uid.Bytes()).Scan(
+			&rowsData.UID, &rowsData.Name, &rowsData.Quantity, &rowsData.QuantityUnit)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: material}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		materialUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		material.UID = materialUID
+		material.Name = rowsData.Name
+		material.Quantity = rowsData.Quantity
+		material.QuantityUnit = rowsData.QuantityUnit
+
+		result <- query.QueryResult{Result: material}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,193 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewRuleReadQueryMysql(db *sql.DB) query.RuleReadQuery {
+	return &RuleReadQueryMysql{DB: db}
+}
+
+type ruleReadResult struct {
+	UID          []byte
+	Name         string
+	Source       string
+	TargetUID    []byte
+	Parameter    string
+	Operator     string
+	Threshold    float32
+	Duration     int
+	Notifiers    string
+	WebhookURL   string
+	Email        string
+	TaskDomain   string
+	TaskCategory string
+	IsActive     bool
+	CreatedDate  time.Time
+}
+
+func (f *RuleReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM RULE_READ WHERE UID = ?`, uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rules, err := scanRuleReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rule := storage.RuleRead{}
+		if len(rules) > 0 {
+			rule = rules[0]
+		}
+
+		result <- query.QueryResult{Result: rule}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *RuleReadQueryMysql) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM RULE_READ ORDER BY CREATED_DATE ASC`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rules, err := scanRuleReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: rules}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *RuleReadQueryMysql) FindAllActiveBySource(source string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM RULE_READ
+			WHERE SOURCE = ? AND IS_ACTIVE = 1
+			ORDER BY CREATED_DATE ASC`, source)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rules, err := scanRuleReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: rules}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanRuleReads(rows *sql.Rows) ([]storage.RuleRead, error) {
+	defer rows.Close()
+
+	rules := []storage.RuleRead{}
+	for rows.Next() {
+		rowsData := ruleReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Source,
+			&rowsData.TargetUID,
+			&rowsData.Parameter,
+			&rowsData.Operator,
+			&rowsData.Threshold,
+			&rowsData.Duration,
+			&rowsData.Notifiers,
+			&rowsData.WebhookURL,
+			&rowsData.Email,
+			&rowsData.TaskDomain,
+			&rowsData.TaskCategory,
+			&rowsData.IsActive,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		var targetUID *uuid.UUID
+		if rowsData.TargetUID != nil {
+			target, err := uuid.FromBytes(rowsData.TargetUID)
+			if err != nil {
+				return nil, err
+			}
+
+			targetUID = &target
+		}
+
+		notifiers := []string{}
+		if rowsData.Notifiers != "" {
+			notifiers = strings.Split(rowsData.Notifiers, ",")
+		}
+
+		rules = append(rules, storage.RuleRead{
+			UID:  uid,
+			Name: rowsData.Name,
+			Condition: storage.RuleCondition{
+				Source:    rowsData.Source,
+				TargetUID: targetUID,
+				Parameter: rowsData.Parameter,
+				Operator:  rowsData.Operator,
+				Threshold: rowsData.Threshold,
+				Duration:  rowsData.Duration,
+			},
+			Action: storage.RuleAction{
+				Notifiers:    notifiers,
+				WebhookURL:   rowsData.WebhookURL,
+				Email:        rowsData.Email,
+				TaskDomain:   rowsData.TaskDomain,
+				TaskCategory: rowsData.TaskCategory,
+			},
+			IsActive:    rowsData.IsActive,
+			CreatedDate: rowsData.CreatedDate,
+		})
+	}
+
+	return rules, rows.Err()
+}
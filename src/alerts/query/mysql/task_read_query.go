@@ -0,0 +1,74 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewTaskQueryMysql(db *sql.DB) query.TaskQuery {
+	return TaskQueryMysql{DB: db}
+}
+
+func (s TaskQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID        []byte
+			Title      string
+			DomainCode string
+			AssetID    []byte
+		}{}
+		task := query.AlertTargetQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, TITLE, DOMAIN_CODE, ASSET_ID
+			FROM TASK_READ WHERE UID = ?`, uid.Bytes()).Scan(
+			&rowsData.UID, &rowsData.Title, &rowsData.DomainCode, &rowsData.AssetID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: task}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		taskUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		if rowsData.AssetID != nil {
+			assetUID, err := uuid.FromBytes(rowsData.AssetID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			task.AssetUID = assetUID
+		}
+
+		task.UID = taskUID
+		task.Name = rowsData.Title
+		task.AssetType = rowsData.DomainCode
+
+		result <- query.QueryResult{Result: task}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,74 @@
+package query
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type QueryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type RuleEventQuery interface {
+	FindAllByID(ruleUID uuid.UUID) <-chan QueryResult
+}
+
+type RuleReadQuery interface {
+	FindByID(ruleUID uuid.UUID) <-chan QueryResult
+	FindAll() <-chan QueryResult
+	FindAllActiveBySource(source string) <-chan QueryResult
+}
+
+type AlertEventQuery interface {
+	FindAllByID(alertUID uuid.UUID) <-chan QueryResult
+}
+
+type AlertReadQuery interface {
+	FindByID(alertUID uuid.UUID) <-chan QueryResult
+	FindAll(status string) <-chan QueryResult
+	FindUnresolved(ruleUID, targetUID uuid.UUID) <-chan QueryResult
+}
+
+type ReservoirQuery interface {
+	FindByID(reservoirUID uuid.UUID) <-chan QueryResult
+}
+
+type MaterialQuery interface {
+	FindByID(materialUID uuid.UUID) <-chan QueryResult
+}
+
+type TaskQuery interface {
+	FindByID(taskUID uuid.UUID) <-chan QueryResult
+}
+
+type ClimateZoneQuery interface {
+	FindByID(climateZoneUID uuid.UUID) <-chan QueryResult
+	FindByAreaID(areaUID uuid.UUID) <-chan QueryResult
+}
+
+// QUERY RESULTS
+
+// AlertTargetQueryResult is the reservoir, material or task a rule watches.
+// Quantity is only set for materials, AssetType and AssetUID only for tasks.
+type AlertTargetQueryResult struct {
+	UID          uuid.UUID
+	Name         string
+	Quantity     float32
+	QuantityUnit string
+	AssetType    string
+	AssetUID     uuid.UUID
+}
+
+// ClimateZoneQueryResult is the climate zone a climate zone rule watches,
+// with the target range of each climate parameter
+type ClimateZoneQueryResult struct {
+	UID     uuid.UUID
+	Name    string
+	Targets map[string]ClimateTargetQueryResult
+}
+
+// ClimateTargetQueryResult is the target range of a climate parameter, a nil bound is not enforced
+type ClimateTargetQueryResult struct {
+	Min *float32
+	Max *float32
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/decoder"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewAlertEventQuerySqlite(db *sql.DB) query.AlertEventQuery {
+	return &AlertEventQuerySqlite{DB: db}
+}
+
+func (f *AlertEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.AlertEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM ALERT_EVENT WHERE ALERT_UID = ? ORDER BY VERSION ASC", uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			AlertUID    string
+			Version     int
+			CreatedDate string
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.AlertUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.AlertEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			alertUID, err := uuid.FromString(rowsData.AlertUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			events = append(events, storage.AlertEvent{
+				AlertUID:    alertUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,218 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewAlertReadQuerySqlite(db *sql.DB) query.AlertReadQuery {
+	return &AlertReadQuerySqlite{DB: db}
+}
+
+type alertReadResult struct {
+	UID              string
+	RuleUID          string
+	RuleName         string
+	Source           string
+	TargetUID        string
+	TargetName       string
+	Value            float32
+	Message          string
+	Status           string
+	TriggeredDate    string
+	AcknowledgedDate sql.NullString
+	ResolvedDate     sql.NullString
+}
+
+func (f *AlertReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM ALERT_READ WHERE UID = ?`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alerts, err := scanAlertReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alert := storage.AlertRead{}
+		if len(alerts) > 0 {
+			alert = alerts[0]
+		}
+
+		result <- query.QueryResult{Result: alert}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *AlertReadQuerySqlite) FindAll(status string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := "SELECT * FROM ALERT_READ WHERE 1 = 1"
+		params := []interface{}{}
+
+		if status != "" {
+			sql += " AND STATUS = ?"
+			params = append(params, status)
+		}
+
+		sql += " ORDER BY TRIGGERED_DATE DESC"
+
+		rows, err := f.DB.Query(sql, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alerts, err := scanAlertReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: alerts}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *AlertReadQuerySqlite) FindUnresolved(ruleUID, targetUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM ALERT_READ
+			WHERE RULE_UID = ? AND TARGET_UID = ? AND STATUS != ?
+			LIMIT 1`, ruleUID, targetUID, domain.AlertStatusResolved)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alerts, err := scanAlertReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		alert := storage.AlertRead{}
+		if len(alerts) > 0 {
+			alert = alerts[0]
+		}
+
+		result <- query.QueryResult{Result: alert}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanAlertReads(rows *sql.Rows) ([]storage.AlertRead, error) {
+	defer rows.Close()
+
+	alerts := []storage.AlertRead{}
+	for rows.Next() {
+		rowsData := alertReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.RuleUID,
+			&rowsData.RuleName,
+			&rowsData.Source,
+			&rowsData.TargetUID,
+			&rowsData.TargetName,
+			&rowsData.Value,
+			&rowsData.Message,
+			&rowsData.Status,
+			&rowsData.TriggeredDate,
+			&rowsData.AcknowledgedDate,
+			&rowsData.ResolvedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleUID, err := uuid.FromString(rowsData.RuleUID)
+		if err != nil {
+			return nil, err
+		}
+
+		targetUID, err := uuid.FromString(rowsData.TargetUID)
+		if err != nil {
+			return nil, err
+		}
+
+		triggeredDate, err := time.Parse(time.RFC3339, rowsData.TriggeredDate)
+		if err != nil {
+			return nil, err
+		}
+
+		acknowledgedDate, err := parseNullDate(rowsData.AcknowledgedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		resolvedDate, err := parseNullDate(rowsData.ResolvedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, storage.AlertRead{
+			UID:              uid,
+			RuleUID:          ruleUID,
+			RuleName:         rowsData.RuleName,
+			Source:           rowsData.Source,
+			TargetUID:        targetUID,
+			TargetName:       rowsData.TargetName,
+			Value:            rowsData.Value,
+			Message:          rowsData.Message,
+			Status:           rowsData.Status,
+			TriggeredDate:    triggeredDate,
+			AcknowledgedDate: acknowledgedDate,
+			ResolvedDate:     resolvedDate,
+		})
+	}
+
+	return alerts, rows.Err()
+}
+
+func parseNullDate(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
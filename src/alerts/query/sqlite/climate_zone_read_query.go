@@ -0,0 +1,103 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	assetsdomain "github.com/Tanibox/tania-core/src/assets/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneQuerySqlite(db *sql.DB) query.ClimateZoneQuery {
+	return ClimateZoneQuerySqlite{DB: db}
+}
+
+func (s ClimateZoneQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	return s.find(`SELECT UID, NAME, TEMPERATURE_MIN, TEMPERATURE_MAX, HUMIDITY_MIN, HUMIDITY_MAX,
+		CO2_MIN, CO2_MAX, LIGHT_MIN, LIGHT_MAX
+		FROM CLIMATE_ZONE_READ WHERE UID = ?`, uid)
+}
+
+func (s ClimateZoneQuerySqlite) FindByAreaID(areaUID uuid.UUID) <-chan query.QueryResult {
+	return s.find(`SELECT z.UID, z.NAME, z.TEMPERATURE_MIN, z.TEMPERATURE_MAX, z.HUMIDITY_MIN, z.HUMIDITY_MAX,
+		z.CO2_MIN, z.CO2_MAX, z.LIGHT_MIN, z.LIGHT_MAX
+		FROM CLIMATE_ZONE_READ z
+		INNER JOIN AREA_READ a ON a.CLIMATE_ZONE_UID = z.UID
+		WHERE a.UID = ?`, areaUID)
+}
+
+func (s ClimateZoneQuerySqlite) find(sqlQuery string, uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID    string
+			Name   string
+			Bounds [8]sql.NullFloat64
+		}{}
+		climateZone := query.ClimateZoneQueryResult{}
+
+		err := s.DB.QueryRow(sqlQuery, uid).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Bounds[0],
+			&rowsData.Bounds[1],
+			&rowsData.Bounds[2],
+			&rowsData.Bounds[3],
+			&rowsData.Bounds[4],
+			&rowsData.Bounds[5],
+			&rowsData.Bounds[6],
+			&rowsData.Bounds[7],
+		)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: climateZone}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		climateZoneUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		climateZone.UID = climateZoneUID
+		climateZone.Name = rowsData.Name
+		climateZone.Targets = map[string]query.ClimateTargetQueryResult{}
+
+		// The bounds are selected in the order of the climate parameters, minimum first
+		for i, parameter := range assetsdomain.ClimateParameters() {
+			climateZone.Targets[parameter] = query.ClimateTargetQueryResult{
+				Min: nullFloat32(rowsData.Bounds[i*2]),
+				Max: nullFloat32(rowsData.Bounds[i*2+1]),
+			}
+		}
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func nullFloat32(v sql.NullFloat64) *float32 {
+	if !v.Valid {
+		return nil
+	}
+
+	f := float32(v.Float64)
+	return &f
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/decoder"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewRuleEventQuerySqlite(db *sql.DB) query.RuleEventQuery {
+	return &RuleEventQuerySqlite{DB: db}
+}
+
+func (f *RuleEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.RuleEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM RULE_EVENT WHERE RULE_UID = ? ORDER BY VERSION ASC", uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			RuleUID     string
+			Version     int
+			CreatedDate string
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.RuleUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.RuleEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			ruleUID, err := uuid.FromString(rowsData.RuleUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			events = append(events, storage.RuleEvent{
+				RuleUID:     ruleUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
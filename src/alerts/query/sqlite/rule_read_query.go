@@ -0,0 +1,198 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewRuleReadQuerySqlite(db *sql.DB) query.RuleReadQuery {
+	return &RuleReadQuerySqlite{DB: db}
+}
+
+type ruleReadResult struct {
+	UID          string
+	Name         string
+	Source       string
+	TargetUID    sql.NullString
+	Parameter    string
+	Operator     string
+	Threshold    float32
+	Duration     int
+	Notifiers    string
+	WebhookURL   string
+	Email        string
+	TaskDomain   string
+	TaskCategory string
+	IsActive     bool
+	CreatedDate  string
+}
+
+func (f *RuleReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM RULE_READ WHERE UID = ?`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rules, err := scanRuleReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rule := storage.RuleRead{}
+		if len(rules) > 0 {
+			rule = rules[0]
+		}
+
+		result <- query.QueryResult{Result: rule}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *RuleReadQuerySqlite) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM RULE_READ ORDER BY CREATED_DATE ASC`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rules, err := scanRuleReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: rules}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *RuleReadQuerySqlite) FindAllActiveBySource(source string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM RULE_READ
+			WHERE SOURCE = ? AND IS_ACTIVE = 1
+			ORDER BY CREATED_DATE ASC`, source)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rules, err := scanRuleReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: rules}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanRuleReads(rows *sql.Rows) ([]storage.RuleRead, error) {
+	defer rows.Close()
+
+	rules := []storage.RuleRead{}
+	for rows.Next() {
+		rowsData := ruleReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.Source,
+			&rowsData.TargetUID,
+			&rowsData.Parameter,
+			&rowsData.Operator,
+			&rowsData.Threshold,
+			&rowsData.Duration,
+			&rowsData.Notifiers,
+			&rowsData.WebhookURL,
+			&rowsData.Email,
+			&rowsData.TaskDomain,
+			&rowsData.TaskCategory,
+			&rowsData.IsActive,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		var targetUID *uuid.UUID
+		if rowsData.TargetUID.Valid {
+			target, err := uuid.FromString(rowsData.TargetUID.String)
+			if err != nil {
+				return nil, err
+			}
+
+			targetUID = &target
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		notifiers := []string{}
+		if rowsData.Notifiers != "" {
+			notifiers = strings.Split(rowsData.Notifiers, ",")
+		}
+
+		rules = append(rules, storage.RuleRead{
+			UID:  uid,
+			Name: rowsData.Name,
+			Condition: storage.RuleCondition{
+				Source:    rowsData.Source,
+				TargetUID: targetUID,
+				Parameter: rowsData.Parameter,
+				Operator:  rowsData.Operator,
+				Threshold: rowsData.Threshold,
+				Duration:  rowsData.Duration,
+			},
+			Action: storage.RuleAction{
+				Notifiers:    notifiers,
+				WebhookURL:   rowsData.WebhookURL,
+				Email:        rowsData.Email,
+				TaskDomain:   rowsData.TaskDomain,
+				TaskCategory: rowsData.TaskCategory,
+			},
+			IsActive:    rowsData.IsActive,
+			CreatedDate: createdDate,
+		})
+	}
+
+	return rules, rows.Err()
+}
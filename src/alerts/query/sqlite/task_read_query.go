@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type TaskQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewTaskQuerySqlite(db *sql.DB) query.TaskQuery {
+	return TaskQuerySqlite{DB: db}
+}
+
+func (s TaskQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID        string
+			Title      string
+			DomainCode string
+			AssetID    sql.NullString
+		}{}
+		task := query.AlertTargetQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, TITLE, DOMAIN_CODE, ASSET_ID
+			FROM TASK_READ WHERE UID = ?`, uid).Scan(
+			&rowsData.UID, &rowsData.Title, &rowsData.DomainCode, &rowsData.AssetID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: task}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		taskUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		if rowsData.AssetID.Valid && rowsData.AssetID.String != "" {
+			assetUID, err := uuid.FromString(rowsData.AssetID.String)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			task.AssetUID = assetUID
+		}
+
+		task.UID = taskUID
+		task.Name = rowsData.Title
+		task.AssetType = rowsData.DomainCode
+
+		result <- query.QueryResult{Result: task}
+
+		close(result)
+	}()
+
+	return result
+}
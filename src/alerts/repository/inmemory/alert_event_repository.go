@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertEventRepositoryInMemory struct {
+	Storage *storage.AlertEventStorage
+}
+
+func NewAlertEventRepositoryInMemory(s *storage.AlertEventStorage) repository.AlertEventRepository {
+	return &AlertEventRepositoryInMemory{Storage: s}
+}
+
+func (f *AlertEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.AlertEvents = append(f.Storage.AlertEvents, storage.AlertEvent{
+				AlertUID: uid,
+				Version:  latestVersion,
+				Event:    v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
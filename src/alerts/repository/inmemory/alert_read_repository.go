@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+type AlertReadRepositoryInMemory struct {
+	Storage *storage.AlertReadStorage
+}
+
+func NewAlertReadRepositoryInMemory(s *storage.AlertReadStorage) repository.AlertReadRepository {
+	return &AlertReadRepositoryInMemory{Storage: s}
+}
+
+func (f *AlertReadRepositoryInMemory) Save(alertRead *storage.AlertRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.AlertReadMap[alertRead.UID] = *alertRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
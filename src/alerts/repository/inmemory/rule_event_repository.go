@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleEventRepositoryInMemory struct {
+	Storage *storage.RuleEventStorage
+}
+
+func NewRuleEventRepositoryInMemory(s *storage.RuleEventStorage) repository.RuleEventRepository {
+	return &RuleEventRepositoryInMemory{Storage: s}
+}
+
+func (f *RuleEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.RuleEvents = append(f.Storage.RuleEvents, storage.RuleEvent{
+				RuleUID: uid,
+				Version: latestVersion,
+				Event:   v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
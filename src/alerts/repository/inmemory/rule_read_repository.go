@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+type RuleReadRepositoryInMemory struct {
+	Storage *storage.RuleReadStorage
+}
+
+func NewRuleReadRepositoryInMemory(s *storage.RuleReadStorage) repository.RuleReadRepository {
+	return &RuleReadRepositoryInMemory{Storage: s}
+}
+
+func (f *RuleReadRepositoryInMemory) Save(ruleRead *storage.RuleRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.RuleReadMap[ruleRead.UID] = *ruleRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/decoder"
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AlertEventRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewAlertEventRepositoryMysql(db *sql.DB) repository.AlertEventRepository {
+	return &AlertEventRepositoryMysql{DB: db}
+}
+
+func (f *AlertEventRepositoryMysql) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			stmt, err := f.DB.Prepare(`INSERT INTO ALERT_EVENT
+				(ALERT_UID, VERSION, CREATED_DATE, EVENT)
+				VALUES (?, ?, ?, ?)`)
+
+			if err != nil {
+				result <- err
+			}
+
+			e, err := json.Marshal(decoder.EventWrapper{
+				EventName: structhelper.GetName(v),
+				EventData: v,
+			})
+
+			if err != nil {
+				panic(err)
+			}
+
+			_, err = stmt.Exec(uid.Bytes(), latestVersion, time.Now(), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
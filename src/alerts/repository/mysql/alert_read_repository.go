@@ -0,0 +1,79 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+type AlertReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewAlertReadRepositoryMysql(db *sql.DB) repository.AlertReadRepository {
+	return &AlertReadRepositoryMysql{DB: db}
+}
+
+func (f *AlertReadRepositoryMysql) Save(alertRead *storage.AlertRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM ALERT_READ WHERE UID = ?`, alertRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE ALERT_READ SET
+				RULE_UID = ?, RULE_NAME = ?, SOURCE = ?, TARGET_UID = ?, TARGET_NAME = ?,
+				VALUE = ?, MESSAGE = ?, STATUS = ?, TRIGGERED_DATE = ?, ACKNOWLEDGED_DATE = ?,
+				RESOLVED_DATE = ?
+				WHERE UID = ?`,
+				alertRead.RuleUID.Bytes(),
+				alertRead.RuleName,
+				alertRead.Source,
+				alertRead.TargetUID.Bytes(),
+				alertRead.TargetName,
+				alertRead.Value,
+				alertRead.Message,
+				alertRead.Status,
+				alertRead.TriggeredDate,
+				alertRead.AcknowledgedDate,
+				alertRead.ResolvedDate,
+				alertRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO ALERT_READ
+				(UID, RULE_UID, RULE_NAME, SOURCE, TARGET_UID, TARGET_NAME, VALUE, MESSAGE,
+				STATUS, TRIGGERED_DATE, ACKNOWLEDGED_DATE, RESOLVED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				alertRead.UID.Bytes(),
+				alertRead.RuleUID.Bytes(),
+				alertRead.RuleName,
+				alertRead.Source,
+				alertRead.TargetUID.Bytes(),
+				alertRead.TargetName,
+				alertRead.Value,
+				alertRead.Message,
+				alertRead.Status,
+				alertRead.TriggeredDate,
+				alertRead.AcknowledgedDate,
+				alertRead.ResolvedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
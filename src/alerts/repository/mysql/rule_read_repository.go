@@ -0,0 +1,91 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+type RuleReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewRuleReadRepositoryMysql(db *sql.DB) repository.RuleReadRepository {
+	return &RuleReadRepositoryMysql{DB: db}
+}
+
+func (f *RuleReadRepositoryMysql) Save(ruleRead *storage.RuleRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM RULE_READ WHERE UID = ?`, ruleRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		var targetUID []byte
+		if ruleRead.Condition.TargetUID != nil {
+			targetUID = ruleRead.Condition.TargetUID.Bytes()
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE RULE_READ SET
+				NAME = ?, SOURCE = ?, TARGET_UID = ?, PARAMETER = ?, OPERATOR = ?, THRESHOLD = ?,
+				DURATION = ?, NOTIFIERS = ?, WEBHOOK_URL = ?, EMAIL = ?, TASK_DOMAIN = ?,
+				TASK_CATEGORY = ?, IS_ACTIVE = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				ruleRead.Name,
+				ruleRead.Condition.Source,
+				targetUID,
+				ruleRead.Condition.Parameter,
+				ruleRead.Condition.Operator,
+				ruleRead.Condition.Threshold,
+				ruleRead.Condition.Duration,
+				strings.Join(ruleRead.Action.Notifiers, ","),
+				ruleRead.Action.WebhookURL,
+				ruleRead.Action.Email,
+				ruleRead.Action.TaskDomain,
+				ruleRead.Action.TaskCategory,
+				ruleRead.IsActive,
+				ruleRead.CreatedDate,
+				ruleRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO RULE_READ
+				(UID, NAME, SOURCE, TARGET_UID, PARAMETER, OPERATOR, THRESHOLD, DURATION,
+				NOTIFIERS, WEBHOOK_URL, EMAIL, TASK_DOMAIN, TASK_CATEGORY, IS_ACTIVE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				ruleRead.UID.Bytes(),
+				ruleRead.Name,
+				ruleRead.Condition.Source,
+				targetUID,
+				ruleRead.Condition.Parameter,
+				ruleRead.Condition.Operator,
+				ruleRead.Condition.Threshold,
+				ruleRead.Condition.Duration,
+				strings.Join(ruleRead.Action.Notifiers, ","),
+				ruleRead.Action.WebhookURL,
+				ruleRead.Action.Email,
+				ruleRead.Action.TaskDomain,
+				ruleRead.Action.TaskCategory,
+				ruleRead.IsActive,
+				ruleRead.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RepositoryResult is a struct to wrap repository result
+// so its easy to use it in channel
+type RepositoryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type RuleEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type RuleReadRepository interface {
+	Save(ruleRead *storage.RuleRead) <-chan error
+}
+
+type AlertEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type AlertReadRepository interface {
+	Save(alertRead *storage.AlertRead) <-chan error
+}
+
+func NewRuleFromHistory(events []storage.RuleEvent) *domain.Rule {
+	state := &domain.Rule{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
+
+func NewAlertFromHistory(events []storage.AlertEvent) *domain.Alert {
+	state := &domain.Alert{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
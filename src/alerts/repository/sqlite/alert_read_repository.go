@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+type AlertReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewAlertReadRepositorySqlite(db *sql.DB) repository.AlertReadRepository {
+	return &AlertReadRepositorySqlite{DB: db}
+}
+
+func (f *AlertReadRepositorySqlite) Save(alertRead *storage.AlertRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM ALERT_READ WHERE UID = ?`, alertRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		var acknowledgedDate, resolvedDate *string
+		if alertRead.AcknowledgedDate != nil {
+			d := alertRead.AcknowledgedDate.Format(time.RFC3339)
+			acknowledgedDate = &d
+		}
+		if alertRead.ResolvedDate != nil {
+			d := alertRead.ResolvedDate.Format(time.RFC3339)
+			resolvedDate = &d
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE ALERT_READ SET
+				RULE_UID = ?, RULE_NAME = ?, SOURCE = ?, TARGET_UID = ?, TARGET_NAME = ?,
+				VALUE = ?, MESSAGE = ?, STATUS = ?, TRIGGERED_DATE = ?, ACKNOWLEDGED_DATE = ?,
+				RESOLVED_DATE = ?
+				WHERE UID = ?`,
+				alertRead.RuleUID,
+				alertRead.RuleName,
+				alertRead.Source,
+				alertRead.TargetUID,
+				alertRead.TargetName,
+				alertRead.Value,
+				alertRead.Message,
+				alertRead.Status,
+				alertRead.TriggeredDate.Format(time.RFC3339),
+				acknowledgedDate,
+				resolvedDate,
+				alertRead.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO ALERT_READ
+				(UID, RULE_UID, RULE_NAME, SOURCE, TARGET_UID, TARGET_NAME, VALUE, MESSAGE,
+				STATUS, TRIGGERED_DATE, ACKNOWLEDGED_DATE, RESOLVED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				alertRead.UID,
+				alertRead.RuleUID,
+				alertRead.RuleName,
+				alertRead.Source,
+				alertRead.TargetUID,
+				alertRead.TargetName,
+				alertRead.Value,
+				alertRead.Message,
+				alertRead.Status,
+				alertRead.TriggeredDate.Format(time.RFC3339),
+				acknowledgedDate,
+				resolvedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,87 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+type RuleReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewRuleReadRepositorySqlite(db *sql.DB) repository.RuleReadRepository {
+	return &RuleReadRepositorySqlite{DB: db}
+}
+
+func (f *RuleReadRepositorySqlite) Save(ruleRead *storage.RuleRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM RULE_READ WHERE UID = ?`, ruleRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE RULE_READ SET
+				NAME = ?, SOURCE = ?, TARGET_UID = ?, PARAMETER = ?, OPERATOR = ?, THRESHOLD = ?,
+				DURATION = ?, NOTIFIERS = ?, WEBHOOK_URL = ?, EMAIL = ?, TASK_DOMAIN = ?,
+				TASK_CATEGORY = ?, IS_ACTIVE = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				ruleRead.Name,
+				ruleRead.Condition.Source,
+				ruleRead.Condition.TargetUID,
+				ruleRead.Condition.Parameter,
+				ruleRead.Condition.Operator,
+				ruleRead.Condition.Threshold,
+				ruleRead.Condition.Duration,
+				strings.Join(ruleRead.Action.Notifiers, ","),
+				ruleRead.Action.WebhookURL,
+				ruleRead.Action.Email,
+				ruleRead.Action.TaskDomain,
+				ruleRead.Action.TaskCategory,
+				ruleRead.IsActive,
+				ruleRead.CreatedDate.Format(time.RFC3339),
+				ruleRead.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO RULE_READ
+				(UID, NAME, SOURCE, TARGET_UID, PARAMETER, OPERATOR, THRESHOLD, DURATION,
+				NOTIFIERS, WEBHOOK_URL, EMAIL, TASK_DOMAIN, TASK_CATEGORY, IS_ACTIVE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				ruleRead.UID,
+				ruleRead.Name,
+				ruleRead.Condition.Source,
+				ruleRead.Condition.TargetUID,
+				ruleRead.Condition.Parameter,
+				ruleRead.Condition.Operator,
+				ruleRead.Condition.Threshold,
+				ruleRead.Condition.Duration,
+				strings.Join(ruleRead.Action.Notifiers, ","),
+				ruleRead.Action.WebhookURL,
+				ruleRead.Action.Email,
+				ruleRead.Action.TaskDomain,
+				ruleRead.Action.TaskCategory,
+				ruleRead.IsActive,
+				ruleRead.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,562 @@
+package server
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Tanibox/tania-core/config"
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	queryInMem "github.com/Tanibox/tania-core/src/alerts/query/inmemory"
+	queryMysql "github.com/Tanibox/tania-core/src/alerts/query/mysql"
+	querySqlite "github.com/Tanibox/tania-core/src/alerts/query/sqlite"
+	"github.com/Tanibox/tania-core/src/alerts/repository"
+	repoInMem "github.com/Tanibox/tania-core/src/alerts/repository/inmemory"
+	repoMysql "github.com/Tanibox/tania-core/src/alerts/repository/mysql"
+	repoSqlite "github.com/Tanibox/tania-core/src/alerts/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/eventbus"
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	taskdomain "github.com/Tanibox/tania-core/src/tasks/domain"
+	taskstorage "github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+)
+
+// AlertServer ties the routes and handlers with injected dependencies
+type AlertServer struct {
+	RuleEventRepo    repository.RuleEventRepository
+	RuleEventQuery   query.RuleEventQuery
+	RuleReadRepo     repository.RuleReadRepository
+	RuleReadQuery    query.RuleReadQuery
+	AlertEventRepo   repository.AlertEventRepository
+	AlertEventQuery  query.AlertEventQuery
+	AlertReadRepo    repository.AlertReadRepository
+	AlertReadQuery   query.AlertReadQuery
+	ReservoirQuery   query.ReservoirQuery
+	MaterialQuery    query.MaterialQuery
+	TaskQuery        query.TaskQuery
+	ClimateZoneQuery query.ClimateZoneQuery
+	BreachTracker    *domain.BreachTracker
+	Notifiers        map[string]Notifier
+	EventBus         eventbus.TaniaEventBus
+}
+
+// NewAlertServer initializes AlertServer's dependencies and create new AlertServer struct
+func NewAlertServer(
+	db *sql.DB,
+	bus eventbus.TaniaEventBus,
+	reservoirReadStorage *assetsstorage.ReservoirReadStorage,
+	materialReadStorage *assetsstorage.MaterialReadStorage,
+	taskReadStorage *taskstorage.TaskReadStorage,
+	climateZoneReadStorage *assetsstorage.ClimateZoneReadStorage,
+	areaReadStorage *assetsstorage.AreaReadStorage,
+	ruleEventStorage *storage.RuleEventStorage,
+	ruleReadStorage *storage.RuleReadStorage,
+	alertEventStorage *storage.AlertEventStorage,
+	alertReadStorage *storage.AlertReadStorage,
+) (*AlertServer, error) {
+	alertServer := &AlertServer{
+		BreachTracker: domain.NewBreachTracker(),
+		Notifiers:     NewNotifiers(),
+		EventBus:      bus,
+	}
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DB_INMEMORY:
+		alertServer.RuleEventRepo = repoInMem.NewRuleEventRepositoryInMemory(ruleEventStorage)
+		alertServer.RuleEventQuery = queryInMem.NewRuleEventQueryInMemory(ruleEventStorage)
+		alertServer.RuleReadRepo = repoInMem.NewRuleReadRepositoryInMemory(ruleReadStorage)
+		alertServer.RuleReadQuery = queryInMem.NewRuleReadQueryInMemory(ruleReadStorage)
+		alertServer.AlertEventRepo = repoInMem.NewAlertEventRepositoryInMemory(alertEventStorage)
+		alertServer.AlertEventQuery = queryInMem.NewAlertEventQueryInMemory(alertEventStorage)
+		alertServer.AlertReadRepo = repoInMem.NewAlertReadRepositoryInMemory(alertReadStorage)
+		alertServer.AlertReadQuery = queryInMem.NewAlertReadQueryInMemory(alertReadStorage)
+
+		alertServer.ReservoirQuery = queryInMem.NewReservoirQueryInMemory(reservoirReadStorage)
+		alertServer.MaterialQuery = queryInMem.NewMaterialQueryInMemory(materialReadStorage)
+		alertServer.TaskQuery = queryInMem.NewTaskQueryInMemory(taskReadStorage)
+		alertServer.ClimateZoneQuery = queryInMem.NewClimateZoneQueryInMemory(climateZoneReadStorage, areaReadStorage)
+
+	case config.DB_SQLITE:
+		alertServer.RuleEventRepo = repoSqlite.NewRuleEventRepositorySqlite(db)
+		alertServer.RuleEventQuery = querySqlite.NewRuleEventQuerySqlite(db)
+		alertServer.RuleReadRepo = repoSqlite.NewRuleReadRepositorySqlite(db)
+		alertServer.RuleReadQuery = querySqlite.NewRuleReadQuerySqlite(db)
+		alertServer.AlertEventRepo = repoSqlite.NewAlertEventRepositorySqlite(db)
+		alertServer.AlertEventQuery = querySqlite.NewAlertEventQuerySqlite(db)
+		alertServer.AlertReadRepo = repoSqlite.NewAlertReadRepositorySqlite(db)
+		alertServer.AlertReadQuery = querySqlite.NewAlertReadQuerySqlite(db)
+
+		alertServer.ReservoirQuery = querySqlite.NewReservoirQuerySqlite(db)
+		alertServer.MaterialQuery = querySqlite.NewMaterialQuerySqlite(db)
+		alertServer.TaskQuery = querySqlite.NewTaskQuerySqlite(db)
+		alertServer.ClimateZoneQuery = querySqlite.NewClimateZoneQuerySqlite(db)
+
+	case config.DB_MYSQL:
+		alertServer.RuleEventRepo = repoMysql.NewRuleEventRepositoryMysql(db)
+		alertServer.RuleEventQuery = queryMysql.NewRuleEventQueryMysql(db)
+		alertServer.RuleReadRepo = repoMysql.NewRuleReadRepositoryMysql(db)
+		alertServer.RuleReadQuery = queryMysql.NewRuleReadQueryMysql(db)
+		alertServer.AlertEventRepo = repoMysql.NewAlertEventRepositoryMysql(db)
+		alertServer.AlertEventQuery = queryMysql.NewAlertEventQueryMysql(db)
+		alertServer.AlertReadRepo = repoMysql.NewAlertReadRepositoryMysql(db)
+		alertServer.AlertReadQuery = queryMysql.NewAlertReadQueryMysql(db)
+
+		alertServer.ReservoirQuery = queryMysql.NewReservoirQueryMysql(db)
+		alertServer.MaterialQuery = queryMysql.NewMaterialQueryMysql(db)
+		alertServer.TaskQuery = queryMysql.NewTaskQueryMysql(db)
+		alertServer.ClimateZoneQuery = queryMysql.NewClimateZoneQueryMysql(db)
+	}
+
+	alertServer.InitSubscriber()
+
+	return alertServer, nil
+}
+
+// InitSubscriber defines the mapping of which event this domain listen with their handler
+func (s *AlertServer) InitSubscriber() {
+	s.EventBus.Subscribe("RuleCreated", s.SaveToRuleReadModel)
+	s.EventBus.Subscribe("RuleChanged", s.SaveToRuleReadModel)
+	s.EventBus.Subscribe("RuleActivated", s.SaveToRuleReadModel)
+	s.EventBus.Subscribe("RuleDeactivated", s.SaveToRuleReadModel)
+	s.EventBus.Subscribe("AlertTriggered", s.SaveToAlertReadModel)
+	s.EventBus.Subscribe("AlertTriggered", s.DispatchAlert)
+	s.EventBus.Subscribe("AlertAcknowledged", s.SaveToAlertReadModel)
+	s.EventBus.Subscribe("AlertResolved", s.SaveToAlertReadModel)
+
+	// Evaluating a rule publishes the alert it raises, so it runs asynchronously
+	s.EventBus.SubscribeAsync("DeviceReadingRecorded", s.EvaluateSensorReading)
+	s.EventBus.SubscribeAsync("ReservoirMeasured", s.EvaluateReservoirMeasurement)
+	s.EventBus.SubscribeAsync("MaterialCreated", s.EvaluateMaterialStock)
+	s.EventBus.SubscribeAsync("MaterialQuantityChanged", s.EvaluateMaterialStock)
+	s.EventBus.SubscribeAsync("MaterialConsumed", s.EvaluateMaterialStock)
+	s.EventBus.SubscribeAsync(taskdomain.TaskDueCode, s.EvaluateTaskDue)
+}
+
+// Mount defines the AlertServer's endpoints with its handlers
+func (s *AlertServer) Mount(g *echo.Group) {
+	g.GET("/rules/sources", s.GetRuleSources)
+	g.POST("/rules", s.SaveRule)
+	g.GET("/rules", s.FindAllRules)
+	g.GET("/rules/:id", s.FindRuleByID)
+	g.PUT("/rules/:id", s.UpdateRule)
+	g.PUT("/rules/:id/activate", s.ActivateRule)
+	g.PUT("/rules/:id/deactivate", s.DeactivateRule)
+
+	g.GET("", s.FindAllAlerts)
+	g.GET("/:id", s.FindAlertByID)
+	g.PUT("/:id/acknowledge", s.AcknowledgeAlert)
+	g.PUT("/:id/resolve", s.ResolveAlert)
+}
+
+func (s *AlertServer) GetRuleSources(c echo.Context) error {
+	data := make(map[string][]domain.RuleSource)
+	data["data"] = domain.RuleSources()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) SaveRule(c echo.Context) error {
+	condition, action, err := s.parseRule(c, domain.RuleCondition{}, domain.RuleAction{})
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	rule, err := domain.CreateRule(c.FormValue("name"), condition, action)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.RuleEventRepo.Save(rule.UID, 0, rule.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(rule)
+
+	data := make(map[string]storage.RuleRead)
+	data["data"] = MapToRuleRead(*rule)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) FindAllRules(c echo.Context) error {
+	queryResult := <-s.RuleReadQuery.FindAll()
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	rules, ok := queryResult.Result.([]storage.RuleRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.RuleRead)
+	data["data"] = rules
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) FindRuleByID(c echo.Context) error {
+	ruleRead, err := s.findRuleRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.RuleRead)
+	data["data"] = ruleRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateRule changes the rule. The fields which are not sent keep their current value.
+func (s *AlertServer) UpdateRule(c echo.Context) error {
+	rule, err := s.findRule(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	condition, action, err := s.parseRule(c, rule.Condition, rule.Action)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	name := rule.Name
+	if c.FormValue("name") != "" {
+		name = c.FormValue("name")
+	}
+
+	err = rule.Change(name, condition, action)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.RuleEventRepo.Save(rule.UID, rule.Version, rule.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(rule)
+
+	data := make(map[string]storage.RuleRead)
+	data["data"] = MapToRuleRead(*rule)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) ActivateRule(c echo.Context) error {
+	rule, err := s.findRule(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = rule.Activate()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.RuleEventRepo.Save(rule.UID, rule.Version, rule.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(rule)
+
+	data := make(map[string]storage.RuleRead)
+	data["data"] = MapToRuleRead(*rule)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) DeactivateRule(c echo.Context) error {
+	rule, err := s.findRule(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = rule.Deactivate()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.RuleEventRepo.Save(rule.UID, rule.Version, rule.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(rule)
+
+	data := make(map[string]storage.RuleRead)
+	data["data"] = MapToRuleRead(*rule)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) FindAllAlerts(c echo.Context) error {
+	status := c.QueryParam("status")
+	switch status {
+	case "", domain.AlertStatusOpen, domain.AlertStatusAcknowledged, domain.AlertStatusResolved:
+	default:
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "status"))
+	}
+
+	queryResult := <-s.AlertReadQuery.FindAll(status)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	alerts, ok := queryResult.Result.([]storage.AlertRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.AlertRead)
+	data["data"] = alerts
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) FindAlertByID(c echo.Context) error {
+	alertRead, err := s.findAlertRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.AlertRead)
+	data["data"] = alertRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) AcknowledgeAlert(c echo.Context) error {
+	alert, err := s.findAlert(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = alert.Acknowledge()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.AlertEventRepo.Save(alert.UID, alert.Version, alert.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(alert)
+
+	data := make(map[string]storage.AlertRead)
+	data["data"] = MapToAlertRead(*alert)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *AlertServer) ResolveAlert(c echo.Context) error {
+	alert, err := s.findAlert(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = alert.Resolve()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.AlertEventRepo.Save(alert.UID, alert.Version, alert.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(alert)
+
+	data := make(map[string]storage.AlertRead)
+	data["data"] = MapToAlertRead(*alert)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// parseRule reads the rule condition and action from the form.
+// The fields which are not sent keep the value of the given condition and action.
+func (s *AlertServer) parseRule(c echo.Context, condition domain.RuleCondition, action domain.RuleAction) (domain.RuleCondition, domain.RuleAction, error) {
+	if c.FormValue("source") != "" {
+		condition.Source = c.FormValue("source")
+	}
+
+	if c.FormValue("target_id") != "" {
+		targetUID, err := uuid.FromString(c.FormValue("target_id"))
+		if err != nil {
+			return condition, action, NewRequestValidationError(PARSE_FAILED, "target_id")
+		}
+
+		condition.TargetUID = &targetUID
+	}
+
+	if c.FormValue("parameter") != "" {
+		condition.Parameter = c.FormValue("parameter")
+	}
+
+	if c.FormValue("operator") != "" {
+		condition.Operator = c.FormValue("operator")
+	}
+
+	if c.FormValue("threshold") != "" {
+		threshold, err := strconv.ParseFloat(c.FormValue("threshold"), 32)
+		if err != nil {
+			return condition, action, NewRequestValidationError(FLOAT, "threshold")
+		}
+
+		condition.Threshold = float32(threshold)
+	}
+
+	if c.FormValue("duration") != "" {
+		duration, err := strconv.Atoi(c.FormValue("duration"))
+		if err != nil {
+			return condition, action, NewRequestValidationError(NUMERIC, "duration")
+		}
+
+		condition.Duration = duration
+	}
+
+	if c.FormValue("notifiers") != "" {
+		action.Notifiers = strings.Split(c.FormValue("notifiers"), ",")
+	}
+
+	if c.FormValue("webhook_url") != "" {
+		action.WebhookURL = c.FormValue("webhook_url")
+	}
+
+	if c.FormValue("email") != "" {
+		action.Email = c.FormValue("email")
+	}
+
+	if c.FormValue("task_domain") != "" {
+		action.TaskDomain = c.FormValue("task_domain")
+
+		switch action.TaskDomain {
+		case taskdomain.TaskDomainAreaCode, taskdomain.TaskDomainCropCode, taskdomain.TaskDomainFinanceCode,
+			taskdomain.TaskDomainGeneralCode, taskdomain.TaskDomainInventoryCode, taskdomain.TaskDomainReservoirCode:
+		default:
+			return condition, action, NewRequestValidationError(INVALID_OPTION, "task_domain")
+		}
+	}
+
+	if c.FormValue("task_category") != "" {
+		action.TaskCategory = c.FormValue("task_category")
+
+		_, err := taskdomain.FindTaskCategoryByCode(action.TaskCategory)
+		if err != nil {
+			return condition, action, NewRequestValidationError(INVALID_OPTION, "task_category")
+		}
+	}
+
+	return condition, action, nil
+}
+
+func (s *AlertServer) findRuleRead(id string) (storage.RuleRead, error) {
+	ruleUID, err := uuid.FromString(id)
+	if err != nil {
+		return storage.RuleRead{}, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	queryResult := <-s.RuleReadQuery.FindByID(ruleUID)
+	if queryResult.Error != nil {
+		return storage.RuleRead{}, queryResult.Error
+	}
+
+	ruleRead, ok := queryResult.Result.(storage.RuleRead)
+	if !ok {
+		return storage.RuleRead{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if ruleRead.UID == (uuid.UUID{}) {
+		return storage.RuleRead{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return ruleRead, nil
+}
+
+func (s *AlertServer) findRule(id string) (*domain.Rule, error) {
+	ruleRead, err := s.findRuleRead(id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventQueryResult := <-s.RuleEventQuery.FindAllByID(ruleRead.UID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.RuleEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	return repository.NewRuleFromHistory(events), nil
+}
+
+func (s *AlertServer) findAlertRead(id string) (storage.AlertRead, error) {
+	alertUID, err := uuid.FromString(id)
+	if err != nil {
+		return storage.AlertRead{}, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	queryResult := <-s.AlertReadQuery.FindByID(alertUID)
+	if queryResult.Error != nil {
+		return storage.AlertRead{}, queryResult.Error
+	}
+
+	alertRead, ok := queryResult.Result.(storage.AlertRead)
+	if !ok {
+		return storage.AlertRead{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if alertRead.UID == (uuid.UUID{}) {
+		return storage.AlertRead{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return alertRead, nil
+}
+
+func (s *AlertServer) findAlert(id string) (*domain.Alert, error) {
+	alertRead, err := s.findAlertRead(id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventQueryResult := <-s.AlertEventQuery.FindAllByID(alertRead.UID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AlertEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	return repository.NewAlertFromHistory(events), nil
+}
+
+func (s *AlertServer) publishUncommittedEvents(entity interface{}) error {
+	switch e := entity.(type) {
+	case *domain.Rule:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	case *domain.Alert:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	}
+
+	return nil
+}
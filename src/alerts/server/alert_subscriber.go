@@ -0,0 +1,440 @@
+package server
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/query"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+	assetsdomain "github.com/Tanibox/tania-core/src/assets/domain"
+	devicedomain "github.com/Tanibox/tania-core/src/devices/domain"
+	taskdomain "github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (s *AlertServer) SaveToRuleReadModel(event interface{}) error {
+	ruleRead := &storage.RuleRead{}
+
+	switch e := event.(type) {
+	case domain.RuleCreated:
+		ruleRead.UID = e.UID
+		ruleRead.Name = e.Name
+		ruleRead.Condition = storage.RuleCondition(e.Condition)
+		ruleRead.Action = storage.RuleAction(e.Action)
+		ruleRead.IsActive = true
+		ruleRead.CreatedDate = e.CreatedDate
+
+	case domain.RuleChanged:
+		r, err := s.getRuleRead(e.RuleUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		ruleRead = &r
+
+		ruleRead.Name = e.Name
+		ruleRead.Condition = storage.RuleCondition(e.Condition)
+		ruleRead.Action = storage.RuleAction(e.Action)
+
+	case domain.RuleActivated:
+		r, err := s.getRuleRead(e.RuleUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		ruleRead = &r
+
+		ruleRead.IsActive = true
+
+	case domain.RuleDeactivated:
+		r, err := s.getRuleRead(e.RuleUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		ruleRead = &r
+
+		ruleRead.IsActive = false
+
+	}
+
+	err := <-s.RuleReadRepo.Save(ruleRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+func (s *AlertServer) SaveToAlertReadModel(event interface{}) error {
+	alertRead := &storage.AlertRead{}
+
+	switch e := event.(type) {
+	case domain.AlertTriggered:
+		alertRead.UID = e.UID
+		alertRead.RuleUID = e.RuleUID
+		alertRead.RuleName = e.RuleName
+		alertRead.Source = e.Source
+		alertRead.TargetUID = e.TargetUID
+		alertRead.TargetName = e.TargetName
+		alertRead.Value = e.Value
+		alertRead.Message = e.Message
+		alertRead.Status = domain.AlertStatusOpen
+		alertRead.TriggeredDate = e.TriggeredDate
+
+	case domain.AlertAcknowledged:
+		a, err := s.getAlertRead(e.AlertUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		alertRead = &a
+
+		acknowledgedDate := e.AcknowledgedDate
+		alertRead.Status = domain.AlertStatusAcknowledged
+		alertRead.AcknowledgedDate = &acknowledgedDate
+
+	case domain.AlertResolved:
+		a, err := s.getAlertRead(e.AlertUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		alertRead = &a
+
+		resolvedDate := e.ResolvedDate
+		alertRead.Status = domain.AlertStatusResolved
+		alertRead.ResolvedDate = &resolvedDate
+
+	}
+
+	err := <-s.AlertReadRepo.Save(alertRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// DispatchAlert sends the triggered alert through the notifiers of its rule.
+// Notifiers may be slow or unreachable, so they don't hold the event bus.
+func (s *AlertServer) DispatchAlert(event interface{}) error {
+	e, ok := event.(domain.AlertTriggered)
+	if !ok {
+		return nil
+	}
+
+	for _, v := range e.Notifiers {
+		notifier, ok := s.Notifiers[v]
+		if !ok {
+			continue
+		}
+
+		go func(code string, notifier Notifier) {
+			err := notifier.Notify(e)
+			if err != nil {
+				log.Error("Alert ", e.UID, " not sent by ", code, ": ", err)
+			}
+		}(v, notifier)
+	}
+
+	return nil
+}
+
+// TODO:
+// We cannot listen to the events below without refer to the original struct.
+// This is considered as domain boundary leak.
+
+func (s *AlertServer) EvaluateSensorReading(event interface{}) error {
+	e, ok := event.(devicedomain.DeviceReadingRecorded)
+	if !ok {
+		return nil
+	}
+
+	err := s.evaluate(domain.RuleSourceSensorReading, domain.AlertTarget{
+		UID:        e.DeviceUID,
+		Name:       e.DeviceName,
+		Parameter:  e.SensorType,
+		AssetType:  e.AttachmentType,
+		AssetUID:   e.AttachmentUID,
+		Value:      e.Value,
+		ObservedAt: e.RecordedDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.evaluateClimateZoneReading(e)
+}
+
+// evaluateClimateZoneReading evaluates the climate zone rules with the reading of a device
+// attached to a climate zone or to one of its areas. The readings of all these devices
+// are the climate of the zone, so the alert is raised for the zone.
+func (s *AlertServer) evaluateClimateZoneReading(e devicedomain.DeviceReadingRecorded) error {
+	var queryResult query.QueryResult
+	switch e.AttachmentType {
+	case devicedomain.DeviceAttachmentClimateZone:
+		queryResult = <-s.ClimateZoneQuery.FindByID(e.AttachmentUID)
+	case devicedomain.DeviceAttachmentArea:
+		queryResult = <-s.ClimateZoneQuery.FindByAreaID(e.AttachmentUID)
+	default:
+		return nil
+	}
+
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return queryResult.Error
+	}
+
+	climateZone, ok := queryResult.Result.(query.ClimateZoneQueryResult)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	if climateZone.UID == (uuid.UUID{}) {
+		return nil
+	}
+
+	// The sensor types of the climate parameters have the same codes
+	target, ok := climateZone.Targets[e.SensorType]
+	if !ok {
+		return nil
+	}
+
+	return s.evaluate(domain.RuleSourceClimateZone, domain.AlertTarget{
+		UID:        climateZone.UID,
+		Name:       climateZone.Name,
+		Parameter:  e.SensorType,
+		AssetType:  e.AttachmentType,
+		AssetUID:   e.AttachmentUID,
+		Value:      e.Value,
+		TargetMin:  target.Min,
+		TargetMax:  target.Max,
+		ObservedAt: e.RecordedDate,
+		SourceUID:  e.DeviceUID,
+	})
+}
+
+func (s *AlertServer) EvaluateReservoirMeasurement(event interface{}) error {
+	e, ok := event.(assetsdomain.ReservoirMeasured)
+	if !ok {
+		return nil
+	}
+
+	queryResult := <-s.ReservoirQuery.FindByID(e.ReservoirUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return queryResult.Error
+	}
+
+	reservoir, ok := queryResult.Result.(query.AlertTargetQueryResult)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	parameters := []string{
+		domain.RuleParameterPH,
+		domain.RuleParameterEC,
+		domain.RuleParameterTemperature,
+		domain.RuleParameterDissolvedOxygen,
+	}
+	values := []*float32{e.PH, e.EC, e.Temperature, e.DissolvedOxygen}
+
+	for i, parameter := range parameters {
+		value := values[i]
+		if value == nil {
+			continue
+		}
+
+		err := s.evaluate(domain.RuleSourceReservoirMeasurement, domain.AlertTarget{
+			UID:        e.ReservoirUID,
+			Name:       reservoir.Name,
+			Parameter:  parameter,
+			AssetType:  taskdomain.TaskDomainReservoirCode,
+			AssetUID:   e.ReservoirUID,
+			Value:      *value,
+			ObservedAt: e.MeasuredDate,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EvaluateMaterialStock checks the stock once the material read model holds the new quantity.
+// It relies on the assets module subscribing to the material events first.
+func (s *AlertServer) EvaluateMaterialStock(event interface{}) error {
+	var materialUID uuid.UUID
+	switch e := event.(type) {
+	case assetsdomain.MaterialCreated:
+		materialUID = e.UID
+	case assetsdomain.MaterialQuantityChanged:
+		materialUID = e.MaterialUID
+	case assetsdomain.MaterialConsumed:
+		materialUID = e.MaterialUID
+	default:
+		return nil
+	}
+
+	queryResult := <-s.MaterialQuery.FindByID(materialUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return queryResult.Error
+	}
+
+	material, ok := queryResult.Result.(query.AlertTargetQueryResult)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	if material.UID == (uuid.UUID{}) {
+		return nil
+	}
+
+	return s.evaluate(domain.RuleSourceMaterialStock, domain.AlertTarget{
+		UID:        material.UID,
+		Name:       material.Name,
+		Parameter:  "stock",
+		AssetType:  taskdomain.TaskDomainInventoryCode,
+		AssetUID:   material.UID,
+		Value:      material.Quantity,
+		ObservedAt: time.Now(),
+	})
+}
+
+func (s *AlertServer) EvaluateTaskDue(event interface{}) error {
+	e, ok := event.(taskdomain.TaskDue)
+	if !ok {
+		return nil
+	}
+
+	queryResult := <-s.TaskQuery.FindByID(e.UID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return queryResult.Error
+	}
+
+	task, ok := queryResult.Result.(query.AlertTargetQueryResult)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	if task.UID == (uuid.UUID{}) {
+		return nil
+	}
+
+	return s.evaluate(domain.RuleSourceTaskDue, domain.AlertTarget{
+		UID:        task.UID,
+		Name:       task.Name,
+		AssetType:  task.AssetType,
+		AssetUID:   task.AssetUID,
+		ObservedAt: time.Now(),
+	})
+}
+
+// evaluate raises an alert for every active rule of the source met by the target.
+// A rule doesn't raise a new alert for a target while the previous one is not resolved.
+func (s *AlertServer) evaluate(source string, target domain.AlertTarget) error {
+	queryResult := <-s.RuleReadQuery.FindAllActiveBySource(source)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return queryResult.Error
+	}
+
+	rules, ok := queryResult.Result.([]storage.RuleRead)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	for _, v := range rules {
+		rule := domain.Rule{
+			UID:       v.UID,
+			Name:      v.Name,
+			Condition: domain.RuleCondition(v.Condition),
+			Action:    domain.RuleAction(v.Action),
+			IsActive:  v.IsActive,
+		}
+
+		if !rule.Condition.Matches(source, target.Parameter, target.UID) {
+			continue
+		}
+
+		if source != domain.RuleSourceTaskDue && !s.BreachTracker.ObserveBreach(rule, target.UID, target.SourceUID, rule.Condition.IsBreachedBy(target), target.ObservedAt) {
+			continue
+		}
+
+		queryResult := <-s.AlertReadQuery.FindUnresolved(rule.UID, target.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+			continue
+		}
+
+		unresolved, ok := queryResult.Result.(storage.AlertRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+			continue
+		}
+
+		if unresolved.UID != (uuid.UUID{}) {
+			continue
+		}
+
+		alert, err := domain.TriggerAlert(rule, target)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		err = <-s.AlertEventRepo.Save(alert.UID, 0, alert.UncommittedChanges)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		s.publishUncommittedEvents(alert)
+	}
+
+	return nil
+}
+
+func (s *AlertServer) getRuleRead(uid uuid.UUID) (storage.RuleRead, error) {
+	queryResult := <-s.RuleReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.RuleRead{}, queryResult.Error
+	}
+
+	ruleRead, ok := queryResult.Result.(storage.RuleRead)
+	if !ok {
+		return storage.RuleRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return ruleRead, nil
+}
+
+func (s *AlertServer) getAlertRead(uid uuid.UUID) (storage.AlertRead, error) {
+	queryResult := <-s.AlertReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.AlertRead{}, queryResult.Error
+	}
+
+	alertRead, ok := queryResult.Result.(storage.AlertRead)
+	if !ok {
+		return storage.AlertRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return alertRead, nil
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+)
+
+// Notifier sends a triggered alert to the people who have to know about it
+type Notifier interface {
+	Notify(alert domain.AlertTriggered) error
+}
+
+// NewNotifiers returns the notifiers a rule can use, by their code.
+// The email notifier is only available when a SMTP host is configured.
+func NewNotifiers() map[string]Notifier {
+	notifiers := map[string]Notifier{
+		domain.NotifierInApp:   InAppNotifier{},
+		domain.NotifierWebhook: WebhookNotifier{Client: &http.Client{Timeout: 10 * time.Second}},
+	}
+
+	if *config.Config.SmtpHost != "" {
+		notifiers[domain.NotifierEmail] = EmailNotifier{
+			Host:     *config.Config.SmtpHost,
+			Port:     *config.Config.SmtpPort,
+			Username: *config.Config.SmtpUsername,
+			Password: *config.Config.SmtpPassword,
+			From:     *config.Config.SmtpFrom,
+		}
+	}
+
+	return notifiers
+}
+
+// InAppNotifier has nothing to send, the alert is shown from the alert read model
+type InAppNotifier struct{}
+
+func (n InAppNotifier) Notify(alert domain.AlertTriggered) error {
+	return nil
+}
+
+// WebhookNotifier posts the alert as JSON to the webhook URL of the rule
+type WebhookNotifier struct {
+	Client *http.Client
+}
+
+type webhookPayload struct {
+	UID           string    `json:"uid"`
+	RuleUID       string    `json:"rule_id"`
+	RuleName      string    `json:"rule_name"`
+	Source        string    `json:"source"`
+	TargetUID     string    `json:"target_id"`
+	TargetName    string    `json:"target_name"`
+	Value         float32   `json:"value"`
+	Message       string    `json:"message"`
+	TriggeredDate time.Time `json:"triggered_date"`
+}
+
+func (n WebhookNotifier) Notify(alert domain.AlertTriggered) error {
+	body, err := json.Marshal(webhookPayload{
+		UID:           alert.UID.String(),
+		RuleUID:       alert.RuleUID.String(),
+		RuleName:      alert.RuleName,
+		Source:        alert.Source,
+		TargetUID:     alert.TargetUID.String(),
+		TargetName:    alert.TargetName,
+		Value:         alert.Value,
+		Message:       alert.Message,
+		TriggeredDate: alert.TriggeredDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := n.Client.Post(alert.WebhookURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("Webhook responded with %s", resp.Status)
+	}
+
+	return nil
+}
+
+// EmailNotifier mails the alert to the email of the rule
+type EmailNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (n EmailNotifier) Notify(alert domain.AlertTriggered) error {
+	var auth smtp.Auth
+	if n.Username != "" {
+		auth = smtp.PlainAuth("", n.Username, n.Password, n.Host)
+	}
+
+	msg := "From: " + n.From + "\r\n" +
+		"To: " + alert.Email + "\r\n" +
+		"Subject: [Tania] " + alert.RuleName + "\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		alert.Message + "\r\n"
+
+	return smtp.SendMail(net.JoinHostPort(n.Host, n.Port), auth, n.From, []string{alert.Email}, []byte(msg))
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	"github.com/Tanibox/tania-core/src/alerts/storage"
+)
+
+func MapToRuleRead(rule domain.Rule) storage.RuleRead {
+	return storage.RuleRead{
+		UID:         rule.UID,
+		Name:        rule.Name,
+		Condition:   storage.RuleCondition(rule.Condition),
+		Action:      storage.RuleAction(rule.Action),
+		IsActive:    rule.IsActive,
+		CreatedDate: rule.CreatedDate,
+	}
+}
+
+func MapToAlertRead(alert domain.Alert) storage.AlertRead {
+	return storage.AlertRead{
+		UID:              alert.UID,
+		RuleUID:          alert.RuleUID,
+		RuleName:         alert.RuleName,
+		Source:           alert.Source,
+		TargetUID:        alert.TargetUID,
+		TargetName:       alert.TargetName,
+		Value:            alert.Value,
+		Message:          alert.Message,
+		Status:           alert.Status,
+		TriggeredDate:    alert.TriggeredDate,
+		AcknowledgedDate: alert.AcknowledgedDate,
+		ResolvedDate:     alert.ResolvedDate,
+	}
+}
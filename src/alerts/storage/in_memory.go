@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	deadlock "github.com/sasha-s/go-deadlock"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleEventStorage struct {
+	Lock       *deadlock.RWMutex
+	RuleEvents []RuleEvent
+}
+
+func CreateRuleEventStorage() *RuleEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("RULE EVENT STORAGE DEADLOCK!")
+	}
+
+	return &RuleEventStorage{Lock: &rwMutex}
+}
+
+type RuleReadStorage struct {
+	Lock        *deadlock.RWMutex
+	RuleReadMap map[uuid.UUID]RuleRead
+}
+
+func CreateRuleReadStorage() *RuleReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("RULE READ STORAGE DEADLOCK!")
+	}
+
+	return &RuleReadStorage{RuleReadMap: make(map[uuid.UUID]RuleRead), Lock: &rwMutex}
+}
+
+type AlertEventStorage struct {
+	Lock        *deadlock.RWMutex
+	AlertEvents []AlertEvent
+}
+
+func CreateAlertEventStorage() *AlertEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("ALERT EVENT STORAGE DEADLOCK!")
+	}
+
+	return &AlertEventStorage{Lock: &rwMutex}
+}
+
+type AlertReadStorage struct {
+	Lock         *deadlock.RWMutex
+	AlertReadMap map[uuid.UUID]AlertRead
+}
+
+func CreateAlertReadStorage() *AlertReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("ALERT READ STORAGE DEADLOCK!")
+	}
+
+	return &AlertReadStorage{AlertReadMap: make(map[uuid.UUID]AlertRead), Lock: &rwMutex}
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/alerts/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type RuleEvent struct {
+	RuleUID     uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type RuleRead struct {
+	UID         uuid.UUID     `json:"uid"`
+	Name        string        `json:"name"`
+	Condition   RuleCondition `json:"condition"`
+	Action      RuleAction    `json:"action"`
+	IsActive    bool          `json:"is_active"`
+	CreatedDate time.Time     `json:"created_date"`
+}
+
+type RuleCondition domain.RuleCondition
+type RuleAction domain.RuleAction
+
+type AlertEvent struct {
+	AlertUID    uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type AlertRead struct {
+	UID              uuid.UUID  `json:"uid"`
+	RuleUID          uuid.UUID  `json:"rule_id"`
+	RuleName         string     `json:"rule_name"`
+	Source           string     `json:"source"`
+	TargetUID        uuid.UUID  `json:"target_id"`
+	TargetName       string     `json:"target_name"`
+	Value            float32    `json:"value"`
+	Message          string     `json:"message"`
+	Status           string     `json:"status"`
+	TriggeredDate    time.Time  `json:"triggered_date"`
+	AcknowledgedDate *time.Time `json:"acknowledged_date"`
+	ResolvedDate     *time.Time `json:"resolved_date"`
+}
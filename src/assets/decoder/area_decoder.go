@@ -118,6 +118,16 @@ func (w *AreaEventWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.EventData = e
+
+	case "AreaClimateZoneChanged":
+		e := domain.AreaClimateZoneChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
 		w.EventData = e
 	}
 
@@ -0,0 +1,75 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/mitchellh/mapstructure"
+)
+
+type ClimateZoneEventWrapper EventWrapper
+
+func (w *ClimateZoneEventWrapper) UnmarshalJSON(b []byte) error {
+	wrapper := EventWrapper{}
+
+	err := json.Unmarshal(b, &wrapper)
+	if err != nil {
+		return err
+	}
+
+	mapped, ok := wrapper.EventData.(map[string]interface{})
+	if !ok {
+		return errors.New("Error type assertion")
+	}
+
+	f := mapstructure.ComposeDecodeHookFunc(
+		UIDHook(),
+		TimeHook(time.RFC3339),
+	)
+
+	switch wrapper.EventName {
+	case "ClimateZoneCreated":
+		e := domain.ClimateZoneCreated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ClimateZoneNameChanged":
+		e := domain.ClimateZoneNameChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ClimateZoneTypeChanged":
+		e := domain.ClimateZoneTypeChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ClimateZoneTargetsChanged":
+		e := domain.ClimateZoneTargetsChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+	}
+
+	return nil
+}
@@ -143,7 +143,11 @@ func MaterialTypeHook() mapstructure.DecodeHookFunc {
 				mapped3 := mapped2["ChemicalType"].(map[string]interface{})
 				typeCode := mapped3["code"].(string)
 
-				t, err := domain.CreateMaterialTypeAgrochemical(typeCode)
+				// Intervals are missing from events recorded before they were introduced
+				phi, _ := mapped2["PreHarvestInterval"].(float64)
+				rei, _ := mapped2["ReEntryInterval"].(float64)
+
+				t, err := domain.CreateMaterialTypeAgrochemical(typeCode, int(phi), int(rei))
 				if err != nil {
 					return data, err
 				}
@@ -78,6 +78,16 @@ func (w *FarmEventWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.EventData = e
+
+	case "FarmBatchIDTemplateChanged":
+		e := domain.FarmBatchIDTemplateChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
 		w.EventData = e
 	}
 
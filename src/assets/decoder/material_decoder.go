@@ -71,6 +71,36 @@ func (w *MaterialEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.EventData = e
 
+	case "MaterialConsumed":
+		e := domain.MaterialConsumed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialCropPlanChanged":
+		e := domain.MaterialCropPlanChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "MaterialSeedsPerUnitChanged":
+		e := domain.MaterialSeedsPerUnitChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
 	case "MaterialTypeChanged":
 		e := domain.MaterialTypeChanged{}
 
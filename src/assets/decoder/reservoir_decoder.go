@@ -79,6 +79,46 @@ func (w *ReservoirEventWrapper) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
+		w.EventData = e
+
+	case "ReservoirMeasured":
+		e := domain.ReservoirMeasured{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ReservoirRefilled":
+		e := domain.ReservoirRefilled{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ReservoirDrained":
+		e := domain.ReservoirDrained{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "ReservoirNutrientAdded":
+		e := domain.ReservoirNutrientAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
 		w.EventData = e
 	}
 
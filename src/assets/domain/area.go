@@ -19,6 +19,9 @@ type Area struct {
 	ReservoirUID uuid.UUID              `json:"-"`
 	FarmUID      uuid.UUID              `json:"-"`
 
+	// ClimateZoneUID is the zone the area grows in, it is empty when the area is in no zone
+	ClimateZoneUID uuid.UUID `json:"-"`
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -28,6 +31,7 @@ type AreaService interface {
 	FindFarmByID(farmUID uuid.UUID) (AreaFarmServiceResult, error)
 	FindReservoirByID(reservoirUID uuid.UUID) (AreaReservoirServiceResult, error)
 	CountCropsByAreaID(areaUID uuid.UUID) (int, error)
+	FindClimateZoneByID(climateZoneUID uuid.UUID) (AreaClimateZoneServiceResult, error)
 }
 
 type AreaFarmServiceResult struct {
@@ -40,6 +44,12 @@ type AreaReservoirServiceResult struct {
 	Name string
 }
 
+type AreaClimateZoneServiceResult struct {
+	UID     uuid.UUID
+	Name    string
+	FarmUID uuid.UUID
+}
+
 const (
 	AreaTypeSeeding = "SEEDING"
 	AreaTypeGrowing = "GROWING"
@@ -172,6 +182,9 @@ func (state *Area) Transition(event interface{}) {
 	case AreaReservoirChanged:
 		state.ReservoirUID = e.ReservoirUID
 
+	case AreaClimateZoneChanged:
+		state.ClimateZoneUID = e.ClimateZoneUID
+
 	case AreaPhotoAdded:
 		state.Photo = AreaPhoto{
 			Filename: e.Filename,
@@ -350,6 +363,28 @@ func (a *Area) ChangeReservoir(reservoirUID uuid.UUID) error {
 	return nil
 }
 
+// ChangeClimateZone moves the area into a climate zone of its farm.
+// An empty climate zone UID takes the area out of its zone.
+func (a *Area) ChangeClimateZone(areaService AreaService, climateZoneUID uuid.UUID) error {
+	if climateZoneUID != (uuid.UUID{}) {
+		climateZone, err := areaService.FindClimateZoneByID(climateZoneUID)
+		if err != nil {
+			return err
+		}
+
+		if climateZone.UID == (uuid.UUID{}) || climateZone.FarmUID != a.FarmUID {
+			return AreaError{AreaErrorClimateZoneNotFound}
+		}
+	}
+
+	a.TrackChange(AreaClimateZoneChanged{
+		AreaUID:        a.UID,
+		ClimateZoneUID: climateZoneUID,
+	})
+
+	return nil
+}
+
 func (a *Area) ChangePhoto(photo AreaPhoto) error {
 	// TODO: Do file type validation here
 
@@ -21,6 +21,8 @@ const (
 	AreaNoteErrorInvalidContent
 	AreaNoteErrorInvalidID
 	AreaNoteErrorNotFound
+
+	AreaErrorClimateZoneNotFound
 )
 
 // AreaError is a custom error from Go built-in error
@@ -62,6 +64,8 @@ func (e AreaError) Error() string {
 		return "Invalid crop note content"
 	case AreaNoteErrorNotFound:
 		return "Area note not found"
+	case AreaErrorClimateZoneNotFound:
+		return "Climate zone not found in the farm of the area"
 	default:
 		return "Unrecognized Area Error Code"
 	}
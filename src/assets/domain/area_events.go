@@ -42,6 +42,11 @@ type AreaReservoirChanged struct {
 	ReservoirUID uuid.UUID
 }
 
+type AreaClimateZoneChanged struct {
+	AreaUID        uuid.UUID
+	ClimateZoneUID uuid.UUID
+}
+
 type AreaPhotoAdded struct {
 	AreaUID  uuid.UUID
 	Filename string
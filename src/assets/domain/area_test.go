@@ -27,6 +27,11 @@ func (m AreaServiceMock) CountCropsByAreaID(areaUID uuid.UUID) (int, error) {
 	return args.Get(0).(int), nil
 }
 
+func (m AreaServiceMock) FindClimateZoneByID(uid uuid.UUID) (AreaClimateZoneServiceResult, error) {
+	args := m.Called(uid)
+	return args.Get(0).(AreaClimateZoneServiceResult), nil
+}
+
 type countCropsResult struct {
 	AreaUID uuid.UUID
 	Count   int
@@ -243,8 +248,54 @@ func mockAreaService(results ...interface{}) *AreaServiceMock {
 			areaServiceMock.On("FindReservoirByID", res.UID).Return(res)
 		case countCropsResult:
 			areaServiceMock.On("CountCropsByAreaID", res.AreaUID).Return(res.Count)
+		case AreaClimateZoneServiceResult:
+			areaServiceMock.On("FindClimateZoneByID", res.UID).Return(res)
 		}
 	}
 
 	return areaServiceMock
 }
+
+func TestAreaChangeClimateZone(t *testing.T) {
+	// Given
+	farmUID, _ := uuid.NewV4()
+	farmResult := AreaFarmServiceResult{UID: farmUID}
+
+	reservoirUID, _ := uuid.NewV4()
+	reservoirResult := AreaReservoirServiceResult{UID: reservoirUID}
+
+	climateZoneUID, _ := uuid.NewV4()
+	climateZoneResult := AreaClimateZoneServiceResult{UID: climateZoneUID, Name: "Greenhouse 1", FarmUID: farmUID}
+
+	otherFarmUID, _ := uuid.NewV4()
+	otherClimateZoneUID, _ := uuid.NewV4()
+	otherClimateZoneResult := AreaClimateZoneServiceResult{UID: otherClimateZoneUID, FarmUID: otherFarmUID}
+
+	areaService := mockAreaService(farmResult, reservoirResult, climateZoneResult, otherClimateZoneResult)
+
+	area, _ := CreateArea(
+		areaService,
+		farmUID,
+		reservoirUID,
+		"My Area 1",
+		AreaTypeGrowing,
+		AreaSize{Unit: GetAreaUnit(SquareMeter), Value: float32(10)},
+		AreaLocationIndoor,
+	)
+
+	// When
+	err := area.ChangeClimateZone(areaService, climateZoneUID)
+	errOtherFarm := area.ChangeClimateZone(areaService, otherClimateZoneUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, climateZoneUID, area.ClimateZoneUID)
+	assert.Equal(t, AreaError{AreaErrorClimateZoneNotFound}, errOtherFarm)
+
+	// When
+	err = area.ChangeClimateZone(areaService, uuid.UUID{})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, uuid.UUID{}, area.ClimateZoneUID)
+}
@@ -0,0 +1,285 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/validationhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+// ClimateZone is an enclosed space of a farm with its own climate, like a greenhouse or a grow tent.
+// It groups the areas growing in it and carries the climate their crops are kept in.
+type ClimateZone struct {
+	UID         uuid.UUID
+	Name        string
+	Type        ClimateZoneType
+	FarmUID     uuid.UUID
+	Targets     ClimateTargets
+	CreatedDate time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+type ClimateZoneService interface {
+	FindFarmByID(farmUID uuid.UUID) (ClimateZoneFarmServiceResult, error)
+}
+
+type ClimateZoneFarmServiceResult struct {
+	UID  uuid.UUID
+	Name string
+}
+
+const (
+	ClimateZoneTypeGreenhouse  = "GREENHOUSE"
+	ClimateZoneTypeNurseryRoom = "NURSERY_ROOM"
+	ClimateZoneTypeGrowTent    = "GROW_TENT"
+)
+
+type ClimateZoneType struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+func ClimateZoneTypes() []ClimateZoneType {
+	return []ClimateZoneType{
+		{Code: ClimateZoneTypeGreenhouse, Name: "Greenhouse"},
+		{Code: ClimateZoneTypeNurseryRoom, Name: "Nursery Room"},
+		{Code: ClimateZoneTypeGrowTent, Name: "Grow Tent"},
+	}
+}
+
+func GetClimateZoneType(code string) ClimateZoneType {
+	for _, v := range ClimateZoneTypes() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return ClimateZoneType{}
+}
+
+// Climate parameters a zone has targets for. They are the codes of the matching sensor types of the devices module.
+const (
+	ClimateParameterTemperature = "TEMPERATURE"
+	ClimateParameterHumidity    = "HUMIDITY"
+	ClimateParameterCO2         = "CO2"
+	ClimateParameterLight       = "LIGHT"
+)
+
+// ClimateParameters lists the climate parameters of a zone
+func ClimateParameters() []string {
+	return []string{
+		ClimateParameterTemperature,
+		ClimateParameterHumidity,
+		ClimateParameterCO2,
+		ClimateParameterLight,
+	}
+}
+
+// ClimateRange is the range a climate parameter is kept in. A nil bound is not enforced.
+type ClimateRange struct {
+	Min *float32 `json:"min"`
+	Max *float32 `json:"max"`
+}
+
+// Contains tells whether the value is within the range, bounds included
+func (r ClimateRange) Contains(value float32) bool {
+	if r.Min != nil && value < *r.Min {
+		return false
+	}
+
+	if r.Max != nil && value > *r.Max {
+		return false
+	}
+
+	return true
+}
+
+// IsEmpty tells whether the range has no bound
+func (r ClimateRange) IsEmpty() bool {
+	return r.Min == nil && r.Max == nil
+}
+
+// ClimateTargets are the ranges the climate of a zone is kept in.
+// Temperature is in °C, humidity in %, CO2 in ppm and light is the PPFD in µmol/m²/s.
+type ClimateTargets struct {
+	Temperature ClimateRange `json:"temperature"`
+	Humidity    ClimateRange `json:"humidity"`
+	CO2         ClimateRange `json:"co2"`
+	Light       ClimateRange `json:"light"`
+}
+
+// Range finds the target range of the climate parameter
+func (t ClimateTargets) Range(parameter string) (ClimateRange, bool) {
+	switch parameter {
+	case ClimateParameterTemperature:
+		return t.Temperature, true
+	case ClimateParameterHumidity:
+		return t.Humidity, true
+	case ClimateParameterCO2:
+		return t.CO2, true
+	case ClimateParameterLight:
+		return t.Light, true
+	}
+
+	return ClimateRange{}, false
+}
+
+func (state *ClimateZone) TrackChange(event interface{}) {
+	state.UncommittedChanges = append(state.UncommittedChanges, event)
+	state.Transition(event)
+}
+
+func (state *ClimateZone) Transition(event interface{}) {
+	switch e := event.(type) {
+	case ClimateZoneCreated:
+		state.UID = e.UID
+		state.Name = e.Name
+		state.Type = e.Type
+		state.FarmUID = e.FarmUID
+		state.CreatedDate = e.CreatedDate
+
+	case ClimateZoneNameChanged:
+		state.Name = e.Name
+
+	case ClimateZoneTypeChanged:
+		state.Type = e.Type
+
+	case ClimateZoneTargetsChanged:
+		state.Targets = e.Targets
+
+	}
+}
+
+// CreateClimateZone registers a new climate zone to a farm. It has no targets until they are set.
+func CreateClimateZone(climateZoneService ClimateZoneService, farmUID uuid.UUID, name, zoneType string) (*ClimateZone, error) {
+	farmServiceResult, err := climateZoneService.FindFarmByID(farmUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if farmServiceResult.UID == (uuid.UUID{}) {
+		return nil, ClimateZoneError{ClimateZoneErrorFarmNotFound}
+	}
+
+	err = validateClimateZoneName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	t := GetClimateZoneType(zoneType)
+	if t == (ClimateZoneType{}) {
+		return nil, ClimateZoneError{ClimateZoneErrorInvalidTypeCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &ClimateZone{}
+
+	initial.TrackChange(ClimateZoneCreated{
+		UID:         uid,
+		Name:        name,
+		Type:        t,
+		FarmUID:     farmServiceResult.UID,
+		CreatedDate: time.Now(),
+	})
+
+	return initial, nil
+}
+
+func (z *ClimateZone) ChangeName(name string) error {
+	err := validateClimateZoneName(name)
+	if err != nil {
+		return err
+	}
+
+	z.TrackChange(ClimateZoneNameChanged{
+		ClimateZoneUID: z.UID,
+		Name:           name,
+	})
+
+	return nil
+}
+
+func (z *ClimateZone) ChangeType(zoneType string) error {
+	t := GetClimateZoneType(zoneType)
+	if t == (ClimateZoneType{}) {
+		return ClimateZoneError{ClimateZoneErrorInvalidTypeCode}
+	}
+
+	z.TrackChange(ClimateZoneTypeChanged{
+		ClimateZoneUID: z.UID,
+		Type:           t,
+	})
+
+	return nil
+}
+
+// ChangeTargets replaces the target ranges of the zone
+func (z *ClimateZone) ChangeTargets(targets ClimateTargets) error {
+	for _, parameter := range ClimateParameters() {
+		r, _ := targets.Range(parameter)
+
+		err := validateClimateRange(parameter, r)
+		if err != nil {
+			return err
+		}
+	}
+
+	z.TrackChange(ClimateZoneTargetsChanged{
+		ClimateZoneUID: z.UID,
+		Targets:        targets,
+	})
+
+	return nil
+}
+
+func validateClimateZoneName(name string) error {
+	if name == "" {
+		return ClimateZoneError{ClimateZoneErrorNameEmptyCode}
+	}
+	if !validationhelper.IsAlphanumSpaceHyphenUnderscore(name) {
+		return ClimateZoneError{ClimateZoneErrorNameAlphanumericOnlyCode}
+	}
+	if len(name) < 5 {
+		return ClimateZoneError{ClimateZoneErrorNameNotEnoughCharacterCode}
+	}
+	if len(name) > 100 {
+		return ClimateZoneError{ClimateZoneErrorNameExceedMaximunCharacterCode}
+	}
+
+	return nil
+}
+
+// validateClimateRange checks the bounds are possible values of the parameter,
+// with the same limits the devices module puts on the sensor readings
+func validateClimateRange(parameter string, r ClimateRange) error {
+	var min, max float32
+	switch parameter {
+	case ClimateParameterTemperature:
+		min, max = -50, 80
+	case ClimateParameterHumidity:
+		min, max = 0, 100
+	case ClimateParameterCO2:
+		min, max = 0, 10000
+	case ClimateParameterLight:
+		min, max = 0, 3000
+	}
+
+	for _, v := range []*float32{r.Min, r.Max} {
+		if v != nil && (*v < min || *v > max) {
+			return ClimateZoneError{ClimateZoneErrorInvalidTargetCode}
+		}
+	}
+
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return ClimateZoneError{ClimateZoneErrorTargetRangeInvertedCode}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package domain
+
+const (
+	ClimateZoneErrorNameEmptyCode = iota
+	ClimateZoneErrorNameNotEnoughCharacterCode
+	ClimateZoneErrorNameExceedMaximunCharacterCode
+	ClimateZoneErrorNameAlphanumericOnlyCode
+	ClimateZoneErrorFarmNotFound
+	ClimateZoneErrorInvalidTypeCode
+	ClimateZoneErrorInvalidTargetCode
+	ClimateZoneErrorTargetRangeInvertedCode
+)
+
+// ClimateZoneError is a custom error from Go built-in error
+type ClimateZoneError struct {
+	Code int
+}
+
+func (e ClimateZoneError) Error() string {
+	switch e.Code {
+	case ClimateZoneErrorNameEmptyCode:
+		return "Climate zone name is required."
+	case ClimateZoneErrorNameNotEnoughCharacterCode:
+		return "Not enough character on Climate Zone Name"
+	case ClimateZoneErrorNameExceedMaximunCharacterCode:
+		return "Climate zone name cannot more than 100 characters"
+	case ClimateZoneErrorNameAlphanumericOnlyCode:
+		return "Climate zone name should be alphanumeric, space, hypen, or underscore"
+	case ClimateZoneErrorFarmNotFound:
+		return "Farm not found"
+	case ClimateZoneErrorInvalidTypeCode:
+		return "Climate zone type is invalid"
+	case ClimateZoneErrorInvalidTargetCode:
+		return "Climate zone target is out of the possible values of its parameter"
+	case ClimateZoneErrorTargetRangeInvertedCode:
+		return "Climate zone target minimum cannot be more than its maximum"
+	default:
+		return "Unrecognized Climate Zone Error Code"
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneCreated struct {
+	UID         uuid.UUID
+	Name        string
+	Type        ClimateZoneType
+	FarmUID     uuid.UUID
+	CreatedDate time.Time
+}
+
+type ClimateZoneNameChanged struct {
+	ClimateZoneUID uuid.UUID
+	Name           string
+}
+
+type ClimateZoneTypeChanged struct {
+	ClimateZoneUID uuid.UUID
+	Type           ClimateZoneType
+}
+
+type ClimateZoneTargetsChanged struct {
+	ClimateZoneUID uuid.UUID
+	Targets        ClimateTargets
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ClimateZoneServiceMock struct {
+	mock.Mock
+}
+
+func (m ClimateZoneServiceMock) FindFarmByID(uid uuid.UUID) (ClimateZoneFarmServiceResult, error) {
+	args := m.Called(uid)
+	return args.Get(0).(ClimateZoneFarmServiceResult), nil
+}
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
+
+func TestCreateClimateZone(t *testing.T) {
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := new(ClimateZoneServiceMock)
+	serviceMock.On("FindFarmByID", farmUID).Return(ClimateZoneFarmServiceResult{UID: farmUID, Name: "My Farm"})
+
+	// When
+	zone, err := CreateClimateZone(serviceMock, farmUID, "Greenhouse 1", ClimateZoneTypeGreenhouse)
+	_, errType := CreateClimateZone(serviceMock, farmUID, "Greenhouse 1", "BARN")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, farmUID, zone.FarmUID)
+	assert.Equal(t, ClimateZoneError{ClimateZoneErrorInvalidTypeCode}, errType)
+
+	event, ok := zone.UncommittedChanges[0].(ClimateZoneCreated)
+	assert.True(t, ok)
+	assert.Equal(t, zone.UID, event.UID)
+
+	// When
+	errTargets := zone.ChangeTargets(ClimateTargets{
+		Temperature: ClimateRange{Min: float32Ptr(18), Max: float32Ptr(26)},
+		CO2:         ClimateRange{Max: float32Ptr(1200)},
+	})
+	errInverted := zone.ChangeTargets(ClimateTargets{Humidity: ClimateRange{Min: float32Ptr(80), Max: float32Ptr(60)}})
+	errInvalid := zone.ChangeTargets(ClimateTargets{Humidity: ClimateRange{Max: float32Ptr(120)}})
+
+	// Then
+	assert.Nil(t, errTargets)
+	assert.Equal(t, ClimateZoneError{ClimateZoneErrorTargetRangeInvertedCode}, errInverted)
+	assert.Equal(t, ClimateZoneError{ClimateZoneErrorInvalidTargetCode}, errInvalid)
+
+	temperature, ok := zone.Targets.Range(ClimateParameterTemperature)
+	assert.True(t, ok)
+	assert.True(t, temperature.Contains(26))
+	assert.False(t, temperature.Contains(17.5))
+	assert.True(t, zone.Targets.CO2.Contains(400))
+	assert.True(t, zone.Targets.Light.IsEmpty())
+}
@@ -19,6 +19,10 @@ type Farm struct {
 	IsActive    bool      `json:"is_active"`
 	CreatedDate time.Time `json:"created_date"`
 
+	// BatchIDTemplate is how the crop batch IDs of the farm are built.
+	// Empty means the default template of the growth module.
+	BatchIDTemplate string `json:"batch_id_template"`
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -65,6 +69,9 @@ func (state *Farm) Transition(event interface{}) {
 		state.Country = e.Country
 		state.City = e.City
 
+	case FarmBatchIDTemplateChanged:
+		state.BatchIDTemplate = e.BatchIDTemplate
+
 	}
 }
 
@@ -181,3 +188,18 @@ func (f *Farm) ChangeRegion(country, city string) error {
 
 	return nil
 }
+
+// ChangeBatchIDTemplate changes how the crop batch IDs of the farm are built
+func (f *Farm) ChangeBatchIDTemplate(template string) error {
+	err := validateBatchIDTemplate(template)
+	if err != nil {
+		return err
+	}
+
+	f.TrackChange(FarmBatchIDTemplateChanged{
+		FarmUID:         f.UID,
+		BatchIDTemplate: template,
+	})
+
+	return nil
+}
@@ -23,6 +23,12 @@ const (
 	FarmErrorInvalidLongitudeValueCode
 	FarmErrorInvalidCountry
 	FarmErrorInvalidCity
+
+	FarmErrorBatchIDTemplateEmptyCode
+	FarmErrorBatchIDTemplateExceedMaximumCharacterCode
+	FarmErrorBatchIDTemplateInvalidCharacterCode
+	FarmErrorBatchIDTemplateUnknownTokenCode
+	FarmErrorBatchIDTemplateNoTokenCode
 )
 
 func (e FarmError) Error() string {
@@ -53,6 +59,16 @@ func (e FarmError) Error() string {
 		return "Invalid country"
 	case FarmErrorInvalidCity:
 		return "Invalid city"
+	case FarmErrorBatchIDTemplateEmptyCode:
+		return "Batch ID template is required."
+	case FarmErrorBatchIDTemplateExceedMaximumCharacterCode:
+		return "Batch ID template cannot more than 50 characters"
+	case FarmErrorBatchIDTemplateInvalidCharacterCode:
+		return "Batch ID template should be alphanumeric, hyphen, underscore, or tokens"
+	case FarmErrorBatchIDTemplateUnknownTokenCode:
+		return "Batch ID template token should be {variety}, {date}, {area}, or {seq}"
+	case FarmErrorBatchIDTemplateNoTokenCode:
+		return "Batch ID template should contain {variety}, {area}, or {seq}"
 	default:
 		return "Unrecognized location error code"
 	}
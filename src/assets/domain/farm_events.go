@@ -39,3 +39,8 @@ type FarmRegionChanged struct {
 	Country string
 	City    string
 }
+
+type FarmBatchIDTemplateChanged struct {
+	FarmUID         uuid.UUID
+	BatchIDTemplate string
+}
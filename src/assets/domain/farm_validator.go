@@ -66,3 +66,38 @@ func validateCity(city string) error {
 
 	return nil
 }
+
+// validateBatchIDTemplate checks the template can be rendered by the growth module.
+// At least one of the tokens which differ between batches of the same day is required.
+func validateBatchIDTemplate(template string) error {
+	if template == "" {
+		return FarmError{FarmErrorBatchIDTemplateEmptyCode}
+	}
+	if len(template) > 50 {
+		return FarmError{FarmErrorBatchIDTemplateExceedMaximumCharacterCode}
+	}
+
+	rxToken := regexp.MustCompile("{[^{}]*}")
+
+	literals := rxToken.ReplaceAllString(template, "")
+	if !regexp.MustCompile("^[a-zA-Z0-9-_]*$").MatchString(literals) {
+		return FarmError{FarmErrorBatchIDTemplateInvalidCharacterCode}
+	}
+
+	hasToken := false
+	for _, v := range rxToken.FindAllString(template, -1) {
+		switch v {
+		case "{variety}", "{area}", "{seq}":
+			hasToken = true
+		case "{date}":
+		default:
+			return FarmError{FarmErrorBatchIDTemplateUnknownTokenCode}
+		}
+	}
+
+	if !hasToken {
+		return FarmError{FarmErrorBatchIDTemplateNoTokenCode}
+	}
+
+	return nil
+}
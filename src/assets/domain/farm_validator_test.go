@@ -116,3 +116,27 @@ func TestValidateCityCode(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateBatchIDTemplate(t *testing.T) {
+	t.Parallel()
+	// Given
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"{variety}-{date}", nil},
+		{"{area}_{date}-{seq}", nil},
+		{"", FarmError{FarmErrorBatchIDTemplateEmptyCode}},
+		{"{variety}/{date}", FarmError{FarmErrorBatchIDTemplateInvalidCharacterCode}},
+		{"{variety}-{week}", FarmError{FarmErrorBatchIDTemplateUnknownTokenCode}},
+		{"batch-{date}", FarmError{FarmErrorBatchIDTemplateNoTokenCode}},
+	}
+
+	for _, test := range tests {
+		// When
+		actual := validateBatchIDTemplate(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected (%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
@@ -4,6 +4,7 @@ const (
 	InventoryMaterialInvalidPlantType = iota
 	InventoryMaterialInvalidVariety
 	InventoryMaterialErrorWrongType
+	InventoryMaterialErrorInvalidInterval
 )
 
 // InventoryMaterialError is a custom error from Go built-in error
@@ -19,6 +20,8 @@ func (e InventoryMaterialError) Error() string {
 		return "Invalid variety"
 	case InventoryMaterialErrorWrongType:
 		return "Wrong type"
+	case InventoryMaterialErrorInvalidInterval:
+		return "Invalid pre-harvest or re-entry interval"
 	default:
 		return "Unrecognized Inventory Material Error Code"
 	}
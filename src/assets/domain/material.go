@@ -17,6 +17,10 @@ type Material struct {
 	Notes          *string          `json:"notes"`
 	ProducedBy     *string          `json:"produced_by"`
 	CreatedDate    time.Time        `json:"created_date"`
+	CropPlan       *CropPlan        `json:"crop_plan"`
+
+	// SeedsPerUnit converts the quantity of a seed material kept in packets, grams or kilograms to seeds
+	SeedsPerUnit float32 `json:"seeds_per_unit"`
 
 	// Events
 	Version            int
@@ -28,6 +32,57 @@ const (
 	MoneyIDR = "IDR"
 )
 
+// CropPlan is the expected schedule of a seed variety, counted in days since seeding.
+// DaysToTransplant is zero for varieties which are sown directly in their growing area.
+// GDDToFirstHarvest is the thermal time the variety needs above its BaseTemperature,
+// in growing degree days; it is zero when only the calendar days are known.
+type CropPlan struct {
+	DaysToGermination  int     `json:"days_to_germination"`
+	DaysToTransplant   int     `json:"days_to_transplant"`
+	DaysToFirstHarvest int     `json:"days_to_first_harvest"`
+	DaysToEndOfHarvest int     `json:"days_to_end_of_harvest"`
+	BaseTemperature    float32 `json:"base_temperature"`
+	GDDToFirstHarvest  float32 `json:"gdd_to_first_harvest"`
+}
+
+func CreateCropPlan(daysToGermination, daysToTransplant, daysToFirstHarvest, daysToEndOfHarvest int) (CropPlan, error) {
+	if daysToGermination <= 0 || daysToTransplant < 0 {
+		return CropPlan{}, MaterialError{MaterialErrorInvalidCropPlan}
+	}
+
+	if daysToTransplant > 0 && daysToTransplant < daysToGermination {
+		return CropPlan{}, MaterialError{MaterialErrorInvalidCropPlan}
+	}
+
+	if daysToFirstHarvest < daysToGermination || daysToFirstHarvest < daysToTransplant {
+		return CropPlan{}, MaterialError{MaterialErrorInvalidCropPlan}
+	}
+
+	if daysToEndOfHarvest < daysToFirstHarvest {
+		return CropPlan{}, MaterialError{MaterialErrorInvalidCropPlan}
+	}
+
+	return CropPlan{
+		DaysToGermination:  daysToGermination,
+		DaysToTransplant:   daysToTransplant,
+		DaysToFirstHarvest: daysToFirstHarvest,
+		DaysToEndOfHarvest: daysToEndOfHarvest,
+	}, nil
+}
+
+// WithThermalTime sets the growing degree days the variety needs from seeding to its first harvest,
+// above a base temperature in Celsius under which it doesn't grow.
+func (p CropPlan) WithThermalTime(baseTemperature, gddToFirstHarvest float32) (CropPlan, error) {
+	if gddToFirstHarvest < 0 || baseTemperature < -10 || baseTemperature > 30 {
+		return CropPlan{}, MaterialError{MaterialErrorInvalidCropPlan}
+	}
+
+	p.BaseTemperature = baseTemperature
+	p.GDDToFirstHarvest = gddToFirstHarvest
+
+	return p, nil
+}
+
 type PricePerUnit struct {
 	Amount       string `json:"amount"`
 	CurrencyCode string `json:"code"`
@@ -180,6 +235,16 @@ func (state *Material) Transition(event interface{}) {
 	case MaterialProducedByChanged:
 		state.ProducedBy = &e.ProducedBy
 
+	case MaterialConsumed:
+		state.Quantity.Value = state.Quantity.Value - e.Quantity
+
+	case MaterialCropPlanChanged:
+		plan := e.CropPlan
+		state.CropPlan = &plan
+
+	case MaterialSeedsPerUnitChanged:
+		state.SeedsPerUnit = e.SeedsPerUnit
+
 	}
 }
 
@@ -309,6 +374,38 @@ func (m *Material) ChangeType(materialType MaterialType) error {
 	return nil
 }
 
+// ChangeCropPlan attaches the expected schedule of the variety to a seed material.
+func (m *Material) ChangeCropPlan(cropPlan CropPlan) error {
+	if _, ok := m.Type.(MaterialTypeSeed); !ok {
+		return MaterialError{MaterialErrorCropPlanNotAllowed}
+	}
+
+	m.TrackChange(MaterialCropPlanChanged{
+		MaterialUID: m.UID,
+		CropPlan:    cropPlan,
+	})
+
+	return nil
+}
+
+// ChangeSeedsPerUnit sets how many seeds are in one unit of a seed material, like in one packet.
+func (m *Material) ChangeSeedsPerUnit(seedsPerUnit float32) error {
+	if _, ok := m.Type.(MaterialTypeSeed); !ok {
+		return MaterialError{MaterialErrorSeedsPerUnitNotAllowed}
+	}
+
+	if seedsPerUnit <= 0 {
+		return MaterialError{MaterialErrorInvalidSeedsPerUnit}
+	}
+
+	m.TrackChange(MaterialSeedsPerUnitChanged{
+		MaterialUID:  m.UID,
+		SeedsPerUnit: seedsPerUnit,
+	})
+
+	return nil
+}
+
 func (m *Material) ChangeExpirationDate(expDate time.Time) error {
 	m.TrackChange(MaterialExpirationDateChanged{
 		MaterialUID:    m.UID,
@@ -336,6 +433,99 @@ func (m *Material) ChangeProducedBy(producedBy string) error {
 	return nil
 }
 
+// Consume takes out the quantity used by a task from the material stock
+func (m *Material) Consume(quantity float32, taskUID uuid.UUID) error {
+	err := validateQuantity(quantity)
+	if err != nil {
+		return err
+	}
+
+	if quantity > m.Quantity.Value {
+		return MaterialError{MaterialErrorInsufficientQuantity}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		TaskUID:      taskUID,
+		Quantity:     quantity,
+		QuantityUnit: m.Quantity.Unit,
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// ConsumeNutrient takes out the agrochemical mixed in a reservoir from the inventory
+func (m *Material) ConsumeNutrient(quantity float32, reservoirUID uuid.UUID) error {
+	err := validateQuantity(quantity)
+	if err != nil {
+		return err
+	}
+
+	if quantity > m.Quantity.Value {
+		return MaterialError{MaterialErrorInsufficientQuantity}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		ReservoirUID: reservoirUID,
+		Quantity:     quantity,
+		QuantityUnit: m.Quantity.Unit,
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
+// SeedQuantity converts a number of seeds to the quantity unit the seed material is kept in
+func (m *Material) SeedQuantity(seeds int) (float32, error) {
+	if _, ok := m.Type.(MaterialTypeSeed); !ok {
+		return 0, MaterialError{MaterialErrorSeedsPerUnitNotAllowed}
+	}
+
+	if m.Quantity.Unit.Code == MaterialUnitSeeds {
+		return float32(seeds), nil
+	}
+
+	if m.SeedsPerUnit <= 0 {
+		return 0, MaterialError{MaterialErrorInvalidSeedsPerUnit}
+	}
+
+	return float32(seeds) / m.SeedsPerUnit, nil
+}
+
+// ConsumeSeeds takes out the seeds sown in a crop batch from the seed material stock.
+// A crop batch can be sown without enough seeds in stock, then what is left is consumed.
+func (m *Material) ConsumeSeeds(seeds int, cropUID uuid.UUID) error {
+	quantity, err := m.SeedQuantity(seeds)
+	if err != nil {
+		return err
+	}
+
+	err = validateQuantity(quantity)
+	if err != nil {
+		return err
+	}
+
+	if quantity > m.Quantity.Value {
+		quantity = m.Quantity.Value
+	}
+
+	if quantity <= 0 {
+		return MaterialError{MaterialErrorInsufficientQuantity}
+	}
+
+	m.TrackChange(MaterialConsumed{
+		MaterialUID:  m.UID,
+		CropUID:      cropUID,
+		Quantity:     quantity,
+		QuantityUnit: m.Quantity.Unit,
+		ConsumedDate: time.Now(),
+	})
+
+	return nil
+}
+
 func validateQuantity(quantity float32) error {
 	if quantity <= 0 {
 		return errors.New("Cannot be empty")
@@ -2,6 +2,11 @@ package domain
 
 const (
 	MaterialErrorInvalidMaterialType = iota
+	MaterialErrorInsufficientQuantity
+	MaterialErrorInvalidCropPlan
+	MaterialErrorCropPlanNotAllowed
+	MaterialErrorInvalidSeedsPerUnit
+	MaterialErrorSeedsPerUnitNotAllowed
 )
 
 // MaterialError is a custom error from Go built-in error
@@ -13,6 +18,16 @@ func (e MaterialError) Error() string {
 	switch e.Code {
 	case MaterialErrorInvalidMaterialType:
 		return "Invalid material type"
+	case MaterialErrorInsufficientQuantity:
+		return "Insufficient material quantity"
+	case MaterialErrorInvalidCropPlan:
+		return "Invalid crop plan. Days must be positive and in the order of the crop stages"
+	case MaterialErrorCropPlanNotAllowed:
+		return "Crop plan can only be attached to a seed material"
+	case MaterialErrorInvalidSeedsPerUnit:
+		return "Seeds per unit must be set to convert the seed material quantity"
+	case MaterialErrorSeedsPerUnitNotAllowed:
+		return "Seeds per unit can only be set on a seed material"
 	default:
 		return "Unrecognized Material Error Code"
 	}
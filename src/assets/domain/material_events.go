@@ -53,3 +53,24 @@ type MaterialProducedByChanged struct {
 	MaterialUID uuid.UUID
 	ProducedBy  string
 }
+
+type MaterialCropPlanChanged struct {
+	MaterialUID uuid.UUID
+	CropPlan    CropPlan
+}
+
+type MaterialSeedsPerUnitChanged struct {
+	MaterialUID  uuid.UUID
+	SeedsPerUnit float32
+}
+
+// MaterialConsumed is either consumed by a completed task or sown in a crop batch
+type MaterialConsumed struct {
+	MaterialUID  uuid.UUID
+	TaskUID      uuid.UUID
+	CropUID      uuid.UUID
+	ReservoirUID uuid.UUID
+	Quantity     float32
+	QuantityUnit MaterialQuantityUnit
+	ConsumedDate time.Time
+}
@@ -3,6 +3,7 @@ package domain
 import (
 	"testing"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,7 +24,7 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, PlantTypeVegetable, tp.PlantType.Code)
 
 	// When
-	mta, err1 := CreateMaterialTypeAgrochemical(ChemicalTypeDisinfectant)
+	mta, err1 := CreateMaterialTypeAgrochemical(ChemicalTypeDisinfectant, 0, 0)
 	material2, err2 := CreateMaterial("Green Disinfectant", "5", MoneyEUR, mta, 5, MaterialUnitPackets, nil, nil, nil)
 	ta, ok := material2.Type.(MaterialTypeAgrochemical)
 
@@ -35,6 +36,16 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, ChemicalTypeDisinfectant, ta.ChemicalType.Code)
 
+	// When
+	_, err3 := CreateMaterialTypeAgrochemical(ChemicalTypePesticide, -1, 12)
+	mtp, err4 := CreateMaterialTypeAgrochemical(ChemicalTypePesticide, 7, 12)
+
+	// Then
+	assert.Equal(t, InventoryMaterialError{InventoryMaterialErrorInvalidInterval}, err3)
+	assert.Nil(t, err4)
+	assert.Equal(t, 7, mtp.PreHarvestInterval)
+	assert.Equal(t, 12, mtp.ReEntryInterval)
+
 	// When
 	mtsc, err1 := CreateMaterialTypeSeedingContainer(ContainerTypeTray)
 	material3, err2 := CreateMaterial("Soft Indoor Tray Pack", "10", MoneyEUR, mtsc, 10, MaterialUnitPieces, nil, nil, nil)
@@ -96,3 +107,81 @@ func TestCreateInventorySeed(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, MaterialTypeOtherCode, mo.Code())
 }
+
+func TestConsumeMaterial(t *testing.T) {
+	// Given
+	mta, _ := CreateMaterialTypeAgrochemical(ChemicalTypeFertilizer, 0, 0)
+	material, _ := CreateMaterial("Organic Fertilizer", "5", MoneyEUR, mta, 5, MaterialUnitBags, nil, nil, nil)
+	taskUID, _ := uuid.NewV4()
+
+	// When
+	err := material.Consume(2, taskUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, float32(3), material.Quantity.Value)
+	assert.Len(t, material.UncommittedChanges, 2)
+
+	event, ok := material.UncommittedChanges[1].(MaterialConsumed)
+	assert.True(t, ok)
+	assert.Equal(t, taskUID, event.TaskUID)
+	assert.Equal(t, float32(2), event.Quantity)
+
+	// When
+	err = material.Consume(4, taskUID)
+
+	// Then
+	assert.Equal(t, MaterialError{MaterialErrorInsufficientQuantity}, err)
+	assert.Equal(t, float32(3), material.Quantity.Value)
+
+	// When
+	err = material.Consume(0, taskUID)
+
+	// Then
+	assert.NotNil(t, err)
+}
+
+func TestChangeCropPlan(t *testing.T) {
+	// Given
+	mts, _ := CreateMaterialTypeSeed(PlantTypeVegetable)
+	seed, _ := CreateMaterial("Tomato Super One", "5", MoneyEUR, mts, 5, MaterialUnitPackets, nil, nil, nil)
+
+	mta, _ := CreateMaterialTypeAgrochemical(ChemicalTypeFertilizer, 0, 0)
+	fertilizer, _ := CreateMaterial("Organic Fertilizer", "5", MoneyEUR, mta, 5, MaterialUnitBags, nil, nil, nil)
+
+	// When
+	plan, err1 := CreateCropPlan(7, 30, 60, 90)
+	_, err2 := CreateCropPlan(7, 30, 20, 90)
+	directSowPlan, err3 := CreateCropPlan(5, 0, 40, 50)
+	err4 := seed.ChangeCropPlan(plan)
+	err5 := fertilizer.ChangeCropPlan(plan)
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidCropPlan}, err2)
+	assert.Nil(t, err3)
+	assert.Equal(t, 0, directSowPlan.DaysToTransplant)
+	assert.Nil(t, err4)
+	assert.Equal(t, &plan, seed.CropPlan)
+	assert.Equal(t, MaterialError{MaterialErrorCropPlanNotAllowed}, err5)
+	assert.Nil(t, fertilizer.CropPlan)
+}
+
+func TestCropPlanWithThermalTime(t *testing.T) {
+	// Given
+	plan, _ := CreateCropPlan(7, 30, 60, 90)
+
+	// When
+	thermalPlan, err1 := plan.WithThermalTime(10, 1200)
+	_, err2 := plan.WithThermalTime(10, -5)
+	_, err3 := plan.WithThermalTime(45, 1200)
+
+	// Then
+	assert.Nil(t, err1)
+	assert.Equal(t, float32(10), thermalPlan.BaseTemperature)
+	assert.Equal(t, float32(1200), thermalPlan.GDDToFirstHarvest)
+	assert.Equal(t, 60, thermalPlan.DaysToFirstHarvest)
+	assert.Equal(t, float32(0), plan.GDDToFirstHarvest)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidCropPlan}, err2)
+	assert.Equal(t, MaterialError{MaterialErrorInvalidCropPlan}, err3)
+}
@@ -67,6 +67,11 @@ func GetPlantType(code string) PlantType {
 
 type MaterialTypeAgrochemical struct {
 	ChemicalType ChemicalType
+
+	// PreHarvestInterval is the number of days to wait after the last application before harvesting
+	PreHarvestInterval int
+	// ReEntryInterval is the number of hours to wait after an application before entering the area
+	ReEntryInterval int
 }
 
 func (mt MaterialTypeAgrochemical) Code() string {
@@ -106,13 +111,21 @@ func GetChemicalType(code string) ChemicalType {
 	return ChemicalType{}
 }
 
-func CreateMaterialTypeAgrochemical(chemicalType string) (MaterialTypeAgrochemical, error) {
+func CreateMaterialTypeAgrochemical(chemicalType string, preHarvestInterval, reEntryInterval int) (MaterialTypeAgrochemical, error) {
 	ct := GetChemicalType(chemicalType)
 	if ct == (ChemicalType{}) {
 		return MaterialTypeAgrochemical{}, InventoryMaterialError{InventoryMaterialErrorWrongType}
 	}
 
-	return MaterialTypeAgrochemical{ct}, nil
+	if preHarvestInterval < 0 || reEntryInterval < 0 {
+		return MaterialTypeAgrochemical{}, InventoryMaterialError{InventoryMaterialErrorInvalidInterval}
+	}
+
+	return MaterialTypeAgrochemical{
+		ChemicalType:       ct,
+		PreHarvestInterval: preHarvestInterval,
+		ReEntryInterval:    reEntryInterval,
+	}, nil
 }
 
 type MaterialTypeGrowingMedium struct {
@@ -17,6 +17,12 @@ type Reservoir struct {
 	Notes       map[uuid.UUID]ReservoirNote
 	CreatedDate time.Time
 
+	LatestMeasurement *ReservoirLatestMeasurement
+
+	// Volume is the water currently in the reservoir, in litres
+	Volume    float32
+	Nutrients map[uuid.UUID]ReservoirNutrient
+
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
@@ -77,6 +83,88 @@ type ReservoirNote struct {
 	CreatedDate time.Time `json:"created_date"`
 }
 
+// Sources of a reservoir measurement
+const (
+	MeasurementSourceManual = "MANUAL"
+	MeasurementSourceSensor = "SENSOR"
+)
+
+// ReservoirMeasurement is a water quality measurement of the reservoir.
+// Every parameter is optional, since a sensor usually only measures one of them.
+type ReservoirMeasurement struct {
+	UID             uuid.UUID `json:"uid"`
+	PH              *float32  `json:"ph"`
+	EC              *float32  `json:"ec"`
+	Temperature     *float32  `json:"temperature"`
+	DissolvedOxygen *float32  `json:"dissolved_oxygen"`
+	Source          string    `json:"source"`
+	MeasuredDate    time.Time `json:"measured_date"`
+}
+
+// ReservoirLatestMeasurement is the latest value of each water quality parameter.
+// A measurement can carry only some of the parameters, so each one keeps its own date.
+type ReservoirLatestMeasurement struct {
+	PH              *ReservoirParameterMeasurement `json:"ph"`
+	EC              *ReservoirParameterMeasurement `json:"ec"`
+	Temperature     *ReservoirParameterMeasurement `json:"temperature"`
+	DissolvedOxygen *ReservoirParameterMeasurement `json:"dissolved_oxygen"`
+}
+
+type ReservoirParameterMeasurement struct {
+	Value        float32   `json:"value"`
+	Source       string    `json:"source"`
+	MeasuredDate time.Time `json:"measured_date"`
+}
+
+// WithMeasurement overwrites only the parameters the measurement carries.
+// Measurements may be recorded late, so only a newer value replaces the latest one.
+func (m ReservoirLatestMeasurement) WithMeasurement(e ReservoirMeasured) ReservoirLatestMeasurement {
+	m.PH = latestParameterMeasurement(m.PH, e.PH, e.Source, e.MeasuredDate)
+	m.EC = latestParameterMeasurement(m.EC, e.EC, e.Source, e.MeasuredDate)
+	m.Temperature = latestParameterMeasurement(m.Temperature, e.Temperature, e.Source, e.MeasuredDate)
+	m.DissolvedOxygen = latestParameterMeasurement(m.DissolvedOxygen, e.DissolvedOxygen, e.Source, e.MeasuredDate)
+
+	return m
+}
+
+func latestParameterMeasurement(latest *ReservoirParameterMeasurement, value *float32, source string, date time.Time) *ReservoirParameterMeasurement {
+	if value == nil || (latest != nil && date.Before(latest.MeasuredDate)) {
+		return latest
+	}
+
+	return &ReservoirParameterMeasurement{
+		Value:        *value,
+		Source:       source,
+		MeasuredDate: date,
+	}
+}
+
+// Types of a reservoir operation
+const (
+	ReservoirOperationRefill   = "REFILL"
+	ReservoirOperationDrain    = "DRAIN"
+	ReservoirOperationNutrient = "NUTRIENT"
+)
+
+// ReservoirNutrient is the amount of an agrochemical material mixed in the reservoir water.
+// The amount is in the quantity unit of the material.
+type ReservoirNutrient struct {
+	MaterialUID  uuid.UUID `json:"material_uid"`
+	MaterialName string    `json:"material_name"`
+	Amount       float32   `json:"amount"`
+	Unit         string    `json:"unit"`
+}
+
+// EstimateConcentration returns the amount of a nutrient per litre of water,
+// assuming the nutrient is evenly mixed in the reservoir.
+func EstimateConcentration(amount, volume float32) float32 {
+	if volume <= 0 {
+		return 0
+	}
+
+	return amount / volume
+}
+
 func (state *Reservoir) TrackChange(event interface{}) {
 	state.UncommittedChanges = append(state.UncommittedChanges, event)
 	state.Transition(event)
@@ -111,6 +199,46 @@ func (state *Reservoir) Transition(event interface{}) {
 	case ReservoirNoteRemoved:
 		delete(state.Notes, e.UID)
 
+	case ReservoirMeasured:
+		latest := ReservoirLatestMeasurement{}
+		if state.LatestMeasurement != nil {
+			latest = *state.LatestMeasurement
+		}
+
+		latest = latest.WithMeasurement(e)
+		state.LatestMeasurement = &latest
+
+	case ReservoirRefilled:
+		state.Volume += e.Volume
+
+	case ReservoirDrained:
+		remaining := state.Volume - e.Volume
+
+		// The nutrients leave the reservoir with the drained water
+		for k, v := range state.Nutrients {
+			if remaining <= 0 {
+				delete(state.Nutrients, k)
+				continue
+			}
+
+			v.Amount = v.Amount * remaining / state.Volume
+			state.Nutrients[k] = v
+		}
+
+		state.Volume = remaining
+
+	case ReservoirNutrientAdded:
+		if state.Nutrients == nil {
+			state.Nutrients = make(map[uuid.UUID]ReservoirNutrient)
+		}
+
+		nutrient := state.Nutrients[e.MaterialUID]
+		nutrient.MaterialUID = e.MaterialUID
+		nutrient.MaterialName = e.MaterialName
+		nutrient.Amount += e.Amount
+		nutrient.Unit = e.Unit
+		state.Nutrients[e.MaterialUID] = nutrient
+
 	}
 }
 
@@ -232,6 +360,144 @@ func (r *Reservoir) RemoveNote(uid uuid.UUID) error {
 	return nil
 }
 
+// RecordMeasurement logs the water quality of the reservoir. At least one parameter is required.
+func (r *Reservoir) RecordMeasurement(ph, ec, temperature, dissolvedOxygen *float32, source string, measuredDate time.Time) error {
+	if ph == nil && ec == nil && temperature == nil && dissolvedOxygen == nil {
+		return ReservoirError{Code: ReservoirMeasurementErrorEmptyCode}
+	}
+
+	if ph != nil {
+		err := validatePH(*ph)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ec != nil {
+		err := validateEC(*ec)
+		if err != nil {
+			return err
+		}
+	}
+
+	if temperature != nil && (*temperature < -10 || *temperature > 60) {
+		return ReservoirError{Code: ReservoirMeasurementErrorTemperatureInvalidCode}
+	}
+
+	if dissolvedOxygen != nil && *dissolvedOxygen < 0 {
+		return ReservoirError{Code: ReservoirMeasurementErrorDissolvedOxygenInvalidCode}
+	}
+
+	if source != MeasurementSourceManual && source != MeasurementSourceSensor {
+		return ReservoirError{Code: ReservoirMeasurementErrorSourceInvalidCode}
+	}
+
+	if measuredDate.IsZero() || measuredDate.After(time.Now().Add(5*time.Minute)) {
+		return ReservoirError{Code: ReservoirMeasurementErrorDateInvalidCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	r.TrackChange(ReservoirMeasured{
+		ReservoirUID:    r.UID,
+		UID:             uid,
+		PH:              ph,
+		EC:              ec,
+		Temperature:     temperature,
+		DissolvedOxygen: dissolvedOxygen,
+		Source:          source,
+		MeasuredDate:    measuredDate,
+	})
+
+	return nil
+}
+
+// Refill adds water to the reservoir. A bucket cannot hold more than its capacity.
+func (r *Reservoir) Refill(volume float32) error {
+	if volume <= 0 {
+		return ReservoirError{Code: ReservoirErrorVolumeInvalidCode}
+	}
+
+	if b, ok := r.WaterSource.(Bucket); ok && r.Volume+volume > b.Capacity {
+		return ReservoirError{Code: ReservoirErrorVolumeExceedCapacityCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	r.TrackChange(ReservoirRefilled{
+		ReservoirUID:  r.UID,
+		UID:           uid,
+		Volume:        volume,
+		OperationDate: time.Now(),
+	})
+
+	return nil
+}
+
+// Drain takes water out of the reservoir, along with its share of the nutrients.
+func (r *Reservoir) Drain(volume float32) error {
+	if volume <= 0 {
+		return ReservoirError{Code: ReservoirErrorVolumeInvalidCode}
+	}
+
+	if volume > r.Volume {
+		return ReservoirError{Code: ReservoirErrorDrainExceedVolumeCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	r.TrackChange(ReservoirDrained{
+		ReservoirUID:  r.UID,
+		UID:           uid,
+		Volume:        volume,
+		OperationDate: time.Now(),
+	})
+
+	return nil
+}
+
+// AddNutrient mixes an amount of an agrochemical material in the reservoir water.
+// Taking the amount out of the inventory is up to the material itself.
+func (r *Reservoir) AddNutrient(material Material, amount float32) error {
+	if _, ok := material.Type.(MaterialTypeAgrochemical); !ok {
+		return ReservoirError{Code: ReservoirNutrientErrorMaterialInvalidCode}
+	}
+
+	if amount <= 0 {
+		return ReservoirError{Code: ReservoirNutrientErrorAmountInvalidCode}
+	}
+
+	if r.Volume <= 0 {
+		return ReservoirError{Code: ReservoirNutrientErrorReservoirEmptyCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	r.TrackChange(ReservoirNutrientAdded{
+		ReservoirUID:  r.UID,
+		UID:           uid,
+		MaterialUID:   material.UID,
+		MaterialName:  material.Name,
+		Amount:        amount,
+		Unit:          material.Quantity.Unit.Code,
+		OperationDate: time.Now(),
+	})
+
+	return nil
+}
+
 func validateWaterSource(waterSourceType string, capacity float32) (WaterSource, error) {
 	var ws WaterSource
 	if waterSourceType == BucketType {
@@ -269,7 +535,7 @@ func validateReservoirName(name string) error {
 }
 
 func validatePH(ph float32) error {
-	if ph < 0 {
+	if ph < 0 || ph > 14 {
 		return ReservoirError{ReservoirErrorPHInvalidCode}
 	}
 
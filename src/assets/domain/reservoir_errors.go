@@ -18,6 +18,20 @@ const (
 
 	ReservoirNoteErrorInvalidContent
 	ReservoirNoteErrorNotFound
+
+	ReservoirMeasurementErrorEmptyCode
+	ReservoirMeasurementErrorTemperatureInvalidCode
+	ReservoirMeasurementErrorDissolvedOxygenInvalidCode
+	ReservoirMeasurementErrorSourceInvalidCode
+	ReservoirMeasurementErrorDateInvalidCode
+
+	ReservoirErrorVolumeInvalidCode
+	ReservoirErrorVolumeExceedCapacityCode
+	ReservoirErrorDrainExceedVolumeCode
+
+	ReservoirNutrientErrorMaterialInvalidCode
+	ReservoirNutrientErrorAmountInvalidCode
+	ReservoirNutrientErrorReservoirEmptyCode
 )
 
 // ReservoirError is a custom error from Go built-in error
@@ -47,6 +61,28 @@ func (e ReservoirError) Error() string {
 		return "Reservoir bucket volume is invalid."
 	case ReservoirNoteErrorInvalidContent:
 		return "Invalid reservoir notes content"
+	case ReservoirMeasurementErrorEmptyCode:
+		return "Reservoir measurement needs at least one of pH, EC, temperature or dissolved oxygen."
+	case ReservoirMeasurementErrorTemperatureInvalidCode:
+		return "Reservoir water temperature is invalid."
+	case ReservoirMeasurementErrorDissolvedOxygenInvalidCode:
+		return "Reservoir dissolved oxygen value is invalid."
+	case ReservoirMeasurementErrorSourceInvalidCode:
+		return "Reservoir measurement source is invalid."
+	case ReservoirMeasurementErrorDateInvalidCode:
+		return "Reservoir measurement date is invalid."
+	case ReservoirErrorVolumeInvalidCode:
+		return "Reservoir water volume is invalid."
+	case ReservoirErrorVolumeExceedCapacityCode:
+		return "Reservoir water volume cannot exceed the bucket capacity."
+	case ReservoirErrorDrainExceedVolumeCode:
+		return "Cannot drain more water than the reservoir holds."
+	case ReservoirNutrientErrorMaterialInvalidCode:
+		return "Only agrochemical materials can be added to the reservoir."
+	case ReservoirNutrientErrorAmountInvalidCode:
+		return "Reservoir nutrient amount is invalid."
+	case ReservoirNutrientErrorReservoirEmptyCode:
+		return "Reservoir has no water to mix the nutrient in."
 	default:
 		return "Unrecognized Reservoir Error Code"
 	}
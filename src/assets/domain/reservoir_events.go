@@ -35,3 +35,38 @@ type ReservoirNoteRemoved struct {
 	ReservoirUID uuid.UUID
 	UID          uuid.UUID
 }
+
+type ReservoirMeasured struct {
+	ReservoirUID    uuid.UUID
+	UID             uuid.UUID
+	PH              *float32
+	EC              *float32
+	Temperature     *float32
+	DissolvedOxygen *float32
+	Source          string
+	MeasuredDate    time.Time
+}
+
+type ReservoirRefilled struct {
+	ReservoirUID  uuid.UUID
+	UID           uuid.UUID
+	Volume        float32
+	OperationDate time.Time
+}
+
+type ReservoirDrained struct {
+	ReservoirUID  uuid.UUID
+	UID           uuid.UUID
+	Volume        float32
+	OperationDate time.Time
+}
+
+type ReservoirNutrientAdded struct {
+	ReservoirUID  uuid.UUID
+	UID           uuid.UUID
+	MaterialUID   uuid.UUID
+	MaterialName  string
+	Amount        float32
+	Unit          string
+	OperationDate time.Time
+}
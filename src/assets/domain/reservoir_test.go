@@ -2,6 +2,7 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
@@ -200,3 +201,98 @@ func TestReservoirChangeName(t *testing.T) {
 	assert.Equal(t, res.UID, event.ReservoirUID)
 	assert.Equal(t, res.Name, event.Name)
 }
+
+func TestReservoirRecordMeasurement(t *testing.T) {
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	res, resErr := CreateReservoir(serviceMock, farmUID, "My Reservoir", BucketType, float32(10))
+
+	ph := float32(6.2)
+	ec := float32(1.8)
+	invalidPH := float32(15)
+
+	now := time.Now()
+
+	// When
+	err1 := res.RecordMeasurement(&ph, &ec, nil, nil, MeasurementSourceManual, now)
+	err2 := res.RecordMeasurement(&ph, nil, nil, nil, MeasurementSourceSensor, now.Add(-time.Hour))
+	err3 := res.RecordMeasurement(&invalidPH, nil, nil, nil, MeasurementSourceManual, now)
+	err4 := res.RecordMeasurement(nil, nil, nil, nil, MeasurementSourceManual, now)
+	err5 := res.RecordMeasurement(&ph, nil, nil, nil, MeasurementSourceManual, now.Add(time.Hour))
+
+	// Then
+	assert.Nil(t, resErr)
+	assert.Nil(t, err1)
+	assert.Nil(t, err2)
+	assert.Equal(t, ReservoirError{ReservoirErrorPHInvalidCode}, err3)
+	assert.Equal(t, ReservoirError{ReservoirMeasurementErrorEmptyCode}, err4)
+	assert.Equal(t, ReservoirError{ReservoirMeasurementErrorDateInvalidCode}, err5)
+
+	// The older measurement is logged but doesn't replace the latest one
+	assert.Len(t, res.UncommittedChanges, 3)
+	assert.Equal(t, MeasurementSourceManual, res.LatestMeasurement.PH.Source)
+	assert.Equal(t, ec, res.LatestMeasurement.EC.Value)
+
+	// When
+	temperature := float32(21)
+	err6 := res.RecordMeasurement(nil, nil, &temperature, nil, MeasurementSourceSensor, now.Add(time.Minute))
+
+	// Then the parameters the measurement doesn't carry keep their latest value
+	assert.Nil(t, err6)
+	assert.Equal(t, temperature, res.LatestMeasurement.Temperature.Value)
+	assert.Equal(t, ph, res.LatestMeasurement.PH.Value)
+	assert.Equal(t, now, res.LatestMeasurement.EC.MeasuredDate)
+
+	event, ok := res.UncommittedChanges[1].(ReservoirMeasured)
+	assert.True(t, ok)
+	assert.Equal(t, res.UID, event.ReservoirUID)
+	assert.Equal(t, ph, *event.PH)
+}
+
+func TestReservoirRefillDrainAndNutrient(t *testing.T) {
+	// Given
+	farmUID, _ := uuid.NewV4()
+	serviceMock := mockReservoirService(farmUID, "My Farm")
+
+	res, resErr := CreateReservoir(serviceMock, farmUID, "My Reservoir", BucketType, float32(100))
+
+	materialUID, _ := uuid.NewV4()
+	fertilizer := Material{
+		UID:      materialUID,
+		Name:     "Nutrient A",
+		Type:     MaterialTypeAgrochemical{ChemicalType: GetChemicalType(ChemicalTypeFertilizer)},
+		Quantity: MaterialQuantity{Value: 10, Unit: GetMaterialQuantityUnit(MaterialTypeAgrochemicalCode, MaterialUnitBottles)},
+	}
+	seed := Material{UID: materialUID, Name: "Tomato", Type: MaterialTypeSeed{}}
+
+	// When
+	err1 := res.AddNutrient(fertilizer, 2)
+	err2 := res.Refill(80)
+	err3 := res.Refill(30)
+	err4 := res.AddNutrient(fertilizer, 4)
+	err5 := res.AddNutrient(seed, 1)
+	err6 := res.Drain(20)
+	err7 := res.Drain(100)
+
+	// Then
+	assert.Nil(t, resErr)
+	assert.Equal(t, ReservoirError{ReservoirNutrientErrorReservoirEmptyCode}, err1)
+	assert.Nil(t, err2)
+	assert.Equal(t, ReservoirError{ReservoirErrorVolumeExceedCapacityCode}, err3)
+	assert.Nil(t, err4)
+	assert.Equal(t, ReservoirError{ReservoirNutrientErrorMaterialInvalidCode}, err5)
+	assert.Nil(t, err6)
+	assert.Equal(t, ReservoirError{ReservoirErrorDrainExceedVolumeCode}, err7)
+
+	// A quarter of the water is drained, so a quarter of the nutrient goes with it
+	assert.Equal(t, float32(60), res.Volume)
+	assert.Equal(t, float32(3), res.Nutrients[materialUID].Amount)
+	assert.Equal(t, float32(0.05), EstimateConcentration(res.Nutrients[materialUID].Amount, res.Volume))
+
+	event, ok := res.UncommittedChanges[2].(ReservoirNutrientAdded)
+	assert.True(t, ok)
+	assert.Equal(t, "Nutrient A", event.MaterialName)
+	assert.Equal(t, MaterialUnitBottles, event.Unit)
+}
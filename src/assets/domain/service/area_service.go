@@ -10,9 +10,10 @@ import (
 )
 
 type AreaServiceInMemory struct {
-	FarmReadQuery      query.FarmReadQuery
-	ReservoirReadQuery query.ReservoirReadQuery
-	CropReadQuery      query.CropReadQuery
+	FarmReadQuery        query.FarmReadQuery
+	ReservoirReadQuery   query.ReservoirReadQuery
+	CropReadQuery        query.CropReadQuery
+	ClimateZoneReadQuery query.ClimateZoneReadQuery
 }
 
 func (s AreaServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.AreaFarmServiceResult, error) {
@@ -61,6 +62,30 @@ func (s AreaServiceInMemory) FindReservoirByID(reservoirUID uuid.UUID) (domain.A
 	}, nil
 }
 
+func (s AreaServiceInMemory) FindClimateZoneByID(climateZoneUID uuid.UUID) (domain.AreaClimateZoneServiceResult, error) {
+	result := <-s.ClimateZoneReadQuery.FindByID(climateZoneUID)
+
+	if result.Error != nil {
+		return domain.AreaClimateZoneServiceResult{}, result.Error
+	}
+
+	climateZone, ok := result.Result.(storage.ClimateZoneRead)
+
+	if !ok {
+		return domain.AreaClimateZoneServiceResult{}, domain.AreaError{Code: domain.AreaErrorClimateZoneNotFound}
+	}
+
+	if climateZone.UID == (uuid.UUID{}) {
+		return domain.AreaClimateZoneServiceResult{}, domain.AreaError{Code: domain.AreaErrorClimateZoneNotFound}
+	}
+
+	return domain.AreaClimateZoneServiceResult{
+		UID:     climateZone.UID,
+		Name:    climateZone.Name,
+		FarmUID: climateZone.Farm.UID,
+	}, nil
+}
+
 func (s AreaServiceInMemory) CountCropsByAreaID(areaUID uuid.UUID) (int, error) {
 	result := <-s.CropReadQuery.CountCropsByArea(areaUID)
 	if result.Error != nil {
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneServiceInMemory struct {
+	FarmReadQuery query.FarmReadQuery
+}
+
+func (s ClimateZoneServiceInMemory) FindFarmByID(uid uuid.UUID) (domain.ClimateZoneFarmServiceResult, error) {
+	result := <-s.FarmReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.ClimateZoneFarmServiceResult{}, result.Error
+	}
+
+	farm, ok := result.Result.(storage.FarmRead)
+
+	if !ok {
+		return domain.ClimateZoneFarmServiceResult{}, domain.ClimateZoneError{Code: domain.ClimateZoneErrorFarmNotFound}
+	}
+
+	if farm == (storage.FarmRead{}) {
+		return domain.ClimateZoneFarmServiceResult{}, domain.ClimateZoneError{Code: domain.ClimateZoneErrorFarmNotFound}
+	}
+
+	return domain.ClimateZoneFarmServiceResult{
+		UID:  farm.UID,
+		Name: farm.Name,
+	}, nil
+}
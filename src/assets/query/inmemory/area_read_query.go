@@ -123,3 +123,25 @@ func (s AreaReadQueryInMemory) CountAreas(farmUID uuid.UUID) <-chan query.QueryR
 
 	return result
 }
+
+func (s AreaReadQueryInMemory) FindAllByClimateZone(climateZoneUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		areas := []storage.AreaRead{}
+		for _, val := range s.Storage.AreaReadMap {
+			if val.ClimateZone.UID == climateZoneUID {
+				areas = append(areas, val)
+			}
+		}
+
+		result <- query.QueryResult{Result: areas}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,58 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateReadingQueryInMemory struct {
+	Storage *storage.DeviceReadingStorage
+}
+
+func NewClimateReadingQueryInMemory(s *storage.DeviceReadingStorage) query.ClimateReadingQuery {
+	return ClimateReadingQueryInMemory{Storage: s}
+}
+
+// FindLatestByAttachment finds the latest reading of each device attached to the asset
+func (s ClimateReadingQueryInMemory) FindLatestByAttachment(attachmentType string, attachmentUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		latest := map[uuid.UUID]query.ClimateReadingQueryResult{}
+		deviceUIDs := []uuid.UUID{}
+		for _, val := range s.Storage.DeviceReadings {
+			if val.AttachmentType != attachmentType || val.AttachmentUID != attachmentUID {
+				continue
+			}
+
+			reading, ok := latest[val.DeviceUID]
+			if !ok {
+				deviceUIDs = append(deviceUIDs, val.DeviceUID)
+			}
+
+			if !ok || val.RecordedDate.After(reading.RecordedDate) {
+				latest[val.DeviceUID] = query.ClimateReadingQueryResult{
+					DeviceUID:    val.DeviceUID,
+					SensorType:   val.SensorType,
+					Value:        val.Value,
+					RecordedDate: val.RecordedDate,
+				}
+			}
+		}
+
+		readings := []query.ClimateReadingQueryResult{}
+		for _, v := range deviceUIDs {
+			readings = append(readings, latest[v])
+		}
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
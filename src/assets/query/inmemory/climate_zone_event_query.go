@@ -0,0 +1,41 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneEventQueryInMemory struct {
+	Storage *storage.ClimateZoneEventStorage
+}
+
+func NewClimateZoneEventQueryInMemory(s *storage.ClimateZoneEventStorage) query.ClimateZoneEventQuery {
+	return &ClimateZoneEventQueryInMemory{Storage: s}
+}
+
+func (f *ClimateZoneEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.ClimateZoneEvent{}
+		for _, v := range f.Storage.ClimateZoneEvents {
+			if v.ClimateZoneUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+	}()
+
+	return result
+}
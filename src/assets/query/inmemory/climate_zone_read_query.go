@@ -0,0 +1,59 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneReadQueryInMemory struct {
+	Storage *storage.ClimateZoneReadStorage
+}
+
+func NewClimateZoneReadQueryInMemory(s *storage.ClimateZoneReadStorage) query.ClimateZoneReadQuery {
+	return ClimateZoneReadQueryInMemory{Storage: s}
+}
+
+func (s ClimateZoneReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		climateZone := storage.ClimateZoneRead{}
+		for _, val := range s.Storage.ClimateZoneReadMap {
+			if val.UID == uid {
+				climateZone = val
+			}
+		}
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s ClimateZoneReadQueryInMemory) FindAllByFarm(farmUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		climateZones := []storage.ClimateZoneRead{}
+		for _, val := range s.Storage.ClimateZoneReadMap {
+			if val.Farm.UID == farmUID {
+				climateZones = append(climateZones, val)
+			}
+		}
+
+		result <- query.QueryResult{Result: climateZones}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type MaterialConsumptionQueryInMemory struct {
+	Storage *storage.MaterialConsumptionStorage
+}
+
+func NewMaterialConsumptionQueryInMemory(s *storage.MaterialConsumptionStorage) query.MaterialConsumptionQuery {
+	return &MaterialConsumptionQueryInMemory{Storage: s}
+}
+
+func (f *MaterialConsumptionQueryInMemory) FindAllByMaterialID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		consumptions := []storage.MaterialConsumptionRead{}
+		for _, v := range f.Storage.MaterialConsumptions {
+			if v.MaterialUID == uid {
+				consumptions = append(consumptions, v)
+			}
+		}
+
+		sort.Slice(consumptions, func(i, j int) bool {
+			return consumptions[i].ConsumedDate.Before(consumptions[j].ConsumedDate)
+		})
+
+		result <- query.QueryResult{Result: consumptions}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,44 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirMeasurementQueryInMemory struct {
+	Storage *storage.ReservoirMeasurementStorage
+}
+
+func NewReservoirMeasurementQueryInMemory(s *storage.ReservoirMeasurementStorage) query.ReservoirMeasurementQuery {
+	return &ReservoirMeasurementQueryInMemory{Storage: s}
+}
+
+func (f *ReservoirMeasurementQueryInMemory) FindAllByReservoirID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		measurements := []storage.ReservoirMeasurementRead{}
+		for _, v := range f.Storage.ReservoirMeasurements {
+			if v.ReservoirUID == uid && !v.MeasuredDate.Before(from) && !v.MeasuredDate.After(to) {
+				measurements = append(measurements, v)
+			}
+		}
+
+		sort.Slice(measurements, func(i, j int) bool {
+			return measurements[i].MeasuredDate.Before(measurements[j].MeasuredDate)
+		})
+
+		result <- query.QueryResult{Result: measurements}
+
+		close(result)
+	}()
+
+	return result
+}
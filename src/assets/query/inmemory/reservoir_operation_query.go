@@ -0,0 +1,44 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirOperationQueryInMemory struct {
+	Storage *storage.ReservoirOperationStorage
+}
+
+func NewReservoirOperationQueryInMemory(s *storage.ReservoirOperationStorage) query.ReservoirOperationQuery {
+	return &ReservoirOperationQueryInMemory{Storage: s}
+}
+
+func (f *ReservoirOperationQueryInMemory) FindAllByReservoirID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		operations := []storage.ReservoirOperationRead{}
+		for _, v := range f.Storage.ReservoirOperations {
+			if v.ReservoirUID == uid && !v.OperationDate.Before(from) && !v.OperationDate.After(to) {
+				operations = append(operations, v)
+			}
+		}
+
+		sort.SliceStable(operations, func(i, j int) bool {
+			return operations[i].OperationDate.Before(operations[j].OperationDate)
+		})
+
+		result <- query.QueryResult{Result: operations}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -19,22 +19,24 @@ func NewAreaReadQueryMysql(db *sql.DB) query.AreaReadQuery {
 }
 
 type areaReadResult struct {
-	UID           []byte
-	Name          string
-	Size          float32
-	SizeUnit      string
-	Type          string
-	Location      string
-	PhotoFilename string
-	PhotoMimetype string
-	PhotoSize     int
-	PhotoWidth    int
-	PhotoHeight   int
-	CreatedDate   time.Time
-	ReservoirUID  []byte
-	ReservoirName string
-	FarmUID       []byte
-	FarmName      string
+	UID             []byte
+	Name            string
+	Size            float32
+	SizeUnit        string
+	Type            string
+	Location        string
+	PhotoFilename   string
+	PhotoMimetype   string
+	PhotoSize       int
+	PhotoWidth      int
+	PhotoHeight     int
+	CreatedDate     time.Time
+	ReservoirUID    []byte
+	ReservoirName   string
+	FarmUID         []byte
+	FarmName        string
+	ClimateZoneUID  []byte
+	ClimateZoneName string
 }
 
 type areaNotesReadResult struct {
@@ -69,6 +71,8 @@ func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			&rowsData.ReservoirName,
 			&rowsData.FarmUID,
 			&rowsData.FarmName,
+			&rowsData.ClimateZoneUID,
+			&rowsData.ClimateZoneName,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -89,6 +93,11 @@ func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			result <- query.QueryResult{Error: err}
 		}
 
+		climateZoneUID, err := uuid.FromBytes(rowsData.ClimateZoneUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		farmUID, err := uuid.FromBytes(rowsData.FarmUID)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -122,12 +131,12 @@ func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 
 		sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 		if sizeUnit == (domain.AreaUnit{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 		}
 
 		location := domain.GetAreaLocation(rowsData.Location)
 		if location == (domain.AreaLocation{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 		}
 
 		areaRead = storage.AreaRead{
@@ -156,6 +165,10 @@ func (s AreaReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 				UID:  reservoirUID,
 				Name: rowsData.ReservoirName,
 			},
+			ClimateZone: storage.AreaClimateZone{
+				UID:  climateZoneUID,
+				Name: rowsData.ClimateZoneName,
+			},
 		}
 
 		result <- query.QueryResult{Result: areaRead}
@@ -195,6 +208,8 @@ func (s AreaReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.QueryR
 				&rowsData.ReservoirName,
 				&rowsData.FarmUID,
 				&rowsData.FarmName,
+				&rowsData.ClimateZoneUID,
+				&rowsData.ClimateZoneName,
 			)
 
 			areaUID, err := uuid.FromBytes(rowsData.UID)
@@ -207,6 +222,11 @@ func (s AreaReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.QueryR
 				result <- query.QueryResult{Error: err}
 			}
 
+			climateZoneUID, err := uuid.FromBytes(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			farmUID, err := uuid.FromBytes(rowsData.FarmUID)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
@@ -241,12 +261,12 @@ func (s AreaReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.QueryR
 
 			sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 			if sizeUnit == (domain.AreaUnit{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 			}
 
 			location := domain.GetAreaLocation(rowsData.Location)
 			if location == (domain.AreaLocation{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 			}
 
 			areaReads = append(areaReads, storage.AreaRead{
@@ -275,6 +295,10 @@ func (s AreaReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.QueryR
 					UID:  reservoirUID,
 					Name: rowsData.ReservoirName,
 				},
+				ClimateZone: storage.AreaClimateZone{
+					UID:  climateZoneUID,
+					Name: rowsData.ClimateZoneName,
+				},
 			})
 		}
 
@@ -310,6 +334,8 @@ func (s AreaReadQueryMysql) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan q
 			&rowsData.ReservoirName,
 			&rowsData.FarmUID,
 			&rowsData.FarmName,
+			&rowsData.ClimateZoneUID,
+			&rowsData.ClimateZoneName,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -330,6 +356,11 @@ func (s AreaReadQueryMysql) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan q
 			result <- query.QueryResult{Error: err}
 		}
 
+		climateZoneUID, err := uuid.FromBytes(rowsData.ClimateZoneUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		farmUID, err := uuid.FromBytes(rowsData.FarmUID)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -363,12 +394,12 @@ func (s AreaReadQueryMysql) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan q
 
 		sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 		if sizeUnit == (domain.AreaUnit{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 		}
 
 		location := domain.GetAreaLocation(rowsData.Location)
 		if location == (domain.AreaLocation{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 		}
 
 		areaRead = storage.AreaRead{
@@ -397,6 +428,10 @@ func (s AreaReadQueryMysql) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan q
 				UID:  reservoirUID,
 				Name: rowsData.ReservoirName,
 			},
+			ClimateZone: storage.AreaClimateZone{
+				UID:  climateZoneUID,
+				Name: rowsData.ClimateZoneName,
+			},
 		}
 
 		result <- query.QueryResult{Result: areaRead}
@@ -436,6 +471,8 @@ func (s AreaReadQueryMysql) FindAreasByReservoirID(reservoirUID uuid.UUID) <-cha
 				&rowsData.ReservoirName,
 				&rowsData.FarmUID,
 				&rowsData.FarmName,
+				&rowsData.ClimateZoneUID,
+				&rowsData.ClimateZoneName,
 			)
 
 			areaUID, err := uuid.FromBytes(rowsData.UID)
@@ -448,6 +485,11 @@ func (s AreaReadQueryMysql) FindAreasByReservoirID(reservoirUID uuid.UUID) <-cha
 				result <- query.QueryResult{Error: err}
 			}
 
+			climateZoneUID, err := uuid.FromBytes(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			farmUID, err := uuid.FromBytes(rowsData.FarmUID)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
@@ -481,12 +523,12 @@ func (s AreaReadQueryMysql) FindAreasByReservoirID(reservoirUID uuid.UUID) <-cha
 
 			sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 			if sizeUnit == (domain.AreaUnit{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 			}
 
 			location := domain.GetAreaLocation(rowsData.Location)
 			if location == (domain.AreaLocation{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 			}
 
 			areaReads = append(areaReads, storage.AreaRead{
@@ -515,6 +557,10 @@ func (s AreaReadQueryMysql) FindAreasByReservoirID(reservoirUID uuid.UUID) <-cha
 					UID:  reservoirUID,
 					Name: rowsData.ReservoirName,
 				},
+				ClimateZone: storage.AreaClimateZone{
+					UID:  climateZoneUID,
+					Name: rowsData.ClimateZoneName,
+				},
 			})
 		}
 
@@ -542,3 +588,133 @@ func (s AreaReadQueryMysql) CountAreas(farmUID uuid.UUID) <-chan query.QueryResu
 
 	return result
 }
+
+func (s AreaReadQueryMysql) FindAllByClimateZone(climateZoneUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		areaReads := []storage.AreaRead{}
+
+		rows, err := s.DB.Query("SELECT * FROM AREA_READ WHERE CLIMATE_ZONE_UID = ?", climateZoneUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := areaReadResult{}
+			rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.SizeUnit,
+				&rowsData.Size,
+				&rowsData.Type,
+				&rowsData.Location,
+				&rowsData.PhotoFilename,
+				&rowsData.PhotoMimetype,
+				&rowsData.PhotoSize,
+				&rowsData.PhotoWidth,
+				&rowsData.PhotoHeight,
+				&rowsData.CreatedDate,
+				&rowsData.ReservoirUID,
+				&rowsData.ReservoirName,
+				&rowsData.FarmUID,
+				&rowsData.FarmName,
+				&rowsData.ClimateZoneUID,
+				&rowsData.ClimateZoneName,
+			)
+
+			areaUID, err := uuid.FromBytes(rowsData.UID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			reservoirUID, err := uuid.FromBytes(rowsData.ReservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			climateZoneUID, err := uuid.FromBytes(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+			rows, err := s.DB.Query("SELECT * FROM AREA_READ_NOTES WHERE AREA_UID = ?", areaUID.Bytes())
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			notes := []storage.AreaNote{}
+			for rows.Next() {
+				notesRowsData := areaNotesReadResult{}
+				rows.Scan(
+					&notesRowsData.UID,
+					&notesRowsData.AreaUID,
+					&notesRowsData.Content,
+					&notesRowsData.CreatedDate,
+				)
+
+				noteUID, err := uuid.FromBytes(notesRowsData.UID)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+
+				notes = append(notes, storage.AreaNote{
+					UID:         noteUID,
+					Content:     notesRowsData.Content,
+					CreatedDate: notesRowsData.CreatedDate,
+				})
+			}
+
+			sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
+			if sizeUnit == (domain.AreaUnit{}) {
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
+			}
+
+			location := domain.GetAreaLocation(rowsData.Location)
+			if location == (domain.AreaLocation{}) {
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
+			}
+
+			areaReads = append(areaReads, storage.AreaRead{
+				UID:  areaUID,
+				Name: rowsData.Name,
+				Size: storage.AreaSize{
+					Value: rowsData.Size,
+					Unit:  sizeUnit,
+				},
+				Location: storage.AreaLocation(location),
+				Type:     rowsData.Type,
+				Photo: storage.AreaPhoto{
+					Filename: rowsData.PhotoFilename,
+					MimeType: rowsData.PhotoMimetype,
+					Size:     rowsData.PhotoSize,
+					Width:    rowsData.PhotoWidth,
+					Height:   rowsData.PhotoHeight,
+				},
+				CreatedDate: rowsData.CreatedDate,
+				Notes:       notes,
+				Farm: storage.AreaFarm{
+					UID:  farmUID,
+					Name: rowsData.FarmName,
+				},
+				Reservoir: storage.AreaReservoir{
+					UID:  reservoirUID,
+					Name: rowsData.ReservoirName,
+				},
+				ClimateZone: storage.AreaClimateZone{
+					UID:  climateZoneUID,
+					Name: rowsData.ClimateZoneName,
+				},
+			})
+		}
+
+		result <- query.QueryResult{Result: areaReads}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateReadingQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewClimateReadingQueryMysql(db *sql.DB) query.ClimateReadingQuery {
+	return ClimateReadingQueryMysql{DB: db}
+}
+
+type climateReadingResult struct {
+	DeviceUID    []byte
+	SensorType   string
+	Value        float32
+	RecordedDate time.Time
+}
+
+// FindLatestByAttachment finds the latest reading of each device attached to the asset
+func (s ClimateReadingQueryMysql) FindLatestByAttachment(attachmentType string, attachmentUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		readings := []query.ClimateReadingQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT r.DEVICE_UID, r.SENSOR_TYPE, r.VALUE, r.RECORDED_DATE
+			FROM DEVICE_READING r
+			WHERE r.ATTACHMENT_TYPE = ? AND r.ATTACHMENT_UID = ?
+			AND r.ID = (SELECT l.ID FROM DEVICE_READING l
+				WHERE l.DEVICE_UID = r.DEVICE_UID AND l.ATTACHMENT_UID = r.ATTACHMENT_UID
+				ORDER BY l.RECORDED_DATE DESC, l.ID DESC LIMIT 1)`,
+			attachmentType, attachmentUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := climateReadingResult{}
+			err := rows.Scan(&rowsData.DeviceUID, &rowsData.SensorType, &rowsData.Value, &rowsData.RecordedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			deviceUID, err := uuid.FromBytes(rowsData.DeviceUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			readings = append(readings, query.ClimateReadingQueryResult{
+				DeviceUID:    deviceUID,
+				SensorType:   rowsData.SensorType,
+				Value:        rowsData.Value,
+				RecordedDate: rowsData.RecordedDate.UTC(),
+			})
+		}
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/decoder"
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneEventQueryMysql(db *sql.DB) query.ClimateZoneEventQuery {
+	return &ClimateZoneEventQueryMysql{DB: db}
+}
+
+func (f *ClimateZoneEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.ClimateZoneEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM CLIMATE_ZONE_EVENT WHERE CLIMATE_ZONE_UID = ? ORDER BY VERSION ASC", uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID             int
+			ClimateZoneUID []byte
+			Version        int
+			CreatedDate    time.Time
+			Event          []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.ClimateZoneUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.ClimateZoneEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			climateZoneUID, err := uuid.FromBytes(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			events = append(events, storage.ClimateZoneEvent{
+				ClimateZoneUID: climateZoneUID,
+				Version:        rowsData.Version,
+				CreatedDate:    rowsData.CreatedDate,
+				Event:          wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -18,15 +18,16 @@ func NewFarmReadQueryMysql(db *sql.DB) query.FarmReadQuery {
 }
 
 type farmReadResult struct {
-	UID         []byte
-	Name        string
-	Latitude    string
-	Longitude   string
-	Type        string
-	Country     string
-	City        string
-	IsActive    int
-	CreatedDate time.Time
+	UID             []byte
+	Name            string
+	Latitude        string
+	Longitude       string
+	Type            string
+	Country         string
+	City            string
+	IsActive        int
+	CreatedDate     time.Time
+	BatchIDTemplate sql.NullString
 }
 
 func (s FarmReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
@@ -46,6 +47,7 @@ func (s FarmReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			&rowsData.City,
 			&rowsData.IsActive,
 			&rowsData.CreatedDate,
+			&rowsData.BatchIDTemplate,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -62,15 +64,16 @@ func (s FarmReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 		}
 
 		farmRead = storage.FarmRead{
-			UID:         farmUID,
-			Name:        rowsData.Name,
-			Latitude:    rowsData.Latitude,
-			Longitude:   rowsData.Longitude,
-			Type:        rowsData.Type,
-			Country:     rowsData.Country,
-			City:        rowsData.City,
-			IsActive:    rowsData.IsActive != 0,
-			CreatedDate: rowsData.CreatedDate,
+			UID:             farmUID,
+			Name:            rowsData.Name,
+			Latitude:        rowsData.Latitude,
+			Longitude:       rowsData.Longitude,
+			Type:            rowsData.Type,
+			Country:         rowsData.Country,
+			City:            rowsData.City,
+			IsActive:        rowsData.IsActive != 0,
+			CreatedDate:     rowsData.CreatedDate,
+			BatchIDTemplate: rowsData.BatchIDTemplate.String,
 		}
 
 		result <- query.QueryResult{Result: farmRead}
@@ -103,6 +106,7 @@ func (s FarmReadQueryMysql) FindAll() <-chan query.QueryResult {
 				&rowsData.City,
 				&rowsData.IsActive,
 				&rowsData.CreatedDate,
+				&rowsData.BatchIDTemplate,
 			)
 
 			if err != nil {
@@ -115,15 +119,16 @@ func (s FarmReadQueryMysql) FindAll() <-chan query.QueryResult {
 			}
 
 			farmReads = append(farmReads, storage.FarmRead{
-				UID:         farmUID,
-				Name:        rowsData.Name,
-				Latitude:    rowsData.Latitude,
-				Longitude:   rowsData.Longitude,
-				Type:        rowsData.Type,
-				Country:     rowsData.Country,
-				City:        rowsData.City,
-				IsActive:    rowsData.IsActive != 0,
-				CreatedDate: rowsData.CreatedDate,
+				UID:             farmUID,
+				Name:            rowsData.Name,
+				Latitude:        rowsData.Latitude,
+				Longitude:       rowsData.Longitude,
+				Type:            rowsData.Type,
+				Country:         rowsData.Country,
+				City:            rowsData.City,
+				IsActive:        rowsData.IsActive != 0,
+				CreatedDate:     rowsData.CreatedDate,
+				BatchIDTemplate: rowsData.BatchIDTemplate.String,
 			})
 		}
 
@@ -0,0 +1,93 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type MaterialConsumptionQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewMaterialConsumptionQueryMysql(db *sql.DB) query.MaterialConsumptionQuery {
+	return &MaterialConsumptionQueryMysql{DB: db}
+}
+
+func (f *MaterialConsumptionQueryMysql) FindAllByMaterialID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		consumptions := []storage.MaterialConsumptionRead{}
+
+		rows, err := f.DB.Query(`SELECT * FROM MATERIAL_READ_CONSUMPTION
+			WHERE MATERIAL_UID = ? ORDER BY CONSUMED_DATE ASC`, uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID           int
+			MaterialUID  []byte
+			TaskUID      []byte
+			Quantity     float32
+			QuantityUnit string
+			ConsumedDate time.Time
+			CropUID      []byte
+			ReservoirUID []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(
+				&rowsData.ID, &rowsData.MaterialUID, &rowsData.TaskUID,
+				&rowsData.Quantity, &rowsData.QuantityUnit, &rowsData.ConsumedDate,
+				&rowsData.CropUID, &rowsData.ReservoirUID,
+			)
+
+			materialUID, err := uuid.FromBytes(rowsData.MaterialUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			taskUID, err := uuid.FromBytes(rowsData.TaskUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			// Consumptions recorded before seeds were consumed by crops have no crop
+			cropUID := uuid.UUID{}
+			if len(rowsData.CropUID) > 0 {
+				cropUID, err = uuid.FromBytes(rowsData.CropUID)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+			}
+
+			reservoirUID := uuid.UUID{}
+			if len(rowsData.ReservoirUID) > 0 {
+				reservoirUID, err = uuid.FromBytes(rowsData.ReservoirUID)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+			}
+
+			consumptions = append(consumptions, storage.MaterialConsumptionRead{
+				MaterialUID:  materialUID,
+				TaskUID:      taskUID,
+				CropUID:      cropUID,
+				ReservoirUID: reservoirUID,
+				Quantity:     rowsData.Quantity,
+				QuantityUnit: rowsData.QuantityUnit,
+				ConsumedDate: rowsData.ConsumedDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: consumptions}
+		close(result)
+	}()
+
+	return result
+}
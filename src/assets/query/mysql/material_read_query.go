@@ -22,18 +22,30 @@ func NewMaterialReadQueryMysql(db *sql.DB) query.MaterialReadQuery {
 }
 
 type materialReadResult struct {
-	UID            []byte
-	Name           string
-	PricePerUnit   string
-	CurrencyCode   string
-	Type           string
-	TypeData       string
-	Quantity       float32
-	QuantityUnit   string
-	ExpirationDate sql.NullString
-	Notes          sql.NullString
-	ProducedBy     sql.NullString
-	CreatedDate    time.Time
+	UID                []byte
+	Name               string
+	PricePerUnit       string
+	CurrencyCode       string
+	Type               string
+	TypeData           string
+	Quantity           float32
+	QuantityUnit       string
+	ExpirationDate     sql.NullString
+	Notes              sql.NullString
+	ProducedBy         sql.NullString
+	CreatedDate        time.Time
+	PreHarvestInterval sql.NullInt64
+	ReEntryInterval    sql.NullInt64
+
+	CropPlanDaysToGermination  sql.NullInt64
+	CropPlanDaysToTransplant   sql.NullInt64
+	CropPlanDaysToFirstHarvest sql.NullInt64
+	CropPlanDaysToEndOfHarvest sql.NullInt64
+
+	SeedsPerUnit sql.NullFloat64
+
+	CropPlanBaseTemperature   sql.NullFloat64
+	CropPlanGDDToFirstHarvest sql.NullFloat64
 }
 
 func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string, page, limit int) <-chan query.QueryResult {
@@ -96,6 +108,15 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 				&rowsData.Notes,
 				&rowsData.ProducedBy,
 				&rowsData.CreatedDate,
+				&rowsData.PreHarvestInterval,
+				&rowsData.ReEntryInterval,
+				&rowsData.CropPlanDaysToGermination,
+				&rowsData.CropPlanDaysToTransplant,
+				&rowsData.CropPlanDaysToFirstHarvest,
+				&rowsData.CropPlanDaysToEndOfHarvest,
+				&rowsData.SeedsPerUnit,
+				&rowsData.CropPlanBaseTemperature,
+				&rowsData.CropPlanGDDToFirstHarvest,
 			)
 
 			if err != nil {
@@ -137,7 +158,11 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 			case domain.MaterialTypeGrowingMediumCode:
 				materialType = domain.MaterialTypeGrowingMedium{}
 			case domain.MaterialTypeAgrochemicalCode:
-				materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+				materialType, err = domain.CreateMaterialTypeAgrochemical(
+					rowsData.TypeData,
+					int(rowsData.PreHarvestInterval.Int64),
+					int(rowsData.ReEntryInterval.Int64),
+				)
 				if err != nil {
 					result <- query.QueryResult{Error: err}
 				}
@@ -184,6 +209,8 @@ func (q MaterialReadQueryMysql) FindAll(materialType, materialTypeDetail string,
 				Notes:          notes,
 				ProducedBy:     producedBy,
 				CreatedDate:    rowsData.CreatedDate,
+				CropPlan:       makeCropPlan(rowsData),
+				SeedsPerUnit:   float32(rowsData.SeedsPerUnit.Float64),
 			})
 		}
 
@@ -258,6 +285,15 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Que
 			&rowsData.Notes,
 			&rowsData.ProducedBy,
 			&rowsData.CreatedDate,
+			&rowsData.PreHarvestInterval,
+			&rowsData.ReEntryInterval,
+			&rowsData.CropPlanDaysToGermination,
+			&rowsData.CropPlanDaysToTransplant,
+			&rowsData.CropPlanDaysToFirstHarvest,
+			&rowsData.CropPlanDaysToEndOfHarvest,
+			&rowsData.SeedsPerUnit,
+			&rowsData.CropPlanBaseTemperature,
+			&rowsData.CropPlanGDDToFirstHarvest,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -303,7 +339,11 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Que
 		case domain.MaterialTypeGrowingMediumCode:
 			materialType = domain.MaterialTypeGrowingMedium{}
 		case domain.MaterialTypeAgrochemicalCode:
-			materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+			materialType, err = domain.CreateMaterialTypeAgrochemical(
+				rowsData.TypeData,
+				int(rowsData.PreHarvestInterval.Int64),
+				int(rowsData.ReEntryInterval.Int64),
+			)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
 			}
@@ -350,6 +390,8 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Que
 			Notes:          notes,
 			ProducedBy:     producedBy,
 			CreatedDate:    rowsData.CreatedDate,
+			CropPlan:       makeCropPlan(rowsData),
+			SeedsPerUnit:   float32(rowsData.SeedsPerUnit.Float64),
 		}
 
 		result <- query.QueryResult{Result: materialRead}
@@ -358,3 +400,18 @@ func (q MaterialReadQueryMysql) FindByID(materialUID uuid.UUID) <-chan query.Que
 
 	return result
 }
+
+func makeCropPlan(rowsData materialReadResult) *storage.CropPlan {
+	if !rowsData.CropPlanDaysToGermination.Valid {
+		return nil
+	}
+
+	return &storage.CropPlan{
+		DaysToGermination:  int(rowsData.CropPlanDaysToGermination.Int64),
+		DaysToTransplant:   int(rowsData.CropPlanDaysToTransplant.Int64),
+		DaysToFirstHarvest: int(rowsData.CropPlanDaysToFirstHarvest.Int64),
+		DaysToEndOfHarvest: int(rowsData.CropPlanDaysToEndOfHarvest.Int64),
+		BaseTemperature:    float32(rowsData.CropPlanBaseTemperature.Float64),
+		GDDToFirstHarvest:  float32(rowsData.CropPlanGDDToFirstHarvest.Float64),
+	}
+}
@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirOperationQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewReservoirOperationQueryMysql(db *sql.DB) query.ReservoirOperationQuery {
+	return &ReservoirOperationQueryMysql{DB: db}
+}
+
+type reservoirOperationReadResult struct {
+	UID           []byte
+	ReservoirUID  []byte
+	Type          string
+	Volume        float32
+	MaterialUID   []byte
+	MaterialName  string
+	Amount        float32
+	Unit          string
+	VolumeAfter   float32
+	OperationDate time.Time
+}
+
+func (f *ReservoirOperationQueryMysql) FindAllByReservoirID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		operations := []storage.ReservoirOperationRead{}
+
+		rows, err := f.DB.Query(`SELECT * FROM RESERVOIR_READ_OPERATION
+			WHERE RESERVOIR_UID = ? AND OPERATION_DATE >= ? AND OPERATION_DATE <= ?
+			ORDER BY OPERATION_DATE ASC`,
+			uid.Bytes(), from, to)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := reservoirOperationReadResult{}
+			err := rows.Scan(
+				&rowsData.UID, &rowsData.ReservoirUID, &rowsData.Type, &rowsData.Volume,
+				&rowsData.MaterialUID, &rowsData.MaterialName, &rowsData.Amount, &rowsData.Unit,
+				&rowsData.VolumeAfter, &rowsData.OperationDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			operationUID, err := uuid.FromBytes(rowsData.UID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			reservoirUID, err := uuid.FromBytes(rowsData.ReservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			materialUID, err := uuid.FromBytes(rowsData.MaterialUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			operations = append(operations, storage.ReservoirOperationRead{
+				UID:           operationUID,
+				ReservoirUID:  reservoirUID,
+				Type:          rowsData.Type,
+				Volume:        rowsData.Volume,
+				MaterialUID:   materialUID,
+				MaterialName:  rowsData.MaterialName,
+				Amount:        rowsData.Amount,
+				Unit:          rowsData.Unit,
+				VolumeAfter:   rowsData.VolumeAfter,
+				OperationDate: rowsData.OperationDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: operations}
+		close(result)
+	}()
+
+	return result
+}
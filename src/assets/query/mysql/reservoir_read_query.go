@@ -25,6 +25,7 @@ type reservoirReadResult struct {
 	FarmUID             []byte
 	FarmName            string
 	CreatedDate         time.Time
+	Volume              float32
 }
 
 type reservoirNotesReadResult struct {
@@ -50,6 +51,7 @@ func (s ReservoirReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResul
 			&rowsData.FarmUID,
 			&rowsData.FarmName,
 			&rowsData.CreatedDate,
+			&rowsData.Volume,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -96,6 +98,16 @@ func (s ReservoirReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResul
 			})
 		}
 
+		latestMeasurement, err := findLatestReservoirMeasurement(s.DB, reservoirUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		nutrients, err := findReservoirNutrients(s.DB, reservoirUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		reservoirRead = storage.ReservoirRead{
 			UID:  reservoirUID,
 			Name: rowsData.Name,
@@ -107,8 +119,11 @@ func (s ReservoirReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResul
 				UID:  farmUID,
 				Name: rowsData.FarmName,
 			},
-			CreatedDate: rowsData.CreatedDate,
-			Notes:       notes,
+			CreatedDate:       rowsData.CreatedDate,
+			Notes:             notes,
+			LatestMeasurement: latestMeasurement,
+			Volume:            rowsData.Volume,
+			Nutrients:         nutrients,
 		}
 
 		result <- query.QueryResult{Result: reservoirRead}
@@ -139,6 +154,7 @@ func (s ReservoirReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Q
 				&rowsData.FarmUID,
 				&rowsData.FarmName,
 				&rowsData.CreatedDate,
+				&rowsData.Volume,
 			)
 
 			if err != nil {
@@ -186,6 +202,16 @@ func (s ReservoirReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Q
 				})
 			}
 
+			latestMeasurement, err := findLatestReservoirMeasurement(s.DB, reservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			nutrients, err := findReservoirNutrients(s.DB, reservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			reservoirReads = append(reservoirReads, storage.ReservoirRead{
 				UID:  reservoirUID,
 				Name: rowsData.Name,
@@ -197,8 +223,11 @@ func (s ReservoirReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Q
 					UID:  farmUID,
 					Name: rowsData.FarmName,
 				},
-				CreatedDate: rowsData.CreatedDate,
-				Notes:       notes,
+				CreatedDate:       rowsData.CreatedDate,
+				Notes:             notes,
+				LatestMeasurement: latestMeasurement,
+				Volume:            rowsData.Volume,
+				Nutrients:         nutrients,
 			})
 		}
 
@@ -208,3 +237,31 @@ func (s ReservoirReadQueryMysql) FindAllByFarm(farmUID uuid.UUID) <-chan query.Q
 
 	return result
 }
+
+func findReservoirNutrients(db *sql.DB, reservoirUID uuid.UUID) ([]storage.ReservoirNutrient, error) {
+	rows, err := db.Query(`SELECT MATERIAL_UID, MATERIAL_NAME, AMOUNT, UNIT, CONCENTRATION
+		FROM RESERVOIR_READ_NUTRIENT WHERE RESERVOIR_UID = ?`, reservoirUID.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nutrients := []storage.ReservoirNutrient{}
+	for rows.Next() {
+		var materialUID []byte
+		nutrient := storage.ReservoirNutrient{}
+		err := rows.Scan(&materialUID, &nutrient.MaterialName, &nutrient.Amount, &nutrient.Unit, &nutrient.Concentration)
+		if err != nil {
+			return nil, err
+		}
+
+		nutrient.MaterialUID, err = uuid.FromBytes(materialUID)
+		if err != nil {
+			return nil, err
+		}
+
+		nutrients = append(nutrients, nutrient)
+	}
+
+	return nutrients, nil
+}
@@ -24,6 +24,14 @@ type ReservoirReadQuery interface {
 	FindAllByFarm(farmUID uuid.UUID) <-chan QueryResult
 }
 
+type ReservoirMeasurementQuery interface {
+	FindAllByReservoirID(reservoirUID uuid.UUID, from, to time.Time) <-chan QueryResult
+}
+
+type ReservoirOperationQuery interface {
+	FindAllByReservoirID(reservoirUID uuid.UUID, from, to time.Time) <-chan QueryResult
+}
+
 type AreaEventQuery interface {
 	FindAllByID(areaUID uuid.UUID) <-chan QueryResult
 }
@@ -34,6 +42,21 @@ type AreaReadQuery interface {
 	FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan QueryResult
 	FindAreasByReservoirID(reservoirUID uuid.UUID) <-chan QueryResult
 	CountAreas(farmUID uuid.UUID) <-chan QueryResult
+	FindAllByClimateZone(climateZoneUID uuid.UUID) <-chan QueryResult
+}
+
+type ClimateZoneEventQuery interface {
+	FindAllByID(climateZoneUID uuid.UUID) <-chan QueryResult
+}
+
+type ClimateZoneReadQuery interface {
+	FindByID(climateZoneUID uuid.UUID) <-chan QueryResult
+	FindAllByFarm(farmUID uuid.UUID) <-chan QueryResult
+}
+
+// ClimateReadingQuery finds the readings of the devices attached to the climate zones and their areas
+type ClimateReadingQuery interface {
+	FindLatestByAttachment(attachmentType string, attachmentUID uuid.UUID) <-chan QueryResult
 }
 
 type CropReadQuery interface {
@@ -51,6 +74,10 @@ type MaterialReadQuery interface {
 	FindByID(materialUID uuid.UUID) <-chan QueryResult
 }
 
+type MaterialConsumptionQuery interface {
+	FindAllByMaterialID(materialUID uuid.UUID) <-chan QueryResult
+}
+
 type QueryResult struct {
 	Result interface{}
 	Error  error
@@ -92,6 +119,14 @@ type CountAreaCropQueryResult struct {
 	TotalCropBatch int
 }
 
+// ClimateReadingQueryResult is the latest reading of a device
+type ClimateReadingQueryResult struct {
+	DeviceUID    uuid.UUID `json:"device_id"`
+	SensorType   string    `json:"sensor_type"`
+	Value        float32   `json:"value"`
+	RecordedDate time.Time `json:"recorded_date"`
+}
+
 type AreaCropQueryResult struct {
 	CropUID          uuid.UUID   `json:"uid"`
 	BatchID          string      `json:"batch_id"`
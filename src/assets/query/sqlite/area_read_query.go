@@ -19,22 +19,24 @@ func NewAreaReadQuerySqlite(db *sql.DB) query.AreaReadQuery {
 }
 
 type areaReadResult struct {
-	UID           string
-	Name          string
-	Size          float32
-	SizeUnit      string
-	Type          string
-	Location      string
-	PhotoFilename string
-	PhotoMimetype string
-	PhotoSize     int
-	PhotoWidth    int
-	PhotoHeight   int
-	CreatedDate   string
-	ReservoirUID  string
-	ReservoirName string
-	FarmUID       string
-	FarmName      string
+	UID             string
+	Name            string
+	Size            float32
+	SizeUnit        string
+	Type            string
+	Location        string
+	PhotoFilename   string
+	PhotoMimetype   string
+	PhotoSize       int
+	PhotoWidth      int
+	PhotoHeight     int
+	CreatedDate     string
+	ReservoirUID    string
+	ReservoirName   string
+	FarmUID         string
+	FarmName        string
+	ClimateZoneUID  string
+	ClimateZoneName string
 }
 
 type areaNotesReadResult struct {
@@ -69,6 +71,8 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			&rowsData.ReservoirName,
 			&rowsData.FarmUID,
 			&rowsData.FarmName,
+			&rowsData.ClimateZoneUID,
+			&rowsData.ClimateZoneName,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -89,6 +93,11 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			result <- query.QueryResult{Error: err}
 		}
 
+		climateZoneUID, err := uuid.FromString(rowsData.ClimateZoneUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		farmUID, err := uuid.FromString(rowsData.FarmUID)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -132,12 +141,12 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 
 		sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 		if sizeUnit == (domain.AreaUnit{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 		}
 
 		location := domain.GetAreaLocation(rowsData.Location)
 		if location == (domain.AreaLocation{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 		}
 
 		areaRead = storage.AreaRead{
@@ -166,6 +175,10 @@ func (s AreaReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 				UID:  reservoirUID,
 				Name: rowsData.ReservoirName,
 			},
+			ClimateZone: storage.AreaClimateZone{
+				UID:  climateZoneUID,
+				Name: rowsData.ClimateZoneName,
+			},
 		}
 
 		result <- query.QueryResult{Result: areaRead}
@@ -205,6 +218,8 @@ func (s AreaReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.Query
 				&rowsData.ReservoirName,
 				&rowsData.FarmUID,
 				&rowsData.FarmName,
+				&rowsData.ClimateZoneUID,
+				&rowsData.ClimateZoneName,
 			)
 
 			areaUID, err := uuid.FromString(rowsData.UID)
@@ -217,6 +232,11 @@ func (s AreaReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.Query
 				result <- query.QueryResult{Error: err}
 			}
 
+			climateZoneUID, err := uuid.FromString(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			farmUID, err := uuid.FromString(rowsData.FarmUID)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
@@ -261,12 +281,12 @@ func (s AreaReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.Query
 
 			sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 			if sizeUnit == (domain.AreaUnit{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 			}
 
 			location := domain.GetAreaLocation(rowsData.Location)
 			if location == (domain.AreaLocation{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 			}
 
 			areaReads = append(areaReads, storage.AreaRead{
@@ -295,6 +315,10 @@ func (s AreaReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.Query
 					UID:  reservoirUID,
 					Name: rowsData.ReservoirName,
 				},
+				ClimateZone: storage.AreaClimateZone{
+					UID:  climateZoneUID,
+					Name: rowsData.ClimateZoneName,
+				},
 			})
 		}
 
@@ -330,6 +354,8 @@ func (s AreaReadQuerySqlite) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan
 			&rowsData.ReservoirName,
 			&rowsData.FarmUID,
 			&rowsData.FarmName,
+			&rowsData.ClimateZoneUID,
+			&rowsData.ClimateZoneName,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -350,6 +376,11 @@ func (s AreaReadQuerySqlite) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan
 			result <- query.QueryResult{Error: err}
 		}
 
+		climateZoneUID, err := uuid.FromString(rowsData.ClimateZoneUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		farmUID, err := uuid.FromString(rowsData.FarmUID)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -393,12 +424,12 @@ func (s AreaReadQuerySqlite) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan
 
 		sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 		if sizeUnit == (domain.AreaUnit{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 		}
 
 		location := domain.GetAreaLocation(rowsData.Location)
 		if location == (domain.AreaLocation{}) {
-			result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+			result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 		}
 
 		areaRead = storage.AreaRead{
@@ -427,6 +458,10 @@ func (s AreaReadQuerySqlite) FindByIDAndFarm(areaUID, farmUID uuid.UUID) <-chan
 				UID:  reservoirUID,
 				Name: rowsData.ReservoirName,
 			},
+			ClimateZone: storage.AreaClimateZone{
+				UID:  climateZoneUID,
+				Name: rowsData.ClimateZoneName,
+			},
 		}
 
 		result <- query.QueryResult{Result: areaRead}
@@ -466,6 +501,8 @@ func (s AreaReadQuerySqlite) FindAreasByReservoirID(reservoirUID uuid.UUID) <-ch
 				&rowsData.ReservoirName,
 				&rowsData.FarmUID,
 				&rowsData.FarmName,
+				&rowsData.ClimateZoneUID,
+				&rowsData.ClimateZoneName,
 			)
 
 			areaUID, err := uuid.FromString(rowsData.UID)
@@ -478,6 +515,11 @@ func (s AreaReadQuerySqlite) FindAreasByReservoirID(reservoirUID uuid.UUID) <-ch
 				result <- query.QueryResult{Error: err}
 			}
 
+			climateZoneUID, err := uuid.FromString(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			farmUID, err := uuid.FromString(rowsData.FarmUID)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
@@ -522,12 +564,12 @@ func (s AreaReadQuerySqlite) FindAreasByReservoirID(reservoirUID uuid.UUID) <-ch
 
 			sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
 			if sizeUnit == (domain.AreaUnit{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidSizeUnitCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
 			}
 
 			location := domain.GetAreaLocation(rowsData.Location)
 			if location == (domain.AreaLocation{}) {
-				result <- query.QueryResult{Error: domain.AreaError{domain.AreaErrorInvalidAreaLocationCode}}
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
 			}
 
 			areaReads = append(areaReads, storage.AreaRead{
@@ -556,6 +598,10 @@ func (s AreaReadQuerySqlite) FindAreasByReservoirID(reservoirUID uuid.UUID) <-ch
 					UID:  reservoirUID,
 					Name: rowsData.ReservoirName,
 				},
+				ClimateZone: storage.AreaClimateZone{
+					UID:  climateZoneUID,
+					Name: rowsData.ClimateZoneName,
+				},
 			})
 		}
 
@@ -583,3 +629,144 @@ func (s AreaReadQuerySqlite) CountAreas(farmUID uuid.UUID) <-chan query.QueryRes
 
 	return result
 }
+
+func (s AreaReadQuerySqlite) FindAllByClimateZone(climateZoneUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		areaReads := []storage.AreaRead{}
+
+		rows, err := s.DB.Query("SELECT * FROM AREA_READ WHERE CLIMATE_ZONE_UID = ?", climateZoneUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := areaReadResult{}
+			rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.SizeUnit,
+				&rowsData.Size,
+				&rowsData.Type,
+				&rowsData.Location,
+				&rowsData.PhotoFilename,
+				&rowsData.PhotoMimetype,
+				&rowsData.PhotoSize,
+				&rowsData.PhotoWidth,
+				&rowsData.PhotoHeight,
+				&rowsData.CreatedDate,
+				&rowsData.ReservoirUID,
+				&rowsData.ReservoirName,
+				&rowsData.FarmUID,
+				&rowsData.FarmName,
+				&rowsData.ClimateZoneUID,
+				&rowsData.ClimateZoneName,
+			)
+
+			areaUID, err := uuid.FromString(rowsData.UID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			reservoirUID, err := uuid.FromString(rowsData.ReservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			climateZoneUID, err := uuid.FromString(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			farmUID, err := uuid.FromString(rowsData.FarmUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			areaCreatedDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			rows, err := s.DB.Query("SELECT * FROM AREA_READ_NOTES WHERE AREA_UID = ?", areaUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			notes := []storage.AreaNote{}
+			for rows.Next() {
+				notesRowsData := areaNotesReadResult{}
+				rows.Scan(
+					&notesRowsData.UID,
+					&notesRowsData.AreaUID,
+					&notesRowsData.Content,
+					&notesRowsData.CreatedDate,
+				)
+
+				noteUID, err := uuid.FromString(notesRowsData.UID)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+
+				noteCreatedDate, err := time.Parse(time.RFC3339, notesRowsData.CreatedDate)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+
+				notes = append(notes, storage.AreaNote{
+					UID:         noteUID,
+					Content:     notesRowsData.Content,
+					CreatedDate: noteCreatedDate,
+				})
+			}
+
+			sizeUnit := domain.GetAreaUnit(rowsData.SizeUnit)
+			if sizeUnit == (domain.AreaUnit{}) {
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidSizeUnitCode}}
+			}
+
+			location := domain.GetAreaLocation(rowsData.Location)
+			if location == (domain.AreaLocation{}) {
+				result <- query.QueryResult{Error: domain.AreaError{Code: domain.AreaErrorInvalidAreaLocationCode}}
+			}
+
+			areaReads = append(areaReads, storage.AreaRead{
+				UID:  areaUID,
+				Name: rowsData.Name,
+				Size: storage.AreaSize{
+					Value: rowsData.Size,
+					Unit:  sizeUnit,
+				},
+				Location: storage.AreaLocation(location),
+				Type:     rowsData.Type,
+				Photo: storage.AreaPhoto{
+					Filename: rowsData.PhotoFilename,
+					MimeType: rowsData.PhotoMimetype,
+					Size:     rowsData.PhotoSize,
+					Width:    rowsData.PhotoWidth,
+					Height:   rowsData.PhotoHeight,
+				},
+				CreatedDate: areaCreatedDate,
+				Notes:       notes,
+				Farm: storage.AreaFarm{
+					UID:  farmUID,
+					Name: rowsData.FarmName,
+				},
+				Reservoir: storage.AreaReservoir{
+					UID:  reservoirUID,
+					Name: rowsData.ReservoirName,
+				},
+				ClimateZone: storage.AreaClimateZone{
+					UID:  climateZoneUID,
+					Name: rowsData.ClimateZoneName,
+				},
+			})
+		}
+
+		result <- query.QueryResult{Result: areaReads}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,84 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateReadingQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewClimateReadingQuerySqlite(db *sql.DB) query.ClimateReadingQuery {
+	return ClimateReadingQuerySqlite{DB: db}
+}
+
+type climateReadingResult struct {
+	DeviceUID    string
+	SensorType   string
+	Value        float32
+	RecordedDate string
+}
+
+// FindLatestByAttachment finds the latest reading of each device attached to the asset
+func (s ClimateReadingQuerySqlite) FindLatestByAttachment(attachmentType string, attachmentUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		readings := []query.ClimateReadingQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT r.DEVICE_UID, r.SENSOR_TYPE, r.VALUE, r.RECORDED_DATE
+			FROM DEVICE_READING r
+			WHERE r.ATTACHMENT_TYPE = ? AND r.ATTACHMENT_UID = ?
+			AND r.ID = (SELECT l.ID FROM DEVICE_READING l
+				WHERE l.DEVICE_UID = r.DEVICE_UID AND l.ATTACHMENT_UID = r.ATTACHMENT_UID
+				ORDER BY l.RECORDED_DATE DESC, l.ID DESC LIMIT 1)`,
+			attachmentType, attachmentUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := climateReadingResult{}
+			err := rows.Scan(&rowsData.DeviceUID, &rowsData.SensorType, &rowsData.Value, &rowsData.RecordedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			deviceUID, err := uuid.FromString(rowsData.DeviceUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			recordedDate, err := time.Parse(time.RFC3339, rowsData.RecordedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			readings = append(readings, query.ClimateReadingQueryResult{
+				DeviceUID:    deviceUID,
+				SensorType:   rowsData.SensorType,
+				Value:        rowsData.Value,
+				RecordedDate: recordedDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
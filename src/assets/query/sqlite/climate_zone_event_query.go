@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/decoder"
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneEventQuerySqlite(db *sql.DB) query.ClimateZoneEventQuery {
+	return &ClimateZoneEventQuerySqlite{DB: db}
+}
+
+func (f *ClimateZoneEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.ClimateZoneEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM CLIMATE_ZONE_EVENT WHERE CLIMATE_ZONE_UID = ? ORDER BY VERSION ASC", uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID             int
+			ClimateZoneUID string
+			Version        int
+			CreatedDate    string
+			Event          []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.ClimateZoneUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.ClimateZoneEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			climateZoneUID, err := uuid.FromString(rowsData.ClimateZoneUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			events = append(events, storage.ClimateZoneEvent{
+				ClimateZoneUID: climateZoneUID,
+				Version:        rowsData.Version,
+				CreatedDate:    createdDate,
+				Event:          wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneReadQuerySqlite(db *sql.DB) query.ClimateZoneReadQuery {
+	return ClimateZoneReadQuerySqlite{DB: db}
+}
+
+type climateZoneReadResult struct {
+	UID            string
+	Name           string
+	Type           string
+	FarmUID        string
+	FarmName       string
+	TemperatureMin sql.NullFloat64
+	TemperatureMax sql.NullFloat64
+	HumidityMin    sql.NullFloat64
+	HumidityMax    sql.NullFloat64
+	CO2Min         sql.NullFloat64
+	CO2Max         sql.NullFloat64
+	LightMin       sql.NullFloat64
+	LightMax       sql.NullFloat64
+	CreatedDate    string
+}
+
+func (s ClimateZoneReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := s.DB.Query("SELECT * FROM CLIMATE_ZONE_READ WHERE UID = ?", uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		climateZoneRead := storage.ClimateZoneRead{}
+		if rows.Next() {
+			climateZoneRead, err = scanClimateZoneRead(rows)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+		}
+
+		result <- query.QueryResult{Result: climateZoneRead}
+		close(result)
+	}()
+
+	return result
+}
+
+func (s ClimateZoneReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := s.DB.Query("SELECT * FROM CLIMATE_ZONE_READ WHERE FARM_UID = ? ORDER BY CREATED_DATE ASC", farmUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		climateZoneReads := []storage.ClimateZoneRead{}
+		for rows.Next() {
+			climateZoneRead, err := scanClimateZoneRead(rows)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			climateZoneReads = append(climateZoneReads, climateZoneRead)
+		}
+
+		result <- query.QueryResult{Result: climateZoneReads}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanClimateZoneRead(rows *sql.Rows) (storage.ClimateZoneRead, error) {
+	rowsData := climateZoneReadResult{}
+	err := rows.Scan(
+		&rowsData.UID,
+		&rowsData.Name,
+		&rowsData.Type,
+		&rowsData.FarmUID,
+		&rowsData.FarmName,
+		&rowsData.TemperatureMin,
+		&rowsData.TemperatureMax,
+		&rowsData.HumidityMin,
+		&rowsData.HumidityMax,
+		&rowsData.CO2Min,
+		&rowsData.CO2Max,
+		&rowsData.LightMin,
+		&rowsData.LightMax,
+		&rowsData.CreatedDate,
+	)
+	if err != nil {
+		return storage.ClimateZoneRead{}, err
+	}
+
+	climateZoneUID, err := uuid.FromString(rowsData.UID)
+	if err != nil {
+		return storage.ClimateZoneRead{}, err
+	}
+
+	farmUID, err := uuid.FromString(rowsData.FarmUID)
+	if err != nil {
+		return storage.ClimateZoneRead{}, err
+	}
+
+	createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+	if err != nil {
+		return storage.ClimateZoneRead{}, err
+	}
+
+	return storage.ClimateZoneRead{
+		UID:  climateZoneUID,
+		Name: rowsData.Name,
+		Type: storage.ClimateZoneType(domain.GetClimateZoneType(rowsData.Type)),
+		Targets: storage.ClimateTargets{
+			Temperature: domain.ClimateRange{Min: nullFloat32(rowsData.TemperatureMin), Max: nullFloat32(rowsData.TemperatureMax)},
+			Humidity:    domain.ClimateRange{Min: nullFloat32(rowsData.HumidityMin), Max: nullFloat32(rowsData.HumidityMax)},
+			CO2:         domain.ClimateRange{Min: nullFloat32(rowsData.CO2Min), Max: nullFloat32(rowsData.CO2Max)},
+			Light:       domain.ClimateRange{Min: nullFloat32(rowsData.LightMin), Max: nullFloat32(rowsData.LightMax)},
+		},
+		Farm: storage.ClimateZoneFarm{
+			UID:  farmUID,
+			Name: rowsData.FarmName,
+		},
+		CreatedDate: createdDate,
+	}, nil
+}
@@ -18,15 +18,16 @@ func NewFarmReadQuerySqlite(db *sql.DB) query.FarmReadQuery {
 }
 
 type farmReadResult struct {
-	UID         string
-	Name        string
-	Latitude    string
-	Longitude   string
-	Type        string
-	Country     string
-	City        string
-	IsActive    int
-	CreatedDate string
+	UID             string
+	Name            string
+	Latitude        string
+	Longitude       string
+	Type            string
+	Country         string
+	City            string
+	IsActive        int
+	CreatedDate     string
+	BatchIDTemplate sql.NullString
 }
 
 func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
@@ -46,6 +47,7 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			&rowsData.City,
 			&rowsData.IsActive,
 			&rowsData.CreatedDate,
+			&rowsData.BatchIDTemplate,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -67,15 +69,16 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 		}
 
 		farmRead = storage.FarmRead{
-			UID:         farmUID,
-			Name:        rowsData.Name,
-			Latitude:    rowsData.Latitude,
-			Longitude:   rowsData.Longitude,
-			Type:        rowsData.Type,
-			Country:     rowsData.Country,
-			City:        rowsData.City,
-			IsActive:    rowsData.IsActive != 0,
-			CreatedDate: createdDate,
+			UID:             farmUID,
+			Name:            rowsData.Name,
+			Latitude:        rowsData.Latitude,
+			Longitude:       rowsData.Longitude,
+			Type:            rowsData.Type,
+			Country:         rowsData.Country,
+			City:            rowsData.City,
+			IsActive:        rowsData.IsActive != 0,
+			CreatedDate:     createdDate,
+			BatchIDTemplate: rowsData.BatchIDTemplate.String,
 		}
 
 		result <- query.QueryResult{Result: farmRead}
@@ -108,6 +111,7 @@ func (s FarmReadQuerySqlite) FindAll() <-chan query.QueryResult {
 				&rowsData.City,
 				&rowsData.IsActive,
 				&rowsData.CreatedDate,
+				&rowsData.BatchIDTemplate,
 			)
 
 			if err != nil {
@@ -125,15 +129,16 @@ func (s FarmReadQuerySqlite) FindAll() <-chan query.QueryResult {
 			}
 
 			farmReads = append(farmReads, storage.FarmRead{
-				UID:         farmUID,
-				Name:        rowsData.Name,
-				Latitude:    rowsData.Latitude,
-				Longitude:   rowsData.Longitude,
-				Type:        rowsData.Type,
-				Country:     rowsData.Country,
-				City:        rowsData.City,
-				IsActive:    rowsData.IsActive != 0,
-				CreatedDate: createdDate,
+				UID:             farmUID,
+				Name:            rowsData.Name,
+				Latitude:        rowsData.Latitude,
+				Longitude:       rowsData.Longitude,
+				Type:            rowsData.Type,
+				Country:         rowsData.Country,
+				City:            rowsData.City,
+				IsActive:        rowsData.IsActive != 0,
+				CreatedDate:     createdDate,
+				BatchIDTemplate: rowsData.BatchIDTemplate.String,
 			})
 		}
 
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type MaterialConsumptionQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewMaterialConsumptionQuerySqlite(db *sql.DB) query.MaterialConsumptionQuery {
+	return &MaterialConsumptionQuerySqlite{DB: db}
+}
+
+func (f *MaterialConsumptionQuerySqlite) FindAllByMaterialID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		consumptions := []storage.MaterialConsumptionRead{}
+
+		rows, err := f.DB.Query(`SELECT * FROM MATERIAL_READ_CONSUMPTION
+			WHERE MATERIAL_UID = ? ORDER BY CONSUMED_DATE ASC`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID           int
+			MaterialUID  string
+			TaskUID      string
+			Quantity     float32
+			QuantityUnit string
+			ConsumedDate string
+			CropUID      sql.NullString
+			ReservoirUID sql.NullString
+		}{}
+
+		for rows.Next() {
+			rows.Scan(
+				&rowsData.ID, &rowsData.MaterialUID, &rowsData.TaskUID,
+				&rowsData.Quantity, &rowsData.QuantityUnit, &rowsData.ConsumedDate,
+				&rowsData.CropUID, &rowsData.ReservoirUID,
+			)
+
+			materialUID, err := uuid.FromString(rowsData.MaterialUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			taskUID, err := uuid.FromString(rowsData.TaskUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			// Consumptions recorded before seeds were consumed by crops have no crop
+			cropUID := uuid.UUID{}
+			if rowsData.CropUID.Valid {
+				cropUID, err = uuid.FromString(rowsData.CropUID.String)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+			}
+
+			reservoirUID := uuid.UUID{}
+			if rowsData.ReservoirUID.Valid {
+				reservoirUID, err = uuid.FromString(rowsData.ReservoirUID.String)
+				if err != nil {
+					result <- query.QueryResult{Error: err}
+				}
+			}
+
+			consumedDate, err := time.Parse(time.RFC3339, rowsData.ConsumedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			consumptions = append(consumptions, storage.MaterialConsumptionRead{
+				MaterialUID:  materialUID,
+				TaskUID:      taskUID,
+				CropUID:      cropUID,
+				ReservoirUID: reservoirUID,
+				Quantity:     rowsData.Quantity,
+				QuantityUnit: rowsData.QuantityUnit,
+				ConsumedDate: consumedDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: consumptions}
+		close(result)
+	}()
+
+	return result
+}
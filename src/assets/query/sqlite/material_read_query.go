@@ -22,18 +22,30 @@ func NewMaterialReadQuerySqlite(db *sql.DB) query.MaterialReadQuery {
 }
 
 type materialReadResult struct {
-	UID            string
-	Name           string
-	PricePerUnit   string
-	CurrencyCode   string
-	Type           string
-	TypeData       string
-	Quantity       float32
-	QuantityUnit   string
-	ExpirationDate sql.NullString
-	Notes          sql.NullString
-	ProducedBy     sql.NullString
-	CreatedDate    string
+	UID                string
+	Name               string
+	PricePerUnit       string
+	CurrencyCode       string
+	Type               string
+	TypeData           string
+	Quantity           float32
+	QuantityUnit       string
+	ExpirationDate     sql.NullString
+	Notes              sql.NullString
+	ProducedBy         sql.NullString
+	CreatedDate        string
+	PreHarvestInterval sql.NullInt64
+	ReEntryInterval    sql.NullInt64
+
+	CropPlanDaysToGermination  sql.NullInt64
+	CropPlanDaysToTransplant   sql.NullInt64
+	CropPlanDaysToFirstHarvest sql.NullInt64
+	CropPlanDaysToEndOfHarvest sql.NullInt64
+
+	SeedsPerUnit sql.NullFloat64
+
+	CropPlanBaseTemperature   sql.NullFloat64
+	CropPlanGDDToFirstHarvest sql.NullFloat64
 }
 
 func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string, page, limit int) <-chan query.QueryResult {
@@ -97,6 +109,15 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 				&rowsData.Notes,
 				&rowsData.ProducedBy,
 				&rowsData.CreatedDate,
+				&rowsData.PreHarvestInterval,
+				&rowsData.ReEntryInterval,
+				&rowsData.CropPlanDaysToGermination,
+				&rowsData.CropPlanDaysToTransplant,
+				&rowsData.CropPlanDaysToFirstHarvest,
+				&rowsData.CropPlanDaysToEndOfHarvest,
+				&rowsData.SeedsPerUnit,
+				&rowsData.CropPlanBaseTemperature,
+				&rowsData.CropPlanGDDToFirstHarvest,
 			)
 
 			if err != nil {
@@ -143,7 +164,11 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 			case domain.MaterialTypeGrowingMediumCode:
 				materialType = domain.MaterialTypeGrowingMedium{}
 			case domain.MaterialTypeAgrochemicalCode:
-				materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+				materialType, err = domain.CreateMaterialTypeAgrochemical(
+					rowsData.TypeData,
+					int(rowsData.PreHarvestInterval.Int64),
+					int(rowsData.ReEntryInterval.Int64),
+				)
 				if err != nil {
 					result <- query.QueryResult{Error: err}
 				}
@@ -190,6 +215,8 @@ func (q MaterialReadQuerySqlite) FindAll(materialType, materialTypeDetail string
 				Notes:          notes,
 				ProducedBy:     producedBy,
 				CreatedDate:    mCreatedDate,
+				CropPlan:       makeCropPlan(rowsData),
+				SeedsPerUnit:   float32(rowsData.SeedsPerUnit.Float64),
 			})
 		}
 
@@ -264,6 +291,15 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 			&rowsData.Notes,
 			&rowsData.ProducedBy,
 			&rowsData.CreatedDate,
+			&rowsData.PreHarvestInterval,
+			&rowsData.ReEntryInterval,
+			&rowsData.CropPlanDaysToGermination,
+			&rowsData.CropPlanDaysToTransplant,
+			&rowsData.CropPlanDaysToFirstHarvest,
+			&rowsData.CropPlanDaysToEndOfHarvest,
+			&rowsData.SeedsPerUnit,
+			&rowsData.CropPlanBaseTemperature,
+			&rowsData.CropPlanGDDToFirstHarvest,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -314,7 +350,11 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 		case domain.MaterialTypeGrowingMediumCode:
 			materialType = domain.MaterialTypeGrowingMedium{}
 		case domain.MaterialTypeAgrochemicalCode:
-			materialType, err = domain.CreateMaterialTypeAgrochemical(rowsData.TypeData)
+			materialType, err = domain.CreateMaterialTypeAgrochemical(
+				rowsData.TypeData,
+				int(rowsData.PreHarvestInterval.Int64),
+				int(rowsData.ReEntryInterval.Int64),
+			)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
 			}
@@ -361,6 +401,8 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 			Notes:          notes,
 			ProducedBy:     producedBy,
 			CreatedDate:    mCreatedDate,
+			CropPlan:       makeCropPlan(rowsData),
+			SeedsPerUnit:   float32(rowsData.SeedsPerUnit.Float64),
 		}
 
 		result <- query.QueryResult{Result: materialRead}
@@ -369,3 +411,18 @@ func (q MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 
 	return result
 }
+
+func makeCropPlan(rowsData materialReadResult) *storage.CropPlan {
+	if !rowsData.CropPlanDaysToGermination.Valid {
+		return nil
+	}
+
+	return &storage.CropPlan{
+		DaysToGermination:  int(rowsData.CropPlanDaysToGermination.Int64),
+		DaysToTransplant:   int(rowsData.CropPlanDaysToTransplant.Int64),
+		DaysToFirstHarvest: int(rowsData.CropPlanDaysToFirstHarvest.Int64),
+		DaysToEndOfHarvest: int(rowsData.CropPlanDaysToEndOfHarvest.Int64),
+		BaseTemperature:    float32(rowsData.CropPlanBaseTemperature.Float64),
+		GDDToFirstHarvest:  float32(rowsData.CropPlanGDDToFirstHarvest.Float64),
+	}
+}
@@ -0,0 +1,161 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirMeasurementQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewReservoirMeasurementQuerySqlite(db *sql.DB) query.ReservoirMeasurementQuery {
+	return &ReservoirMeasurementQuerySqlite{DB: db}
+}
+
+type reservoirMeasurementReadResult struct {
+	UID             string
+	ReservoirUID    string
+	PH              sql.NullFloat64
+	EC              sql.NullFloat64
+	Temperature     sql.NullFloat64
+	DissolvedOxygen sql.NullFloat64
+	Source          string
+	MeasuredDate    string
+}
+
+func (f *ReservoirMeasurementQuerySqlite) FindAllByReservoirID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		measurements := []storage.ReservoirMeasurementRead{}
+
+		rows, err := f.DB.Query(`SELECT * FROM RESERVOIR_READ_MEASUREMENT
+			WHERE RESERVOIR_UID = ? AND MEASURED_DATE >= ? AND MEASURED_DATE <= ?
+			ORDER BY MEASURED_DATE ASC`,
+			uid, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := reservoirMeasurementReadResult{}
+			err := rows.Scan(
+				&rowsData.UID, &rowsData.ReservoirUID,
+				&rowsData.PH, &rowsData.EC, &rowsData.Temperature, &rowsData.DissolvedOxygen,
+				&rowsData.Source, &rowsData.MeasuredDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			measurement, err := mapReservoirMeasurementRead(rowsData)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			measurements = append(measurements, measurement)
+		}
+
+		result <- query.QueryResult{Result: measurements}
+		close(result)
+	}()
+
+	return result
+}
+
+// findLatestReservoirMeasurement finds the latest value of each parameter, as a measurement can carry only some of them.
+// It returns nil when the reservoir has never been measured.
+func findLatestReservoirMeasurement(db *sql.DB, reservoirUID uuid.UUID) (*storage.ReservoirLatestMeasurement, error) {
+	latest := storage.ReservoirLatestMeasurement{}
+	parameters := map[string]**domain.ReservoirParameterMeasurement{
+		"PH":               &latest.PH,
+		"EC":               &latest.EC,
+		"TEMPERATURE":      &latest.Temperature,
+		"DISSOLVED_OXYGEN": &latest.DissolvedOxygen,
+	}
+
+	isMeasured := false
+	for column, parameter := range parameters {
+		var (
+			value        float32
+			source       string
+			measuredDate string
+		)
+
+		err := db.QueryRow(`SELECT `+column+`, SOURCE, MEASURED_DATE FROM RESERVOIR_READ_MEASUREMENT
+			WHERE RESERVOIR_UID = ? AND `+column+` IS NOT NULL ORDER BY MEASURED_DATE DESC LIMIT 1`, reservoirUID).Scan(
+			&value, &source, &measuredDate,
+		)
+
+		if err == sql.ErrNoRows {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		date, err := time.Parse(time.RFC3339, measuredDate)
+		if err != nil {
+			return nil, err
+		}
+
+		*parameter = &domain.ReservoirParameterMeasurement{
+			Value:        value,
+			Source:       source,
+			MeasuredDate: date,
+		}
+		isMeasured = true
+	}
+
+	if !isMeasured {
+		return nil, nil
+	}
+
+	return &latest, nil
+}
+
+func mapReservoirMeasurementRead(rowsData reservoirMeasurementReadResult) (storage.ReservoirMeasurementRead, error) {
+	uid, err := uuid.FromString(rowsData.UID)
+	if err != nil {
+		return storage.ReservoirMeasurementRead{}, err
+	}
+
+	reservoirUID, err := uuid.FromString(rowsData.ReservoirUID)
+	if err != nil {
+		return storage.ReservoirMeasurementRead{}, err
+	}
+
+	measuredDate, err := time.Parse(time.RFC3339, rowsData.MeasuredDate)
+	if err != nil {
+		return storage.ReservoirMeasurementRead{}, err
+	}
+
+	return storage.ReservoirMeasurementRead{
+		ReservoirUID: reservoirUID,
+		ReservoirMeasurement: storage.ReservoirMeasurement{
+			UID:             uid,
+			PH:              nullFloat32(rowsData.PH),
+			EC:              nullFloat32(rowsData.EC),
+			Temperature:     nullFloat32(rowsData.Temperature),
+			DissolvedOxygen: nullFloat32(rowsData.DissolvedOxygen),
+			Source:          rowsData.Source,
+			MeasuredDate:    measuredDate,
+		},
+	}, nil
+}
+
+func nullFloat32(v sql.NullFloat64) *float32 {
+	if !v.Valid {
+		return nil
+	}
+
+	f := float32(v.Float64)
+	return &f
+}
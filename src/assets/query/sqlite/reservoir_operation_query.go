@@ -0,0 +1,97 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/query"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirOperationQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewReservoirOperationQuerySqlite(db *sql.DB) query.ReservoirOperationQuery {
+	return &ReservoirOperationQuerySqlite{DB: db}
+}
+
+type reservoirOperationReadResult struct {
+	UID           string
+	ReservoirUID  string
+	Type          string
+	Volume        float32
+	MaterialUID   string
+	MaterialName  string
+	Amount        float32
+	Unit          string
+	VolumeAfter   float32
+	OperationDate string
+}
+
+func (f *ReservoirOperationQuerySqlite) FindAllByReservoirID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		operations := []storage.ReservoirOperationRead{}
+
+		rows, err := f.DB.Query(`SELECT * FROM RESERVOIR_READ_OPERATION
+			WHERE RESERVOIR_UID = ? AND OPERATION_DATE >= ? AND OPERATION_DATE <= ?
+			ORDER BY OPERATION_DATE ASC, ROWID ASC`,
+			uid, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := reservoirOperationReadResult{}
+			err := rows.Scan(
+				&rowsData.UID, &rowsData.ReservoirUID, &rowsData.Type, &rowsData.Volume,
+				&rowsData.MaterialUID, &rowsData.MaterialName, &rowsData.Amount, &rowsData.Unit,
+				&rowsData.VolumeAfter, &rowsData.OperationDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			operationUID, err := uuid.FromString(rowsData.UID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			reservoirUID, err := uuid.FromString(rowsData.ReservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			materialUID, err := uuid.FromString(rowsData.MaterialUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			operationDate, err := time.Parse(time.RFC3339, rowsData.OperationDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			operations = append(operations, storage.ReservoirOperationRead{
+				UID:           operationUID,
+				ReservoirUID:  reservoirUID,
+				Type:          rowsData.Type,
+				Volume:        rowsData.Volume,
+				MaterialUID:   materialUID,
+				MaterialName:  rowsData.MaterialName,
+				Amount:        rowsData.Amount,
+				Unit:          rowsData.Unit,
+				VolumeAfter:   rowsData.VolumeAfter,
+				OperationDate: operationDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: operations}
+		close(result)
+	}()
+
+	return result
+}
@@ -25,6 +25,7 @@ type reservoirReadResult struct {
 	FarmUID             string
 	FarmName            string
 	CreatedDate         string
+	Volume              float32
 }
 
 type reservoirNotesReadResult struct {
@@ -50,6 +51,7 @@ func (s ReservoirReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResu
 			&rowsData.FarmUID,
 			&rowsData.FarmName,
 			&rowsData.CreatedDate,
+			&rowsData.Volume,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -106,6 +108,16 @@ func (s ReservoirReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResu
 			})
 		}
 
+		latestMeasurement, err := findLatestReservoirMeasurement(s.DB, reservoirUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		nutrients, err := findReservoirNutrients(s.DB, reservoirUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		reservoirRead = storage.ReservoirRead{
 			UID:  reservoirUID,
 			Name: rowsData.Name,
@@ -117,8 +129,11 @@ func (s ReservoirReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResu
 				UID:  farmUID,
 				Name: rowsData.FarmName,
 			},
-			CreatedDate: resCreatedDate,
-			Notes:       notes,
+			CreatedDate:       resCreatedDate,
+			Notes:             notes,
+			LatestMeasurement: latestMeasurement,
+			Volume:            rowsData.Volume,
+			Nutrients:         nutrients,
 		}
 
 		result <- query.QueryResult{Result: reservoirRead}
@@ -149,6 +164,7 @@ func (s ReservoirReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.
 				&rowsData.FarmUID,
 				&rowsData.FarmName,
 				&rowsData.CreatedDate,
+				&rowsData.Volume,
 			)
 
 			if err != nil {
@@ -206,6 +222,16 @@ func (s ReservoirReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.
 				})
 			}
 
+			latestMeasurement, err := findLatestReservoirMeasurement(s.DB, reservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			nutrients, err := findReservoirNutrients(s.DB, reservoirUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			reservoirReads = append(reservoirReads, storage.ReservoirRead{
 				UID:  reservoirUID,
 				Name: rowsData.Name,
@@ -217,8 +243,11 @@ func (s ReservoirReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.
 					UID:  farmUID,
 					Name: rowsData.FarmName,
 				},
-				CreatedDate: resCreatedDate,
-				Notes:       notes,
+				CreatedDate:       resCreatedDate,
+				Notes:             notes,
+				LatestMeasurement: latestMeasurement,
+				Volume:            rowsData.Volume,
+				Nutrients:         nutrients,
 			})
 		}
 
@@ -228,3 +257,31 @@ func (s ReservoirReadQuerySqlite) FindAllByFarm(farmUID uuid.UUID) <-chan query.
 
 	return result
 }
+
+func findReservoirNutrients(db *sql.DB, reservoirUID uuid.UUID) ([]storage.ReservoirNutrient, error) {
+	rows, err := db.Query(`SELECT MATERIAL_UID, MATERIAL_NAME, AMOUNT, UNIT, CONCENTRATION
+		FROM RESERVOIR_READ_NUTRIENT WHERE RESERVOIR_UID = ?`, reservoirUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nutrients := []storage.ReservoirNutrient{}
+	for rows.Next() {
+		var materialUID string
+		nutrient := storage.ReservoirNutrient{}
+		err := rows.Scan(&materialUID, &nutrient.MaterialName, &nutrient.Amount, &nutrient.Unit, &nutrient.Concentration)
+		if err != nil {
+			return nil, err
+		}
+
+		nutrient.MaterialUID, err = uuid.FromString(materialUID)
+		if err != nil {
+			return nil, err
+		}
+
+		nutrients = append(nutrients, nutrient)
+	}
+
+	return nutrients, nil
+}
@@ -1,6 +1,8 @@
 package inmemory
 
 import (
+	"time"
+
 	"github.com/Tanibox/tania-core/src/assets/repository"
 	"github.com/Tanibox/tania-core/src/assets/storage"
 	uuid "github.com/satori/go.uuid"
@@ -24,9 +26,10 @@ func (f *AreaEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, eve
 		for _, v := range events {
 			latestVersion++
 			f.Storage.AreaEvents = append(f.Storage.AreaEvents, storage.AreaEvent{
-				AreaUID: uid,
-				Version: latestVersion,
-				Event:   v,
+				AreaUID:     uid,
+				Version:     latestVersion,
+				CreatedDate: time.Now(),
+				Event:       v,
 			})
 		}
 
@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneEventRepositoryInMemory struct {
+	Storage *storage.ClimateZoneEventStorage
+}
+
+func NewClimateZoneEventRepositoryInMemory(s *storage.ClimateZoneEventStorage) repository.ClimateZoneEventRepository {
+	return &ClimateZoneEventRepositoryInMemory{Storage: s}
+}
+
+func (f *ClimateZoneEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.ClimateZoneEvents = append(f.Storage.ClimateZoneEvents, storage.ClimateZoneEvent{
+				ClimateZoneUID: uid,
+				Version:        latestVersion,
+				Event:          v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
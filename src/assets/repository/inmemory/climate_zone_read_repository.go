@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ClimateZoneReadRepositoryInMemory struct {
+	Storage *storage.ClimateZoneReadStorage
+}
+
+func NewClimateZoneReadRepositoryInMemory(s *storage.ClimateZoneReadStorage) repository.ClimateZoneReadRepository {
+	return &ClimateZoneReadRepositoryInMemory{Storage: s}
+}
+
+func (f *ClimateZoneReadRepositoryInMemory) Save(climateZoneRead *storage.ClimateZoneRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.ClimateZoneReadMap[climateZoneRead.UID] = *climateZoneRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
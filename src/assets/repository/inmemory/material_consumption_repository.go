@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type MaterialConsumptionRepositoryInMemory struct {
+	Storage *storage.MaterialConsumptionStorage
+}
+
+func NewMaterialConsumptionRepositoryInMemory(s *storage.MaterialConsumptionStorage) repository.MaterialConsumptionRepository {
+	return &MaterialConsumptionRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *MaterialConsumptionRepositoryInMemory) Save(materialConsumption *storage.MaterialConsumptionRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.MaterialConsumptions = append(f.Storage.MaterialConsumptions, *materialConsumption)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
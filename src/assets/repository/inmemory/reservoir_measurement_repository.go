@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ReservoirMeasurementRepositoryInMemory struct {
+	Storage *storage.ReservoirMeasurementStorage
+}
+
+func NewReservoirMeasurementRepositoryInMemory(s *storage.ReservoirMeasurementStorage) repository.ReservoirMeasurementRepository {
+	return &ReservoirMeasurementRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *ReservoirMeasurementRepositoryInMemory) Save(reservoirMeasurement *storage.ReservoirMeasurementRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.ReservoirMeasurements = append(f.Storage.ReservoirMeasurements, *reservoirMeasurement)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ReservoirOperationRepositoryInMemory struct {
+	Storage *storage.ReservoirOperationStorage
+}
+
+func NewReservoirOperationRepositoryInMemory(s *storage.ReservoirOperationStorage) repository.ReservoirOperationRepository {
+	return &ReservoirOperationRepositoryInMemory{Storage: s}
+}
+
+// Save is to save
+func (f *ReservoirOperationRepositoryInMemory) Save(reservoirOperation *storage.ReservoirOperationRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.ReservoirOperations = append(f.Storage.ReservoirOperations, *reservoirOperation)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
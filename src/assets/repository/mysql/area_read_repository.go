@@ -29,12 +29,14 @@ func (f *AreaReadRepositoryMysql) Save(areaRead *storage.AreaRead) <-chan error
 			_, err := f.DB.Exec(`UPDATE AREA_READ SET
 				NAME = ?, SIZE_UNIT = ?, SIZE = ?, TYPE = ?, LOCATION = ?,
 				PHOTO_FILENAME = ?, PHOTO_MIMETYPE = ?, PHOTO_SIZE = ?, PHOTO_WIDTH = ?, PHOTO_HEIGHT = ?,
-				CREATED_DATE = ?, FARM_UID = ?, FARM_NAME = ?, RESERVOIR_UID = ?, RESERVOIR_NAME = ?
+				CREATED_DATE = ?, FARM_UID = ?, FARM_NAME = ?, RESERVOIR_UID = ?, RESERVOIR_NAME = ?,
+				CLIMATE_ZONE_UID = ?, CLIMATE_ZONE_NAME = ?
 				WHERE UID = ?`,
 				areaRead.Name, areaRead.Size.Unit.Symbol, areaRead.Size.Value, areaRead.Type,
 				areaRead.Location.Code, areaRead.Photo.Filename, areaRead.Photo.MimeType,
 				areaRead.Photo.Size, areaRead.Photo.Width, areaRead.Photo.Height, areaRead.CreatedDate,
-				areaRead.Farm.UID.Bytes(), areaRead.Farm.Name, areaRead.Reservoir.UID.Bytes(), areaRead.Reservoir.Name, areaRead.UID.Bytes())
+				areaRead.Farm.UID.Bytes(), areaRead.Farm.Name, areaRead.Reservoir.UID.Bytes(), areaRead.Reservoir.Name,
+				areaRead.ClimateZone.UID.Bytes(), areaRead.ClimateZone.Name, areaRead.UID.Bytes())
 
 			if err != nil {
 				result <- err
@@ -60,12 +62,14 @@ func (f *AreaReadRepositoryMysql) Save(areaRead *storage.AreaRead) <-chan error
 		} else {
 			_, err := f.DB.Exec(`INSERT INTO AREA_READ
 				(UID, NAME, SIZE_UNIT, SIZE, TYPE, LOCATION, PHOTO_FILENAME, PHOTO_MIMETYPE,
-				PHOTO_SIZE, PHOTO_WIDTH, PHOTO_HEIGHT, CREATED_DATE, FARM_UID, FARM_NAME, RESERVOIR_UID, RESERVOIR_NAME)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				PHOTO_SIZE, PHOTO_WIDTH, PHOTO_HEIGHT, CREATED_DATE, FARM_UID, FARM_NAME, RESERVOIR_UID, RESERVOIR_NAME,
+				CLIMATE_ZONE_UID, CLIMATE_ZONE_NAME)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				areaRead.UID.Bytes(), areaRead.Name, areaRead.Size.Unit.Symbol, areaRead.Size.Value, areaRead.Type,
 				areaRead.Location.Code, areaRead.Photo.Filename, areaRead.Photo.MimeType,
 				areaRead.Photo.Size, areaRead.Photo.Width, areaRead.Photo.Height, areaRead.CreatedDate,
-				areaRead.Farm.UID.Bytes(), areaRead.Farm.Name, areaRead.Reservoir.UID.Bytes(), areaRead.Reservoir.Name)
+				areaRead.Farm.UID.Bytes(), areaRead.Farm.Name, areaRead.Reservoir.UID.Bytes(), areaRead.Reservoir.Name,
+				areaRead.ClimateZone.UID.Bytes(), areaRead.ClimateZone.Name)
 
 			if err != nil {
 				result <- err
@@ -0,0 +1,84 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ClimateZoneReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneReadRepositoryMysql(db *sql.DB) repository.ClimateZoneReadRepository {
+	return &ClimateZoneReadRepositoryMysql{DB: db}
+}
+
+func (f *ClimateZoneReadRepositoryMysql) Save(climateZoneRead *storage.ClimateZoneRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM CLIMATE_ZONE_READ WHERE UID = ?`, climateZoneRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		targets := climateZoneRead.Targets
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE CLIMATE_ZONE_READ SET
+				NAME = ?, TYPE = ?, FARM_UID = ?, FARM_NAME = ?,
+				TEMPERATURE_MIN = ?, TEMPERATURE_MAX = ?, HUMIDITY_MIN = ?, HUMIDITY_MAX = ?,
+				CO2_MIN = ?, CO2_MAX = ?, LIGHT_MIN = ?, LIGHT_MAX = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				climateZoneRead.Name,
+				climateZoneRead.Type.Code,
+				climateZoneRead.Farm.UID.Bytes(),
+				climateZoneRead.Farm.Name,
+				targets.Temperature.Min,
+				targets.Temperature.Max,
+				targets.Humidity.Min,
+				targets.Humidity.Max,
+				targets.CO2.Min,
+				targets.CO2.Max,
+				targets.Light.Min,
+				targets.Light.Max,
+				climateZoneRead.CreatedDate,
+				climateZoneRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO CLIMATE_ZONE_READ
+				(UID, NAME, TYPE, FARM_UID, FARM_NAME, TEMPERATURE_MIN, TEMPERATURE_MAX, HUMIDITY_MIN, HUMIDITY_MAX,
+				CO2_MIN, CO2_MAX, LIGHT_MIN, LIGHT_MAX, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				climateZoneRead.UID.Bytes(),
+				climateZoneRead.Name,
+				climateZoneRead.Type.Code,
+				climateZoneRead.Farm.UID.Bytes(),
+				climateZoneRead.Farm.Name,
+				targets.Temperature.Min,
+				targets.Temperature.Max,
+				targets.Humidity.Min,
+				targets.Humidity.Max,
+				targets.CO2.Min,
+				targets.CO2.Max,
+				targets.Light.Min,
+				targets.Light.Max,
+				climateZoneRead.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
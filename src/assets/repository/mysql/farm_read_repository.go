@@ -28,21 +28,21 @@ func (f *FarmReadRepositoryMysql) Save(farmRead *storage.FarmRead) <-chan error
 		if count > 0 {
 			_, err := f.DB.Exec(`UPDATE FARM_READ SET
 				NAME = ?, LATITUDE = ?, LONGITUDE = ?, TYPE = ?, COUNTRY = ?, CITY = ?,
-				IS_ACTIVE = ?, CREATED_DATE = ?
+				IS_ACTIVE = ?, CREATED_DATE = ?, BATCH_ID_TEMPLATE = ?
 				WHERE UID = ?`,
 				farmRead.Name, farmRead.Latitude, farmRead.Longitude, farmRead.Type,
 				farmRead.Country, farmRead.City, farmRead.IsActive, farmRead.CreatedDate,
-				farmRead.UID.Bytes())
+				farmRead.BatchIDTemplate, farmRead.UID.Bytes())
 
 			if err != nil {
 				result <- err
 			}
 		} else {
 			_, err := f.DB.Exec(`INSERT INTO FARM_READ
-				(UID, NAME, LATITUDE, LONGITUDE, TYPE, COUNTRY, CITY, IS_ACTIVE, CREATED_DATE)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				(UID, NAME, LATITUDE, LONGITUDE, TYPE, COUNTRY, CITY, IS_ACTIVE, CREATED_DATE, BATCH_ID_TEMPLATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				farmRead.UID.Bytes(), farmRead.Name, farmRead.Latitude, farmRead.Longitude, farmRead.Type,
-				farmRead.Country, farmRead.City, farmRead.IsActive, farmRead.CreatedDate)
+				farmRead.Country, farmRead.City, farmRead.IsActive, farmRead.CreatedDate, farmRead.BatchIDTemplate)
 
 			if err != nil {
 				result <- err
@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type MaterialConsumptionRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewMaterialConsumptionRepositoryMysql(db *sql.DB) repository.MaterialConsumptionRepository {
+	return &MaterialConsumptionRepositoryMysql{DB: db}
+}
+
+func (f *MaterialConsumptionRepositoryMysql) Save(materialConsumption *storage.MaterialConsumptionRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO MATERIAL_READ_CONSUMPTION
+			(MATERIAL_UID, TASK_UID, QUANTITY, QUANTITY_UNIT, CONSUMED_DATE, CROP_UID, RESERVOIR_UID)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			materialConsumption.MaterialUID.Bytes(),
+			materialConsumption.TaskUID.Bytes(),
+			materialConsumption.Quantity,
+			materialConsumption.QuantityUnit,
+			materialConsumption.ConsumedDate,
+			materialConsumption.CropUID.Bytes(),
+			materialConsumption.ReservoirUID.Bytes())
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ReservoirMeasurementRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewReservoirMeasurementRepositoryMysql(db *sql.DB) repository.ReservoirMeasurementRepository {
+	return &ReservoirMeasurementRepositoryMysql{DB: db}
+}
+
+func (f *ReservoirMeasurementRepositoryMysql) Save(reservoirMeasurement *storage.ReservoirMeasurementRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_MEASUREMENT
+			(UID, RESERVOIR_UID, PH, EC, TEMPERATURE, DISSOLVED_OXYGEN, SOURCE, MEASURED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			reservoirMeasurement.UID.Bytes(),
+			reservoirMeasurement.ReservoirUID.Bytes(),
+			reservoirMeasurement.PH,
+			reservoirMeasurement.EC,
+			reservoirMeasurement.Temperature,
+			reservoirMeasurement.DissolvedOxygen,
+			reservoirMeasurement.Source,
+			reservoirMeasurement.MeasuredDate)
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
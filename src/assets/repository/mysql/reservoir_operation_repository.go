@@ -0,0 +1,45 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ReservoirOperationRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewReservoirOperationRepositoryMysql(db *sql.DB) repository.ReservoirOperationRepository {
+	return &ReservoirOperationRepositoryMysql{DB: db}
+}
+
+func (f *ReservoirOperationRepositoryMysql) Save(reservoirOperation *storage.ReservoirOperationRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_OPERATION
+			(UID, RESERVOIR_UID, TYPE, VOLUME, MATERIAL_UID, MATERIAL_NAME, AMOUNT, UNIT, VOLUME_AFTER, OPERATION_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			reservoirOperation.UID.Bytes(),
+			reservoirOperation.ReservoirUID.Bytes(),
+			reservoirOperation.Type,
+			reservoirOperation.Volume,
+			reservoirOperation.MaterialUID.Bytes(),
+			reservoirOperation.MaterialName,
+			reservoirOperation.Amount,
+			reservoirOperation.Unit,
+			reservoirOperation.VolumeAfter,
+			reservoirOperation.OperationDate)
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
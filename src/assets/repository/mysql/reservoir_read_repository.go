@@ -28,7 +28,7 @@ func (f *ReservoirReadRepositoryMysql) Save(reservoirRead *storage.ReservoirRead
 		if count > 0 {
 			_, err = f.DB.Exec(`UPDATE RESERVOIR_READ SET
 				NAME = ?, WATERSOURCE_TYPE = ?, WATERSOURCE_CAPACITY = ?, FARM_UID = ?,
-				FARM_NAME = ?, CREATED_DATE = ?
+				FARM_NAME = ?, CREATED_DATE = ?, VOLUME = ?
 				WHERE UID = ?`,
 				reservoirRead.Name,
 				reservoirRead.WaterSource.Type,
@@ -36,12 +36,30 @@ func (f *ReservoirReadRepositoryMysql) Save(reservoirRead *storage.ReservoirRead
 				reservoirRead.Farm.UID.Bytes(),
 				reservoirRead.Farm.Name,
 				reservoirRead.CreatedDate,
+				reservoirRead.Volume,
 				reservoirRead.UID.Bytes())
 
 			if err != nil {
 				result <- err
 			}
 
+			// A drained reservoir may have no nutrients left, so they are always replaced
+			_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_NUTRIENT WHERE RESERVOIR_UID = ?`, reservoirRead.UID.Bytes())
+			if err != nil {
+				result <- err
+			}
+
+			for _, v := range reservoirRead.Nutrients {
+				_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_NUTRIENT
+					(RESERVOIR_UID, MATERIAL_UID, MATERIAL_NAME, AMOUNT, UNIT, CONCENTRATION)
+					VALUES (?, ?, ?, ?, ?, ?)`,
+					reservoirRead.UID.Bytes(), v.MaterialUID.Bytes(), v.MaterialName, v.Amount, v.Unit, v.Concentration)
+
+				if err != nil {
+					result <- err
+				}
+			}
+
 			if len(reservoirRead.Notes) > 0 {
 				// Just delete them all then insert them all again.
 				// We can refactor it later.
@@ -62,15 +80,16 @@ func (f *ReservoirReadRepositoryMysql) Save(reservoirRead *storage.ReservoirRead
 
 		} else {
 			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ
-				(UID, NAME, WATERSOURCE_TYPE, WATERSOURCE_CAPACITY, FARM_UID, FARM_NAME, CREATED_DATE)
-				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				(UID, NAME, WATERSOURCE_TYPE, WATERSOURCE_CAPACITY, FARM_UID, FARM_NAME, CREATED_DATE, VOLUME)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 				reservoirRead.UID.Bytes(),
 				reservoirRead.Name,
 				reservoirRead.WaterSource.Type,
 				reservoirRead.WaterSource.Capacity,
 				reservoirRead.Farm.UID.Bytes(),
 				reservoirRead.Farm.Name,
-				reservoirRead.CreatedDate)
+				reservoirRead.CreatedDate,
+				reservoirRead.Volume)
 
 			if err != nil {
 				result <- err
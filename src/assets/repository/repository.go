@@ -47,6 +47,23 @@ func NewAreaFromHistory(events []storage.AreaEvent) *domain.Area {
 	return state
 }
 
+type ClimateZoneEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type ClimateZoneReadRepository interface {
+	Save(climateZoneRead *storage.ClimateZoneRead) <-chan error
+}
+
+func NewClimateZoneFromHistory(events []storage.ClimateZoneEvent) *domain.ClimateZone {
+	state := &domain.ClimateZone{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
+
 type ReservoirEventRepository interface {
 	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
 }
@@ -55,6 +72,14 @@ type ReservoirReadRepository interface {
 	Save(reservoirRead *storage.ReservoirRead) <-chan error
 }
 
+type ReservoirMeasurementRepository interface {
+	Save(reservoirMeasurement *storage.ReservoirMeasurementRead) <-chan error
+}
+
+type ReservoirOperationRepository interface {
+	Save(reservoirOperation *storage.ReservoirOperationRead) <-chan error
+}
+
 func NewReservoirFromHistory(events []storage.ReservoirEvent) *domain.Reservoir {
 	state := &domain.Reservoir{}
 	for _, v := range events {
@@ -89,3 +114,7 @@ func (w MaterialEventTypeWrapper) Code() string {
 type MaterialReadRepository interface {
 	Save(materialRead *storage.MaterialRead) <-chan error
 }
+
+type MaterialConsumptionRepository interface {
+	Save(materialConsumption *storage.MaterialConsumptionRead) <-chan error
+}
@@ -30,12 +30,14 @@ func (f *AreaReadRepositorySqlite) Save(areaRead *storage.AreaRead) <-chan error
 			_, err := f.DB.Exec(`UPDATE AREA_READ SET
 				NAME = ?, SIZE_UNIT = ?, SIZE = ?, TYPE = ?, LOCATION = ?,
 				PHOTO_FILENAME = ?, PHOTO_MIMETYPE = ?, PHOTO_SIZE = ?, PHOTO_WIDTH = ?, PHOTO_HEIGHT = ?,
-				CREATED_DATE = ?, FARM_UID = ?, FARM_NAME = ?, RESERVOIR_UID = ?, RESERVOIR_NAME = ?
+				CREATED_DATE = ?, FARM_UID = ?, FARM_NAME = ?, RESERVOIR_UID = ?, RESERVOIR_NAME = ?,
+				CLIMATE_ZONE_UID = ?, CLIMATE_ZONE_NAME = ?
 				WHERE UID = ?`,
 				areaRead.Name, areaRead.Size.Unit.Symbol, areaRead.Size.Value, areaRead.Type,
 				areaRead.Location.Code, areaRead.Photo.Filename, areaRead.Photo.MimeType,
 				areaRead.Photo.Size, areaRead.Photo.Width, areaRead.Photo.Height, areaRead.CreatedDate.Format(time.RFC3339),
-				areaRead.Farm.UID, areaRead.Farm.Name, areaRead.Reservoir.UID, areaRead.Reservoir.Name, areaRead.UID)
+				areaRead.Farm.UID, areaRead.Farm.Name, areaRead.Reservoir.UID, areaRead.Reservoir.Name,
+				areaRead.ClimateZone.UID, areaRead.ClimateZone.Name, areaRead.UID)
 
 			if err != nil {
 				result <- err
@@ -61,12 +63,14 @@ func (f *AreaReadRepositorySqlite) Save(areaRead *storage.AreaRead) <-chan error
 		} else {
 			_, err := f.DB.Exec(`INSERT INTO AREA_READ
 				(UID, NAME, SIZE_UNIT, SIZE, TYPE, LOCATION, PHOTO_FILENAME, PHOTO_MIMETYPE,
-				PHOTO_SIZE, PHOTO_WIDTH, PHOTO_HEIGHT, CREATED_DATE, FARM_UID, FARM_NAME, RESERVOIR_UID, RESERVOIR_NAME)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				PHOTO_SIZE, PHOTO_WIDTH, PHOTO_HEIGHT, CREATED_DATE, FARM_UID, FARM_NAME, RESERVOIR_UID, RESERVOIR_NAME,
+				CLIMATE_ZONE_UID, CLIMATE_ZONE_NAME)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				areaRead.UID, areaRead.Name, areaRead.Size.Unit.Symbol, areaRead.Size.Value, areaRead.Type,
 				areaRead.Location.Code, areaRead.Photo.Filename, areaRead.Photo.MimeType,
 				areaRead.Photo.Size, areaRead.Photo.Width, areaRead.Photo.Height, areaRead.CreatedDate.Format(time.RFC3339),
-				areaRead.Farm.UID, areaRead.Farm.Name, areaRead.Reservoir.UID, areaRead.Reservoir.Name)
+				areaRead.Farm.UID, areaRead.Farm.Name, areaRead.Reservoir.UID, areaRead.Reservoir.Name,
+				areaRead.ClimateZone.UID, areaRead.ClimateZone.Name)
 
 			if err != nil {
 				result <- err
@@ -0,0 +1,43 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type MaterialConsumptionRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewMaterialConsumptionRepositorySqlite(db *sql.DB) repository.MaterialConsumptionRepository {
+	return &MaterialConsumptionRepositorySqlite{DB: db}
+}
+
+func (f *MaterialConsumptionRepositorySqlite) Save(materialConsumption *storage.MaterialConsumptionRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := f.DB.Exec(`INSERT INTO MATERIAL_READ_CONSUMPTION
+			(MATERIAL_UID, TASK_UID, QUANTITY, QUANTITY_UNIT, CONSUMED_DATE, CROP_UID, RESERVOIR_UID)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			materialConsumption.MaterialUID,
+			materialConsumption.TaskUID,
+			materialConsumption.Quantity,
+			materialConsumption.QuantityUnit,
+			materialConsumption.ConsumedDate.Format(time.RFC3339),
+			materialConsumption.CropUID,
+			materialConsumption.ReservoirUID)
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -28,6 +28,7 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 		}
 
 		var typeData string
+		var preHarvestInterval, reEntryInterval int
 		switch t := materialRead.Type.(type) {
 		case domain.MaterialTypeSeed:
 			typeData = t.PlantType.Code
@@ -35,10 +36,26 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 			typeData = t.PlantType.Code
 		case domain.MaterialTypeAgrochemical:
 			typeData = t.ChemicalType.Code
+			preHarvestInterval = t.PreHarvestInterval
+			reEntryInterval = t.ReEntryInterval
 		case domain.MaterialTypeSeedingContainer:
 			typeData = t.ContainerType.Code
 		}
 
+		var daysToGermination, daysToTransplant, daysToFirstHarvest, daysToEndOfHarvest *int
+		if materialRead.CropPlan != nil {
+			daysToGermination = &materialRead.CropPlan.DaysToGermination
+			daysToTransplant = &materialRead.CropPlan.DaysToTransplant
+			daysToFirstHarvest = &materialRead.CropPlan.DaysToFirstHarvest
+			daysToEndOfHarvest = &materialRead.CropPlan.DaysToEndOfHarvest
+		}
+
+		var baseTemperature, gddToFirstHarvest *float32
+		if materialRead.CropPlan != nil {
+			baseTemperature = &materialRead.CropPlan.BaseTemperature
+			gddToFirstHarvest = &materialRead.CropPlan.GDDToFirstHarvest
+		}
+
 		expirationDate := ""
 		if materialRead.ExpirationDate != nil {
 			expirationDate = materialRead.ExpirationDate.Format(time.RFC3339)
@@ -48,7 +65,10 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 			_, err = f.DB.Exec(`UPDATE MATERIAL_READ SET
 				NAME = ?, PRICE_PER_UNIT = ?, CURRENCY_CODE = ?, TYPE = ?, TYPE_DATA = ?,
 				QUANTITY = ?, QUANTITY_UNIT = ?, EXPIRATION_DATE = ?, NOTES = ?,
-				PRODUCED_BY = ?, CREATED_DATE = ?
+				PRODUCED_BY = ?, CREATED_DATE = ?, PRE_HARVEST_INTERVAL = ?, RE_ENTRY_INTERVAL = ?,
+				CROP_PLAN_DAYS_TO_GERMINATION = ?, CROP_PLAN_DAYS_TO_TRANSPLANT = ?,
+				CROP_PLAN_DAYS_TO_FIRST_HARVEST = ?, CROP_PLAN_DAYS_TO_END_OF_HARVEST = ?,
+				SEEDS_PER_UNIT = ?, CROP_PLAN_BASE_TEMPERATURE = ?, CROP_PLAN_GDD_TO_FIRST_HARVEST = ?
 				WHERE UID = ?`,
 				materialRead.Name,
 				materialRead.PricePerUnit.Amount,
@@ -61,6 +81,15 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 				materialRead.Notes,
 				materialRead.ProducedBy,
 				materialRead.CreatedDate.Format(time.RFC3339),
+				preHarvestInterval,
+				reEntryInterval,
+				daysToGermination,
+				daysToTransplant,
+				daysToFirstHarvest,
+				daysToEndOfHarvest,
+				materialRead.SeedsPerUnit,
+				baseTemperature,
+				gddToFirstHarvest,
 				materialRead.UID)
 
 			if err != nil {
@@ -70,8 +99,12 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 		} else {
 			_, err = f.DB.Exec(`INSERT INTO MATERIAL_READ
 				(UID, NAME, PRICE_PER_UNIT, CURRENCY_CODE, TYPE, TYPE_DATA, QUANTITY,
-				QUANTITY_UNIT, EXPIRATION_DATE, NOTES, PRODUCED_BY, CREATED_DATE)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				QUANTITY_UNIT, EXPIRATION_DATE, NOTES, PRODUCED_BY, CREATED_DATE,
+				PRE_HARVEST_INTERVAL, RE_ENTRY_INTERVAL,
+				CROP_PLAN_DAYS_TO_GERMINATION, CROP_PLAN_DAYS_TO_TRANSPLANT,
+				CROP_PLAN_DAYS_TO_FIRST_HARVEST, CROP_PLAN_DAYS_TO_END_OF_HARVEST,
+				SEEDS_PER_UNIT, CROP_PLAN_BASE_TEMPERATURE, CROP_PLAN_GDD_TO_FIRST_HARVEST)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				materialRead.UID,
 				materialRead.Name,
 				materialRead.PricePerUnit.Amount,
@@ -83,7 +116,16 @@ func (f *MaterialReadRepositorySqlite) Save(materialRead *storage.MaterialRead)
 				expirationDate,
 				materialRead.Notes,
 				materialRead.ProducedBy,
-				materialRead.CreatedDate.Format(time.RFC3339))
+				materialRead.CreatedDate.Format(time.RFC3339),
+				preHarvestInterval,
+				reEntryInterval,
+				daysToGermination,
+				daysToTransplant,
+				daysToFirstHarvest,
+				daysToEndOfHarvest,
+				materialRead.SeedsPerUnit,
+				baseTemperature,
+				gddToFirstHarvest)
 
 			if err != nil {
 				result <- err
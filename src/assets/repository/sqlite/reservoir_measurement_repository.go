@@ -0,0 +1,45 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ReservoirMeasurementRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewReservoirMeasurementRepositorySqlite(db *sql.DB) repository.ReservoirMeasurementRepository {
+	return &ReservoirMeasurementRepositorySqlite{DB: db}
+}
+
+func (f *ReservoirMeasurementRepositorySqlite) Save(reservoirMeasurement *storage.ReservoirMeasurementRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		// Dates are stored in UTC so the history can be filtered by comparing the strings
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_MEASUREMENT
+			(UID, RESERVOIR_UID, PH, EC, TEMPERATURE, DISSOLVED_OXYGEN, SOURCE, MEASURED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			reservoirMeasurement.UID,
+			reservoirMeasurement.ReservoirUID,
+			reservoirMeasurement.PH,
+			reservoirMeasurement.EC,
+			reservoirMeasurement.Temperature,
+			reservoirMeasurement.DissolvedOxygen,
+			reservoirMeasurement.Source,
+			reservoirMeasurement.MeasuredDate.UTC().Format(time.RFC3339))
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
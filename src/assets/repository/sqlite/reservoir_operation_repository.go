@@ -0,0 +1,47 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/assets/repository"
+	"github.com/Tanibox/tania-core/src/assets/storage"
+)
+
+type ReservoirOperationRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewReservoirOperationRepositorySqlite(db *sql.DB) repository.ReservoirOperationRepository {
+	return &ReservoirOperationRepositorySqlite{DB: db}
+}
+
+func (f *ReservoirOperationRepositorySqlite) Save(reservoirOperation *storage.ReservoirOperationRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		// Dates are stored in UTC so the log can be filtered by comparing the strings
+		_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_OPERATION
+			(UID, RESERVOIR_UID, TYPE, VOLUME, MATERIAL_UID, MATERIAL_NAME, AMOUNT, UNIT, VOLUME_AFTER, OPERATION_DATE)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			reservoirOperation.UID,
+			reservoirOperation.ReservoirUID,
+			reservoirOperation.Type,
+			reservoirOperation.Volume,
+			reservoirOperation.MaterialUID,
+			reservoirOperation.MaterialName,
+			reservoirOperation.Amount,
+			reservoirOperation.Unit,
+			reservoirOperation.VolumeAfter,
+			reservoirOperation.OperationDate.UTC().Format(time.RFC3339))
+
+		if err != nil {
+			result <- err
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -29,7 +29,7 @@ func (f *ReservoirReadRepositorySqlite) Save(reservoirRead *storage.ReservoirRea
 		if count > 0 {
 			_, err = f.DB.Exec(`UPDATE RESERVOIR_READ SET
 				NAME = ?, WATERSOURCE_TYPE = ?, WATERSOURCE_CAPACITY = ?, FARM_UID = ?,
-				FARM_NAME = ?, CREATED_DATE = ?
+				FARM_NAME = ?, CREATED_DATE = ?, VOLUME = ?
 				WHERE UID = ?`,
 				reservoirRead.Name,
 				reservoirRead.WaterSource.Type,
@@ -37,12 +37,30 @@ func (f *ReservoirReadRepositorySqlite) Save(reservoirRead *storage.ReservoirRea
 				reservoirRead.Farm.UID,
 				reservoirRead.Farm.Name,
 				reservoirRead.CreatedDate.Format(time.RFC3339),
+				reservoirRead.Volume,
 				reservoirRead.UID)
 
 			if err != nil {
 				result <- err
 			}
 
+			// A drained reservoir may have no nutrients left, so they are always replaced
+			_, err = f.DB.Exec(`DELETE FROM RESERVOIR_READ_NUTRIENT WHERE RESERVOIR_UID = ?`, reservoirRead.UID)
+			if err != nil {
+				result <- err
+			}
+
+			for _, v := range reservoirRead.Nutrients {
+				_, err := f.DB.Exec(`INSERT INTO RESERVOIR_READ_NUTRIENT
+					(RESERVOIR_UID, MATERIAL_UID, MATERIAL_NAME, AMOUNT, UNIT, CONCENTRATION)
+					VALUES (?, ?, ?, ?, ?, ?)`,
+					reservoirRead.UID, v.MaterialUID, v.MaterialName, v.Amount, v.Unit, v.Concentration)
+
+				if err != nil {
+					result <- err
+				}
+			}
+
 			if len(reservoirRead.Notes) > 0 {
 				// Just delete them all then insert them all again.
 				// We can refactor it later.
@@ -63,15 +81,16 @@ func (f *ReservoirReadRepositorySqlite) Save(reservoirRead *storage.ReservoirRea
 
 		} else {
 			_, err = f.DB.Exec(`INSERT INTO RESERVOIR_READ
-				(UID, NAME, WATERSOURCE_TYPE, WATERSOURCE_CAPACITY, FARM_UID, FARM_NAME, CREATED_DATE)
-				VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				(UID, NAME, WATERSOURCE_TYPE, WATERSOURCE_CAPACITY, FARM_UID, FARM_NAME, CREATED_DATE, VOLUME)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
 				reservoirRead.UID,
 				reservoirRead.Name,
 				reservoirRead.WaterSource.Type,
 				reservoirRead.WaterSource.Capacity,
 				reservoirRead.Farm.UID,
 				reservoirRead.Farm.Name,
-				reservoirRead.CreatedDate.Format(time.RFC3339))
+				reservoirRead.CreatedDate.Format(time.RFC3339),
+				reservoirRead.Volume)
 
 			if err != nil {
 				result <- err
@@ -19,39 +19,55 @@ import (
 	repoMysql "github.com/Tanibox/tania-core/src/assets/repository/mysql"
 	repoSqlite "github.com/Tanibox/tania-core/src/assets/repository/sqlite"
 	"github.com/Tanibox/tania-core/src/assets/storage"
+	devicedomain "github.com/Tanibox/tania-core/src/devices/domain"
+	devicestorage "github.com/Tanibox/tania-core/src/devices/storage"
 	"github.com/Tanibox/tania-core/src/eventbus"
 	growthstorage "github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/filehelper"
 	"github.com/Tanibox/tania-core/src/helper/imagehelper"
 	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
 	"github.com/Tanibox/tania-core/src/helper/stringhelper"
 	"github.com/Tanibox/tania-core/src/helper/structhelper"
 	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
 	uuid "github.com/satori/go.uuid"
 )
 
 // FarmServer ties the routes and handlers with injected dependencies
 type FarmServer struct {
-	FarmEventRepo       repository.FarmEventRepository
-	FarmEventQuery      query.FarmEventQuery
-	FarmReadRepo        repository.FarmReadRepository
-	FarmReadQuery       query.FarmReadQuery
-	ReservoirEventRepo  repository.ReservoirEventRepository
-	ReservoirEventQuery query.ReservoirEventQuery
-	ReservoirReadRepo   repository.ReservoirReadRepository
-	ReservoirReadQuery  query.ReservoirReadQuery
-	ReservoirService    domain.ReservoirService
-	AreaEventRepo       repository.AreaEventRepository
-	AreaReadRepo        repository.AreaReadRepository
-	AreaEventQuery      query.AreaEventQuery
-	AreaReadQuery       query.AreaReadQuery
-	AreaService         domain.AreaService
-	MaterialEventRepo   repository.MaterialEventRepository
-	MaterialEventQuery  query.MaterialEventQuery
-	MaterialReadRepo    repository.MaterialReadRepository
-	MaterialReadQuery   query.MaterialReadQuery
-	CropReadQuery       query.CropReadQuery
-	File                File
-	EventBus            eventbus.TaniaEventBus
+	FarmEventRepo             repository.FarmEventRepository
+	FarmEventQuery            query.FarmEventQuery
+	FarmReadRepo              repository.FarmReadRepository
+	FarmReadQuery             query.FarmReadQuery
+	ReservoirEventRepo        repository.ReservoirEventRepository
+	ReservoirEventQuery       query.ReservoirEventQuery
+	ReservoirReadRepo         repository.ReservoirReadRepository
+	ReservoirReadQuery        query.ReservoirReadQuery
+	ReservoirMeasurementRepo  repository.ReservoirMeasurementRepository
+	ReservoirMeasurementQuery query.ReservoirMeasurementQuery
+	ReservoirOperationRepo    repository.ReservoirOperationRepository
+	ReservoirOperationQuery   query.ReservoirOperationQuery
+	ReservoirService          domain.ReservoirService
+	AreaEventRepo             repository.AreaEventRepository
+	AreaReadRepo              repository.AreaReadRepository
+	AreaEventQuery            query.AreaEventQuery
+	AreaReadQuery             query.AreaReadQuery
+	AreaService               domain.AreaService
+	ClimateZoneEventRepo      repository.ClimateZoneEventRepository
+	ClimateZoneEventQuery     query.ClimateZoneEventQuery
+	ClimateZoneReadRepo       repository.ClimateZoneReadRepository
+	ClimateZoneReadQuery      query.ClimateZoneReadQuery
+	ClimateZoneService        domain.ClimateZoneService
+	ClimateReadingQuery       query.ClimateReadingQuery
+	MaterialEventRepo         repository.MaterialEventRepository
+	MaterialEventQuery        query.MaterialEventQuery
+	MaterialReadRepo          repository.MaterialReadRepository
+	MaterialReadQuery         query.MaterialReadQuery
+	MaterialConsumptionRepo   repository.MaterialConsumptionRepository
+	MaterialConsumptionQuery  query.MaterialConsumptionQuery
+	CropReadQuery             query.CropReadQuery
+	File                      filehelper.File
+	EventBus                  eventbus.TaniaEventBus
 }
 
 // NewFarmServer initializes FarmServer's dependencies and create new FarmServer struct
@@ -61,15 +77,21 @@ func NewFarmServer(
 	farmReadStorage *storage.FarmReadStorage,
 	areaEventStorage *storage.AreaEventStorage,
 	areaReadStorage *storage.AreaReadStorage,
+	climateZoneEventStorage *storage.ClimateZoneEventStorage,
+	climateZoneReadStorage *storage.ClimateZoneReadStorage,
 	reservoirEventStorage *storage.ReservoirEventStorage,
 	reservoirReadStorage *storage.ReservoirReadStorage,
+	reservoirMeasurementStorage *storage.ReservoirMeasurementStorage,
+	reservoirOperationStorage *storage.ReservoirOperationStorage,
 	materialEventStorage *storage.MaterialEventStorage,
 	materialReadStorage *storage.MaterialReadStorage,
+	materialConsumptionStorage *storage.MaterialConsumptionStorage,
 	cropReadStorage *growthstorage.CropReadStorage,
+	deviceReadingStorage *devicestorage.DeviceReadingStorage,
 	eventBus eventbus.TaniaEventBus,
 ) (*FarmServer, error) {
 	farmServer := &FarmServer{
-		File:     LocalFile{},
+		File:     filehelper.LocalFile{},
 		EventBus: eventBus,
 	}
 
@@ -85,28 +107,44 @@ func NewFarmServer(
 		farmServer.AreaReadRepo = repoInMem.NewAreaReadRepositoryInMemory(areaReadStorage)
 		farmServer.AreaReadQuery = queryInMem.NewAreaReadQueryInMemory(areaReadStorage)
 
+		farmServer.ClimateZoneEventRepo = repoInMem.NewClimateZoneEventRepositoryInMemory(climateZoneEventStorage)
+		farmServer.ClimateZoneEventQuery = queryInMem.NewClimateZoneEventQueryInMemory(climateZoneEventStorage)
+		farmServer.ClimateZoneReadRepo = repoInMem.NewClimateZoneReadRepositoryInMemory(climateZoneReadStorage)
+		farmServer.ClimateZoneReadQuery = queryInMem.NewClimateZoneReadQueryInMemory(climateZoneReadStorage)
+		farmServer.ClimateReadingQuery = queryInMem.NewClimateReadingQueryInMemory(deviceReadingStorage)
+
 		farmServer.ReservoirEventRepo = repoInMem.NewReservoirEventRepositoryInMemory(reservoirEventStorage)
 		farmServer.ReservoirEventQuery = queryInMem.NewReservoirEventQueryInMemory(reservoirEventStorage)
 		farmServer.ReservoirReadRepo = repoInMem.NewReservoirReadRepositoryInMemory(reservoirReadStorage)
 		farmServer.ReservoirReadQuery = queryInMem.NewReservoirReadQueryInMemory(reservoirReadStorage)
+		farmServer.ReservoirMeasurementRepo = repoInMem.NewReservoirMeasurementRepositoryInMemory(reservoirMeasurementStorage)
+		farmServer.ReservoirMeasurementQuery = queryInMem.NewReservoirMeasurementQueryInMemory(reservoirMeasurementStorage)
+		farmServer.ReservoirOperationRepo = repoInMem.NewReservoirOperationRepositoryInMemory(reservoirOperationStorage)
+		farmServer.ReservoirOperationQuery = queryInMem.NewReservoirOperationQueryInMemory(reservoirOperationStorage)
 
 		farmServer.MaterialEventRepo = repoInMem.NewMaterialEventRepositoryInMemory(materialEventStorage)
 		farmServer.MaterialEventQuery = queryInMem.NewMaterialEventQueryInMemory(materialEventStorage)
 		farmServer.MaterialReadRepo = repoInMem.NewMaterialReadRepositoryInMemory(materialReadStorage)
 		farmServer.MaterialReadQuery = queryInMem.NewMaterialReadQueryInMemory(materialReadStorage)
+		farmServer.MaterialConsumptionRepo = repoInMem.NewMaterialConsumptionRepositoryInMemory(materialConsumptionStorage)
+		farmServer.MaterialConsumptionQuery = queryInMem.NewMaterialConsumptionQueryInMemory(materialConsumptionStorage)
 
 		farmServer.CropReadQuery = queryInMem.NewCropReadQueryInMemory(cropReadStorage)
 
 		// TODO: AreaServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.AreaService = service.AreaServiceInMemory{
-			FarmReadQuery:      farmServer.FarmReadQuery,
-			ReservoirReadQuery: farmServer.ReservoirReadQuery,
-			CropReadQuery:      farmServer.CropReadQuery,
+			FarmReadQuery:        farmServer.FarmReadQuery,
+			ReservoirReadQuery:   farmServer.ReservoirReadQuery,
+			CropReadQuery:        farmServer.CropReadQuery,
+			ClimateZoneReadQuery: farmServer.ClimateZoneReadQuery,
 		}
 		// TODO: ReservoirServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.ReservoirService = service.ReservoirServiceInMemory{
 			FarmReadQuery: farmServer.FarmReadQuery,
 		}
+		farmServer.ClimateZoneService = service.ClimateZoneServiceInMemory{
+			FarmReadQuery: farmServer.FarmReadQuery,
+		}
 
 	case config.DB_SQLITE:
 		farmServer.FarmEventRepo = repoSqlite.NewFarmEventRepositorySqlite(db)
@@ -119,28 +157,44 @@ func NewFarmServer(
 		farmServer.AreaReadRepo = repoSqlite.NewAreaReadRepositorySqlite(db)
 		farmServer.AreaReadQuery = querySqlite.NewAreaReadQuerySqlite(db)
 
+		farmServer.ClimateZoneEventRepo = repoSqlite.NewClimateZoneEventRepositorySqlite(db)
+		farmServer.ClimateZoneEventQuery = querySqlite.NewClimateZoneEventQuerySqlite(db)
+		farmServer.ClimateZoneReadRepo = repoSqlite.NewClimateZoneReadRepositorySqlite(db)
+		farmServer.ClimateZoneReadQuery = querySqlite.NewClimateZoneReadQuerySqlite(db)
+		farmServer.ClimateReadingQuery = querySqlite.NewClimateReadingQuerySqlite(db)
+
 		farmServer.ReservoirEventRepo = repoSqlite.NewReservoirEventRepositorySqlite(db)
 		farmServer.ReservoirEventQuery = querySqlite.NewReservoirEventQuerySqlite(db)
 		farmServer.ReservoirReadRepo = repoSqlite.NewReservoirReadRepositorySqlite(db)
 		farmServer.ReservoirReadQuery = querySqlite.NewReservoirReadQuerySqlite(db)
+		farmServer.ReservoirMeasurementRepo = repoSqlite.NewReservoirMeasurementRepositorySqlite(db)
+		farmServer.ReservoirMeasurementQuery = querySqlite.NewReservoirMeasurementQuerySqlite(db)
+		farmServer.ReservoirOperationRepo = repoSqlite.NewReservoirOperationRepositorySqlite(db)
+		farmServer.ReservoirOperationQuery = querySqlite.NewReservoirOperationQuerySqlite(db)
 
 		farmServer.MaterialEventRepo = repoSqlite.NewMaterialEventRepositorySqlite(db)
 		farmServer.MaterialEventQuery = querySqlite.NewMaterialEventQuerySqlite(db)
 		farmServer.MaterialReadRepo = repoSqlite.NewMaterialReadRepositorySqlite(db)
 		farmServer.MaterialReadQuery = querySqlite.NewMaterialReadQuerySqlite(db)
+		farmServer.MaterialConsumptionRepo = repoSqlite.NewMaterialConsumptionRepositorySqlite(db)
+		farmServer.MaterialConsumptionQuery = querySqlite.NewMaterialConsumptionQuerySqlite(db)
 
 		farmServer.CropReadQuery = querySqlite.NewCropReadQuerySqlite(db)
 
 		// TODO: AreaServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.AreaService = service.AreaServiceInMemory{
-			FarmReadQuery:      farmServer.FarmReadQuery,
-			ReservoirReadQuery: farmServer.ReservoirReadQuery,
-			CropReadQuery:      farmServer.CropReadQuery,
+			FarmReadQuery:        farmServer.FarmReadQuery,
+			ReservoirReadQuery:   farmServer.ReservoirReadQuery,
+			CropReadQuery:        farmServer.CropReadQuery,
+			ClimateZoneReadQuery: farmServer.ClimateZoneReadQuery,
 		}
 		// TODO: ReservoirServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.ReservoirService = service.ReservoirServiceInMemory{
 			FarmReadQuery: farmServer.FarmReadQuery,
 		}
+		farmServer.ClimateZoneService = service.ClimateZoneServiceInMemory{
+			FarmReadQuery: farmServer.FarmReadQuery,
+		}
 
 	case config.DB_MYSQL:
 		farmServer.FarmEventRepo = repoMysql.NewFarmEventRepositoryMysql(db)
@@ -153,28 +207,44 @@ func NewFarmServer(
 		farmServer.AreaReadRepo = repoMysql.NewAreaReadRepositoryMysql(db)
 		farmServer.AreaReadQuery = queryMysql.NewAreaReadQueryMysql(db)
 
+		farmServer.ClimateZoneEventRepo = repoMysql.NewClimateZoneEventRepositoryMysql(db)
+		farmServer.ClimateZoneEventQuery = queryMysql.NewClimateZoneEventQueryMysql(db)
+		farmServer.ClimateZoneReadRepo = repoMysql.NewClimateZoneReadRepositoryMysql(db)
+		farmServer.ClimateZoneReadQuery = queryMysql.NewClimateZoneReadQueryMysql(db)
+		farmServer.ClimateReadingQuery = queryMysql.NewClimateReadingQueryMysql(db)
+
 		farmServer.ReservoirEventRepo = repoMysql.NewReservoirEventRepositoryMysql(db)
 		farmServer.ReservoirEventQuery = queryMysql.NewReservoirEventQueryMysql(db)
 		farmServer.ReservoirReadRepo = repoMysql.NewReservoirReadRepositoryMysql(db)
 		farmServer.ReservoirReadQuery = queryMysql.NewReservoirReadQueryMysql(db)
+		farmServer.ReservoirMeasurementRepo = repoMysql.NewReservoirMeasurementRepositoryMysql(db)
+		farmServer.ReservoirMeasurementQuery = queryMysql.NewReservoirMeasurementQueryMysql(db)
+		farmServer.ReservoirOperationRepo = repoMysql.NewReservoirOperationRepositoryMysql(db)
+		farmServer.ReservoirOperationQuery = queryMysql.NewReservoirOperationQueryMysql(db)
 
 		farmServer.MaterialEventRepo = repoMysql.NewMaterialEventRepositoryMysql(db)
 		farmServer.MaterialEventQuery = queryMysql.NewMaterialEventQueryMysql(db)
 		farmServer.MaterialReadRepo = repoMysql.NewMaterialReadRepositoryMysql(db)
 		farmServer.MaterialReadQuery = queryMysql.NewMaterialReadQueryMysql(db)
+		farmServer.MaterialConsumptionRepo = repoMysql.NewMaterialConsumptionRepositoryMysql(db)
+		farmServer.MaterialConsumptionQuery = queryMysql.NewMaterialConsumptionQueryMysql(db)
 
 		farmServer.CropReadQuery = queryMysql.NewCropReadQueryMysql(db)
 
 		// TODO: AreaServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.AreaService = service.AreaServiceInMemory{
-			FarmReadQuery:      farmServer.FarmReadQuery,
-			ReservoirReadQuery: farmServer.ReservoirReadQuery,
-			CropReadQuery:      farmServer.CropReadQuery,
+			FarmReadQuery:        farmServer.FarmReadQuery,
+			ReservoirReadQuery:   farmServer.ReservoirReadQuery,
+			CropReadQuery:        farmServer.CropReadQuery,
+			ClimateZoneReadQuery: farmServer.ClimateZoneReadQuery,
 		}
 		// TODO: ReservoirServiceInMemory should be renamed. It doesn't need InMemory name
 		farmServer.ReservoirService = service.ReservoirServiceInMemory{
 			FarmReadQuery: farmServer.FarmReadQuery,
 		}
+		farmServer.ClimateZoneService = service.ClimateZoneServiceInMemory{
+			FarmReadQuery: farmServer.FarmReadQuery,
+		}
 	}
 
 	farmServer.InitSubscriber()
@@ -189,12 +259,18 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("FarmTypeChanged", s.SaveToFarmReadModel)
 	s.EventBus.Subscribe("FarmGeolocationChanged", s.SaveToFarmReadModel)
 	s.EventBus.Subscribe("FarmRegionChanged", s.SaveToFarmReadModel)
+	s.EventBus.Subscribe("FarmBatchIDTemplateChanged", s.SaveToFarmReadModel)
 
 	s.EventBus.Subscribe("ReservoirCreated", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirNameChanged", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirWaterSourceChanged", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirNoteAdded", s.SaveToReservoirReadModel)
 	s.EventBus.Subscribe("ReservoirNoteRemoved", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirMeasured", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirMeasured", s.SaveToReservoirMeasurementReadModel)
+	s.EventBus.Subscribe("ReservoirRefilled", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirDrained", s.SaveToReservoirReadModel)
+	s.EventBus.Subscribe("ReservoirNutrientAdded", s.SaveToReservoirReadModel)
 
 	s.EventBus.Subscribe("AreaCreated", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaNameChanged", s.SaveToAreaReadModel)
@@ -205,6 +281,12 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("AreaPhotoAdded", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaNoteAdded", s.SaveToAreaReadModel)
 	s.EventBus.Subscribe("AreaNoteRemoved", s.SaveToAreaReadModel)
+	s.EventBus.Subscribe("AreaClimateZoneChanged", s.SaveToAreaReadModel)
+
+	s.EventBus.Subscribe("ClimateZoneCreated", s.SaveToClimateZoneReadModel)
+	s.EventBus.Subscribe("ClimateZoneNameChanged", s.SaveToClimateZoneReadModel)
+	s.EventBus.Subscribe("ClimateZoneTypeChanged", s.SaveToClimateZoneReadModel)
+	s.EventBus.Subscribe("ClimateZoneTargetsChanged", s.SaveToClimateZoneReadModel)
 
 	s.EventBus.Subscribe("MaterialCreated", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialNameChanged", s.SaveToMaterialReadModel)
@@ -214,6 +296,12 @@ func (s *FarmServer) InitSubscriber() {
 	s.EventBus.Subscribe("MaterialExpirationDateChanged", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialNotesChanged", s.SaveToMaterialReadModel)
 	s.EventBus.Subscribe("MaterialProducedByChanged", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialConsumed", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialCropPlanChanged", s.SaveToMaterialReadModel)
+	s.EventBus.Subscribe("MaterialSeedsPerUnitChanged", s.SaveToMaterialReadModel)
+
+	s.EventBus.SubscribeAsync("TaskCompleted", s.ConsumeTaskMaterial)
+	s.EventBus.SubscribeAsync("CropBatchCreated", s.ConsumeCropSeed)
 
 }
 
@@ -227,6 +315,9 @@ func (s *FarmServer) Mount(g *echo.Group) {
 	g.POST("/inventories/materials/:type", s.SaveMaterial)
 	g.PUT("/inventories/materials/:type/:id", s.UpdateMaterial)
 	g.GET("/inventories/materials/:id", s.GetMaterialByID)
+	g.GET("/inventories/materials/:id/consumptions", s.GetMaterialConsumptions)
+	g.PUT("/inventories/materials/:id/crop_plan", s.UpdateMaterialCropPlan)
+	g.PUT("/inventories/materials/:id/seeds_per_unit", s.UpdateMaterialSeedsPerUnit)
 
 	g.POST("", s.SaveFarm)
 	g.PUT("/:id", s.UpdateFarm)
@@ -237,6 +328,12 @@ func (s *FarmServer) Mount(g *echo.Group) {
 	g.PUT("/reservoirs/:id", s.UpdateReservoir)
 	g.POST("/reservoirs/:id/notes", s.SaveReservoirNotes)
 	g.DELETE("/reservoirs/:reservoir_id/notes/:note_id", s.RemoveReservoirNotes)
+	g.POST("/reservoirs/:id/measurements", s.SaveReservoirMeasurement)
+	g.GET("/reservoirs/:id/measurements", s.GetReservoirMeasurements)
+	g.POST("/reservoirs/:id/refills", s.RefillReservoir)
+	g.POST("/reservoirs/:id/drains", s.DrainReservoir)
+	g.POST("/reservoirs/:id/nutrients", s.AddReservoirNutrient)
+	g.GET("/reservoirs/:id/operations", s.GetReservoirOperations)
 	g.GET("/:id/reservoirs", s.GetFarmReservoirs)
 	g.GET("/:farm_id/reservoirs/:reservoir_id", s.GetReservoirsByID)
 
@@ -244,10 +341,20 @@ func (s *FarmServer) Mount(g *echo.Group) {
 	g.PUT("/areas/:id", s.UpdateArea)
 	g.POST("/areas/:id/notes", s.SaveAreaNotes)
 	g.DELETE("/areas/:area_id/notes/:note_id", s.RemoveAreaNotes)
+	g.GET("/areas/:id/reservoir_operations", s.GetAreaReservoirOperations)
 	g.GET("/:id/areas/total", s.GetTotalAreas)
 	g.GET("/:id/areas", s.GetFarmAreas)
 	g.GET("/:farm_id/areas/:area_id", s.GetAreasByID)
 	g.GET("/:farm_id/areas/:area_id/photos", s.GetAreaPhotos)
+	g.PUT("/areas/:id/climate_zone", s.UpdateAreaClimateZone)
+
+	g.GET("/climate_zones/types", s.GetClimateZoneTypes)
+	g.POST("/:id/climate_zones", s.SaveClimateZone)
+	g.GET("/:id/climate_zones", s.GetFarmClimateZones)
+	g.GET("/climate_zones/:id", s.GetClimateZoneByID)
+	g.PUT("/climate_zones/:id", s.UpdateClimateZone)
+	g.PUT("/climate_zones/:id/targets", s.UpdateClimateZoneTargets)
+	g.GET("/climate_zones/:id/dashboard", s.GetClimateZoneDashboard)
 }
 
 // GetTypes is a FarmServer's handle to get farm types
@@ -316,6 +423,7 @@ func (s *FarmServer) UpdateFarm(c echo.Context) error {
 	longitude := c.FormValue("longitude")
 	country := c.FormValue("country")
 	city := c.FormValue("city")
+	batchIDTemplate := c.FormValue("batch_id_template")
 
 	// Validate //
 	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
@@ -389,6 +497,13 @@ func (s *FarmServer) UpdateFarm(c echo.Context) error {
 		}
 	}
 
+	if batchIDTemplate != "" {
+		err = farm.ChangeBatchIDTemplate(batchIDTemplate)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
 	err = <-s.FarmEventRepo.Save(farm.UID, farm.Version, farm.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
@@ -696,222 +811,672 @@ func (s *FarmServer) RemoveReservoirNotes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *FarmServer) GetFarmReservoirs(c echo.Context) error {
-	farmUID, err := uuid.FromString(c.Param("id"))
+// SaveReservoirMeasurement records the water quality of the reservoir.
+// The measured date defaults to now, and the source to a manual measurement.
+func (s *FarmServer) SaveReservoirMeasurement(c echo.Context) error {
+	validation := RequestValidation{}
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.ReservoirReadQuery.FindAllByFarm(farmUID)
-	if result.Error != nil {
-		return Error(c, result.Error)
+	// Validate //
+	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
 	}
 
-	reservoirs, ok := result.Result.([]storage.ReservoirRead)
+	reservoirRead, ok := queryResult.Result.(storage.ReservoirRead)
 	if !ok {
-		return echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	data := make(map[string][]storage.ReservoirRead)
-	for _, v := range reservoirs {
-		r, err := MapToReservoirReadFromRead(s, v)
+	if reservoirRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	ph, err := validation.ValidateMeasurementValue(c.FormValue("ph"), "ph")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	ec, err := validation.ValidateMeasurementValue(c.FormValue("ec"), "ec")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	temperature, err := validation.ValidateMeasurementValue(c.FormValue("temperature"), "temperature")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	dissolvedOxygen, err := validation.ValidateMeasurementValue(c.FormValue("dissolved_oxygen"), "dissolved_oxygen")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	source := c.FormValue("source")
+	if source == "" {
+		source = domain.MeasurementSourceManual
+	}
+
+	measuredDate := time.Now()
+	if c.FormValue("measured_date") != "" {
+		measuredDate, err = time.Parse(time.RFC3339, c.FormValue("measured_date"))
 		if err != nil {
-			return Error(c, err)
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "measured_date"))
 		}
+	}
 
-		data["data"] = append(data["data"], r)
+	// Process //
+	eventQueryResult := <-s.ReservoirEventQuery.FindAllByID(reservoirRead.UID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
 	}
 
+	events := eventQueryResult.Result.([]storage.ReservoirEvent)
+	reservoir := repository.NewReservoirFromHistory(events)
+
+	err = reservoir.RecordMeasurement(ph, ec, temperature, dissolvedOxygen, source, measuredDate)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.ReservoirEventRepo.Save(reservoir.UID, reservoir.Version, reservoir.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(reservoir)
+
+	resRead, err := MapToReservoirRead(s, *reservoir)
+	if err != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string]storage.ReservoirRead)
+	data["data"] = resRead
+
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *FarmServer) GetReservoirsByID(c echo.Context) error {
-	reservoirUID, err := uuid.FromString(c.Param("reservoir_id"))
+// GetReservoirMeasurements returns the water quality log of the reservoir in chronological order,
+// between the from and to dates. It defaults to the last 30 days.
+func (s *FarmServer) GetReservoirMeasurements(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Validate //
-	result := <-s.ReservoirReadQuery.FindByID(reservoirUID)
-	if result.Error != nil {
-		return Error(c, result.Error)
+	to := time.Now()
+	if c.QueryParam("to") != "" {
+		to, err = time.Parse(time.RFC3339, c.QueryParam("to"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "to"))
+		}
 	}
 
-	reservoir, ok := result.Result.(storage.ReservoirRead)
+	from := to.AddDate(0, 0, -30)
+	if c.QueryParam("from") != "" {
+		from, err = time.Parse(time.RFC3339, c.QueryParam("from"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "from"))
+		}
+	}
+
+	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	reservoirRead, ok := queryResult.Result.(storage.ReservoirRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	if reservoir.UID == (uuid.UUID{}) {
-		return Error(c, NewRequestValidationError(REQUIRED, "reservoir_id"))
+	if reservoirRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	data := make(map[string]storage.ReservoirRead)
-	data["data"], err = MapToReservoirReadFromRead(s, reservoir)
-	if err != nil {
-		Error(c, err)
+	queryResult = <-s.ReservoirMeasurementQuery.FindAllByReservoirID(reservoirUID, from, to)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
 	}
 
+	measurements, ok := queryResult.Result.([]storage.ReservoirMeasurementRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.ReservoirMeasurementRead)
+	data["data"] = measurements
+
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *FarmServer) SaveArea(c echo.Context) error {
+// RefillReservoir adds water to the reservoir, in litres
+func (s *FarmServer) RefillReservoir(c echo.Context) error {
 	validation := RequestValidation{}
 
-	farmUID, err := uuid.FromString(c.Param("id"))
+	reservoirUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	reservoirUID, err := uuid.FromString(c.FormValue("reservoir_id"))
+	volume, err := validation.ValidateOperationAmount(c.FormValue("volume"), "volume")
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Validation //
-	reservoir, err := validation.ValidateReservoir(*s, reservoirUID)
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	farm, err := validation.ValidateFarm(*s, farmUID)
+	err = reservoir.Refill(volume)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	size, err := validation.ValidateAreaSize(c.FormValue("size"), c.FormValue("size_unit"))
+	return s.saveReservoir(c, reservoir)
+}
+
+// DrainReservoir takes water out of the reservoir, in litres
+func (s *FarmServer) DrainReservoir(c echo.Context) error {
+	validation := RequestValidation{}
+
+	reservoirUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	location, err := validation.ValidateAreaLocation(c.FormValue("location"))
+	volume, err := validation.ValidateOperationAmount(c.FormValue("volume"), "volume")
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Process //
-	area, err := domain.CreateArea(s.AreaService, farm.UID, reservoir.UID, c.FormValue("name"), c.FormValue("type"), size, location)
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	photo, err := c.FormFile("photo")
-	if err == nil {
-		destPath := stringhelper.Join(*config.Config.UploadPathArea, "/", photo.Filename)
-		err = s.File.Upload(photo, destPath)
+	err = reservoir.Drain(volume)
+	if err != nil {
+		return Error(c, err)
+	}
 
-		if err != nil {
-			return Error(c, err)
-		}
+	return s.saveReservoir(c, reservoir)
+}
 
-		width, height, err := imagehelper.GetImageDimension(destPath)
-		if err != nil {
-			return Error(c, err)
-		}
+// AddReservoirNutrient mixes an agrochemical material in the reservoir,
+// taking the amount out of the material stock.
+func (s *FarmServer) AddReservoirNutrient(c echo.Context) error {
+	validation := RequestValidation{}
 
-		areaPhoto := domain.AreaPhoto{
-			Filename: photo.Filename,
-			MimeType: photo.Header["Content-Type"][0],
-			Size:     int(photo.Size),
-			Width:    width,
-			Height:   height,
-		}
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
 
-		area.ChangePhoto(areaPhoto)
+	if c.FormValue("material_id") == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "material_id"))
 	}
 
-	// Persists //
-	err = <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+	materialUID, err := uuid.FromString(c.FormValue("material_id"))
 	if err != nil {
-		return Error(c, err)
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "material_id"))
 	}
 
-	// Publish //
-	s.publishUncommittedEvents(area)
-
-	data := make(map[string]DetailArea)
-	detailArea, err := MapToDetailArea(s, *area)
+	amount, err := validation.ValidateOperationAmount(c.FormValue("amount"), "amount")
 	if err != nil {
 		return Error(c, err)
 	}
 
-	data["data"] = detailArea
-
-	return c.JSON(http.StatusOK, data)
-}
-
-func (s *FarmServer) UpdateArea(c echo.Context) error {
-	validation := RequestValidation{}
-
-	areaUID, err := uuid.FromString(c.Param("id"))
+	reservoir, err := s.findReservoirFromHistory(reservoirUID)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	name := c.FormValue("name")
-	size := c.FormValue("size")
-	sizeUnit := c.FormValue("size_unit")
-	areaType := c.FormValue("type")
-	location := c.FormValue("location")
-	reservoirID := c.FormValue("reservoir_id")
-	photo, photoErr := c.FormFile("photo")
-
-	// Validate //
-	queryResult := <-s.AreaReadQuery.FindByID(areaUID)
-	if queryResult.Error != nil {
-		return Error(c, queryResult.Error)
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(materialUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
 	}
 
-	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
 	if !ok {
 		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	if areaRead.UID == (uuid.UUID{}) {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	if len(events) == 0 {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "material_id"))
 	}
 
-	if size != "" && sizeUnit == "" {
-		return Error(c, NewRequestValidationError(REQUIRED, "size_unit"))
+	material := repository.NewMaterialFromHistory(events)
+
+	// Process //
+	err = reservoir.AddNutrient(*material, amount)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	if sizeUnit != "" && size == "" {
-		return Error(c, NewRequestValidationError(REQUIRED, "size"))
+	err = material.ConsumeNutrient(amount, reservoir.UID)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// Process //
-	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaRead.UID)
-	if eventQueryResult.Error != nil {
-		return Error(c, eventQueryResult.Error)
+	// Persists //
+	// The reservoir is saved first, so a failure never leaves the stock consumed without the nutrient
+	err = <-s.ReservoirEventRepo.Save(reservoir.UID, reservoir.Version, reservoir.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	events := eventQueryResult.Result.([]storage.AreaEvent)
+	s.publishUncommittedEvents(reservoir)
 
-	area := repository.NewAreaFromHistory(events)
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		// The nutrient is already in the reservoir, so the request isn't failed to avoid adding it twice
+		log.Error("Nutrient added to reservoir ", reservoir.UID, " but not consumed from material ", material.UID, ": ", err)
+	} else {
+		s.publishUncommittedEvents(material)
+	}
 
-	if name != "" {
-		err := area.ChangeName(name)
-		if err != nil {
-			return Error(c, err)
-		}
+	resRead, err := MapToReservoirRead(s, *reservoir)
+	if err != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	if size != "" && sizeUnit != "" {
-		areaSize, err := validation.ValidateAreaSize(size, sizeUnit)
-		if err != nil {
-			return Error(c, err)
-		}
+	data := make(map[string]storage.ReservoirRead)
+	data["data"] = resRead
 
-		err = area.ChangeSize(areaSize)
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetReservoirOperations returns the refills, drains and nutrient additions of the reservoir
+// in chronological order, between the from and to dates. It defaults to the last 30 days.
+func (s *FarmServer) GetReservoirOperations(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	to := time.Now()
+	if c.QueryParam("to") != "" {
+		to, err = time.Parse(time.RFC3339, c.QueryParam("to"))
 		if err != nil {
-			return Error(c, err)
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "to"))
 		}
 	}
 
-	if areaType != "" {
-		err = area.ChangeType(s.AreaService, areaType)
+	from := to.AddDate(0, 0, -30)
+	if c.QueryParam("from") != "" {
+		from, err = time.Parse(time.RFC3339, c.QueryParam("from"))
 		if err != nil {
-			return Error(c, err)
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "from"))
+		}
+	}
+
+	queryResult := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	reservoirRead, ok := queryResult.Result.(storage.ReservoirRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if reservoirRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	queryResult = <-s.ReservoirOperationQuery.FindAllByReservoirID(reservoirUID, from, to)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	operations, ok := queryResult.Result.([]storage.ReservoirOperationRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.ReservoirOperationRead)
+	data["data"] = operations
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetAreaReservoirOperations returns the reservoir operations an area received.
+// An area only receives the operations of a reservoir while it is linked to it,
+// so the links are rebuilt from the area history.
+func (s *FarmServer) GetAreaReservoirOperations(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AreaEvent)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if len(events) == 0 {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	type reservoirLink struct {
+		ReservoirUID uuid.UUID
+		From         time.Time
+		To           time.Time
+	}
+
+	links := []reservoirLink{}
+	for _, v := range events {
+		reservoirUID := uuid.UUID{}
+		switch e := v.Event.(type) {
+		case domain.AreaCreated:
+			reservoirUID = e.ReservoirUID
+		case domain.AreaReservoirChanged:
+			reservoirUID = e.ReservoirUID
+		default:
+			continue
+		}
+
+		if len(links) > 0 {
+			links[len(links)-1].To = v.CreatedDate
+		}
+
+		links = append(links, reservoirLink{ReservoirUID: reservoirUID, From: v.CreatedDate, To: time.Now()})
+	}
+
+	operations := []storage.ReservoirOperationRead{}
+	for _, v := range links {
+		if v.ReservoirUID == (uuid.UUID{}) {
+			continue
+		}
+
+		queryResult := <-s.ReservoirOperationQuery.FindAllByReservoirID(v.ReservoirUID, v.From, v.To)
+		if queryResult.Error != nil {
+			return Error(c, queryResult.Error)
+		}
+
+		reservoirOperations, ok := queryResult.Result.([]storage.ReservoirOperationRead)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		operations = append(operations, reservoirOperations...)
+	}
+
+	data := make(map[string][]storage.ReservoirOperationRead)
+	data["data"] = operations
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) findReservoirFromHistory(reservoirUID uuid.UUID) (*domain.Reservoir, error) {
+	eventQueryResult := <-s.ReservoirEventQuery.FindAllByID(reservoirUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.ReservoirEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return repository.NewReservoirFromHistory(events), nil
+}
+
+func (s *FarmServer) saveReservoir(c echo.Context, reservoir *domain.Reservoir) error {
+	// Persists //
+	err := <-s.ReservoirEventRepo.Save(reservoir.UID, reservoir.Version, reservoir.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(reservoir)
+
+	resRead, err := MapToReservoirRead(s, *reservoir)
+	if err != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string]storage.ReservoirRead)
+	data["data"] = resRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) GetFarmReservoirs(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.ReservoirReadQuery.FindAllByFarm(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	reservoirs, ok := result.Result.([]storage.ReservoirRead)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	data := make(map[string][]storage.ReservoirRead)
+	for _, v := range reservoirs {
+		r, err := MapToReservoirReadFromRead(s, v)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		data["data"] = append(data["data"], r)
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) GetReservoirsByID(c echo.Context) error {
+	reservoirUID, err := uuid.FromString(c.Param("reservoir_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.ReservoirReadQuery.FindByID(reservoirUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	reservoir, ok := result.Result.(storage.ReservoirRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if reservoir.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(REQUIRED, "reservoir_id"))
+	}
+
+	data := make(map[string]storage.ReservoirRead)
+	data["data"], err = MapToReservoirReadFromRead(s, reservoir)
+	if err != nil {
+		Error(c, err)
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) SaveArea(c echo.Context) error {
+	validation := RequestValidation{}
+
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	reservoirUID, err := uuid.FromString(c.FormValue("reservoir_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validation //
+	reservoir, err := validation.ValidateReservoir(*s, reservoirUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	farm, err := validation.ValidateFarm(*s, farmUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	size, err := validation.ValidateAreaSize(c.FormValue("size"), c.FormValue("size_unit"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	location, err := validation.ValidateAreaLocation(c.FormValue("location"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	area, err := domain.CreateArea(s.AreaService, farm.UID, reservoir.UID, c.FormValue("name"), c.FormValue("type"), size, location)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	photo, err := c.FormFile("photo")
+	if err == nil {
+		destPath := stringhelper.Join(*config.Config.UploadPathArea, "/", photo.Filename)
+		err = s.File.Upload(photo, destPath)
+
+		if err != nil {
+			return Error(c, err)
+		}
+
+		width, height, err := imagehelper.GetImageDimension(destPath)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		areaPhoto := domain.AreaPhoto{
+			Filename: photo.Filename,
+			MimeType: photo.Header["Content-Type"][0],
+			Size:     int(photo.Size),
+			Width:    width,
+			Height:   height,
+		}
+
+		area.ChangePhoto(areaPhoto)
+	}
+
+	// Persists //
+	err = <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(area)
+
+	data := make(map[string]DetailArea)
+	detailArea, err := MapToDetailArea(s, *area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = detailArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) UpdateArea(c echo.Context) error {
+	validation := RequestValidation{}
+
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	name := c.FormValue("name")
+	size := c.FormValue("size")
+	sizeUnit := c.FormValue("size_unit")
+	areaType := c.FormValue("type")
+	location := c.FormValue("location")
+	reservoirID := c.FormValue("reservoir_id")
+	photo, photoErr := c.FormFile("photo")
+
+	// Validate //
+	queryResult := <-s.AreaReadQuery.FindByID(areaUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if areaRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	if size != "" && sizeUnit == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "size_unit"))
+	}
+
+	if sizeUnit != "" && size == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "size"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaRead.UID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.AreaEvent)
+
+	area := repository.NewAreaFromHistory(events)
+
+	if name != "" {
+		err := area.ChangeName(name)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if size != "" && sizeUnit != "" {
+		areaSize, err := validation.ValidateAreaSize(size, sizeUnit)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		err = area.ChangeSize(areaSize)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if areaType != "" {
+		err = area.ChangeType(s.AreaService, areaType)
+		if err != nil {
+			return Error(c, err)
 		}
 	}
 
@@ -1168,94 +1733,408 @@ func (s *FarmServer) GetAreasByID(c echo.Context) error {
 		return Error(c, queryResult.Error)
 	}
 
-	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if areaRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "area_id"))
+	}
+
+	detailArea, err := MapToDetailAreaFromStorage(s, areaRead)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]DetailArea)
+	data["data"] = detailArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) GetAreaPhotos(c echo.Context) error {
+	// Validate //
+	farmUID, err := uuid.FromString(c.Param("farm_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	areaUID, err := uuid.FromString(c.Param("area_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	farmRead, ok := queryResult.Result.(storage.FarmRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if farmRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "farm_id"))
+	}
+
+	queryResult = <-s.AreaReadQuery.FindByIDAndFarm(areaUID, farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areaRead, ok := queryResult.Result.(storage.AreaRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if areaRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "area_id"))
+	}
+
+	if areaRead.Photo.Filename == "" {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "photo"))
+	}
+
+	// Process //
+	srcPath := stringhelper.Join(*config.Config.UploadPathArea, "/", areaRead.Photo.Filename)
+
+	return c.File(srcPath)
+}
+
+func (s *FarmServer) GetTotalAreas(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.AreaReadQuery.CountAreas(farmUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	totalArea, ok := queryResult.Result.(int)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string]int)
+	data["data"] = totalArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateAreaClimateZone moves the area into a climate zone of its farm. An empty climate_zone_id takes it out of its zone.
+func (s *FarmServer) UpdateAreaClimateZone(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	climateZoneUID := uuid.UUID{}
+	if c.FormValue("climate_zone_id") != "" {
+		climateZoneUID, err = uuid.FromString(c.FormValue("climate_zone_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "climate_zone_id"))
+		}
+	}
+
+	// Process //
+	eventQueryResult := <-s.AreaEventQuery.FindAllByID(areaUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.AreaEvent)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if len(events) == 0 {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	area := repository.NewAreaFromHistory(events)
+
+	err = area.ChangeClimateZone(s.AreaService, climateZoneUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.AreaEventRepo.Save(area.UID, area.Version, area.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(area)
+
+	detailArea, err := MapToDetailArea(s, *area)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]DetailArea)
+	data["data"] = detailArea
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) GetClimateZoneTypes(c echo.Context) error {
+	data := make(map[string][]domain.ClimateZoneType)
+	data["data"] = domain.ClimateZoneTypes()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) SaveClimateZone(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	climateZone, err := domain.CreateClimateZone(s.ClimateZoneService, farmUID, c.FormValue("name"), c.FormValue("type"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveClimateZone(c, climateZone)
+}
+
+func (s *FarmServer) UpdateClimateZone(c echo.Context) error {
+	climateZoneUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	climateZone, err := s.findClimateZoneFromHistory(climateZoneUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if c.FormValue("name") != "" {
+		err = climateZone.ChangeName(c.FormValue("name"))
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	if c.FormValue("type") != "" {
+		err = climateZone.ChangeType(c.FormValue("type"))
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	return s.saveClimateZone(c, climateZone)
+}
+
+// UpdateClimateZoneTargets replaces the climate targets of the zone. A bound left empty is not enforced.
+func (s *FarmServer) UpdateClimateZoneTargets(c echo.Context) error {
+	validation := RequestValidation{}
+
+	climateZoneUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	targets := domain.ClimateTargets{}
+	for _, v := range []struct {
+		field string
+		r     *domain.ClimateRange
+	}{
+		{"temperature", &targets.Temperature},
+		{"humidity", &targets.Humidity},
+		{"co2", &targets.CO2},
+		{"light", &targets.Light},
+	} {
+		v.r.Min, err = validation.ValidateMeasurementValue(c.FormValue(v.field+"_min"), v.field+"_min")
+		if err != nil {
+			return Error(c, err)
+		}
+
+		v.r.Max, err = validation.ValidateMeasurementValue(c.FormValue(v.field+"_max"), v.field+"_max")
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	// Process //
+	climateZone, err := s.findClimateZoneFromHistory(climateZoneUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = climateZone.ChangeTargets(targets)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveClimateZone(c, climateZone)
+}
+
+func (s *FarmServer) GetFarmClimateZones(c echo.Context) error {
+	farmUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.ClimateZoneReadQuery.FindAllByFarm(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	climateZones, ok := result.Result.([]storage.ClimateZoneRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.ClimateZoneRead)
+	data["data"] = climateZones
+	if len(climateZones) == 0 {
+		data["data"] = []storage.ClimateZoneRead{}
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) GetClimateZoneByID(c echo.Context) error {
+	climateZoneUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	climateZone, err := s.findClimateZoneRead(climateZoneUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.ClimateZoneRead)
+	data["data"] = climateZone
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetClimateZoneDashboard shows the areas of the zone with their crops,
+// and the latest readings of the devices in the zone against its climate targets.
+func (s *FarmServer) GetClimateZoneDashboard(c echo.Context) error {
+	climateZoneUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	climateZone, err := s.findClimateZoneRead(climateZoneUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.AreaReadQuery.FindAllByClimateZone(climateZone.UID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	areas, ok := queryResult.Result.([]storage.AreaRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
 	}
 
-	if areaRead.UID == (uuid.UUID{}) {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "area_id"))
+	areaList, err := MapToAreaList(s, areas)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	detailArea, err := MapToDetailAreaFromStorage(s, areaRead)
+	// The devices attached to the zone itself and to its areas all measure the climate of the zone
+	readings, err := s.findLatestClimateReadings(devicedomain.DeviceAttachmentClimateZone, climateZone.UID)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	data := make(map[string]DetailArea)
-	data["data"] = detailArea
+	for _, v := range areas {
+		areaReadings, err := s.findLatestClimateReadings(devicedomain.DeviceAttachmentArea, v.UID)
+		if err != nil {
+			return Error(c, err)
+		}
+
+		readings = append(readings, areaReadings...)
+	}
+
+	data := make(map[string]ClimateZoneDashboard)
+	data["data"] = MapToClimateZoneDashboard(climateZone, areaList, readings)
 
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *FarmServer) GetAreaPhotos(c echo.Context) error {
-	// Validate //
-	farmUID, err := uuid.FromString(c.Param("farm_id"))
-	if err != nil {
-		return Error(c, err)
+func (s *FarmServer) findLatestClimateReadings(attachmentType string, attachmentUID uuid.UUID) ([]query.ClimateReadingQueryResult, error) {
+	result := <-s.ClimateReadingQuery.FindLatestByAttachment(attachmentType, attachmentUID)
+	if result.Error != nil {
+		return nil, result.Error
 	}
 
-	areaUID, err := uuid.FromString(c.Param("area_id"))
-	if err != nil {
-		return Error(c, err)
+	readings, ok := result.Result.([]query.ClimateReadingQueryResult)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
-	queryResult := <-s.FarmReadQuery.FindByID(farmUID)
-	if queryResult.Error != nil {
-		return Error(c, queryResult.Error)
+	return readings, nil
+}
+
+func (s *FarmServer) findClimateZoneRead(climateZoneUID uuid.UUID) (storage.ClimateZoneRead, error) {
+	result := <-s.ClimateZoneReadQuery.FindByID(climateZoneUID)
+	if result.Error != nil {
+		return storage.ClimateZoneRead{}, result.Error
 	}
 
-	farmRead, ok := queryResult.Result.(storage.FarmRead)
+	climateZone, ok := result.Result.(storage.ClimateZoneRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+		return storage.ClimateZoneRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
-	if farmRead.UID == (uuid.UUID{}) {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "farm_id"))
+	if climateZone.UID == (uuid.UUID{}) {
+		return storage.ClimateZoneRead{}, NewRequestValidationError(NOT_FOUND, "id")
 	}
 
-	queryResult = <-s.AreaReadQuery.FindByIDAndFarm(areaUID, farmUID)
-	if queryResult.Error != nil {
-		return Error(c, queryResult.Error)
-	}
+	return climateZone, nil
+}
 
-	areaRead, ok := queryResult.Result.(storage.AreaRead)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+func (s *FarmServer) findClimateZoneFromHistory(climateZoneUID uuid.UUID) (*domain.ClimateZone, error) {
+	eventQueryResult := <-s.ClimateZoneEventQuery.FindAllByID(climateZoneUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
 	}
 
-	if areaRead.UID == (uuid.UUID{}) {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "area_id"))
+	events, ok := eventQueryResult.Result.([]storage.ClimateZoneEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
 	}
 
-	if areaRead.Photo.Filename == "" {
-		return Error(c, NewRequestValidationError(NOT_FOUND, "photo"))
+	if len(events) == 0 {
+		return nil, NewRequestValidationError(NOT_FOUND, "id")
 	}
 
-	// Process //
-	srcPath := stringhelper.Join(*config.Config.UploadPathArea, "/", areaRead.Photo.Filename)
-
-	return c.File(srcPath)
+	return repository.NewClimateZoneFromHistory(events), nil
 }
 
-func (s *FarmServer) GetTotalAreas(c echo.Context) error {
-	farmUID, err := uuid.FromString(c.Param("id"))
+func (s *FarmServer) saveClimateZone(c echo.Context, climateZone *domain.ClimateZone) error {
+	// Persists //
+	err := <-s.ClimateZoneEventRepo.Save(climateZone.UID, climateZone.Version, climateZone.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	queryResult := <-s.AreaReadQuery.CountAreas(farmUID)
-	if queryResult.Error != nil {
-		return Error(c, queryResult.Error)
-	}
+	// Publish //
+	s.publishUncommittedEvents(climateZone)
 
-	totalArea, ok := queryResult.Result.(int)
-	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	climateZoneRead, err := MapToClimateZoneRead(s, *climateZone)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	data := make(map[string]int)
-	data["data"] = totalArea
+	data := make(map[string]storage.ClimateZoneRead)
+	data["data"] = climateZoneRead
 
 	return c.JSON(http.StatusOK, data)
 }
@@ -1343,6 +2222,8 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 	plantType := c.FormValue("plant_type")
 	chemicalType := c.FormValue("chemical_type")
 	containerType := c.FormValue("container_type")
+	preHarvestInterval := c.FormValue("pre_harvest_interval")
+	reEntryInterval := c.FormValue("re_entry_interval")
 
 	pricePerUnit := c.FormValue("price_per_unit")
 	currencyCode := c.FormValue("currency_code")
@@ -1397,9 +2278,19 @@ func (s *FarmServer) SaveMaterial(c echo.Context) error {
 			return Error(c, NewRequestValidationError(INVALID_OPTION, "chemical_type"))
 		}
 
-		mt, err = domain.CreateMaterialTypeAgrochemical(ct.Code)
+		phi, err := parseMaterialInterval(preHarvestInterval, 0)
 		if err != nil {
-			return Error(c, NewRequestValidationError(INVALID_OPTION, "type"))
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "pre_harvest_interval"))
+		}
+
+		rei, err := parseMaterialInterval(reEntryInterval, 0)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "re_entry_interval"))
+		}
+
+		mt, err = domain.CreateMaterialTypeAgrochemical(ct.Code, phi, rei)
+		if err != nil {
+			return Error(c, err)
 		}
 	case strings.ToLower(domain.MaterialTypeGrowingMediumCode):
 		mt = domain.MaterialTypeGrowingMedium{}
@@ -1465,6 +2356,8 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 	plantType := c.FormValue("plant_type")
 	chemicalType := c.FormValue("chemical_type")
 	containerType := c.FormValue("container_type")
+	preHarvestInterval := c.FormValue("pre_harvest_interval")
+	reEntryInterval := c.FormValue("re_entry_interval")
 
 	name := c.FormValue("name")
 	pricePerUnit := c.FormValue("price_per_unit")
@@ -1544,15 +2437,30 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 			materialRead.Type = mt
 		}
 	case strings.ToLower(domain.MaterialTypeAgrochemicalCode):
-		if chemicalType != "" {
-			ct := domain.GetChemicalType(chemicalType)
-			if ct == (domain.ChemicalType{}) {
-				return Error(c, NewRequestValidationError(INVALID_OPTION, "chemical_type"))
+		if chemicalType != "" || preHarvestInterval != "" || reEntryInterval != "" {
+			current, _ := materialRead.Type.(domain.MaterialTypeAgrochemical)
+
+			ct := current.ChemicalType
+			if chemicalType != "" {
+				ct = domain.GetChemicalType(chemicalType)
+				if ct == (domain.ChemicalType{}) {
+					return Error(c, NewRequestValidationError(INVALID_OPTION, "chemical_type"))
+				}
 			}
 
-			mt, err = domain.CreateMaterialTypeAgrochemical(ct.Code)
+			phi, err := parseMaterialInterval(preHarvestInterval, current.PreHarvestInterval)
 			if err != nil {
-				return Error(c, NewRequestValidationError(INVALID_OPTION, "type"))
+				return Error(c, NewRequestValidationError(PARSE_FAILED, "pre_harvest_interval"))
+			}
+
+			rei, err := parseMaterialInterval(reEntryInterval, current.ReEntryInterval)
+			if err != nil {
+				return Error(c, NewRequestValidationError(PARSE_FAILED, "re_entry_interval"))
+			}
+
+			mt, err = domain.CreateMaterialTypeAgrochemical(ct.Code, phi, rei)
+			if err != nil {
+				return Error(c, err)
 			}
 
 			materialRead.Type = mt
@@ -1642,6 +2550,188 @@ func (s *FarmServer) UpdateMaterial(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// parseMaterialInterval parses an agrochemical interval form value,
+// falling back to the given value when it is empty.
+func parseMaterialInterval(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+
+	return strconv.Atoi(value)
+}
+
+func (s *FarmServer) UpdateMaterialCropPlan(c echo.Context) error {
+	data := make(map[string]Material)
+
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	days := map[string]int{}
+	for _, field := range []string{
+		"days_to_germination",
+		"days_to_transplant",
+		"days_to_first_harvest",
+		"days_to_end_of_harvest",
+	} {
+		value := c.FormValue(field)
+		if value == "" && field != "days_to_transplant" {
+			return Error(c, NewRequestValidationError(REQUIRED, field))
+		}
+
+		if value == "" {
+			continue
+		}
+
+		d, err := strconv.Atoi(value)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, field))
+		}
+
+		days[field] = d
+	}
+
+	cropPlan, err := domain.CreateCropPlan(
+		days["days_to_germination"],
+		days["days_to_transplant"],
+		days["days_to_first_harvest"],
+		days["days_to_end_of_harvest"],
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	thermalTime := map[string]float32{}
+	for _, field := range []string{"base_temperature", "gdd_to_first_harvest"} {
+		value := c.FormValue(field)
+		if value == "" {
+			continue
+		}
+
+		f, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, field))
+		}
+
+		thermalTime[field] = float32(f)
+	}
+
+	if _, ok := thermalTime["gdd_to_first_harvest"]; ok {
+		baseTemperature, ok := thermalTime["base_temperature"]
+		if !ok {
+			return Error(c, NewRequestValidationError(REQUIRED, "base_temperature"))
+		}
+
+		cropPlan, err = cropPlan.WithThermalTime(baseTemperature, thermalTime["gdd_to_first_harvest"])
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	queryResult := <-s.MaterialReadQuery.FindByID(materialUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	materialRead, ok := queryResult.Result.(storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if materialRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(materialRead.UID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.MaterialEvent)
+	material := repository.NewMaterialFromHistory(events)
+
+	err = material.ChangeCropPlan(cropPlan)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persist //
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(material)
+
+	data["data"] = MapToMaterial(*material)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *FarmServer) UpdateMaterialSeedsPerUnit(c echo.Context) error {
+	data := make(map[string]Material)
+
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	if c.FormValue("seeds_per_unit") == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "seeds_per_unit"))
+	}
+
+	seedsPerUnit, err := strconv.ParseFloat(c.FormValue("seeds_per_unit"), 32)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "seeds_per_unit"))
+	}
+
+	queryResult := <-s.MaterialReadQuery.FindByID(materialUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	materialRead, ok := queryResult.Result.(storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if materialRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(materialRead.UID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.MaterialEvent)
+	material := repository.NewMaterialFromHistory(events)
+
+	err = material.ChangeSeedsPerUnit(float32(seedsPerUnit))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persist //
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Publish //
+	s.publishUncommittedEvents(material)
+
+	data["data"] = MapToMaterial(*material)
+
+	return c.JSON(http.StatusOK, data)
+}
+
 func (s *FarmServer) GetMaterialByID(c echo.Context) error {
 	materialUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
@@ -1665,6 +2755,43 @@ func (s *FarmServer) GetMaterialByID(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// GetMaterialConsumptions returns the usage ledger of the material
+func (s *FarmServer) GetMaterialConsumptions(c echo.Context) error {
+	materialUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.MaterialReadQuery.FindByID(materialUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	materialRead, ok := queryResult.Result.(storage.MaterialRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if materialRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	queryResult = <-s.MaterialConsumptionQuery.FindAllByMaterialID(materialUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	consumptions, ok := queryResult.Result.([]storage.MaterialConsumptionRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.MaterialConsumptionRead)
+	data["data"] = consumptions
+
+	return c.JSON(http.StatusOK, data)
+}
+
 func (s *FarmServer) GetAvailableMaterialPlantType(c echo.Context) error {
 	data := make(map[string][]AvailableMaterialPlantType)
 
@@ -1701,6 +2828,11 @@ func (s *FarmServer) publishUncommittedEvents(entity interface{}) error {
 			name := structhelper.GetName(v)
 			s.EventBus.Publish(name, v)
 		}
+	case *domain.ClimateZone:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
 	case *domain.Material:
 		for _, v := range e.UncommittedChanges {
 			name := structhelper.GetName(v)
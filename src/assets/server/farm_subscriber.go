@@ -4,8 +4,12 @@ import (
 	"errors"
 
 	"github.com/Tanibox/tania-core/src/assets/domain"
+	"github.com/Tanibox/tania-core/src/assets/repository"
 	"github.com/Tanibox/tania-core/src/assets/storage"
+	growthdomain "github.com/Tanibox/tania-core/src/growth/domain"
+	taskdomain "github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
 )
 
 func (s *FarmServer) SaveToFarmReadModel(event interface{}) error {
@@ -84,6 +88,21 @@ func (s *FarmServer) SaveToFarmReadModel(event interface{}) error {
 
 		farm.Country = e.Country
 		farm.City = e.City
+
+	case domain.FarmBatchIDTemplateChanged:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		farmRead = &farm
+
+		farmRead.BatchIDTemplate = e.BatchIDTemplate
 	}
 
 	err := <-s.FarmReadRepo.Save(farmRead)
@@ -215,6 +234,133 @@ func (s *FarmServer) SaveToReservoirReadModel(event interface{}) error {
 
 		reservoirRead.Notes = notes
 
+	case domain.ReservoirMeasured:
+		queryResult := <-s.ReservoirReadQuery.FindByID(e.ReservoirUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		r, ok := queryResult.Result.(storage.ReservoirRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		reservoirRead = &r
+
+		latest := domain.ReservoirLatestMeasurement{}
+		if reservoirRead.LatestMeasurement != nil {
+			latest = domain.ReservoirLatestMeasurement(*reservoirRead.LatestMeasurement)
+		}
+
+		latestRead := storage.ReservoirLatestMeasurement(latest.WithMeasurement(e))
+		reservoirRead.LatestMeasurement = &latestRead
+
+	case domain.ReservoirRefilled:
+		queryResult := <-s.ReservoirReadQuery.FindByID(e.ReservoirUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		r, ok := queryResult.Result.(storage.ReservoirRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		reservoirRead = &r
+
+		reservoirRead.Volume += e.Volume
+		reservoirRead.Nutrients = estimateNutrientConcentrations(reservoirRead.Nutrients, reservoirRead.Volume)
+
+		s.saveReservoirOperation(storage.ReservoirOperationRead{
+			UID:           e.UID,
+			ReservoirUID:  e.ReservoirUID,
+			Type:          domain.ReservoirOperationRefill,
+			Volume:        e.Volume,
+			VolumeAfter:   reservoirRead.Volume,
+			OperationDate: e.OperationDate,
+		})
+
+	case domain.ReservoirDrained:
+		queryResult := <-s.ReservoirReadQuery.FindByID(e.ReservoirUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		r, ok := queryResult.Result.(storage.ReservoirRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		reservoirRead = &r
+
+		remaining := reservoirRead.Volume - e.Volume
+
+		nutrients := []storage.ReservoirNutrient{}
+		for _, v := range reservoirRead.Nutrients {
+			if remaining > 0 {
+				v.Amount = v.Amount * remaining / reservoirRead.Volume
+				nutrients = append(nutrients, v)
+			}
+		}
+
+		reservoirRead.Volume = remaining
+		reservoirRead.Nutrients = estimateNutrientConcentrations(nutrients, reservoirRead.Volume)
+
+		s.saveReservoirOperation(storage.ReservoirOperationRead{
+			UID:           e.UID,
+			ReservoirUID:  e.ReservoirUID,
+			Type:          domain.ReservoirOperationDrain,
+			Volume:        e.Volume,
+			VolumeAfter:   reservoirRead.Volume,
+			OperationDate: e.OperationDate,
+		})
+
+	case domain.ReservoirNutrientAdded:
+		queryResult := <-s.ReservoirReadQuery.FindByID(e.ReservoirUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		r, ok := queryResult.Result.(storage.ReservoirRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		reservoirRead = &r
+
+		isExist := false
+		for i, v := range reservoirRead.Nutrients {
+			if v.MaterialUID == e.MaterialUID {
+				reservoirRead.Nutrients[i].MaterialName = e.MaterialName
+				reservoirRead.Nutrients[i].Amount += e.Amount
+				reservoirRead.Nutrients[i].Unit = e.Unit
+				isExist = true
+			}
+		}
+
+		if !isExist {
+			reservoirRead.Nutrients = append(reservoirRead.Nutrients, storage.ReservoirNutrient{
+				MaterialUID:  e.MaterialUID,
+				MaterialName: e.MaterialName,
+				Amount:       e.Amount,
+				Unit:         e.Unit,
+			})
+		}
+
+		reservoirRead.Nutrients = estimateNutrientConcentrations(reservoirRead.Nutrients, reservoirRead.Volume)
+
+		s.saveReservoirOperation(storage.ReservoirOperationRead{
+			UID:           e.UID,
+			ReservoirUID:  e.ReservoirUID,
+			Type:          domain.ReservoirOperationNutrient,
+			MaterialUID:   e.MaterialUID,
+			MaterialName:  e.MaterialName,
+			Amount:        e.Amount,
+			Unit:          e.Unit,
+			VolumeAfter:   reservoirRead.Volume,
+			OperationDate: e.OperationDate,
+		})
+
 	}
 
 	err := <-s.ReservoirReadRepo.Save(reservoirRead)
@@ -225,6 +371,46 @@ func (s *FarmServer) SaveToReservoirReadModel(event interface{}) error {
 	return nil
 }
 
+func (s *FarmServer) saveReservoirOperation(reservoirOperation storage.ReservoirOperationRead) {
+	err := <-s.ReservoirOperationRepo.Save(&reservoirOperation)
+	if err != nil {
+		log.Error(err)
+	}
+}
+
+func estimateNutrientConcentrations(nutrients []storage.ReservoirNutrient, volume float32) []storage.ReservoirNutrient {
+	for i, v := range nutrients {
+		nutrients[i].Concentration = domain.EstimateConcentration(v.Amount, volume)
+	}
+
+	return nutrients
+}
+
+func (s *FarmServer) SaveToReservoirMeasurementReadModel(event interface{}) error {
+	e, ok := event.(domain.ReservoirMeasured)
+	if !ok {
+		return errors.New("Internal server error. Error type assertion")
+	}
+
+	err := <-s.ReservoirMeasurementRepo.Save(&storage.ReservoirMeasurementRead{
+		ReservoirUID: e.ReservoirUID,
+		ReservoirMeasurement: storage.ReservoirMeasurement{
+			UID:             e.UID,
+			PH:              e.PH,
+			EC:              e.EC,
+			Temperature:     e.Temperature,
+			DissolvedOxygen: e.DissolvedOxygen,
+			Source:          e.Source,
+			MeasuredDate:    e.MeasuredDate,
+		},
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
 func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 	areaRead := &storage.AreaRead{}
 
@@ -353,6 +539,34 @@ func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 			Name: reservoir.Name,
 		}
 
+	case domain.AreaClimateZoneChanged:
+		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(storage.AreaRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.ClimateZoneReadQuery.FindByID(e.ClimateZoneUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		climateZone, ok := queryResult.Result.(storage.ClimateZoneRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		areaRead = &area
+
+		areaRead.ClimateZone = storage.AreaClimateZone{
+			UID:  climateZone.UID,
+			Name: climateZone.Name,
+		}
+
 	case domain.AreaPhotoAdded:
 		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
 		if queryResult.Error != nil {
@@ -428,6 +642,111 @@ func (s *FarmServer) SaveToAreaReadModel(event interface{}) error {
 	return nil
 }
 
+func (s *FarmServer) SaveToClimateZoneReadModel(event interface{}) error {
+	climateZoneRead := &storage.ClimateZoneRead{}
+
+	switch e := event.(type) {
+	case domain.ClimateZoneCreated:
+		queryResult := <-s.FarmReadQuery.FindByID(e.FarmUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		farm, ok := queryResult.Result.(storage.FarmRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		climateZoneRead.UID = e.UID
+		climateZoneRead.Name = e.Name
+		climateZoneRead.Type = storage.ClimateZoneType(e.Type)
+		climateZoneRead.CreatedDate = e.CreatedDate
+		climateZoneRead.Farm = storage.ClimateZoneFarm{
+			UID:  farm.UID,
+			Name: farm.Name,
+		}
+
+	case domain.ClimateZoneNameChanged:
+		z, err := s.getClimateZoneRead(e.ClimateZoneUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		climateZoneRead = &z
+
+		climateZoneRead.Name = e.Name
+
+		s.renameAreasClimateZone(e.ClimateZoneUID, e.Name)
+
+	case domain.ClimateZoneTypeChanged:
+		z, err := s.getClimateZoneRead(e.ClimateZoneUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		climateZoneRead = &z
+
+		climateZoneRead.Type = storage.ClimateZoneType(e.Type)
+
+	case domain.ClimateZoneTargetsChanged:
+		z, err := s.getClimateZoneRead(e.ClimateZoneUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		climateZoneRead = &z
+
+		climateZoneRead.Targets = storage.ClimateTargets(e.Targets)
+
+	}
+
+	err := <-s.ClimateZoneReadRepo.Save(climateZoneRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// renameAreasClimateZone keeps the climate zone name of the areas read model up to date
+func (s *FarmServer) renameAreasClimateZone(climateZoneUID uuid.UUID, name string) {
+	queryResult := <-s.AreaReadQuery.FindAllByClimateZone(climateZoneUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return
+	}
+
+	areas, ok := queryResult.Result.([]storage.AreaRead)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return
+	}
+
+	for _, v := range areas {
+		area := v
+		area.ClimateZone.Name = name
+
+		err := <-s.AreaReadRepo.Save(&area)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+func (s *FarmServer) getClimateZoneRead(uid uuid.UUID) (storage.ClimateZoneRead, error) {
+	queryResult := <-s.ClimateZoneReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.ClimateZoneRead{}, queryResult.Error
+	}
+
+	climateZoneRead, ok := queryResult.Result.(storage.ClimateZoneRead)
+	if !ok {
+		return storage.ClimateZoneRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return climateZoneRead, nil
+}
+
 func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 	materialRead := &storage.MaterialRead{}
 
@@ -550,6 +869,65 @@ func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 		materialRead = &material
 
 		materialRead.ProducedBy = &e.ProducedBy
+
+	case domain.MaterialCropPlanChanged:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		materialRead = &material
+
+		cropPlan := storage.CropPlan(e.CropPlan)
+		materialRead.CropPlan = &cropPlan
+
+	case domain.MaterialSeedsPerUnitChanged:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.SeedsPerUnit = e.SeedsPerUnit
+
+	case domain.MaterialConsumed:
+		queryResult := <-s.MaterialReadQuery.FindByID(e.MaterialUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		material, ok := queryResult.Result.(storage.MaterialRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		materialRead = &material
+
+		materialRead.Quantity.Value = materialRead.Quantity.Value - e.Quantity
+
+		err := <-s.MaterialConsumptionRepo.Save(&storage.MaterialConsumptionRead{
+			MaterialUID:  e.MaterialUID,
+			TaskUID:      e.TaskUID,
+			CropUID:      e.CropUID,
+			ReservoirUID: e.ReservoirUID,
+			Quantity:     e.Quantity,
+			QuantityUnit: e.QuantityUnit.Code,
+			ConsumedDate: e.ConsumedDate,
+		})
+		if err != nil {
+			log.Error(err)
+		}
 	}
 
 	err := <-s.MaterialReadRepo.Save(materialRead)
@@ -559,3 +937,111 @@ func (s *FarmServer) SaveToMaterialReadModel(event interface{}) error {
 
 	return nil
 }
+
+// ConsumeTaskMaterial takes out the material used by a completed task from the inventory.
+// The task domain has already checked the stock when completing the task,
+// so an insufficient stock here only happens when the material changed in between.
+func (s *FarmServer) ConsumeTaskMaterial(event interface{}) error {
+	// TODO:
+	// We cannot listen to this events without refer to the original struct.
+	// This is considered as domain boundary leak.
+	e, ok := event.(taskdomain.TaskCompleted)
+	if !ok {
+		return nil
+	}
+
+	if e.MaterialUID == nil || e.MaterialQuantity <= 0 {
+		return nil
+	}
+
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(*e.MaterialUID)
+	if eventQueryResult.Error != nil {
+		log.Error(eventQueryResult.Error)
+		return eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	material := repository.NewMaterialFromHistory(events)
+
+	err := material.Consume(e.MaterialQuantity, e.UID)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	s.publishUncommittedEvents(material)
+
+	return nil
+}
+
+// ConsumeCropSeed takes out the seeds sown in a new crop batch from the seed inventory.
+// The batch can be created with a short stock, then the stock is emptied and the deficit is logged.
+func (s *FarmServer) ConsumeCropSeed(event interface{}) error {
+	// TODO:
+	// We cannot listen to this events without refer to the original struct.
+	// This is considered as domain boundary leak.
+	e, ok := event.(growthdomain.CropBatchCreated)
+	if !ok {
+		return nil
+	}
+
+	// A split batch was sown with its parent batch
+	if e.ParentUID != (uuid.UUID{}) {
+		return nil
+	}
+
+	eventQueryResult := <-s.MaterialEventQuery.FindAllByID(e.InventoryUID)
+	if eventQueryResult.Error != nil {
+		log.Error(eventQueryResult.Error)
+		return eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.MaterialEvent)
+	if !ok {
+		err := errors.New("Internal server error. Error type assertion")
+		log.Error(err)
+		return err
+	}
+
+	material := repository.NewMaterialFromHistory(events)
+
+	// Only seed materials are consumed, plants are not tracked by seeds
+	if _, ok := material.Type.(domain.MaterialTypeSeed); !ok {
+		return nil
+	}
+
+	seeds := e.Container.SeedCount()
+	stock := material.Quantity.Value
+
+	err := material.ConsumeSeeds(seeds, e.UID)
+	if err != nil {
+		log.Warn("Seeds of crop batch ", e.BatchID, " not consumed from material ", material.Name, ": ", err)
+		return err
+	}
+
+	if quantity, err := material.SeedQuantity(seeds); err == nil && quantity > stock {
+		log.Warn("Crop batch ", e.BatchID, " was sown with ", quantity-stock, " ", material.Quantity.Unit.Code, " more than the stock of material ", material.Name)
+	}
+
+	err = <-s.MaterialEventRepo.Save(material.UID, material.Version, material.UncommittedChanges)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	s.publishUncommittedEvents(material)
+
+	return nil
+}
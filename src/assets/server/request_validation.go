@@ -100,6 +100,11 @@ func Error(c echo.Context, err error) error {
 		errorResponse["error_code"] = strconv.Itoa(re.Code)
 		errorResponse["error_message"] = re.Error()
 
+		return c.JSON(http.StatusBadRequest, errorResponse)
+	} else if re, ok := err.(domain.ClimateZoneError); ok {
+		errorResponse["error_code"] = strconv.Itoa(re.Code)
+		errorResponse["error_message"] = re.Error()
+
 		return c.JSON(http.StatusBadRequest, errorResponse)
 	} else if rve, ok := err.(RequestValidationError); ok {
 		errorResponse["field_name"] = rve.FieldName
@@ -55,3 +55,41 @@ func (rv *RequestValidation) ValidateType(t string) (string, error) {
 
 	return t, nil
 }
+
+// ValidateOperationAmount parses a required volume or amount of a reservoir operation.
+// The domain checks whether the value is valid for the reservoir.
+func (rv *RequestValidation) ValidateOperationAmount(value, field string) (float32, error) {
+	if value == "" {
+		return 0, NewRequestValidationError(REQUIRED, field)
+	}
+
+	if !validationhelper.IsFloat(value) {
+		return 0, NewRequestValidationError(FLOAT, field)
+	}
+
+	v, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return 0, NewRequestValidationError(PARSE_FAILED, field)
+	}
+
+	return float32(v), nil
+}
+
+// ValidateMeasurementValue parses an optional measurement parameter, returning nil when it is empty
+func (rv *RequestValidation) ValidateMeasurementValue(value, field string) (*float32, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if !validationhelper.IsFloat(value) {
+		return nil, NewRequestValidationError(FLOAT, field)
+	}
+
+	v, err := strconv.ParseFloat(value, 32)
+	if err != nil {
+		return nil, NewRequestValidationError(PARSE_FAILED, field)
+	}
+
+	f := float32(v)
+	return &f, nil
+}
@@ -85,6 +85,17 @@ type Material struct {
 	Notes          *string          `json:"notes"`
 	ProducedBy     *string          `json:"produced_by"`
 	CreatedDate    time.Time        `json:"created_date"`
+	CropPlan       *CropPlan        `json:"crop_plan"`
+	SeedsPerUnit   float32          `json:"seeds_per_unit"`
+}
+
+type CropPlan struct {
+	DaysToGermination  int     `json:"days_to_germination"`
+	DaysToTransplant   int     `json:"days_to_transplant"`
+	DaysToFirstHarvest int     `json:"days_to_first_harvest"`
+	DaysToEndOfHarvest int     `json:"days_to_end_of_harvest"`
+	BaseTemperature    float32 `json:"base_temperature"`
+	GDDToFirstHarvest  float32 `json:"gdd_to_first_harvest"`
 }
 
 type PricePerUnit struct {
@@ -112,7 +123,9 @@ type MaterialTypePlant struct {
 }
 
 type MaterialTypeAgrochemical struct {
-	ChemicalType domain.ChemicalType `json:"chemical_type"`
+	ChemicalType       domain.ChemicalType `json:"chemical_type"`
+	PreHarvestInterval int                 `json:"pre_harvest_interval"`
+	ReEntryInterval    int                 `json:"re_entry_interval"`
 }
 
 type MaterialTypeSeedingContainer struct {
@@ -124,6 +137,25 @@ type AvailableMaterialPlantType struct {
 	Names     []string `json:"names"`
 }
 
+// ClimateZoneDashboard is the overview of a climate zone, its areas and its current climate
+type ClimateZoneDashboard struct {
+	ClimateZone    storage.ClimateZoneRead `json:"climate_zone"`
+	Areas          []AreaList              `json:"areas"`
+	TotalCropBatch int                     `json:"total_crop_batch"`
+	PlantQuantity  int                     `json:"plant_quantity"`
+	Climate        []ClimateParameter      `json:"climate"`
+}
+
+// ClimateParameter is the current value of a climate parameter of the zone, averaged over the latest
+// reading of every device measuring it. Average and WithinTarget are nil when no device measures it.
+type ClimateParameter struct {
+	Parameter    string                            `json:"parameter"`
+	Target       domain.ClimateRange               `json:"target"`
+	Average      *float32                          `json:"average"`
+	WithinTarget *bool                             `json:"within_target"`
+	Readings     []query.ClimateReadingQueryResult `json:"readings"`
+}
+
 type SortedAreaNotes []domain.AreaNote
 
 // Len is part of sort.Interface.
@@ -146,6 +178,7 @@ func MapToFarmRead(farm *domain.Farm) *storage.FarmRead {
 	farmRead.City = farm.City
 	farmRead.CreatedDate = farm.CreatedDate
 	farmRead.IsActive = farm.IsActive
+	farmRead.BatchIDTemplate = farm.BatchIDTemplate
 
 	return farmRead
 }
@@ -232,6 +265,27 @@ func MapToReservoirRead(s *FarmServer, reservoir domain.Reservoir) (storage.Rese
 		return resRead.Notes[i].CreatedDate.After(resRead.Notes[j].CreatedDate)
 	})
 
+	if reservoir.LatestMeasurement != nil {
+		latestMeasurement := storage.ReservoirLatestMeasurement(*reservoir.LatestMeasurement)
+		resRead.LatestMeasurement = &latestMeasurement
+	}
+
+	resRead.Volume = reservoir.Volume
+
+	for _, v := range reservoir.Nutrients {
+		resRead.Nutrients = append(resRead.Nutrients, storage.ReservoirNutrient{
+			MaterialUID:   v.MaterialUID,
+			MaterialName:  v.MaterialName,
+			Amount:        v.Amount,
+			Unit:          v.Unit,
+			Concentration: domain.EstimateConcentration(v.Amount, reservoir.Volume),
+		})
+	}
+
+	sort.Slice(resRead.Nutrients, func(i, j int) bool {
+		return resRead.Nutrients[i].MaterialName < resRead.Nutrients[j].MaterialName
+	})
+
 	queryResult := <-s.FarmReadQuery.FindByID(reservoir.FarmUID)
 	if queryResult.Error != nil {
 		return storage.ReservoirRead{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
@@ -304,6 +358,7 @@ func MapToDetailAreaFromStorage(s *FarmServer, areaRead storage.AreaRead) (Detai
 	detailArea.CreatedDate = areaRead.CreatedDate
 	detailArea.Reservoir = areaRead.Reservoir
 	detailArea.Farm = areaRead.Farm
+	detailArea.ClimateZone = areaRead.ClimateZone
 
 	queryResult := <-s.CropReadQuery.CountCropsByArea(areaRead.UID)
 	if queryResult.Error != nil {
@@ -386,6 +441,23 @@ func MapToDetailArea(s *FarmServer, area domain.Area) (DetailArea, error) {
 		Name: farm.Name,
 	}
 
+	if area.ClimateZoneUID != (uuid.UUID{}) {
+		queryResult = <-s.ClimateZoneReadQuery.FindByID(area.ClimateZoneUID)
+		if queryResult.Error != nil {
+			return DetailArea{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+		}
+
+		climateZone, ok := queryResult.Result.(storage.ClimateZoneRead)
+		if !ok {
+			return DetailArea{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+		}
+
+		areaRead.ClimateZone = storage.AreaClimateZone{
+			UID:  climateZone.UID,
+			Name: climateZone.Name,
+		}
+	}
+
 	queryResult = <-s.CropReadQuery.CountCropsByArea(area.UID)
 	if queryResult.Error != nil {
 		return DetailArea{}, queryResult.Error
@@ -429,6 +501,74 @@ func MapToDetailArea(s *FarmServer, area domain.Area) (DetailArea, error) {
 	return areaRead, nil
 }
 
+func MapToClimateZoneRead(s *FarmServer, climateZone domain.ClimateZone) (storage.ClimateZoneRead, error) {
+	queryResult := <-s.FarmReadQuery.FindByID(climateZone.FarmUID)
+	if queryResult.Error != nil {
+		return storage.ClimateZoneRead{}, queryResult.Error
+	}
+
+	farm, ok := queryResult.Result.(storage.FarmRead)
+	if !ok {
+		return storage.ClimateZoneRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	return storage.ClimateZoneRead{
+		UID:     climateZone.UID,
+		Name:    climateZone.Name,
+		Type:    storage.ClimateZoneType(climateZone.Type),
+		Targets: storage.ClimateTargets(climateZone.Targets),
+		Farm: storage.ClimateZoneFarm{
+			UID:  farm.UID,
+			Name: farm.Name,
+		},
+		CreatedDate: climateZone.CreatedDate,
+	}, nil
+}
+
+func MapToClimateZoneDashboard(climateZone storage.ClimateZoneRead, areas []AreaList, readings []query.ClimateReadingQueryResult) ClimateZoneDashboard {
+	dashboard := ClimateZoneDashboard{
+		ClimateZone: climateZone,
+		Areas:       areas,
+		Climate:     []ClimateParameter{},
+	}
+
+	for _, v := range areas {
+		dashboard.TotalCropBatch += v.TotalCropBatch
+		dashboard.PlantQuantity += v.PlantQuantity
+	}
+
+	targets := domain.ClimateTargets(climateZone.Targets)
+	for _, parameter := range domain.ClimateParameters() {
+		target, _ := targets.Range(parameter)
+
+		climate := ClimateParameter{
+			Parameter: parameter,
+			Target:    target,
+			Readings:  []query.ClimateReadingQueryResult{},
+		}
+
+		total := float32(0)
+		for _, v := range readings {
+			if v.SensorType == parameter {
+				climate.Readings = append(climate.Readings, v)
+				total += v.Value
+			}
+		}
+
+		if len(climate.Readings) > 0 {
+			average := total / float32(len(climate.Readings))
+			withinTarget := target.Contains(average)
+
+			climate.Average = &average
+			climate.WithinTarget = &withinTarget
+		}
+
+		dashboard.Climate = append(dashboard.Climate, climate)
+	}
+
+	return dashboard
+}
+
 func MapToPlantType(plantTypes []domain.PlantType) []string {
 	pt := make([]string, len(plantTypes))
 
@@ -474,7 +614,9 @@ func MapToMaterial(material domain.Material) Material {
 		m.Type = MaterialType{
 			Code: v.Code(),
 			MaterialTypeDetail: MaterialTypeAgrochemical{
-				ChemicalType: v.ChemicalType,
+				ChemicalType:       v.ChemicalType,
+				PreHarvestInterval: v.PreHarvestInterval,
+				ReEntryInterval:    v.ReEntryInterval,
 			},
 		}
 	case domain.MaterialTypeGrowingMedium:
@@ -512,6 +654,13 @@ func MapToMaterial(material domain.Material) Material {
 
 	m.CreatedDate = material.CreatedDate
 
+	if material.CropPlan != nil {
+		cp := CropPlan(*material.CropPlan)
+		m.CropPlan = &cp
+	}
+
+	m.SeedsPerUnit = material.SeedsPerUnit
+
 	return m
 }
 
@@ -552,7 +701,9 @@ func MapToMaterialFromRead(material storage.MaterialRead) Material {
 		m.Type = MaterialType{
 			Code: v.Code(),
 			MaterialTypeDetail: MaterialTypeAgrochemical{
-				ChemicalType: v.ChemicalType,
+				ChemicalType:       v.ChemicalType,
+				PreHarvestInterval: v.PreHarvestInterval,
+				ReEntryInterval:    v.ReEntryInterval,
 			},
 		}
 	case domain.MaterialTypeGrowingMedium:
@@ -590,6 +741,13 @@ func MapToMaterialFromRead(material storage.MaterialRead) Material {
 
 	m.CreatedDate = material.CreatedDate
 
+	if material.CropPlan != nil {
+		cp := CropPlan(*material.CropPlan)
+		m.CropPlan = &cp
+	}
+
+	m.SeedsPerUnit = material.SeedsPerUnit
+
 	return m
 }
 
@@ -621,7 +779,9 @@ func MapToMaterialSimpleFromRead(materials []storage.MaterialRead) []MaterialSim
 			mSimple.Type = MaterialType{
 				Code: v.Code(),
 				MaterialTypeDetail: MaterialTypeAgrochemical{
-					ChemicalType: v.ChemicalType,
+					ChemicalType:       v.ChemicalType,
+					PreHarvestInterval: v.PreHarvestInterval,
+					ReEntryInterval:    v.ReEntryInterval,
 				},
 			}
 		case domain.MaterialTypeGrowingMedium:
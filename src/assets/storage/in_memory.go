@@ -98,6 +98,36 @@ func CreateAreaReadStorage() *AreaReadStorage {
 	return &AreaReadStorage{AreaReadMap: make(map[uuid.UUID]AreaRead), Lock: &rwMutex}
 }
 
+type ClimateZoneEventStorage struct {
+	Lock              *deadlock.RWMutex
+	ClimateZoneEvents []ClimateZoneEvent
+}
+
+func CreateClimateZoneEventStorage() *ClimateZoneEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("CLIMATE ZONE EVENT STORAGE DEADLOCK!")
+	}
+
+	return &ClimateZoneEventStorage{Lock: &rwMutex}
+}
+
+type ClimateZoneReadStorage struct {
+	Lock               *deadlock.RWMutex
+	ClimateZoneReadMap map[uuid.UUID]ClimateZoneRead
+}
+
+func CreateClimateZoneReadStorage() *ClimateZoneReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("CLIMATE ZONE READ STORAGE DEADLOCK!")
+	}
+
+	return &ClimateZoneReadStorage{ClimateZoneReadMap: make(map[uuid.UUID]ClimateZoneRead), Lock: &rwMutex}
+}
+
 type MaterialEventStorage struct {
 	Lock           *deadlock.RWMutex
 	MaterialEvents []MaterialEvent
@@ -127,3 +157,48 @@ func CreateMaterialReadStorage() *MaterialReadStorage {
 
 	return &MaterialReadStorage{MaterialReadMap: make(map[uuid.UUID]MaterialRead), Lock: &rwMutex}
 }
+
+type ReservoirMeasurementStorage struct {
+	Lock                  *deadlock.RWMutex
+	ReservoirMeasurements []ReservoirMeasurementRead
+}
+
+func CreateReservoirMeasurementStorage() *ReservoirMeasurementStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("RESERVOIR MEASUREMENT STORAGE DEADLOCK!")
+	}
+
+	return &ReservoirMeasurementStorage{ReservoirMeasurements: []ReservoirMeasurementRead{}, Lock: &rwMutex}
+}
+
+type ReservoirOperationStorage struct {
+	Lock                *deadlock.RWMutex
+	ReservoirOperations []ReservoirOperationRead
+}
+
+func CreateReservoirOperationStorage() *ReservoirOperationStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("RESERVOIR OPERATION STORAGE DEADLOCK!")
+	}
+
+	return &ReservoirOperationStorage{ReservoirOperations: []ReservoirOperationRead{}, Lock: &rwMutex}
+}
+
+type MaterialConsumptionStorage struct {
+	Lock                 *deadlock.RWMutex
+	MaterialConsumptions []MaterialConsumptionRead
+}
+
+func CreateMaterialConsumptionStorage() *MaterialConsumptionStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("MATERIAL CONSUMPTION STORAGE DEADLOCK!")
+	}
+
+	return &MaterialConsumptionStorage{MaterialConsumptions: []MaterialConsumptionRead{}, Lock: &rwMutex}
+}
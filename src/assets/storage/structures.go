@@ -15,15 +15,16 @@ type FarmEvent struct {
 }
 
 type FarmRead struct {
-	UID         uuid.UUID `json:"uid"`
-	Name        string    `json:"name"`
-	Latitude    string    `json:"latitude"`
-	Longitude   string    `json:"longitude"`
-	Type        string    `json:"type"`
-	Country     string    `json:"country"`
-	City        string    `json:"city"`
-	IsActive    bool      `json:"is_active"`
-	CreatedDate time.Time `json:"created_date"`
+	UID             uuid.UUID `json:"uid"`
+	Name            string    `json:"name"`
+	Latitude        string    `json:"latitude"`
+	Longitude       string    `json:"longitude"`
+	Type            string    `json:"type"`
+	Country         string    `json:"country"`
+	City            string    `json:"city"`
+	IsActive        bool      `json:"is_active"`
+	CreatedDate     time.Time `json:"created_date"`
+	BatchIDTemplate string    `json:"batch_id_template"`
 }
 
 type ReservoirEvent struct {
@@ -34,13 +35,16 @@ type ReservoirEvent struct {
 }
 
 type ReservoirRead struct {
-	UID             uuid.UUID       `json:"uid"`
-	Name            string          `json:"name"`
-	WaterSource     WaterSource     `json:"water_source"`
-	Farm            ReservoirFarm   `json:"farm"`
-	Notes           []ReservoirNote `json:"notes"`
-	CreatedDate     time.Time       `json:"created_date"`
-	InstalledToArea []AreaInstalled `json:"installed_to_area"`
+	UID               uuid.UUID                   `json:"uid"`
+	Name              string                      `json:"name"`
+	WaterSource       WaterSource                 `json:"water_source"`
+	Farm              ReservoirFarm               `json:"farm"`
+	Notes             []ReservoirNote             `json:"notes"`
+	CreatedDate       time.Time                   `json:"created_date"`
+	InstalledToArea   []AreaInstalled             `json:"installed_to_area"`
+	LatestMeasurement *ReservoirLatestMeasurement `json:"latest_measurement"`
+	Volume            float32                     `json:"volume"`
+	Nutrients         []ReservoirNutrient         `json:"nutrients"`
 }
 
 type WaterSource struct {
@@ -54,6 +58,38 @@ type ReservoirFarm struct {
 }
 
 type ReservoirNote domain.ReservoirNote
+type ReservoirMeasurement domain.ReservoirMeasurement
+type ReservoirLatestMeasurement domain.ReservoirLatestMeasurement
+
+// ReservoirMeasurementRead is a row of the reservoir water quality log
+type ReservoirMeasurementRead struct {
+	ReservoirUID uuid.UUID `json:"reservoir_uid"`
+	ReservoirMeasurement
+}
+
+// ReservoirNutrient is a nutrient mixed in the reservoir.
+// The concentration is the estimated amount per litre of the current volume.
+type ReservoirNutrient struct {
+	MaterialUID   uuid.UUID `json:"material_uid"`
+	MaterialName  string    `json:"material_name"`
+	Amount        float32   `json:"amount"`
+	Unit          string    `json:"unit"`
+	Concentration float32   `json:"concentration"`
+}
+
+// ReservoirOperationRead is a row of the reservoir refill, drain and nutrient log
+type ReservoirOperationRead struct {
+	UID           uuid.UUID `json:"uid"`
+	ReservoirUID  uuid.UUID `json:"reservoir_uid"`
+	Type          string    `json:"type"`
+	Volume        float32   `json:"volume"`
+	MaterialUID   uuid.UUID `json:"material_uid"`
+	MaterialName  string    `json:"material_name"`
+	Amount        float32   `json:"amount"`
+	Unit          string    `json:"unit"`
+	VolumeAfter   float32   `json:"volume_after"`
+	OperationDate time.Time `json:"operation_date"`
+}
 
 type AreaInstalled struct {
 	UID  uuid.UUID `json:"uid"`
@@ -68,16 +104,17 @@ type AreaEvent struct {
 }
 
 type AreaRead struct {
-	UID         uuid.UUID     `json:"uid"`
-	Name        string        `json:"name"`
-	Size        AreaSize      `json:"size"`
-	Location    AreaLocation  `json:"location"`
-	Type        string        `json:"type"`
-	Photo       AreaPhoto     `json:"photo"`
-	CreatedDate time.Time     `json:"created_date"`
-	Notes       []AreaNote    `json:"notes"`
-	Farm        AreaFarm      `json:"farm"`
-	Reservoir   AreaReservoir `json:"reservoir"`
+	UID         uuid.UUID       `json:"uid"`
+	Name        string          `json:"name"`
+	Size        AreaSize        `json:"size"`
+	Location    AreaLocation    `json:"location"`
+	Type        string          `json:"type"`
+	Photo       AreaPhoto       `json:"photo"`
+	CreatedDate time.Time       `json:"created_date"`
+	Notes       []AreaNote      `json:"notes"`
+	Farm        AreaFarm        `json:"farm"`
+	Reservoir   AreaReservoir   `json:"reservoir"`
+	ClimateZone AreaClimateZone `json:"climate_zone"`
 }
 
 type AreaFarm struct {
@@ -90,12 +127,42 @@ type AreaReservoir struct {
 	Name string    `json:"name"`
 }
 
+// AreaClimateZone is the climate zone the area grows in, it is empty when the area is in no zone
+type AreaClimateZone struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
 type AreaSize domain.AreaSize
 type AreaLocation domain.AreaLocation
 type AreaType domain.AreaType
 type AreaPhoto domain.AreaPhoto
 type AreaNote domain.AreaNote
 
+type ClimateZoneEvent struct {
+	ClimateZoneUID uuid.UUID
+	Version        int
+	CreatedDate    time.Time
+	Event          interface{}
+}
+
+type ClimateZoneRead struct {
+	UID         uuid.UUID       `json:"uid"`
+	Name        string          `json:"name"`
+	Type        ClimateZoneType `json:"type"`
+	Targets     ClimateTargets  `json:"targets"`
+	Farm        ClimateZoneFarm `json:"farm"`
+	CreatedDate time.Time       `json:"created_date"`
+}
+
+type ClimateZoneFarm struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type ClimateZoneType domain.ClimateZoneType
+type ClimateTargets domain.ClimateTargets
+
 type MaterialEvent struct {
 	MaterialUID uuid.UUID
 	Version     int
@@ -114,11 +181,24 @@ type MaterialRead struct {
 	IsExpense      *bool            `json:"is_expense"`
 	ProducedBy     *string          `json:"produced_by"`
 	CreatedDate    time.Time        `json:"created_date"`
+	CropPlan       *CropPlan        `json:"crop_plan"`
+	SeedsPerUnit   float32          `json:"seeds_per_unit"`
+}
+
+type MaterialConsumptionRead struct {
+	MaterialUID  uuid.UUID `json:"material_uid"`
+	TaskUID      uuid.UUID `json:"task_uid"`
+	CropUID      uuid.UUID `json:"crop_uid"`
+	ReservoirUID uuid.UUID `json:"reservoir_uid"`
+	Quantity     float32   `json:"quantity"`
+	QuantityUnit string    `json:"quantity_unit"`
+	ConsumedDate time.Time `json:"consumed_date"`
 }
 
 type PricePerUnit domain.PricePerUnit
 type MaterialType domain.MaterialType
 type MaterialQuantity domain.MaterialQuantity
+type CropPlan domain.CropPlan
 
 type CropRead struct {
 	UID        uuid.UUID  `json:"uid"`
@@ -0,0 +1,292 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/validationhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Device is a sensor installed in an area or a reservoir which sends its readings to Tania.
+type Device struct {
+	UID         uuid.UUID
+	Name        string
+	SensorType  SensorType
+	AttachedTo  DeviceAttachment
+	FarmUID     uuid.UUID
+	TokenHash   string
+	CreatedDate time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+// DeviceService handles device behaviours that needs external interaction to be worked
+type DeviceService interface {
+	FindAreaByID(uid uuid.UUID) ServiceResult
+	FindReservoirByID(uid uuid.UUID) ServiceResult
+	FindClimateZoneByID(uid uuid.UUID) ServiceResult
+}
+
+// ServiceResult is the container for service result
+type ServiceResult struct {
+	Result interface{}
+	Error  error
+}
+
+// DeviceAttachmentServiceResult is the area, reservoir or climate zone the device is attached to
+type DeviceAttachmentServiceResult struct {
+	UID     uuid.UUID
+	Name    string
+	FarmUID uuid.UUID
+}
+
+const (
+	DeviceAttachmentArea        = "AREA"
+	DeviceAttachmentReservoir   = "RESERVOIR"
+	DeviceAttachmentClimateZone = "CLIMATE_ZONE"
+)
+
+// DeviceAttachment is the asset where the device measures its readings
+type DeviceAttachment struct {
+	Type string    `json:"type"`
+	UID  uuid.UUID `json:"uid"`
+}
+
+const (
+	SensorTypePH           = "PH"
+	SensorTypeEC           = "EC"
+	SensorTypeTemperature  = "TEMPERATURE"
+	SensorTypeHumidity     = "HUMIDITY"
+	SensorTypeSoilMoisture = "SOIL_MOISTURE"
+	SensorTypeWaterLevel   = "WATER_LEVEL"
+	SensorTypeLight        = "LIGHT"
+	SensorTypeCO2          = "CO2"
+)
+
+type SensorType struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Unit string `json:"unit"`
+}
+
+func SensorTypes() []SensorType {
+	return []SensorType{
+		{Code: SensorTypePH, Name: "pH", Unit: "pH"},
+		{Code: SensorTypeEC, Name: "Electrical Conductivity", Unit: "mS/cm"},
+		{Code: SensorTypeTemperature, Name: "Temperature", Unit: "°C"},
+		{Code: SensorTypeHumidity, Name: "Humidity", Unit: "%"},
+		{Code: SensorTypeSoilMoisture, Name: "Soil Moisture", Unit: "%"},
+		{Code: SensorTypeWaterLevel, Name: "Water Level", Unit: "cm"},
+		{Code: SensorTypeLight, Name: "Light (PPFD)", Unit: "µmol/m²/s"},
+		{Code: SensorTypeCO2, Name: "Carbon Dioxide", Unit: "ppm"},
+	}
+}
+
+func GetSensorType(code string) SensorType {
+	for _, v := range SensorTypes() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return SensorType{}
+}
+
+// DeviceReading is a single value measured by the device
+type DeviceReading struct {
+	Value        float32
+	RecordedDate time.Time
+}
+
+func (state *Device) TrackChange(event interface{}) {
+	state.UncommittedChanges = append(state.UncommittedChanges, event)
+	state.Transition(event)
+}
+
+func (state *Device) Transition(event interface{}) {
+	switch e := event.(type) {
+	case DeviceCreated:
+		state.UID = e.UID
+		state.Name = e.Name
+		state.SensorType = e.SensorType
+		state.AttachedTo = e.AttachedTo
+		state.FarmUID = e.FarmUID
+		state.TokenHash = e.TokenHash
+		state.CreatedDate = e.CreatedDate
+
+	case DeviceNameChanged:
+		state.Name = e.Name
+
+	case DeviceTokenRegenerated:
+		state.TokenHash = e.TokenHash
+
+	}
+}
+
+// CreateDevice registers a new sensor. The plain token is returned only once,
+// the device keeps its hash to authenticate the readings it sends.
+func CreateDevice(deviceService DeviceService, name, sensorTypeCode, attachmentType string, attachmentUID uuid.UUID) (*Device, string, error) {
+	err := validateDeviceName(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sensorType := GetSensorType(sensorTypeCode)
+	if sensorType == (SensorType{}) {
+		return nil, "", DeviceError{DeviceErrorInvalidSensorTypeCode}
+	}
+
+	var serviceResult ServiceResult
+	switch attachmentType {
+	case DeviceAttachmentArea:
+		serviceResult = deviceService.FindAreaByID(attachmentUID)
+	case DeviceAttachmentReservoir:
+		serviceResult = deviceService.FindReservoirByID(attachmentUID)
+	case DeviceAttachmentClimateZone:
+		serviceResult = deviceService.FindClimateZoneByID(attachmentUID)
+	default:
+		return nil, "", DeviceError{DeviceErrorInvalidAttachmentTypeCode}
+	}
+
+	if serviceResult.Error != nil {
+		return nil, "", serviceResult.Error
+	}
+
+	attachment, ok := serviceResult.Result.(DeviceAttachmentServiceResult)
+	if !ok || attachment.UID == (uuid.UUID{}) {
+		return nil, "", DeviceError{DeviceErrorAttachmentNotFoundCode}
+	}
+
+	token, tokenHash, err := generateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, "", err
+	}
+
+	device := &Device{}
+
+	device.TrackChange(DeviceCreated{
+		UID:        uid,
+		Name:       name,
+		SensorType: sensorType,
+		AttachedTo: DeviceAttachment{
+			Type: attachmentType,
+			UID:  attachment.UID,
+		},
+		FarmUID:     attachment.FarmUID,
+		TokenHash:   tokenHash,
+		CreatedDate: time.Now(),
+	})
+
+	return device, token, nil
+}
+
+func (d *Device) ChangeName(name string) error {
+	err := validateDeviceName(name)
+	if err != nil {
+		return err
+	}
+
+	d.TrackChange(DeviceNameChanged{
+		DeviceUID: d.UID,
+		Name:      name,
+	})
+
+	return nil
+}
+
+// RegenerateToken replaces the device token, the previous one can't be used anymore.
+func (d *Device) RegenerateToken() (string, error) {
+	token, tokenHash, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+
+	d.TrackChange(DeviceTokenRegenerated{
+		DeviceUID:       d.UID,
+		TokenHash:       tokenHash,
+		RegeneratedDate: time.Now(),
+	})
+
+	return token, nil
+}
+
+// IsTokenValid compares the token sent by the device with its stored hash
+func IsTokenValid(tokenHash, token string) bool {
+	if tokenHash == "" || token == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(tokenHash), []byte(hashToken(token))) == 1
+}
+
+// ValidateReading checks the value is physically possible for the sensor type
+func ValidateReading(sensorType SensorType, reading DeviceReading) error {
+	if reading.RecordedDate.IsZero() || reading.RecordedDate.After(time.Now().Add(5*time.Minute)) {
+		return DeviceError{DeviceErrorReadingInvalidDateCode}
+	}
+
+	valid := true
+	switch sensorType.Code {
+	case SensorTypePH:
+		valid = reading.Value >= 0 && reading.Value <= 14
+	case SensorTypeHumidity, SensorTypeSoilMoisture:
+		valid = reading.Value >= 0 && reading.Value <= 100
+	case SensorTypeEC, SensorTypeWaterLevel:
+		valid = reading.Value >= 0
+	case SensorTypeTemperature:
+		valid = reading.Value >= -50 && reading.Value <= 80
+	case SensorTypeLight:
+		// Full sunlight is about 2000 µmol/m²/s
+		valid = reading.Value >= 0 && reading.Value <= 3000
+	case SensorTypeCO2:
+		valid = reading.Value >= 0 && reading.Value <= 10000
+	}
+
+	if !valid {
+		return DeviceError{DeviceErrorReadingInvalidValueCode}
+	}
+
+	return nil
+}
+
+func generateToken() (string, string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := hex.EncodeToString(b)
+
+	return token, hashToken(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func validateDeviceName(name string) error {
+	if name == "" {
+		return DeviceError{DeviceErrorNameEmptyCode}
+	}
+	if !validationhelper.IsAlphanumSpaceHyphenUnderscore(name) {
+		return DeviceError{DeviceErrorNameAlphanumericOnlyCode}
+	}
+	if len(name) > 100 {
+		return DeviceError{DeviceErrorNameExceedMaximumCharacterCode}
+	}
+
+	return nil
+}
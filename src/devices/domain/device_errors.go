@@ -0,0 +1,48 @@
+package domain
+
+const (
+	DeviceErrorNameEmptyCode = iota
+	DeviceErrorNameAlphanumericOnlyCode
+	DeviceErrorNameExceedMaximumCharacterCode
+	DeviceErrorInvalidSensorTypeCode
+	DeviceErrorInvalidAttachmentTypeCode
+	DeviceErrorAttachmentNotFoundCode
+
+	// Device reading errors
+	DeviceErrorReadingInvalidValueCode
+	DeviceErrorReadingInvalidDateCode
+	DeviceErrorReadingEmptyCode
+	DeviceErrorInvalidTokenCode
+)
+
+// DeviceError is a custom error from Go built-in error
+type DeviceError struct {
+	Code int
+}
+
+func (e DeviceError) Error() string {
+	switch e.Code {
+	case DeviceErrorNameEmptyCode:
+		return "Device name is required"
+	case DeviceErrorNameAlphanumericOnlyCode:
+		return "Device name should be alphanumeric, space, hypen, or underscore"
+	case DeviceErrorNameExceedMaximumCharacterCode:
+		return "Device name cannot more than 100 characters"
+	case DeviceErrorInvalidSensorTypeCode:
+		return "Invalid sensor type"
+	case DeviceErrorInvalidAttachmentTypeCode:
+		return "Device can only be attached to an area or a reservoir"
+	case DeviceErrorAttachmentNotFoundCode:
+		return "Area or reservoir of the device not found"
+	case DeviceErrorReadingInvalidValueCode:
+		return "Reading value is out of the sensor range"
+	case DeviceErrorReadingInvalidDateCode:
+		return "Invalid reading date"
+	case DeviceErrorReadingEmptyCode:
+		return "Readings are required"
+	case DeviceErrorInvalidTokenCode:
+		return "Invalid device token"
+	default:
+		return "Unrecognized Device Error Code"
+	}
+}
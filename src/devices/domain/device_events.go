@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceCreated struct {
+	UID         uuid.UUID
+	Name        string
+	SensorType  SensorType
+	AttachedTo  DeviceAttachment
+	FarmUID     uuid.UUID
+	TokenHash   string
+	CreatedDate time.Time
+}
+
+type DeviceNameChanged struct {
+	DeviceUID uuid.UUID
+	Name      string
+}
+
+type DeviceTokenRegenerated struct {
+	DeviceUID       uuid.UUID
+	TokenHash       string
+	RegeneratedDate time.Time
+}
+
+// DeviceReadingRecorded is published for every stored reading so other modules can react to it.
+// Readings aren't part of the device history, so it is never saved in the event store.
+type DeviceReadingRecorded struct {
+	DeviceUID      uuid.UUID
+	DeviceName     string
+	SensorType     string
+	AttachmentType string
+	AttachmentUID  uuid.UUID
+	Value          float32
+	RecordedDate   time.Time
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeviceServiceMock struct {
+	mock.Mock
+}
+
+func (m *DeviceServiceMock) FindAreaByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
+
+func (m *DeviceServiceMock) FindReservoirByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
+
+func (m *DeviceServiceMock) FindClimateZoneByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
+
+func TestCreateDevice(t *testing.T) {
+	// Given
+	deviceServiceMock := new(DeviceServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	farmUID, _ := uuid.NewV4()
+	deviceServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: DeviceAttachmentServiceResult{UID: areaUID, Name: "Area 1", FarmUID: farmUID},
+	})
+
+	missingUID, _ := uuid.NewV4()
+	deviceServiceMock.On("FindReservoirByID", missingUID).Return(ServiceResult{
+		Result: DeviceAttachmentServiceResult{},
+	})
+
+	// When
+	device, token, err := CreateDevice(deviceServiceMock, "EC Probe 1", SensorTypeEC, DeviceAttachmentArea, areaUID)
+	_, _, errType := CreateDevice(deviceServiceMock, "EC Probe 1", "CO3", DeviceAttachmentArea, areaUID)
+	_, _, errMissing := CreateDevice(deviceServiceMock, "EC Probe 1", SensorTypeEC, DeviceAttachmentReservoir, missingUID)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, farmUID, device.FarmUID)
+	assert.Equal(t, SensorTypeEC, device.SensorType.Code)
+	assert.True(t, IsTokenValid(device.TokenHash, token))
+	assert.False(t, IsTokenValid(device.TokenHash, "wrong-token"))
+	assert.Equal(t, DeviceError{DeviceErrorInvalidSensorTypeCode}, errType)
+	assert.Equal(t, DeviceError{DeviceErrorAttachmentNotFoundCode}, errMissing)
+
+	// When
+	newToken, err := device.RegenerateToken()
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, IsTokenValid(device.TokenHash, token))
+	assert.True(t, IsTokenValid(device.TokenHash, newToken))
+}
+
+func TestValidateReading(t *testing.T) {
+	// Given
+	ph := GetSensorType(SensorTypePH)
+	now := time.Now()
+
+	// When
+	errValid := ValidateReading(ph, DeviceReading{Value: 6.2, RecordedDate: now})
+	errValue := ValidateReading(ph, DeviceReading{Value: 15, RecordedDate: now})
+	errDate := ValidateReading(ph, DeviceReading{Value: 6.2, RecordedDate: now.Add(time.Hour)})
+
+	// Then
+	assert.Nil(t, errValid)
+	assert.Equal(t, DeviceError{DeviceErrorReadingInvalidValueCode}, errValue)
+	assert.Equal(t, DeviceError{DeviceErrorReadingInvalidDateCode}, errDate)
+}
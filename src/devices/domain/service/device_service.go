@@ -0,0 +1,61 @@
+package service
+
+import (
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceServiceImpl struct {
+	AreaQuery        query.AreaQuery
+	ReservoirQuery   query.ReservoirQuery
+	ClimateZoneQuery query.ClimateZoneQuery
+}
+
+func (s DeviceServiceImpl) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.AreaQuery.FindByID(uid)
+	if result.Error != nil {
+		return domain.ServiceResult{Error: result.Error}
+	}
+
+	area, ok := result.Result.(query.DeviceAttachmentQueryResult)
+	if !ok {
+		return domain.ServiceResult{Error: domain.DeviceError{Code: domain.DeviceErrorAttachmentNotFoundCode}}
+	}
+
+	return domain.ServiceResult{
+		Result: domain.DeviceAttachmentServiceResult(area),
+	}
+}
+
+func (s DeviceServiceImpl) FindReservoirByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.ReservoirQuery.FindByID(uid)
+	if result.Error != nil {
+		return domain.ServiceResult{Error: result.Error}
+	}
+
+	reservoir, ok := result.Result.(query.DeviceAttachmentQueryResult)
+	if !ok {
+		return domain.ServiceResult{Error: domain.DeviceError{Code: domain.DeviceErrorAttachmentNotFoundCode}}
+	}
+
+	return domain.ServiceResult{
+		Result: domain.DeviceAttachmentServiceResult(reservoir),
+	}
+}
+
+func (s DeviceServiceImpl) FindClimateZoneByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.ClimateZoneQuery.FindByID(uid)
+	if result.Error != nil {
+		return domain.ServiceResult{Error: result.Error}
+	}
+
+	climateZone, ok := result.Result.(query.DeviceAttachmentQueryResult)
+	if !ok {
+		return domain.ServiceResult{Error: domain.DeviceError{Code: domain.DeviceErrorAttachmentNotFoundCode}}
+	}
+
+	return domain.ServiceResult{
+		Result: domain.DeviceAttachmentServiceResult(climateZone),
+	}
+}
@@ -0,0 +1,179 @@
+// Package mqtt subscribes to the topics the greenhouse controllers publish their readings to,
+// using the Eclipse Paho MQTT 3.1.1 client.
+package mqtt
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+// Options are the broker connection settings
+type Options struct {
+	BrokerURL string
+	ClientID  string
+	Username  string
+	Password  string
+	KeepAlive time.Duration
+}
+
+// Message is a message published to a subscribed topic
+type Message struct {
+	Topic   string
+	Payload []byte
+}
+
+// Client is a connection to a MQTT broker
+type Client struct {
+	client    paho.Client
+	messages  chan Message
+	lost      chan error
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+const timeout = 10 * time.Second
+
+// Connect dials the broker and waits for it to accept the connection.
+// The broker URL scheme is tcp or mqtt for plain connections, ssl, tls or mqtts for TLS connections.
+func Connect(options Options) (*Client, error) {
+	u, err := url.Parse(options.BrokerURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// MQTT 3.1.1 section 3.1.2.9 doesn't allow a password without a user name
+	if options.Password != "" && options.Username == "" {
+		return nil, errors.New("MQTT password requires a user name")
+	}
+
+	if options.KeepAlive == 0 {
+		options.KeepAlive = 30 * time.Second
+	}
+
+	broker := ""
+	switch u.Scheme {
+	case "tcp", "mqtt":
+		broker = "tcp://" + hostWithPort(u, "1883")
+	case "ssl", "tls", "mqtts":
+		broker = "ssl://" + hostWithPort(u, "8883")
+	default:
+		return nil, fmt.Errorf("Unsupported MQTT broker scheme %s", u.Scheme)
+	}
+
+	c := &Client{
+		messages: make(chan Message),
+		lost:     make(chan error, 1),
+		closed:   make(chan struct{}),
+	}
+
+	// Reconnecting is up to the caller, so a lost connection ends Listen
+	clientOptions := paho.NewClientOptions().
+		AddBroker(broker).
+		SetClientID(options.ClientID).
+		SetUsername(options.Username).
+		SetPassword(options.Password).
+		SetKeepAlive(options.KeepAlive).
+		SetCleanSession(true).
+		SetAutoReconnect(false).
+		SetConnectTimeout(timeout).
+		SetTLSConfig(&tls.Config{ServerName: u.Hostname()}).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			select {
+			case c.lost <- err:
+			default:
+			}
+		})
+
+	c.client = paho.NewClient(clientOptions)
+
+	token := c.client.Connect()
+	if !token.WaitTimeout(timeout) {
+		c.client.Disconnect(0)
+		return nil, errors.New("MQTT broker didn't answer the connection")
+	}
+
+	if token.Error() != nil {
+		return nil, token.Error()
+	}
+
+	return c, nil
+}
+
+func hostWithPort(u *url.URL, defaultPort string) string {
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+
+	return u.Host
+}
+
+// Subscribe asks the broker for the messages of the topic filters, with QoS 1 at most.
+// The messages are handled by Listen.
+func (c *Client) Subscribe(filters []string) error {
+	if len(filters) == 0 {
+		return errors.New("No MQTT topic to subscribe to")
+	}
+
+	qos := make(map[string]byte)
+	for _, v := range filters {
+		qos[v] = 1
+	}
+
+	token := c.client.SubscribeMultiple(qos, func(_ paho.Client, m paho.Message) {
+		select {
+		case c.messages <- Message{Topic: m.Topic(), Payload: m.Payload()}:
+		case <-c.closed:
+		}
+	})
+	if !token.WaitTimeout(timeout) {
+		return errors.New("MQTT broker didn't answer the subscription")
+	}
+
+	if token.Error() != nil {
+		return token.Error()
+	}
+
+	if subscribeToken, ok := token.(*paho.SubscribeToken); ok {
+		for _, v := range subscribeToken.Result() {
+			if v == 0x80 {
+				return errors.New("MQTT broker refused the subscription")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Listen calls the handler for every published message.
+// It blocks until the connection is lost or closed.
+func (c *Client) Listen(handler func(Message)) error {
+	defer c.Close()
+
+	for {
+		select {
+		case m := <-c.messages:
+			handler(m)
+		case err := <-c.lost:
+			return err
+		case <-c.closed:
+			return nil
+		}
+	}
+}
+
+// Close disconnects from the broker
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.client.Disconnect(250)
+	})
+
+	return nil
+}
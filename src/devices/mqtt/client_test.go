@@ -0,0 +1,119 @@
+package mqtt
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListen(t *testing.T) {
+	// Given
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer listener.Close()
+
+	subscribed := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		p, err := packets.ReadPacket(conn)
+		if _, ok := p.(*packets.ConnectPacket); err != nil || !ok {
+			return
+		}
+		packets.NewControlPacket(packets.Connack).Write(conn)
+
+		p, err = packets.ReadPacket(conn)
+		subscribe, ok := p.(*packets.SubscribePacket)
+		if err != nil || !ok {
+			return
+		}
+		subscribed <- subscribe.Topics[0]
+
+		suback := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+		suback.MessageID = subscribe.MessageID
+		suback.ReturnCodes = []byte{1}
+		suback.Write(conn)
+
+		publish := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+		publish.TopicName = "tania/devices/abc/readings"
+		publish.Qos = 1
+		publish.MessageID = 7
+		publish.Payload = []byte("6.5")
+		publish.Write(conn)
+
+		// Wait for the PUBACK and the DISCONNECT
+		packets.ReadPacket(conn)
+		packets.ReadPacket(conn)
+	}()
+
+	// When
+	_, errPassword := Connect(Options{BrokerURL: "tcp://" + listener.Addr().String(), ClientID: "tania", Password: "secret"})
+
+	client, err := Connect(Options{BrokerURL: "tcp://" + listener.Addr().String(), ClientID: "tania"})
+	assert.Nil(t, err)
+
+	err = client.Subscribe([]string{"tania/devices/+/readings"})
+	assert.Nil(t, err)
+
+	messages := make(chan Message, 1)
+	go client.Listen(func(m Message) {
+		messages <- m
+		client.Close()
+	})
+
+	// Then
+	assert.NotNil(t, errPassword)
+
+	select {
+	case filter := <-subscribed:
+		assert.Equal(t, "tania/devices/+/readings", filter)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Subscription not received")
+	}
+
+	select {
+	case m := <-messages:
+		assert.Equal(t, "tania/devices/abc/readings", m.Topic)
+		assert.Equal(t, "6.5", string(m.Payload))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Message not received")
+	}
+}
+
+func TestMapping(t *testing.T) {
+	// Given
+	patterns := ParseTopicPatterns("greenhouse/{device_id}/ph, tania/#")
+	template, err := ParsePayloadTemplate(`{"data": {"ph": "{value}"}, "ts": "{recorded_date}"}`)
+	assert.Nil(t, err)
+
+	// When
+	deviceID, ok := patterns[0].Match("greenhouse/abc/ph")
+	_, notOk := patterns[0].Match("greenhouse/abc/ec")
+	readings, mapErr := template.Map(deviceID, []byte(`[{"data": {"ph": 6.2}, "ts": 1500000000}, {"data": {"ph": "6.4"}}]`), time.Unix(1600000000, 0))
+
+	// Then
+	assert.Equal(t, "greenhouse/+/ph", patterns[0].Filter())
+	assert.True(t, ok)
+	assert.False(t, notOk)
+	assert.Equal(t, "abc", deviceID)
+
+	assert.Nil(t, mapErr)
+	assert.Len(t, readings, 2)
+	assert.Equal(t, float32(6.2), readings[0].Value)
+	assert.Equal(t, int64(1500000000), readings[0].RecordedDate.Unix())
+	assert.Equal(t, float32(6.4), readings[1].Value)
+	assert.Equal(t, int64(1600000000), readings[1].RecordedDate.Unix())
+
+	// When
+	_, err = ParsePayloadTemplate(`{"ph": "value"}`)
+
+	// Then
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,259 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Placeholders which can be used in the topic patterns and the payload template
+const (
+	PlaceholderDeviceID     = "{device_id}"
+	PlaceholderValue        = "{value}"
+	PlaceholderRecordedDate = "{recorded_date}"
+)
+
+// Reading is a sensor reading mapped from a MQTT message
+type Reading struct {
+	DeviceID     string
+	Value        float32
+	RecordedDate time.Time
+}
+
+// TopicPattern is a topic filter where one level may be {device_id},
+// for example greenhouse/{device_id}/ph
+type TopicPattern struct {
+	Levels []string
+}
+
+// ParseTopicPatterns parses the comma separated topic patterns
+func ParseTopicPatterns(patterns string) []TopicPattern {
+	topicPatterns := []TopicPattern{}
+	for _, v := range strings.Split(patterns, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		topicPatterns = append(topicPatterns, TopicPattern{Levels: strings.Split(v, "/")})
+	}
+
+	return topicPatterns
+}
+
+// Filter is the topic filter to subscribe to
+func (p TopicPattern) Filter() string {
+	levels := make([]string, len(p.Levels))
+	for i, v := range p.Levels {
+		if v == PlaceholderDeviceID {
+			v = "+"
+		}
+
+		levels[i] = v
+	}
+
+	return strings.Join(levels, "/")
+}
+
+// Match checks the topic against the pattern and returns the device ID found in the topic, if any
+func (p TopicPattern) Match(topic string) (string, bool) {
+	deviceID := ""
+	levels := strings.Split(topic, "/")
+
+	for i, v := range p.Levels {
+		if v == "#" {
+			return deviceID, true
+		}
+
+		if i >= len(levels) {
+			return "", false
+		}
+
+		switch v {
+		case PlaceholderDeviceID:
+			deviceID = levels[i]
+		case "+":
+		default:
+			if v != levels[i] {
+				return "", false
+			}
+		}
+	}
+
+	return deviceID, len(levels) == len(p.Levels)
+}
+
+// PayloadTemplate mirrors the JSON payload sent by the controllers, with placeholders
+// where the values are. For example {"sensor": "{device_id}", "data": {"ph": "{value}"}, "ts": "{recorded_date}"}.
+// An empty template means the payload is the value itself.
+type PayloadTemplate struct {
+	template interface{}
+}
+
+// ParsePayloadTemplate parses a JSON payload template
+func ParsePayloadTemplate(template string) (PayloadTemplate, error) {
+	if strings.TrimSpace(template) == "" {
+		return PayloadTemplate{}, nil
+	}
+
+	var t interface{}
+	err := json.Unmarshal([]byte(template), &t)
+	if err != nil {
+		return PayloadTemplate{}, err
+	}
+
+	if !containsPlaceholder(t, PlaceholderValue) {
+		return PayloadTemplate{}, errors.New("MQTT payload template must contain " + PlaceholderValue)
+	}
+
+	return PayloadTemplate{template: t}, nil
+}
+
+func containsPlaceholder(t interface{}, placeholder string) bool {
+	switch v := t.(type) {
+	case string:
+		return v == placeholder
+	case map[string]interface{}:
+		for _, child := range v {
+			if containsPlaceholder(child, placeholder) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if containsPlaceholder(child, placeholder) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Map extracts the readings from the message. The payload may also be a JSON array
+// of objects matching the template to send a batch of readings in one message.
+// The device ID in the payload takes precedence over the one in the topic.
+func (t PayloadTemplate) Map(deviceID string, payload []byte, receivedDate time.Time) ([]Reading, error) {
+	if t.template == nil {
+		value, err := strconv.ParseFloat(strings.TrimSpace(string(payload)), 32)
+		if err != nil {
+			return nil, err
+		}
+
+		return []Reading{{DeviceID: deviceID, Value: float32(value), RecordedDate: receivedDate}}, nil
+	}
+
+	var data interface{}
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []interface{}{data}
+	if list, ok := data.([]interface{}); ok {
+		if _, isList := t.template.([]interface{}); !isList {
+			items = list
+		}
+	}
+
+	readings := []Reading{}
+	for _, item := range items {
+		values := map[string]interface{}{}
+		extract(t.template, item, values)
+
+		reading := Reading{DeviceID: deviceID, RecordedDate: receivedDate}
+
+		if v, ok := values[PlaceholderDeviceID]; ok {
+			reading.DeviceID = toString(v)
+		}
+
+		value, ok := values[PlaceholderValue]
+		if !ok {
+			return nil, errors.New("Value not found in the MQTT payload")
+		}
+
+		reading.Value, err = toFloat(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := values[PlaceholderRecordedDate]; ok {
+			reading.RecordedDate, err = toTime(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings, nil
+}
+
+func extract(template, data interface{}, values map[string]interface{}) {
+	switch t := template.(type) {
+	case string:
+		if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+			values[t] = data
+		}
+	case map[string]interface{}:
+		d, ok := data.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		for key, child := range t {
+			if v, ok := d[key]; ok {
+				extract(child, v, values)
+			}
+		}
+	case []interface{}:
+		d, ok := data.([]interface{})
+		if !ok {
+			return
+		}
+
+		for i, child := range t {
+			if i < len(d) {
+				extract(child, d[i], values)
+			}
+		}
+	}
+}
+
+func toString(v interface{}) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case float64:
+		return strconv.FormatFloat(s, 'f', -1, 64)
+	}
+
+	return ""
+}
+
+func toFloat(v interface{}) (float32, error) {
+	switch f := v.(type) {
+	case float64:
+		return float32(f), nil
+	case string:
+		parsed, err := strconv.ParseFloat(f, 32)
+		return float32(parsed), err
+	}
+
+	return 0, errors.New("Invalid value in the MQTT payload")
+}
+
+// toTime accepts RFC3339 dates and unix timestamps in seconds
+func toTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case float64:
+		return time.Unix(int64(t), 0), nil
+	case string:
+		return time.Parse(time.RFC3339, t)
+	}
+
+	return time.Time{}, errors.New("Invalid recorded date in the MQTT payload")
+}
@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneQueryInMemory struct {
+	Storage *storage.ClimateZoneReadStorage
+}
+
+func NewClimateZoneQueryInMemory(s *storage.ClimateZoneReadStorage) query.ClimateZoneQuery {
+	return ClimateZoneQueryInMemory{Storage: s}
+}
+
+func (s ClimateZoneQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		climateZone := query.DeviceAttachmentQueryResult{}
+		if val, ok := s.Storage.ClimateZoneReadMap[uid]; ok {
+			climateZone.UID = val.UID
+			climateZone.Name = val.Name
+			climateZone.FarmUID = val.Farm.UID
+		}
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
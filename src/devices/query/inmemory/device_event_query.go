@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceEventQueryInMemory struct {
+	Storage *storage.DeviceEventStorage
+}
+
+func NewDeviceEventQueryInMemory(s *storage.DeviceEventStorage) query.DeviceEventQuery {
+	return &DeviceEventQueryInMemory{Storage: s}
+}
+
+func (f *DeviceEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.DeviceEvent{}
+		for _, v := range f.Storage.DeviceEvents {
+			if v.DeviceUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,64 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceReadQueryInMemory struct {
+	Storage *storage.DeviceReadStorage
+}
+
+func NewDeviceReadQueryInMemory(s *storage.DeviceReadStorage) query.DeviceReadQuery {
+	return &DeviceReadQueryInMemory{Storage: s}
+}
+
+func (f *DeviceReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.DeviceReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *DeviceReadQueryInMemory) FindAll(attachmentType string, attachmentUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		devices := []storage.DeviceRead{}
+		for _, v := range f.Storage.DeviceReadMap {
+			if attachmentType != "" && v.AttachedTo.Type != attachmentType {
+				continue
+			}
+
+			if attachmentUID != (uuid.UUID{}) && v.AttachedTo.UID != attachmentUID {
+				continue
+			}
+
+			devices = append(devices, v)
+		}
+
+		sort.Slice(devices, func(i, j int) bool {
+			return devices[i].CreatedDate.Before(devices[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: devices}
+
+		close(result)
+	}()
+
+	return result
+}
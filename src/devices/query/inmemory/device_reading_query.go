@@ -0,0 +1,72 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceReadingQueryInMemory struct {
+	Storage *storage.DeviceReadingStorage
+}
+
+func NewDeviceReadingQueryInMemory(s *storage.DeviceReadingStorage) query.DeviceReadingQuery {
+	return &DeviceReadingQueryInMemory{Storage: s}
+}
+
+func (f *DeviceReadingQueryInMemory) FindAllByDeviceID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		readings := []storage.DeviceReading{}
+		for _, v := range f.Storage.DeviceReadings {
+			if v.DeviceUID != uid {
+				continue
+			}
+
+			if v.RecordedDate.Before(from) || v.RecordedDate.After(to) {
+				continue
+			}
+
+			readings = append(readings, v)
+		}
+
+		sort.Slice(readings, func(i, j int) bool {
+			return readings[i].RecordedDate.Before(readings[j].RecordedDate)
+		})
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *DeviceReadingQueryInMemory) FindLatestByDeviceID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		latest := storage.DeviceReading{}
+		for _, v := range f.Storage.DeviceReadings {
+			if v.DeviceUID == uid && v.RecordedDate.After(latest.RecordedDate) {
+				latest = v
+			}
+		}
+
+		result <- query.QueryResult{Result: latest}
+
+		close(result)
+	}()
+
+	return result
+}
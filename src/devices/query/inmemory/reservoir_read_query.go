@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirQueryInMemory struct {
+	Storage *storage.ReservoirReadStorage
+}
+
+func NewReservoirQueryInMemory(s *storage.ReservoirReadStorage) query.ReservoirQuery {
+	return ReservoirQueryInMemory{Storage: s}
+}
+
+func (s ReservoirQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		reservoir := query.DeviceAttachmentQueryResult{}
+		if val, ok := s.Storage.ReservoirReadMap[uid]; ok {
+			reservoir.UID = val.UID
+			reservoir.Name = val.Name
+			reservoir.FarmUID = val.Farm.UID
+		}
+
+		result <- query.QueryResult{Result: reservoir}
+
+		close(result)
+	}()
+
+	return result
+}
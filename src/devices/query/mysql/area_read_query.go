@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AreaQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAreaQueryMysql(db *sql.DB) query.AreaQuery {
+	return AreaQueryMysql{DB: db}
+}
+
+func (s AreaQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID     []byte
+			Name    string
+			FarmUID []byte
+		}{}
+		area := query.DeviceAttachmentQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM AREA_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: area}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		areaUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		area.UID = areaUID
+		area.Name = rowsData.Name
+		area.FarmUID = farmUID
+
+		result <- query.QueryResult{Result: area}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneQueryMysql(db *sql.DB) query.ClimateZoneQuery {
+	return ClimateZoneQueryMysql{DB: db}
+}
+
+func (s ClimateZoneQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID     []byte
+			Name    string
+			FarmUID []byte
+		}{}
+		climateZone := query.DeviceAttachmentQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM CLIMATE_ZONE_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: climateZone}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		climateZoneUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		climateZone.UID = climateZoneUID
+		climateZone.Name = rowsData.Name
+		climateZone.FarmUID = farmUID
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,145 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceReadingQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewDeviceReadingQueryMysql(db *sql.DB) query.DeviceReadingQuery {
+	return &DeviceReadingQueryMysql{DB: db}
+}
+
+type deviceReadingResult struct {
+	DeviceUID      []byte
+	SensorType     string
+	AttachmentType string
+	AttachmentUID  []byte
+	Value          float32
+	RecordedDate   time.Time
+}
+
+func (f *DeviceReadingQueryMysql) FindAllByDeviceID(uid uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		readings := []storage.DeviceReading{}
+
+		rows, err := f.DB.Query(`SELECT DEVICE_UID, SENSOR_TYPE, ATTACHMENT_TYPE, ATTACHMENT_UID, VALUE, RECORDED_DATE
+			FROM DEVICE_READING
+			WHERE DEVICE_UID = ? AND RECORDED_DATE >= ? AND RECORDED_DATE <= ?
+			ORDER BY RECORDED_DATE ASC`, uid.Bytes(), from.UTC(), to.UTC())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := deviceReadingResult{}
+
+			err = rows.Scan(
+				&rowsData.DeviceUID,
+				&rowsData.SensorType,
+				&rowsData.AttachmentType,
+				&rowsData.AttachmentUID,
+				&rowsData.Value,
+				&rowsData.RecordedDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			reading, err := makeDeviceReading(rowsData)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			readings = append(readings, reading)
+		}
+
+		result <- query.QueryResult{Result: readings}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *DeviceReadingQueryMysql) FindLatestByDeviceID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := deviceReadingResult{}
+
+		err := f.DB.QueryRow(`SELECT DEVICE_UID, SENSOR_TYPE, ATTACHMENT_TYPE, ATTACHMENT_UID, VALUE, RECORDED_DATE
+			FROM DEVICE_READING
+			WHERE DEVICE_UID = ?
+			ORDER BY RECORDED_DATE DESC LIMIT 1`, uid.Bytes()).Scan(
+			&rowsData.DeviceUID,
+			&rowsData.SensorType,
+			&rowsData.AttachmentType,
+			&rowsData.AttachmentUID,
+			&rowsData.Value,
+			&rowsData.RecordedDate,
+		)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: storage.DeviceReading{}}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		reading, err := makeDeviceReading(rowsData)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: reading}
+		close(result)
+	}()
+
+	return result
+}
+
+func makeDeviceReading(rowsData deviceReadingResult) (storage.DeviceReading, error) {
+	deviceUID, err := uuid.FromBytes(rowsData.DeviceUID)
+	if err != nil {
+		return storage.DeviceReading{}, err
+	}
+
+	attachmentUID, err := uuid.FromBytes(rowsData.AttachmentUID)
+	if err != nil {
+		return storage.DeviceReading{}, err
+	}
+
+	recordedDate := rowsData.RecordedDate
+
+	return storage.DeviceReading{
+		DeviceUID:      deviceUID,
+		SensorType:     rowsData.SensorType,
+		AttachmentType: rowsData.AttachmentType,
+		AttachmentUID:  attachmentUID,
+		Value:          rowsData.Value,
+		RecordedDate:   recordedDate,
+	}, nil
+}
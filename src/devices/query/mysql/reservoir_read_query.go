@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ReservoirQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewReservoirQueryMysql(db *sql.DB) query.ReservoirQuery {
+	return ReservoirQueryMysql{DB: db}
+}
+
+func (s ReservoirQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID     []byte
+			Name    string
+			FarmUID []byte
+		}{}
+		reservoir := query.DeviceAttachmentQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM RESERVOIR_READ WHERE UID = ?`, uid.Bytes()).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: reservoir}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		reservoirUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		reservoir.UID = reservoirUID
+		reservoir.Name = rowsData.Name
+		reservoir.FarmUID = farmUID
+
+		result <- query.QueryResult{Result: reservoir}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,46 @@
+package query
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type QueryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type DeviceEventQuery interface {
+	FindAllByID(deviceUID uuid.UUID) <-chan QueryResult
+}
+
+type DeviceReadQuery interface {
+	FindByID(deviceUID uuid.UUID) <-chan QueryResult
+	FindAll(attachmentType string, attachmentUID uuid.UUID) <-chan QueryResult
+}
+
+type DeviceReadingQuery interface {
+	FindAllByDeviceID(deviceUID uuid.UUID, from, to time.Time) <-chan QueryResult
+	FindLatestByDeviceID(deviceUID uuid.UUID) <-chan QueryResult
+}
+
+type AreaQuery interface {
+	FindByID(areaUID uuid.UUID) <-chan QueryResult
+}
+
+type ReservoirQuery interface {
+	FindByID(reservoirUID uuid.UUID) <-chan QueryResult
+}
+
+type ClimateZoneQuery interface {
+	FindByID(climateZoneUID uuid.UUID) <-chan QueryResult
+}
+
+// QUERY RESULTS
+
+type DeviceAttachmentQueryResult struct {
+	UID     uuid.UUID `json:"uid"`
+	Name    string    `json:"name"`
+	FarmUID uuid.UUID `json:"farm_id"`
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/devices/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type ClimateZoneQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewClimateZoneQuerySqlite(db *sql.DB) query.ClimateZoneQuery {
+	return ClimateZoneQuerySqlite{DB: db}
+}
+
+func (s ClimateZoneQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := struct {
+			UID     string
+			Name    string
+			FarmUID string
+		}{}
+		climateZone := query.DeviceAttachmentQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, FARM_UID
+			FROM CLIMATE_ZONE_READ WHERE UID = ?`, uid).Scan(&rowsData.UID, &rowsData.Name, &rowsData.FarmUID)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: climateZone}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		climateZoneUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farmUID, err := uuid.FromString(rowsData.FarmUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		climateZone.UID = climateZoneUID
+		climateZone.Name = rowsData.Name
+		climateZone.FarmUID = farmUID
+
+		result <- query.QueryResult{Result: climateZone}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,177 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewDeviceReadQuerySqlite(db *sql.DB) query.DeviceReadQuery {
+	return &DeviceReadQuerySqlite{DB: db}
+}
+
+type deviceReadResult struct {
+	UID            string
+	Name           string
+	SensorType     string
+	AttachmentType string
+	AttachmentUID  string
+	AttachmentName string
+	FarmUID        string
+	TokenHash      string
+	CreatedDate    string
+}
+
+func (f *DeviceReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := deviceReadResult{}
+
+		err := f.DB.QueryRow(`SELECT * FROM DEVICE_READ WHERE UID = ?`, uid).Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.SensorType,
+			&rowsData.AttachmentType,
+			&rowsData.AttachmentUID,
+			&rowsData.AttachmentName,
+			&rowsData.FarmUID,
+			&rowsData.TokenHash,
+			&rowsData.CreatedDate,
+		)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: storage.DeviceRead{}}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deviceRead, err := makeDeviceRead(rowsData)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: deviceRead}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *DeviceReadQuerySqlite) FindAll(attachmentType string, attachmentUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		devices := []storage.DeviceRead{}
+
+		sql := "SELECT * FROM DEVICE_READ WHERE 1 = 1"
+		params := []interface{}{}
+
+		if attachmentType != "" {
+			sql += " AND ATTACHMENT_TYPE = ?"
+			params = append(params, attachmentType)
+		}
+
+		if attachmentUID != (uuid.UUID{}) {
+			sql += " AND ATTACHMENT_UID = ?"
+			params = append(params, attachmentUID)
+		}
+
+		sql += " ORDER BY CREATED_DATE ASC"
+
+		rows, err := f.DB.Query(sql, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := deviceReadResult{}
+
+			err = rows.Scan(
+				&rowsData.UID,
+				&rowsData.Name,
+				&rowsData.SensorType,
+				&rowsData.AttachmentType,
+				&rowsData.AttachmentUID,
+				&rowsData.AttachmentName,
+				&rowsData.FarmUID,
+				&rowsData.TokenHash,
+				&rowsData.CreatedDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			deviceRead, err := makeDeviceRead(rowsData)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			devices = append(devices, deviceRead)
+		}
+
+		result <- query.QueryResult{Result: devices}
+		close(result)
+	}()
+
+	return result
+}
+
+func makeDeviceRead(rowsData deviceReadResult) (storage.DeviceRead, error) {
+	deviceUID, err := uuid.FromString(rowsData.UID)
+	if err != nil {
+		return storage.DeviceRead{}, err
+	}
+
+	attachmentUID, err := uuid.FromString(rowsData.AttachmentUID)
+	if err != nil {
+		return storage.DeviceRead{}, err
+	}
+
+	farmUID, err := uuid.FromString(rowsData.FarmUID)
+	if err != nil {
+		return storage.DeviceRead{}, err
+	}
+
+	createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+	if err != nil {
+		return storage.DeviceRead{}, err
+	}
+
+	return storage.DeviceRead{
+		UID:        deviceUID,
+		Name:       rowsData.Name,
+		SensorType: storage.SensorType(domain.GetSensorType(rowsData.SensorType)),
+		AttachedTo: storage.DeviceAttachment{
+			Type: rowsData.AttachmentType,
+			UID:  attachmentUID,
+			Name: rowsData.AttachmentName,
+		},
+		FarmUID:     farmUID,
+		TokenHash:   rowsData.TokenHash,
+		CreatedDate: createdDate,
+	}, nil
+}
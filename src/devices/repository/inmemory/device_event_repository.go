@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceEventRepositoryInMemory struct {
+	Storage *storage.DeviceEventStorage
+}
+
+func NewDeviceEventRepositoryInMemory(s *storage.DeviceEventStorage) repository.DeviceEventRepository {
+	return &DeviceEventRepositoryInMemory{Storage: s}
+}
+
+func (f *DeviceEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.DeviceEvents = append(f.Storage.DeviceEvents, storage.DeviceEvent{
+				DeviceUID: uid,
+				Version:   latestVersion,
+				Event:     v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+type DeviceReadRepositoryInMemory struct {
+	Storage *storage.DeviceReadStorage
+}
+
+func NewDeviceReadRepositoryInMemory(s *storage.DeviceReadStorage) repository.DeviceReadRepository {
+	return &DeviceReadRepositoryInMemory{Storage: s}
+}
+
+func (f *DeviceReadRepositoryInMemory) Save(deviceRead *storage.DeviceRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.DeviceReadMap[deviceRead.UID] = *deviceRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
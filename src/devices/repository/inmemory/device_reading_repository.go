@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+type DeviceReadingRepositoryInMemory struct {
+	Storage *storage.DeviceReadingStorage
+}
+
+func NewDeviceReadingRepositoryInMemory(s *storage.DeviceReadingStorage) repository.DeviceReadingRepository {
+	return &DeviceReadingRepositoryInMemory{Storage: s}
+}
+
+func (f *DeviceReadingRepositoryInMemory) Save(readings []storage.DeviceReading) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.DeviceReadings = append(f.Storage.DeviceReadings, readings...)
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
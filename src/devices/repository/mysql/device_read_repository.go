@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+type DeviceReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewDeviceReadRepositoryMysql(db *sql.DB) repository.DeviceReadRepository {
+	return &DeviceReadRepositoryMysql{DB: db}
+}
+
+func (f *DeviceReadRepositoryMysql) Save(deviceRead *storage.DeviceRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM DEVICE_READ WHERE UID = ?`, deviceRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE DEVICE_READ SET
+				NAME = ?, SENSOR_TYPE = ?, ATTACHMENT_TYPE = ?, ATTACHMENT_UID = ?,
+				ATTACHMENT_NAME = ?, FARM_UID = ?, TOKEN_HASH = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				deviceRead.Name,
+				deviceRead.SensorType.Code,
+				deviceRead.AttachedTo.Type,
+				deviceRead.AttachedTo.UID.Bytes(),
+				deviceRead.AttachedTo.Name,
+				deviceRead.FarmUID.Bytes(),
+				deviceRead.TokenHash,
+				deviceRead.CreatedDate,
+				deviceRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO DEVICE_READ
+				(UID, NAME, SENSOR_TYPE, ATTACHMENT_TYPE, ATTACHMENT_UID, ATTACHMENT_NAME,
+				FARM_UID, TOKEN_HASH, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				deviceRead.UID.Bytes(),
+				deviceRead.Name,
+				deviceRead.SensorType.Code,
+				deviceRead.AttachedTo.Type,
+				deviceRead.AttachedTo.UID.Bytes(),
+				deviceRead.AttachedTo.Name,
+				deviceRead.FarmUID.Bytes(),
+				deviceRead.TokenHash,
+				deviceRead.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,61 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+type DeviceReadingRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewDeviceReadingRepositoryMysql(db *sql.DB) repository.DeviceReadingRepository {
+	return &DeviceReadingRepositoryMysql{DB: db}
+}
+
+func (f *DeviceReadingRepositoryMysql) Save(readings []storage.DeviceReading) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		// A batch of readings is stored all at once, or not at all
+		tx, err := f.DB.Begin()
+		if err != nil {
+			result <- err
+			return
+		}
+
+		stmt, err := tx.Prepare(`INSERT INTO DEVICE_READING
+			(DEVICE_UID, SENSOR_TYPE, ATTACHMENT_TYPE, ATTACHMENT_UID, VALUE, RECORDED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?)`)
+		if err != nil {
+			tx.Rollback()
+			result <- err
+			return
+		}
+		defer stmt.Close()
+
+		for _, v := range readings {
+			_, err = stmt.Exec(
+				v.DeviceUID.Bytes(),
+				v.SensorType,
+				v.AttachmentType,
+				v.AttachmentUID.Bytes(),
+				v.Value,
+				v.RecordedDate.UTC())
+
+			if err != nil {
+				tx.Rollback()
+				result <- err
+				return
+			}
+		}
+
+		result <- tx.Commit()
+	}()
+
+	return result
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RepositoryResult is a struct to wrap repository result
+// so its easy to use it in channel
+type RepositoryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type DeviceEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type DeviceReadRepository interface {
+	Save(deviceRead *storage.DeviceRead) <-chan error
+}
+
+type DeviceReadingRepository interface {
+	Save(readings []storage.DeviceReading) <-chan error
+}
+
+func NewDeviceFromHistory(events []storage.DeviceEvent) *domain.Device {
+	state := &domain.Device{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
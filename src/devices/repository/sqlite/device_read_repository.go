@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+type DeviceReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewDeviceReadRepositorySqlite(db *sql.DB) repository.DeviceReadRepository {
+	return &DeviceReadRepositorySqlite{DB: db}
+}
+
+func (f *DeviceReadRepositorySqlite) Save(deviceRead *storage.DeviceRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM DEVICE_READ WHERE UID = ?`, deviceRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE DEVICE_READ SET
+				NAME = ?, SENSOR_TYPE = ?, ATTACHMENT_TYPE = ?, ATTACHMENT_UID = ?,
+				ATTACHMENT_NAME = ?, FARM_UID = ?, TOKEN_HASH = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				deviceRead.Name,
+				deviceRead.SensorType.Code,
+				deviceRead.AttachedTo.Type,
+				deviceRead.AttachedTo.UID,
+				deviceRead.AttachedTo.Name,
+				deviceRead.FarmUID,
+				deviceRead.TokenHash,
+				deviceRead.CreatedDate.Format(time.RFC3339),
+				deviceRead.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO DEVICE_READ
+				(UID, NAME, SENSOR_TYPE, ATTACHMENT_TYPE, ATTACHMENT_UID, ATTACHMENT_NAME,
+				FARM_UID, TOKEN_HASH, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				deviceRead.UID,
+				deviceRead.Name,
+				deviceRead.SensorType.Code,
+				deviceRead.AttachedTo.Type,
+				deviceRead.AttachedTo.UID,
+				deviceRead.AttachedTo.Name,
+				deviceRead.FarmUID,
+				deviceRead.TokenHash,
+				deviceRead.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
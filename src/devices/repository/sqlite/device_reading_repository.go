@@ -0,0 +1,62 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+type DeviceReadingRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewDeviceReadingRepositorySqlite(db *sql.DB) repository.DeviceReadingRepository {
+	return &DeviceReadingRepositorySqlite{DB: db}
+}
+
+func (f *DeviceReadingRepositorySqlite) Save(readings []storage.DeviceReading) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		// A batch of readings is stored all at once, or not at all
+		tx, err := f.DB.Begin()
+		if err != nil {
+			result <- err
+			return
+		}
+
+		stmt, err := tx.Prepare(`INSERT INTO DEVICE_READING
+			(DEVICE_UID, SENSOR_TYPE, ATTACHMENT_TYPE, ATTACHMENT_UID, VALUE, RECORDED_DATE)
+			VALUES (?, ?, ?, ?, ?, ?)`)
+		if err != nil {
+			tx.Rollback()
+			result <- err
+			return
+		}
+		defer stmt.Close()
+
+		for _, v := range readings {
+			_, err = stmt.Exec(
+				v.DeviceUID,
+				v.SensorType,
+				v.AttachmentType,
+				v.AttachmentUID,
+				v.Value,
+				v.RecordedDate.UTC().Format(time.RFC3339))
+
+			if err != nil {
+				tx.Rollback()
+				result <- err
+				return
+			}
+		}
+
+		result <- tx.Commit()
+	}()
+
+	return result
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/mqtt"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MQTTBridge subscribes to the topics the greenhouse controllers publish to
+// and stores the messages as readings of the registered devices
+type MQTTBridge struct {
+	Options         mqtt.Options
+	TopicPatterns   []mqtt.TopicPattern
+	PayloadTemplate mqtt.PayloadTemplate
+}
+
+// StartMQTTBridge validates the MQTT configuration and keeps the bridge connected in the background.
+// The bridge is disabled when no broker URL is configured.
+func (s *DeviceServer) StartMQTTBridge() error {
+	if *config.Config.MqttBrokerURL == "" {
+		return nil
+	}
+
+	bridge, err := NewMQTTBridge(
+		*config.Config.MqttBrokerURL,
+		*config.Config.MqttClientID,
+		*config.Config.MqttUsername,
+		*config.Config.MqttPassword,
+		*config.Config.MqttTopics,
+		*config.Config.MqttPayloadTemplate,
+	)
+	if err != nil {
+		return err
+	}
+
+	go bridge.Run(s.SaveMQTTMessage)
+
+	return nil
+}
+
+func NewMQTTBridge(brokerURL, clientID, username, password, topics, payloadTemplate string) (*MQTTBridge, error) {
+	topicPatterns := mqtt.ParseTopicPatterns(topics)
+	if len(topicPatterns) == 0 {
+		return nil, NewRequestValidationError(REQUIRED, "mqtt_topics")
+	}
+
+	if password != "" && username == "" {
+		return nil, NewRequestValidationError(REQUIRED, "mqtt_username")
+	}
+
+	template, err := mqtt.ParsePayloadTemplate(payloadTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MQTTBridge{
+		Options: mqtt.Options{
+			BrokerURL: brokerURL,
+			ClientID:  clientID,
+			Username:  username,
+			Password:  password,
+		},
+		TopicPatterns:   topicPatterns,
+		PayloadTemplate: template,
+	}, nil
+}
+
+// Run connects to the broker and reconnects with an increasing delay whenever the connection is lost
+func (b *MQTTBridge) Run(save func(readings []mqtt.Reading) error) {
+	delay := time.Second
+
+	for {
+		err := b.listen(save, func() { delay = time.Second })
+		log.Error("MQTT bridge disconnected: ", err)
+
+		time.Sleep(delay)
+		if delay < time.Minute {
+			delay *= 2
+		}
+	}
+}
+
+func (b *MQTTBridge) listen(save func(readings []mqtt.Reading) error, connected func()) error {
+	client, err := mqtt.Connect(b.Options)
+	if err != nil {
+		return err
+	}
+
+	filters := []string{}
+	for _, v := range b.TopicPatterns {
+		filters = append(filters, v.Filter())
+	}
+
+	err = client.Subscribe(filters)
+	if err != nil {
+		client.Close()
+		return err
+	}
+
+	connected()
+	log.Info("MQTT bridge subscribed to ", filters)
+
+	return client.Listen(func(message mqtt.Message) {
+		readings, err := b.Map(message, time.Now())
+		if err != nil {
+			log.Warn("Ignoring MQTT message on ", message.Topic, ": ", err)
+			return
+		}
+
+		err = save(readings)
+		if err != nil {
+			log.Warn("Ignoring MQTT message on ", message.Topic, ": ", err)
+		}
+	})
+}
+
+// Map converts the message to readings using the first topic pattern it matches
+func (b *MQTTBridge) Map(message mqtt.Message, receivedDate time.Time) ([]mqtt.Reading, error) {
+	for _, v := range b.TopicPatterns {
+		deviceID, ok := v.Match(message.Topic)
+		if !ok {
+			continue
+		}
+
+		return b.PayloadTemplate.Map(deviceID, message.Payload, receivedDate)
+	}
+
+	return nil, NewRequestValidationError(NOT_FOUND, "topic")
+}
+
+// SaveMQTTMessage stores the readings of a MQTT message, grouped by device.
+// The broker authenticates the controllers, so the device token isn't required here.
+func (s *DeviceServer) SaveMQTTMessage(readings []mqtt.Reading) error {
+	deviceReadings := map[string][]domain.DeviceReading{}
+	deviceIDs := []string{}
+	for _, v := range readings {
+		if _, ok := deviceReadings[v.DeviceID]; !ok {
+			deviceIDs = append(deviceIDs, v.DeviceID)
+		}
+
+		deviceReadings[v.DeviceID] = append(deviceReadings[v.DeviceID], domain.DeviceReading{
+			Value:        v.Value,
+			RecordedDate: v.RecordedDate,
+		})
+	}
+
+	for _, id := range deviceIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return NewRequestValidationError(PARSE_FAILED, "device_id")
+		}
+
+		deviceRead, err := s.findDeviceRead(id)
+		if err != nil {
+			return err
+		}
+
+		err = s.saveReadings(deviceRead, deviceReadings[id])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
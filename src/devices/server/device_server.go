@@ -0,0 +1,497 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/domain/service"
+	"github.com/Tanibox/tania-core/src/devices/query"
+	queryInMem "github.com/Tanibox/tania-core/src/devices/query/inmemory"
+	queryMysql "github.com/Tanibox/tania-core/src/devices/query/mysql"
+	querySqlite "github.com/Tanibox/tania-core/src/devices/query/sqlite"
+	"github.com/Tanibox/tania-core/src/devices/repository"
+	repoInMem "github.com/Tanibox/tania-core/src/devices/repository/inmemory"
+	repoMysql "github.com/Tanibox/tania-core/src/devices/repository/mysql"
+	repoSqlite "github.com/Tanibox/tania-core/src/devices/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	"github.com/Tanibox/tania-core/src/eventbus"
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+)
+
+// MaxReadingsPerRequest limits the size of a batch of readings sent by a device
+const MaxReadingsPerRequest = 1000
+
+// DeviceServer ties the routes and handlers with injected dependencies
+type DeviceServer struct {
+	DeviceEventRepo    repository.DeviceEventRepository
+	DeviceEventQuery   query.DeviceEventQuery
+	DeviceReadRepo     repository.DeviceReadRepository
+	DeviceReadQuery    query.DeviceReadQuery
+	DeviceReadingRepo  repository.DeviceReadingRepository
+	DeviceReadingQuery query.DeviceReadingQuery
+	AreaQuery          query.AreaQuery
+	ReservoirQuery     query.ReservoirQuery
+	ClimateZoneQuery   query.ClimateZoneQuery
+	DeviceService      domain.DeviceService
+	EventBus           eventbus.TaniaEventBus
+}
+
+// NewDeviceServer initializes DeviceServer's dependencies and create new DeviceServer struct
+func NewDeviceServer(
+	db *sql.DB,
+	bus eventbus.TaniaEventBus,
+	areaReadStorage *assetsstorage.AreaReadStorage,
+	reservoirReadStorage *assetsstorage.ReservoirReadStorage,
+	climateZoneReadStorage *assetsstorage.ClimateZoneReadStorage,
+	deviceEventStorage *storage.DeviceEventStorage,
+	deviceReadStorage *storage.DeviceReadStorage,
+	deviceReadingStorage *storage.DeviceReadingStorage,
+) (*DeviceServer, error) {
+	deviceServer := &DeviceServer{
+		EventBus: bus,
+	}
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DB_INMEMORY:
+		deviceServer.DeviceEventRepo = repoInMem.NewDeviceEventRepositoryInMemory(deviceEventStorage)
+		deviceServer.DeviceEventQuery = queryInMem.NewDeviceEventQueryInMemory(deviceEventStorage)
+		deviceServer.DeviceReadRepo = repoInMem.NewDeviceReadRepositoryInMemory(deviceReadStorage)
+		deviceServer.DeviceReadQuery = queryInMem.NewDeviceReadQueryInMemory(deviceReadStorage)
+		deviceServer.DeviceReadingRepo = repoInMem.NewDeviceReadingRepositoryInMemory(deviceReadingStorage)
+		deviceServer.DeviceReadingQuery = queryInMem.NewDeviceReadingQueryInMemory(deviceReadingStorage)
+
+		deviceServer.AreaQuery = queryInMem.NewAreaQueryInMemory(areaReadStorage)
+		deviceServer.ReservoirQuery = queryInMem.NewReservoirQueryInMemory(reservoirReadStorage)
+		deviceServer.ClimateZoneQuery = queryInMem.NewClimateZoneQueryInMemory(climateZoneReadStorage)
+
+	case config.DB_SQLITE:
+		deviceServer.DeviceEventRepo = repoSqlite.NewDeviceEventRepositorySqlite(db)
+		deviceServer.DeviceEventQuery = querySqlite.NewDeviceEventQuerySqlite(db)
+		deviceServer.DeviceReadRepo = repoSqlite.NewDeviceReadRepositorySqlite(db)
+		deviceServer.DeviceReadQuery = querySqlite.NewDeviceReadQuerySqlite(db)
+		deviceServer.DeviceReadingRepo = repoSqlite.NewDeviceReadingRepositorySqlite(db)
+		deviceServer.DeviceReadingQuery = querySqlite.NewDeviceReadingQuerySqlite(db)
+
+		deviceServer.AreaQuery = querySqlite.NewAreaQuerySqlite(db)
+		deviceServer.ReservoirQuery = querySqlite.NewReservoirQuerySqlite(db)
+		deviceServer.ClimateZoneQuery = querySqlite.NewClimateZoneQuerySqlite(db)
+
+	case config.DB_MYSQL:
+		deviceServer.DeviceEventRepo = repoMysql.NewDeviceEventRepositoryMysql(db)
+		deviceServer.DeviceEventQuery = queryMysql.NewDeviceEventQueryMysql(db)
+		deviceServer.DeviceReadRepo = repoMysql.NewDeviceReadRepositoryMysql(db)
+		deviceServer.DeviceReadQuery = queryMysql.NewDeviceReadQueryMysql(db)
+		deviceServer.DeviceReadingRepo = repoMysql.NewDeviceReadingRepositoryMysql(db)
+		deviceServer.DeviceReadingQuery = queryMysql.NewDeviceReadingQueryMysql(db)
+
+		deviceServer.AreaQuery = queryMysql.NewAreaQueryMysql(db)
+		deviceServer.ReservoirQuery = queryMysql.NewReservoirQueryMysql(db)
+		deviceServer.ClimateZoneQuery = queryMysql.NewClimateZoneQueryMysql(db)
+	}
+
+	deviceServer.DeviceService = service.DeviceServiceImpl{
+		AreaQuery:        deviceServer.AreaQuery,
+		ReservoirQuery:   deviceServer.ReservoirQuery,
+		ClimateZoneQuery: deviceServer.ClimateZoneQuery,
+	}
+
+	deviceServer.InitSubscriber()
+
+	return deviceServer, nil
+}
+
+// InitSubscriber defines the mapping of which event this domain listen with their handler
+func (s *DeviceServer) InitSubscriber() {
+	s.EventBus.Subscribe("DeviceCreated", s.SaveToDeviceReadModel)
+	s.EventBus.Subscribe("DeviceNameChanged", s.SaveToDeviceReadModel)
+	s.EventBus.Subscribe("DeviceTokenRegenerated", s.SaveToDeviceReadModel)
+}
+
+// Mount defines the DeviceServer's endpoints with its handlers
+func (s *DeviceServer) Mount(g *echo.Group) {
+	g.GET("/sensor_types", s.GetSensorTypes)
+	g.POST("", s.SaveDevice)
+	g.GET("", s.FindAllDevices)
+	g.GET("/:id", s.FindDeviceByID)
+	g.PUT("/:id", s.UpdateDevice)
+	g.POST("/:id/token", s.RegenerateDeviceToken)
+	g.GET("/:id/readings", s.GetDeviceReadings)
+}
+
+// MountIngestion defines the endpoints used by the devices themselves.
+// They are authenticated by the device token instead of the user access token.
+func (s *DeviceServer) MountIngestion(g *echo.Group) {
+	g.POST("/devices/:id/readings", s.SaveDeviceReadings)
+}
+
+func (s *DeviceServer) GetSensorTypes(c echo.Context) error {
+	data := make(map[string][]domain.SensorType)
+	data["data"] = domain.SensorTypes()
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *DeviceServer) SaveDevice(c echo.Context) error {
+	attachmentUID, err := uuid.FromString(c.FormValue("attachment_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "attachment_id"))
+	}
+
+	// Process //
+	device, token, err := domain.CreateDevice(
+		s.DeviceService,
+		c.FormValue("name"),
+		c.FormValue("sensor_type"),
+		c.FormValue("attachment_type"),
+		attachmentUID,
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.DeviceEventRepo.Save(device.UID, 0, device.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(device)
+
+	// The token is only shown once, the device has to be configured with it right away
+	data := make(map[string]interface{})
+	data["data"] = MapToDeviceRead(s, *device)
+	data["token"] = token
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *DeviceServer) FindAllDevices(c echo.Context) error {
+	attachmentUID := uuid.UUID{}
+	if c.QueryParam("attachment_id") != "" {
+		uid, err := uuid.FromString(c.QueryParam("attachment_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "attachment_id"))
+		}
+
+		attachmentUID = uid
+	}
+
+	queryResult := <-s.DeviceReadQuery.FindAll(c.QueryParam("attachment_type"), attachmentUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	devices, ok := queryResult.Result.([]storage.DeviceRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.DeviceRead)
+	data["data"] = devices
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *DeviceServer) FindDeviceByID(c echo.Context) error {
+	deviceRead, err := s.findDeviceRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.DeviceReadingQuery.FindLatestByDeviceID(deviceRead.UID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	latest, ok := queryResult.Result.(storage.DeviceReading)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	detail := DeviceDetail{DeviceRead: deviceRead}
+	if latest.DeviceUID != (uuid.UUID{}) {
+		detail.LatestReading = &latest
+	}
+
+	data := make(map[string]DeviceDetail)
+	data["data"] = detail
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *DeviceServer) UpdateDevice(c echo.Context) error {
+	device, err := s.findDevice(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	name := c.FormValue("name")
+	if name != "" {
+		err = device.ChangeName(name)
+		if err != nil {
+			return Error(c, err)
+		}
+	}
+
+	err = <-s.DeviceEventRepo.Save(device.UID, device.Version, device.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(device)
+
+	data := make(map[string]storage.DeviceRead)
+	data["data"] = MapToDeviceRead(s, *device)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *DeviceServer) RegenerateDeviceToken(c echo.Context) error {
+	device, err := s.findDevice(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	token, err := device.RegenerateToken()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.DeviceEventRepo.Save(device.UID, device.Version, device.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(device)
+
+	data := make(map[string]interface{})
+	data["data"] = MapToDeviceRead(s, *device)
+	data["token"] = token
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetDeviceReadings returns the readings between from and to, the last 24 hours by default.
+// When an interval like 15m or 1h is given, the readings are downsampled into buckets of that interval.
+func (s *DeviceServer) GetDeviceReadings(c echo.Context) error {
+	deviceRead, err := s.findDeviceRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	to := time.Now()
+	if c.QueryParam("to") != "" {
+		to, err = time.Parse(time.RFC3339, c.QueryParam("to"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "to"))
+		}
+	}
+
+	from := to.Add(-24 * time.Hour)
+	if c.QueryParam("from") != "" {
+		from, err = time.Parse(time.RFC3339, c.QueryParam("from"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "from"))
+		}
+	}
+
+	if from.After(to) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "from"))
+	}
+
+	var interval time.Duration
+	if c.QueryParam("interval") != "" {
+		interval, err = time.ParseDuration(c.QueryParam("interval"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "interval"))
+		}
+
+		if interval < time.Minute {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "interval"))
+		}
+	}
+
+	queryResult := <-s.DeviceReadingQuery.FindAllByDeviceID(deviceRead.UID, from, to)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	readings, ok := queryResult.Result.([]storage.DeviceReading)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string]interface{})
+	if interval > 0 {
+		data["data"] = DownsampleReadings(readings, interval)
+	} else {
+		data["data"] = readings
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+type readingsRequest struct {
+	Readings []struct {
+		Value        *float32 `json:"value"`
+		RecordedDate string   `json:"recorded_date"`
+	} `json:"readings"`
+}
+
+// SaveDeviceReadings ingests a batch of readings sent by the device.
+// The device authenticates with its token in the X-Device-Token header.
+func (s *DeviceServer) SaveDeviceReadings(c echo.Context) error {
+	deviceRead, err := s.findDeviceRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if !domain.IsTokenValid(deviceRead.TokenHash, c.Request().Header.Get("X-Device-Token")) {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	request := readingsRequest{}
+	err = json.NewDecoder(c.Request().Body).Decode(&request)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "readings"))
+	}
+
+	if len(request.Readings) == 0 {
+		return Error(c, domain.DeviceError{Code: domain.DeviceErrorReadingEmptyCode})
+	}
+
+	if len(request.Readings) > MaxReadingsPerRequest {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "readings"))
+	}
+
+	readings := []domain.DeviceReading{}
+	for _, v := range request.Readings {
+		if v.Value == nil {
+			return Error(c, NewRequestValidationError(REQUIRED, "value"))
+		}
+
+		recordedDate := time.Now()
+		if v.RecordedDate != "" {
+			recordedDate, err = time.Parse(time.RFC3339, v.RecordedDate)
+			if err != nil {
+				return Error(c, NewRequestValidationError(PARSE_FAILED, "recorded_date"))
+			}
+		}
+
+		readings = append(readings, domain.DeviceReading{Value: *v.Value, RecordedDate: recordedDate})
+	}
+
+	err = s.saveReadings(deviceRead, readings)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]int)
+	data["data"] = len(readings)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// saveReadings validates the readings of the device and stores them along with the area or reservoir
+// the device is attached to, so they can be queried by asset too.
+func (s *DeviceServer) saveReadings(deviceRead storage.DeviceRead, readings []domain.DeviceReading) error {
+	sensorType := domain.SensorType(deviceRead.SensorType)
+
+	deviceReadings := []storage.DeviceReading{}
+	for _, v := range readings {
+		err := domain.ValidateReading(sensorType, v)
+		if err != nil {
+			return err
+		}
+
+		deviceReadings = append(deviceReadings, storage.DeviceReading{
+			DeviceUID:      deviceRead.UID,
+			SensorType:     sensorType.Code,
+			AttachmentType: deviceRead.AttachedTo.Type,
+			AttachmentUID:  deviceRead.AttachedTo.UID,
+			Value:          v.Value,
+			RecordedDate:   v.RecordedDate,
+		})
+	}
+
+	err := <-s.DeviceReadingRepo.Save(deviceReadings)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range deviceReadings {
+		s.EventBus.Publish("DeviceReadingRecorded", domain.DeviceReadingRecorded{
+			DeviceUID:      v.DeviceUID,
+			DeviceName:     deviceRead.Name,
+			SensorType:     v.SensorType,
+			AttachmentType: v.AttachmentType,
+			AttachmentUID:  v.AttachmentUID,
+			Value:          v.Value,
+			RecordedDate:   v.RecordedDate,
+		})
+	}
+
+	return nil
+}
+
+func (s *DeviceServer) findDeviceRead(id string) (storage.DeviceRead, error) {
+	deviceUID, err := uuid.FromString(id)
+	if err != nil {
+		return storage.DeviceRead{}, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	queryResult := <-s.DeviceReadQuery.FindByID(deviceUID)
+	if queryResult.Error != nil {
+		return storage.DeviceRead{}, queryResult.Error
+	}
+
+	deviceRead, ok := queryResult.Result.(storage.DeviceRead)
+	if !ok {
+		return storage.DeviceRead{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if deviceRead.UID == (uuid.UUID{}) {
+		return storage.DeviceRead{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return deviceRead, nil
+}
+
+func (s *DeviceServer) findDevice(id string) (*domain.Device, error) {
+	deviceRead, err := s.findDeviceRead(id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventQueryResult := <-s.DeviceEventQuery.FindAllByID(deviceRead.UID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.DeviceEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	return repository.NewDeviceFromHistory(events), nil
+}
+
+func (s *DeviceServer) publishUncommittedEvents(entity interface{}) error {
+	switch e := entity.(type) {
+	case *domain.Device:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/query"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	"github.com/labstack/gommon/log"
+)
+
+func (s *DeviceServer) SaveToDeviceReadModel(event interface{}) error {
+	deviceRead := &storage.DeviceRead{}
+
+	switch e := event.(type) {
+	case domain.DeviceCreated:
+		attachment, err := s.findAttachment(e.AttachedTo)
+		if err != nil {
+			log.Error(err)
+		}
+
+		deviceRead.UID = e.UID
+		deviceRead.Name = e.Name
+		deviceRead.SensorType = storage.SensorType(e.SensorType)
+		deviceRead.AttachedTo = storage.DeviceAttachment{
+			Type: e.AttachedTo.Type,
+			UID:  e.AttachedTo.UID,
+			Name: attachment.Name,
+		}
+		deviceRead.FarmUID = e.FarmUID
+		deviceRead.TokenHash = e.TokenHash
+		deviceRead.CreatedDate = e.CreatedDate
+
+	case domain.DeviceNameChanged:
+		queryResult := <-s.DeviceReadQuery.FindByID(e.DeviceUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		d, ok := queryResult.Result.(storage.DeviceRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		deviceRead = &d
+
+		deviceRead.Name = e.Name
+
+	case domain.DeviceTokenRegenerated:
+		queryResult := <-s.DeviceReadQuery.FindByID(e.DeviceUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		d, ok := queryResult.Result.(storage.DeviceRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		deviceRead = &d
+
+		deviceRead.TokenHash = e.TokenHash
+
+	}
+
+	err := <-s.DeviceReadRepo.Save(deviceRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+func (s *DeviceServer) findAttachment(attachment domain.DeviceAttachment) (query.DeviceAttachmentQueryResult, error) {
+	var queryResult query.QueryResult
+	switch attachment.Type {
+	case domain.DeviceAttachmentArea:
+		queryResult = <-s.AreaQuery.FindByID(attachment.UID)
+	case domain.DeviceAttachmentReservoir:
+		queryResult = <-s.ReservoirQuery.FindByID(attachment.UID)
+	case domain.DeviceAttachmentClimateZone:
+		queryResult = <-s.ClimateZoneQuery.FindByID(attachment.UID)
+	default:
+		return query.DeviceAttachmentQueryResult{}, domain.DeviceError{Code: domain.DeviceErrorInvalidAttachmentTypeCode}
+	}
+
+	if queryResult.Error != nil {
+		return query.DeviceAttachmentQueryResult{}, queryResult.Error
+	}
+
+	result, ok := queryResult.Result.(query.DeviceAttachmentQueryResult)
+	if !ok {
+		return query.DeviceAttachmentQueryResult{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+)
+
+// DeviceDetail is the device with its most recent reading
+type DeviceDetail struct {
+	storage.DeviceRead
+	LatestReading *storage.DeviceReading `json:"latest_reading"`
+}
+
+// ReadingBucket summarizes the readings recorded within one interval of a downsampled series
+type ReadingBucket struct {
+	StartDate time.Time `json:"start_date"`
+	Min       float32   `json:"min"`
+	Max       float32   `json:"max"`
+	Average   float32   `json:"average"`
+	Count     int       `json:"count"`
+}
+
+func MapToDeviceRead(s *DeviceServer, device domain.Device) storage.DeviceRead {
+	deviceRead := storage.DeviceRead{
+		UID:        device.UID,
+		Name:       device.Name,
+		SensorType: storage.SensorType(device.SensorType),
+		AttachedTo: storage.DeviceAttachment{
+			Type: device.AttachedTo.Type,
+			UID:  device.AttachedTo.UID,
+		},
+		FarmUID:     device.FarmUID,
+		TokenHash:   device.TokenHash,
+		CreatedDate: device.CreatedDate,
+	}
+
+	attachment, err := s.findAttachment(device.AttachedTo)
+	if err == nil {
+		deviceRead.AttachedTo.Name = attachment.Name
+	}
+
+	return deviceRead
+}
+
+// DownsampleReadings groups the readings, which must be sorted by date, into buckets of the interval
+func DownsampleReadings(readings []storage.DeviceReading, interval time.Duration) []ReadingBucket {
+	buckets := []ReadingBucket{}
+
+	var sum float32
+	for _, v := range readings {
+		start := v.RecordedDate.UTC().Truncate(interval)
+
+		last := len(buckets) - 1
+		if last < 0 || !buckets[last].StartDate.Equal(start) {
+			buckets = append(buckets, ReadingBucket{
+				StartDate: start,
+				Min:       v.Value,
+				Max:       v.Value,
+			})
+			last++
+			sum = 0
+		}
+
+		if v.Value < buckets[last].Min {
+			buckets[last].Min = v.Value
+		}
+		if v.Value > buckets[last].Max {
+			buckets[last].Max = v.Value
+		}
+
+		sum += v.Value
+		buckets[last].Count++
+		buckets[last].Average = sum / float32(buckets[last].Count)
+	}
+
+	return buckets
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	deadlock "github.com/sasha-s/go-deadlock"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceEventStorage struct {
+	Lock         *deadlock.RWMutex
+	DeviceEvents []DeviceEvent
+}
+
+func CreateDeviceEventStorage() *DeviceEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("DEVICE EVENT STORAGE DEADLOCK!")
+	}
+
+	return &DeviceEventStorage{Lock: &rwMutex}
+}
+
+type DeviceReadStorage struct {
+	Lock          *deadlock.RWMutex
+	DeviceReadMap map[uuid.UUID]DeviceRead
+}
+
+func CreateDeviceReadStorage() *DeviceReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("DEVICE READ STORAGE DEADLOCK!")
+	}
+
+	return &DeviceReadStorage{DeviceReadMap: make(map[uuid.UUID]DeviceRead), Lock: &rwMutex}
+}
+
+type DeviceReadingStorage struct {
+	Lock           *deadlock.RWMutex
+	DeviceReadings []DeviceReading
+}
+
+func CreateDeviceReadingStorage() *DeviceReadingStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("DEVICE READING STORAGE DEADLOCK!")
+	}
+
+	return &DeviceReadingStorage{Lock: &rwMutex}
+}
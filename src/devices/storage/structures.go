@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DeviceEvent struct {
+	DeviceUID   uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type DeviceRead struct {
+	UID         uuid.UUID        `json:"uid"`
+	Name        string           `json:"name"`
+	SensorType  SensorType       `json:"sensor_type"`
+	AttachedTo  DeviceAttachment `json:"attached_to"`
+	FarmUID     uuid.UUID        `json:"farm_id"`
+	TokenHash   string           `json:"-"`
+	CreatedDate time.Time        `json:"created_date"`
+}
+
+type SensorType domain.SensorType
+
+type DeviceAttachment struct {
+	Type string    `json:"type"`
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+// DeviceReading is a time-series point, it is stored as is without going through the events
+type DeviceReading struct {
+	DeviceUID      uuid.UUID `json:"device_id"`
+	SensorType     string    `json:"sensor_type"`
+	AttachmentType string    `json:"attachment_type"`
+	AttachmentUID  uuid.UUID `json:"attachment_id"`
+	Value          float32   `json:"value"`
+	RecordedDate   time.Time `json:"recorded_date"`
+}
@@ -1,16 +1,23 @@
 package eventbus
 
 import (
+	"sync"
+
 	"github.com/asaskevich/EventBus"
 )
 
 type TaniaEventBus interface {
 	Publish(eventName string, event interface{})
 	Subscribe(eventName string, handlerFunc interface{})
+	SubscribeAsync(eventName string, handlerFunc interface{})
+	SubscribeAll(handlerFunc func(eventName string, event interface{}))
 }
 
 type SimpleEventBus struct {
 	bus EventBus.Bus
+
+	lock        sync.RWMutex
+	allHandlers []func(eventName string, event interface{})
 }
 
 func NewSimpleEventBus(bus EventBus.Bus) *SimpleEventBus {
@@ -19,8 +26,32 @@ func NewSimpleEventBus(bus EventBus.Bus) *SimpleEventBus {
 
 func (e *SimpleEventBus) Publish(eventName string, event interface{}) {
 	e.bus.Publish(eventName, event)
+
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	for _, handler := range e.allHandlers {
+		handler(eventName, event)
+	}
 }
 
 func (e *SimpleEventBus) Subscribe(eventName string, handler interface{}) {
 	e.bus.Subscribe(eventName, handler)
 }
+
+// SubscribeAsync runs the handler in its own goroutine, one event at a time.
+// Handlers which publish events have to be subscribed this way, because
+// the bus is locked while the synchronous handlers run.
+func (e *SimpleEventBus) SubscribeAsync(eventName string, handler interface{}) {
+	e.bus.SubscribeAsync(eventName, handler, true)
+}
+
+// SubscribeAll calls the handler with every published event, whatever its name,
+// after the handlers subscribed to that name. It runs in the goroutine of the publisher,
+// so a handler with slow work, like a network call, has to do it in its own goroutine.
+func (e *SimpleEventBus) SubscribeAll(handler func(eventName string, event interface{})) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.allHandlers = append(e.allHandlers, handler)
+}
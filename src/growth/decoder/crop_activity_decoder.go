@@ -81,6 +81,76 @@ func (w *CropActivityTypeWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = a
 
+	case storage.CorrectionActivityCode:
+		a := storage.CorrectionActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.SplitActivityCode:
+		a := storage.SplitActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.MergeActivityCode:
+		a := storage.MergeActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.StageActivityCode:
+		a := storage.StageActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.FertilizeActivityCode:
+		a := storage.FertilizeActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.PruneActivityCode:
+		a := storage.PruneActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
+	case storage.PesticideActivityCode:
+		a := storage.PesticideActivity{}
+
+		_, err := Decode(f, &mapped, &a)
+		if err != nil {
+			return err
+		}
+
+		w.Data = a
+
 	case storage.PhotoActivityCode:
 		a := storage.PhotoActivity{}
 
@@ -140,55 +140,20 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		// This decoding is too complex so we do this here instead in DecodeHookFunc
 		if v, ok := mapped["UpdatedHarvestedStorage"]; ok {
-			mapped2, ok2 := v.(map[string]interface{})
-			if !ok2 {
-				return errors.New("Error type assertion")
-			}
-
-			harvestedStorage := domain.HarvestedStorage{}
-
-			if v2, ok2 := mapped2["quantity"]; ok2 {
-				val, ok3 := v2.(float64)
-				if !ok3 {
-					return errors.New("Error type assertion")
-				}
-
-				harvestedStorage.Quantity = int(val)
-			}
-			if v2, ok2 := mapped2["produced_gram_quantity"]; ok2 {
-				val, ok3 := v2.(float64)
-				if !ok3 {
-					return errors.New("Error type assertion")
-				}
-
-				harvestedStorage.ProducedGramQuantity = float32(val)
-			}
-			if v2, ok2 := mapped2["source_area_id"]; ok2 {
-				uid, err := makeUUID(v2)
-				if err != nil {
-					return err
-				}
-
-				harvestedStorage.SourceAreaUID = uid
-			}
-			if v2, ok2 := mapped2["created_date"]; ok2 {
-				val, err := makeTime(v2)
-				if err != nil {
-					return err
-				}
-
-				harvestedStorage.CreatedDate = val
+			harvestedStorage, err := makeHarvestedStorage(v)
+			if err != nil {
+				return err
 			}
-			if v2, ok2 := mapped2["last_updated"]; ok2 {
-				val, err := makeTime(v2)
-				if err != nil {
-					return err
-				}
 
-				harvestedStorage.LastUpdated = val
+			e.UpdatedHarvestedStorage = harvestedStorage
+		}
+		if v, ok := mapped["HarvestLot"]; ok {
+			harvestLot, err := makeHarvestLot(v)
+			if err != nil {
+				return err
 			}
 
-			e.UpdatedHarvestedStorage = harvestedStorage
+			e.HarvestLot = harvestLot
 		}
 		if v, ok := mapped["HarvestedArea"]; ok {
 			code, ok2 := mapped["HarvestedAreaCode"].(string)
@@ -216,53 +181,90 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
-	case "CropBatchDumped":
-		e := domain.CropBatchDumped{}
+	case "CropBatchSplit":
+		e := domain.CropBatchSplit{}
 
 		_, err := Decode(f, &mapped, &e)
 		if err != nil {
 			return err
 		}
 
-		if v, ok := mapped["UpdatedTrash"]; ok {
-			mapped2, ok2 := v.(map[string]interface{})
+		if v, ok := mapped["UpdatedSrcArea"]; ok {
+			code, ok2 := mapped["UpdatedSrcAreaCode"].(string)
 			if !ok2 {
 				return errors.New("Error type assertion")
 			}
 
-			trash := domain.Trash{}
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
 
-			if v2, ok2 := mapped2["quantity"]; ok2 {
-				val, ok3 := v2.(float64)
-				if !ok3 {
-					return errors.New("Error type assertion")
-				}
+			e.UpdatedSrcArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchMerged":
+		e := domain.CropBatchMerged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
 
-				trash.Quantity = int(val)
+		if v, ok := mapped["UpdatedArea"]; ok {
+			code, ok2 := mapped["UpdatedAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
 			}
-			if v2, ok2 := mapped2["source_area_id"]; ok2 {
-				uid, err := makeUUID(v2)
-				if err != nil {
-					return err
-				}
 
-				trash.SourceAreaUID = uid
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
 			}
-			if v2, ok2 := mapped2["created_date"]; ok2 {
-				val, err := makeTime(v2)
-				if err != nil {
-					return err
-				}
 
-				trash.CreatedDate = val
+			e.UpdatedArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchMergedInto":
+		e := domain.CropBatchMergedInto{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["UpdatedArea"]; ok {
+			code, ok2 := mapped["UpdatedAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
 			}
-			if v2, ok2 := mapped2["last_updated"]; ok2 {
-				val, err := makeTime(v2)
-				if err != nil {
-					return err
-				}
 
-				trash.LastUpdated = val
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchDumped":
+		e := domain.CropBatchDumped{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["UpdatedTrash"]; ok {
+			trash, err := makeTrash(v)
+			if err != nil {
+				return err
 			}
 
 			e.UpdatedTrash = trash
@@ -303,6 +305,167 @@ func (w *CropEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case "CropBatchHarvestCorrected":
+		e := domain.CropBatchHarvestCorrected{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["UpdatedHarvestedStorage"]; ok {
+			harvestedStorage, err := makeHarvestedStorage(v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedHarvestedStorage = harvestedStorage
+		}
+		if v, ok := mapped["RestoredArea"]; ok {
+			code, ok2 := mapped["RestoredAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.RestoredArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchDumpCorrected":
+		e := domain.CropBatchDumpCorrected{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["UpdatedTrash"]; ok {
+			trash, err := makeTrash(v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedTrash = trash
+		}
+		if v, ok := mapped["RestoredArea"]; ok {
+			code, ok2 := mapped["RestoredAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.RestoredArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchMoveCorrected":
+		e := domain.CropBatchMoveCorrected{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		if v, ok := mapped["UpdatedSrcArea"]; ok {
+			code, ok2 := mapped["UpdatedSrcAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedSrcArea = area
+		}
+		if v, ok := mapped["UpdatedDstArea"]; ok {
+			code, ok2 := mapped["UpdatedDstAreaCode"].(string)
+			if !ok2 {
+				return errors.New("Error type assertion")
+			}
+
+			area, err := makeCropArea(code, v)
+			if err != nil {
+				return err
+			}
+
+			e.UpdatedDstArea = area
+		}
+
+		w.Data = e
+
+	case "CropBatchWateringCorrected":
+		e := domain.CropBatchWateringCorrected{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchArchived":
+		e := domain.CropBatchArchived{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchStageChanged":
+		e := domain.CropBatchStageChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchFertilized":
+		e := domain.CropBatchFertilized{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchPruned":
+		e := domain.CropBatchPruned{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case "CropBatchPesticided":
+		e := domain.CropBatchPesticided{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
 	case "CropBatchPhotoCreated":
 		e := domain.CropBatchPhotoCreated{}
 
@@ -410,6 +573,18 @@ func makeCropContainer(v interface{}) (domain.CropContainer, error) {
 	}, nil
 }
 
+// makeCropArea decodes an area which is either the initial area or a moved area depending on its code
+func makeCropArea(code string, v interface{}) (interface{}, error) {
+	if code == "INITIAL_AREA" {
+		return makeCropInitialArea(v)
+	}
+	if code == "MOVED_AREA" {
+		return makeCropMovedArea(v)
+	}
+
+	return nil, nil
+}
+
 func makeCropInitialArea(v interface{}) (domain.InitialArea, error) {
 	initialArea := domain.InitialArea{}
 	mapped, ok := v.(map[string]interface{})
@@ -480,10 +655,192 @@ func makeCropInitialArea(v interface{}) (domain.InitialArea, error) {
 		}
 		initialArea.LastPruned = val
 	}
+	if v, ok := mapped["safe_harvest_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.InitialArea{}, err
+		}
+		initialArea.SafeHarvestDate = val
+	}
 
 	return initialArea, nil
 }
 
+func makeHarvestLot(v interface{}) (domain.HarvestLot, error) {
+	harvestLot := domain.HarvestLot{}
+	mapped, ok := v.(map[string]interface{})
+	if !ok {
+		return domain.HarvestLot{}, errors.New("Error type assertion")
+	}
+
+	if v, ok := mapped["uid"]; ok {
+		uid, err := makeUUID(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.UID = uid
+	}
+	if v, ok := mapped["code"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.Code = val
+	}
+	if v, ok := mapped["source_area_id"]; ok {
+		uid, err := makeUUID(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.SourceAreaUID = uid
+	}
+	if v, ok := mapped["harvest_type"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.HarvestType = val
+	}
+	if v, ok := mapped["quantity"]; ok {
+		qty, ok2 := v.(float64)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.Quantity = int(qty)
+	}
+	if v, ok := mapped["produced_gram_quantity"]; ok {
+		qty, ok2 := v.(float64)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.ProducedGramQuantity = float32(qty)
+	}
+	if v, ok := mapped["grade"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.Grade = val
+	}
+	if v, ok := mapped["destination"]; ok {
+		val, ok2 := v.(string)
+		if !ok2 {
+			return domain.HarvestLot{}, errors.New("Error type assertion")
+		}
+		harvestLot.Destination = val
+	}
+	if v, ok := mapped["harvest_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.HarvestDate = val
+	}
+	if v, ok := mapped["corrected_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.HarvestLot{}, err
+		}
+		harvestLot.CorrectedDate = val
+	}
+
+	return harvestLot, nil
+}
+
+func makeHarvestedStorage(v interface{}) (domain.HarvestedStorage, error) {
+	harvestedStorage := domain.HarvestedStorage{}
+	mapped, ok := v.(map[string]interface{})
+	if !ok {
+		return domain.HarvestedStorage{}, errors.New("Error type assertion")
+	}
+
+	if v, ok := mapped["quantity"]; ok {
+		val, ok2 := v.(float64)
+		if !ok2 {
+			return domain.HarvestedStorage{}, errors.New("Error type assertion")
+		}
+
+		harvestedStorage.Quantity = int(val)
+	}
+	if v, ok := mapped["produced_gram_quantity"]; ok {
+		val, ok2 := v.(float64)
+		if !ok2 {
+			return domain.HarvestedStorage{}, errors.New("Error type assertion")
+		}
+
+		harvestedStorage.ProducedGramQuantity = float32(val)
+	}
+	if v, ok := mapped["source_area_id"]; ok {
+		uid, err := makeUUID(v)
+		if err != nil {
+			return domain.HarvestedStorage{}, err
+		}
+
+		harvestedStorage.SourceAreaUID = uid
+	}
+	if v, ok := mapped["created_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.HarvestedStorage{}, err
+		}
+
+		harvestedStorage.CreatedDate = val
+	}
+	if v, ok := mapped["last_updated"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.HarvestedStorage{}, err
+		}
+
+		harvestedStorage.LastUpdated = val
+	}
+
+	return harvestedStorage, nil
+}
+
+func makeTrash(v interface{}) (domain.Trash, error) {
+	trash := domain.Trash{}
+	mapped, ok := v.(map[string]interface{})
+	if !ok {
+		return domain.Trash{}, errors.New("Error type assertion")
+	}
+
+	if v, ok := mapped["quantity"]; ok {
+		val, ok2 := v.(float64)
+		if !ok2 {
+			return domain.Trash{}, errors.New("Error type assertion")
+		}
+
+		trash.Quantity = int(val)
+	}
+	if v, ok := mapped["source_area_id"]; ok {
+		uid, err := makeUUID(v)
+		if err != nil {
+			return domain.Trash{}, err
+		}
+
+		trash.SourceAreaUID = uid
+	}
+	if v, ok := mapped["created_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.Trash{}, err
+		}
+
+		trash.CreatedDate = val
+	}
+	if v, ok := mapped["last_updated"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.Trash{}, err
+		}
+
+		trash.LastUpdated = val
+	}
+
+	return trash, nil
+}
+
 func makeCropMovedArea(v interface{}) (domain.MovedArea, error) {
 	movedArea := domain.MovedArea{}
 	mapped, ok := v.(map[string]interface{})
@@ -563,6 +920,13 @@ func makeCropMovedArea(v interface{}) (domain.MovedArea, error) {
 		}
 		movedArea.LastPruned = val
 	}
+	if v, ok := mapped["safe_harvest_date"]; ok {
+		val, err := makeTime(v)
+		if err != nil {
+			return domain.MovedArea{}, err
+		}
+		movedArea.SafeHarvestDate = val
+	}
 
 	return movedArea, nil
 }
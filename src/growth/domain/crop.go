@@ -1,11 +1,9 @@
 package domain
 
 import (
-	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/src/growth/query"
-	"github.com/Tanibox/tania-core/src/helper/stringhelper"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -14,6 +12,7 @@ type Crop struct {
 	BatchID      string
 	Status       CropStatus
 	Type         CropType
+	Stage        CropStage
 	Container    CropContainer
 	InventoryUID uuid.UUID
 	FarmUID      uuid.UUID
@@ -23,8 +22,15 @@ type Crop struct {
 	InitialArea      InitialArea
 	MovedArea        []MovedArea
 	HarvestedStorage []HarvestedStorage
+	HarvestLots      []HarvestLot
 	Trash            []Trash
 
+	// Batches this batch was split from or merged with
+	Lineage []CropLineage
+
+	// Events of the batch which were recorded by mistake and have been reversed
+	Corrections []CropCorrection
+
 	// Fields to track care crop
 	LastFertilized time.Time
 	LastPruned     time.Time
@@ -42,7 +48,9 @@ type Crop struct {
 type CropService interface {
 	FindMaterialByID(uid uuid.UUID) ServiceResult
 	FindByBatchID(batchID string) ServiceResult
+	ReserveBatchID(batchID string) ServiceResult
 	FindAreaByID(uid uuid.UUID) ServiceResult
+	FindFarmByID(uid uuid.UUID) ServiceResult
 }
 
 // ServiceResult is the container for service result
@@ -142,6 +150,9 @@ type InitialArea struct {
 	LastFertilized time.Time `json:"last_fertilized"`
 	LastPruned     time.Time `json:"last_pruned"`
 	LastPesticided time.Time `json:"last_pesticided"`
+
+	// SafeHarvestDate is when the pre-harvest interval of the last agrochemical application ends
+	SafeHarvestDate time.Time `json:"safe_harvest_date"`
 }
 
 type MovedArea struct {
@@ -156,6 +167,9 @@ type MovedArea struct {
 	LastFertilized time.Time `json:"last_fertilized"`
 	LastPruned     time.Time `json:"last_pruned"`
 	LastPesticided time.Time `json:"last_pesticided"`
+
+	// SafeHarvestDate is when the pre-harvest interval of the last agrochemical application ends
+	SafeHarvestDate time.Time `json:"safe_harvest_date"`
 }
 
 type HarvestedStorage struct {
@@ -255,17 +269,64 @@ func (state *Crop) Transition(event interface{}) {
 		state.BatchID = e.BatchID
 		state.Status = e.Status
 		state.Type = e.Type
+		state.Stage = GetCropStage(CropStageGerminating)
 		state.Container = e.Container
 		state.InventoryUID = e.InventoryUID
 		state.InitialArea = InitialArea{
 			AreaUID:         e.InitialAreaUID,
 			InitialQuantity: e.Quantity,
 			CurrentQuantity: e.Quantity,
+			SafeHarvestDate: e.SafeHarvestDate,
 			CreatedDate:     e.CreatedDate,
 			LastUpdated:     e.CreatedDate,
 		}
 		state.FarmUID = e.FarmUID
 
+		if e.ParentUID != (uuid.UUID{}) {
+			state.Lineage = append(state.Lineage, CropLineage{
+				Type:     CropLineageSplitFrom,
+				CropUID:  e.ParentUID,
+				BatchID:  e.ParentBatchID,
+				AreaUID:  e.InitialAreaUID,
+				Quantity: e.Quantity,
+				Date:     e.SplitDate,
+			})
+		}
+
+	case CropBatchSplit:
+		state.replaceArea(e.UpdatedSrcAreaCode, e.UpdatedSrcArea)
+		state.Lineage = append(state.Lineage, CropLineage{
+			Type:     CropLineageSplitInto,
+			CropUID:  e.SplitCropUID,
+			BatchID:  e.SplitBatchID,
+			AreaUID:  e.SrcAreaUID,
+			Quantity: e.Quantity,
+			Date:     e.SplitDate,
+		})
+
+	case CropBatchMerged:
+		state.replaceArea(e.UpdatedAreaCode, e.UpdatedArea)
+		state.Lineage = append(state.Lineage, CropLineage{
+			Type:     CropLineageMergedFrom,
+			CropUID:  e.MergedCropUID,
+			BatchID:  e.MergedBatchID,
+			AreaUID:  e.AreaUID,
+			Quantity: e.Quantity,
+			Date:     e.MergeDate,
+		})
+
+	case CropBatchMergedInto:
+		state.replaceArea(e.UpdatedAreaCode, e.UpdatedArea)
+		state.Lineage = append(state.Lineage, CropLineage{
+			Type:     CropLineageMergedInto,
+			CropUID:  e.TargetCropUID,
+			BatchID:  e.TargetBatchID,
+			AreaUID:  e.AreaUID,
+			Quantity: e.Quantity,
+			Date:     e.MergeDate,
+		})
+		state.Status = GetCropStatus(e.CropStatus)
+
 	case CropBatchInventoryChanged:
 		state.InventoryUID = e.InventoryUID
 		state.BatchID = e.BatchID
@@ -273,6 +334,12 @@ func (state *Crop) Transition(event interface{}) {
 	case CropBatchTypeChanged:
 		state.Type = e.Type
 
+	case CropBatchArchived:
+		state.Status = GetCropStatus(CropArchived)
+
+	case CropBatchStageChanged:
+		state.Stage = GetCropStage(e.Stage)
+
 	case CropBatchContainerChanged:
 		state.Container = e.Container
 		state.InitialArea.CurrentQuantity = e.Container.Quantity
@@ -334,6 +401,11 @@ func (state *Crop) Transition(event interface{}) {
 			state.HarvestedStorage = append(state.HarvestedStorage, e.UpdatedHarvestedStorage)
 		}
 
+		// Harvests recorded before harvest lots were introduced don't have a lot
+		if e.HarvestLot.UID != (uuid.UUID{}) {
+			state.HarvestLots = append(state.HarvestLots, e.HarvestLot)
+		}
+
 		if e.HarvestedAreaCode == "INITIAL_AREA" {
 			ha := e.HarvestedArea.(InitialArea)
 			state.InitialArea = ha
@@ -377,6 +449,72 @@ func (state *Crop) Transition(event interface{}) {
 
 		state.Status = GetCropStatus(e.CropStatus)
 
+	case CropBatchHarvestCorrected:
+		for i, v := range state.HarvestedStorage {
+			if v.SourceAreaUID == e.UpdatedHarvestedStorage.SourceAreaUID {
+				state.HarvestedStorage[i] = e.UpdatedHarvestedStorage
+			}
+		}
+
+		for i, v := range state.HarvestLots {
+			if v.Code == e.HarvestLotCode {
+				state.HarvestLots[i].CorrectedDate = e.CorrectionDate
+			}
+		}
+
+		state.replaceArea(e.RestoredAreaCode, e.RestoredArea)
+		state.Status = GetCropStatus(e.CropStatus)
+		state.Corrections = append(state.Corrections, CropCorrection{
+			OriginalVersion: e.OriginalVersion,
+			Type:            CropCorrectionHarvest,
+			Reason:          e.Reason,
+			CorrectionDate:  e.CorrectionDate,
+		})
+
+	case CropBatchDumpCorrected:
+		for i, v := range state.Trash {
+			if v.SourceAreaUID == e.UpdatedTrash.SourceAreaUID {
+				state.Trash[i] = e.UpdatedTrash
+			}
+		}
+
+		state.replaceArea(e.RestoredAreaCode, e.RestoredArea)
+		state.Status = GetCropStatus(e.CropStatus)
+		state.Corrections = append(state.Corrections, CropCorrection{
+			OriginalVersion: e.OriginalVersion,
+			Type:            CropCorrectionDump,
+			Reason:          e.Reason,
+			CorrectionDate:  e.CorrectionDate,
+		})
+
+	case CropBatchMoveCorrected:
+		state.replaceArea(e.UpdatedSrcAreaCode, e.UpdatedSrcArea)
+		state.replaceArea(e.UpdatedDstAreaCode, e.UpdatedDstArea)
+		state.Corrections = append(state.Corrections, CropCorrection{
+			OriginalVersion: e.OriginalVersion,
+			Type:            CropCorrectionMove,
+			Reason:          e.Reason,
+			CorrectionDate:  e.CorrectionDate,
+		})
+
+	case CropBatchWateringCorrected:
+		if state.InitialArea.AreaUID == e.AreaUID {
+			state.InitialArea.LastWatered = e.RestoredLastWatered
+		}
+
+		for i, v := range state.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				state.MovedArea[i].LastWatered = e.RestoredLastWatered
+			}
+		}
+
+		state.Corrections = append(state.Corrections, CropCorrection{
+			OriginalVersion: e.OriginalVersion,
+			Type:            CropCorrectionWatering,
+			Reason:          e.Reason,
+			CorrectionDate:  e.CorrectionDate,
+		})
+
 	case CropBatchWatered:
 		if state.InitialArea.AreaUID == e.AreaUID {
 			state.InitialArea.LastWatered = e.WateringDate
@@ -388,6 +526,61 @@ func (state *Crop) Transition(event interface{}) {
 			}
 		}
 
+	case CropBatchFertilized:
+		state.LastFertilized = e.FertilizingDate
+
+		if state.InitialArea.AreaUID == e.AreaUID {
+			state.InitialArea.LastFertilized = e.FertilizingDate
+
+			if e.SafeHarvestDate.After(state.InitialArea.SafeHarvestDate) {
+				state.InitialArea.SafeHarvestDate = e.SafeHarvestDate
+			}
+		}
+
+		for i, v := range state.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				state.MovedArea[i].LastFertilized = e.FertilizingDate
+
+				if e.SafeHarvestDate.After(v.SafeHarvestDate) {
+					state.MovedArea[i].SafeHarvestDate = e.SafeHarvestDate
+				}
+			}
+		}
+
+	case CropBatchPruned:
+		state.LastPruned = e.PruningDate
+
+		if state.InitialArea.AreaUID == e.AreaUID {
+			state.InitialArea.LastPruned = e.PruningDate
+		}
+
+		for i, v := range state.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				state.MovedArea[i].LastPruned = e.PruningDate
+			}
+		}
+
+	case CropBatchPesticided:
+		state.LastPesticided = e.PesticidingDate
+
+		if state.InitialArea.AreaUID == e.AreaUID {
+			state.InitialArea.LastPesticided = e.PesticidingDate
+
+			if e.SafeHarvestDate.After(state.InitialArea.SafeHarvestDate) {
+				state.InitialArea.SafeHarvestDate = e.SafeHarvestDate
+			}
+		}
+
+		for i, v := range state.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				state.MovedArea[i].LastPesticided = e.PesticidingDate
+
+				if e.SafeHarvestDate.After(v.SafeHarvestDate) {
+					state.MovedArea[i].SafeHarvestDate = e.SafeHarvestDate
+				}
+			}
+		}
+
 	case CropBatchNoteCreated:
 		if len(state.Notes) == 0 {
 			state.Notes = make(map[uuid.UUID]CropNote)
@@ -453,7 +646,7 @@ func CreateCropBatch(
 
 	createdDate := time.Now()
 
-	batchID, err := generateBatchID(cropService, inv, createdDate)
+	batchID, err := generateBatchID(cropService, inv, area, createdDate)
 	if err != nil {
 		return nil, err
 	}
@@ -562,6 +755,10 @@ func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID uuid.UUID, dest
 	// Process //
 	movedDate := time.Now()
 
+	// The plants keep the chemicals applied in the source area,
+	// so the destination can't be harvested before the source is safe to harvest
+	srcSafeHarvestDate := c.AreaSafeHarvestDate(srcArea.UID)
+
 	var updatedSrcArea interface{}
 	updatedSrcAreaCode := ""
 	if c.InitialArea.AreaUID == srcArea.UID {
@@ -591,6 +788,9 @@ func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID uuid.UUID, dest
 		ia := c.InitialArea
 		ia.CurrentQuantity += quantity
 		ia.LastUpdated = movedDate
+		if srcSafeHarvestDate.After(ia.SafeHarvestDate) {
+			ia.SafeHarvestDate = srcSafeHarvestDate
+		}
 
 		updatedDstArea = ia
 		updatedDstAreaCode = "INITIAL_AREA"
@@ -605,6 +805,9 @@ func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID uuid.UUID, dest
 				da := v
 				da.CurrentQuantity += quantity
 				da.LastUpdated = movedDate
+				if srcSafeHarvestDate.After(da.SafeHarvestDate) {
+					da.SafeHarvestDate = srcSafeHarvestDate
+				}
 
 				updatedDstArea = da
 				updatedDstAreaCode = "MOVED_AREA"
@@ -619,6 +822,7 @@ func (c *Crop) MoveToArea(cropService CropService, sourceAreaUID uuid.UUID, dest
 			SourceAreaUID:   srcArea.UID,
 			InitialQuantity: quantity,
 			CurrentQuantity: quantity,
+			SafeHarvestDate: srcSafeHarvestDate,
 			CreatedDate:     movedDate,
 			LastUpdated:     movedDate,
 		}
@@ -647,7 +851,10 @@ func (c *Crop) Harvest(
 	harvestType string,
 	producedQuantity float32,
 	producedUnit ProducedUnit,
-	notes string) error {
+	grade string,
+	destination string,
+	notes string,
+	preHarvestOverrideReason string) error {
 
 	// Validate //
 	// Check if source area is exist in DB
@@ -689,9 +896,24 @@ func (c *Crop) Harvest(
 		return CropError{Code: CropHarvestErrorInvalidHarvestType}
 	}
 
+	hg := GetHarvestGrade(grade)
+	if hg == (HarvestGrade{}) {
+		return CropError{Code: CropHarvestErrorInvalidGrade}
+	}
+
 	// Process //
 	harvestDate := time.Now()
 
+	// Harvesting within the pre-harvest interval of an agrochemical needs a reason to override it
+	overrideReason := ""
+	if harvestDate.Before(c.AreaSafeHarvestDate(srcArea.UID)) {
+		if preHarvestOverrideReason == "" {
+			return CropError{Code: CropHarvestErrorWithinPreHarvestInterval}
+		}
+
+		overrideReason = preHarvestOverrideReason
+	}
+
 	// If harvestType All, then empty the quantity in the area because it has been all harvested
 	// Else if harvestType Partial, then we assume that the quantity of moved plant is 0
 	harvestedQuantity := 0
@@ -757,6 +979,23 @@ func (c *Crop) Harvest(
 
 	harvestedStorage.ProducedGramQuantity += totalProduced
 
+	lotUID, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	harvestLot := HarvestLot{
+		UID:                  lotUID,
+		Code:                 c.harvestLotCode(harvestDate),
+		SourceAreaUID:        srcArea.UID,
+		HarvestType:          ht.Code,
+		Quantity:             harvestedQuantity,
+		ProducedGramQuantity: totalProduced,
+		Grade:                hg.Code,
+		Destination:          destination,
+		HarvestDate:          harvestDate,
+	}
+
 	// Check all the quantity in InitialArea and MovedArea,
 	// if its all empty then crop status is marked to archive
 	initialAreaEmpty := false
@@ -794,16 +1033,18 @@ func (c *Crop) Harvest(
 
 	// Process //
 	c.TrackChange(CropBatchHarvested{
-		UID:                     c.UID,
-		CropStatus:              status,
-		HarvestType:             ht.Code,
-		HarvestedQuantity:       harvestedQuantity,
-		ProducedGramQuantity:    totalProduced,
-		UpdatedHarvestedStorage: harvestedStorage,
-		HarvestedArea:           harvestedArea,
-		HarvestedAreaCode:       harvestedAreaCode,
-		HarvestDate:             harvestDate,
-		Notes:                   notes,
+		UID:                      c.UID,
+		CropStatus:               status,
+		HarvestType:              ht.Code,
+		HarvestedQuantity:        harvestedQuantity,
+		ProducedGramQuantity:     totalProduced,
+		UpdatedHarvestedStorage:  harvestedStorage,
+		HarvestLot:               harvestLot,
+		HarvestedArea:            harvestedArea,
+		HarvestedAreaCode:        harvestedAreaCode,
+		HarvestDate:              harvestDate,
+		Notes:                    notes,
+		PreHarvestOverrideReason: overrideReason,
 	})
 
 	return nil
@@ -954,24 +1195,198 @@ func (c *Crop) Dump(cropService CropService, sourceAreaUID uuid.UUID, quantity i
 	return nil
 }
 
-func (c *Crop) Fertilize() error {
-	// c.LastFertilized = time.Now()
+func (c *Crop) Fertilize(cropService CropService, sourceAreaUID, materialUID uuid.UUID, dose float32, doseUnit string, fertilizingDate time.Time) error {
+	srcArea, err := c.findCareArea(cropService, sourceAreaUID)
+	if err != nil {
+		return err
+	}
+
+	material, err := findCareMaterial(cropService, materialUID, dose, doseUnit, "FERTILIZER", "MANURE")
+	if err != nil {
+		return err
+	}
+
+	if fertilizingDate.IsZero() {
+		return CropError{Code: CropCareErrorInvalidDate}
+	}
+
+	c.TrackChange(CropBatchFertilized{
+		UID:             c.UID,
+		BatchID:         c.BatchID,
+		ContainerType:   c.Container.Type.Code(),
+		AreaUID:         srcArea.UID,
+		AreaName:        srcArea.Name,
+		MaterialUID:     material.UID,
+		MaterialName:    material.Name,
+		Dose:            dose,
+		DoseUnit:        doseUnit,
+		FertilizingDate: fertilizingDate,
+		SafeHarvestDate: calculateSafeHarvestDate(material, fertilizingDate),
+	})
 
 	return nil
 }
 
-func (c *Crop) Prune() error {
-	// c.LastPruned = time.Now()
+func (c *Crop) Prune(cropService CropService, sourceAreaUID uuid.UUID, pruningDate time.Time, notes string) error {
+	srcArea, err := c.findCareArea(cropService, sourceAreaUID)
+	if err != nil {
+		return err
+	}
+
+	if pruningDate.IsZero() {
+		return CropError{Code: CropCareErrorInvalidDate}
+	}
+
+	c.TrackChange(CropBatchPruned{
+		UID:           c.UID,
+		BatchID:       c.BatchID,
+		ContainerType: c.Container.Type.Code(),
+		AreaUID:       srcArea.UID,
+		AreaName:      srcArea.Name,
+		PruningDate:   pruningDate,
+		Notes:         notes,
+	})
 
 	return nil
 }
 
-func (c *Crop) Pesticide() error {
-	// c.LastPesticided = time.Now()
+func (c *Crop) Pesticide(cropService CropService, sourceAreaUID, materialUID uuid.UUID, dose float32, doseUnit string, pesticidingDate time.Time) error {
+	srcArea, err := c.findCareArea(cropService, sourceAreaUID)
+	if err != nil {
+		return err
+	}
+
+	material, err := findCareMaterial(cropService, materialUID, dose, doseUnit, "PESTICIDE")
+	if err != nil {
+		return err
+	}
+
+	if pesticidingDate.IsZero() {
+		return CropError{Code: CropCareErrorInvalidDate}
+	}
+
+	c.TrackChange(CropBatchPesticided{
+		UID:             c.UID,
+		BatchID:         c.BatchID,
+		ContainerType:   c.Container.Type.Code(),
+		AreaUID:         srcArea.UID,
+		AreaName:        srcArea.Name,
+		MaterialUID:     material.UID,
+		MaterialName:    material.Name,
+		Dose:            dose,
+		DoseUnit:        doseUnit,
+		PesticidingDate: pesticidingDate,
+		SafeHarvestDate: calculateSafeHarvestDate(material, pesticidingDate),
+	})
 
 	return nil
 }
 
+// AreaSafeHarvestDate returns the date the crop in the given area is safe to harvest from.
+func (c Crop) AreaSafeHarvestDate(areaUID uuid.UUID) time.Time {
+	if c.InitialArea.AreaUID == areaUID {
+		return c.InitialArea.SafeHarvestDate
+	}
+
+	for _, v := range c.MovedArea {
+		if v.AreaUID == areaUID {
+			return v.SafeHarvestDate
+		}
+	}
+
+	return time.Time{}
+}
+
+// SafeHarvestDate returns the latest safe harvest date across all of the crop's areas.
+func (c Crop) SafeHarvestDate() time.Time {
+	date := c.InitialArea.SafeHarvestDate
+
+	for _, v := range c.MovedArea {
+		if v.SafeHarvestDate.After(date) {
+			date = v.SafeHarvestDate
+		}
+	}
+
+	return date
+}
+
+func calculateSafeHarvestDate(material query.CropMaterialQueryResult, applicationDate time.Time) time.Time {
+	if material.PreHarvestInterval <= 0 {
+		return time.Time{}
+	}
+
+	return applicationDate.AddDate(0, 0, material.PreHarvestInterval)
+}
+
+// findCareArea makes sure the area being taken care of is one of the crop's areas.
+func (c *Crop) findCareArea(cropService CropService, sourceAreaUID uuid.UUID) (query.CropAreaQueryResult, error) {
+	serviceResult := cropService.FindAreaByID(sourceAreaUID)
+	if serviceResult.Error != nil {
+		return query.CropAreaQueryResult{}, serviceResult.Error
+	}
+
+	srcArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return query.CropAreaQueryResult{}, CropError{Code: CropCareErrorInvalidSourceArea}
+	}
+
+	if srcArea == (query.CropAreaQueryResult{}) {
+		return query.CropAreaQueryResult{}, CropError{Code: CropCareErrorSourceAreaNotFound}
+	}
+
+	isCropArea := c.InitialArea.AreaUID == srcArea.UID
+	for _, v := range c.MovedArea {
+		if v.AreaUID == srcArea.UID {
+			isCropArea = true
+		}
+	}
+
+	if !isCropArea {
+		return query.CropAreaQueryResult{}, CropError{Code: CropCareErrorSourceAreaNotFound}
+	}
+
+	return srcArea, nil
+}
+
+// findCareMaterial makes sure the material applied to the crop is an agrochemical
+// of one of the chemical types expected by the activity.
+func findCareMaterial(cropService CropService, materialUID uuid.UUID, dose float32, doseUnit string, chemicalTypes ...string) (query.CropMaterialQueryResult, error) {
+	serviceResult := cropService.FindMaterialByID(materialUID)
+	if serviceResult.Error != nil {
+		return query.CropMaterialQueryResult{}, serviceResult.Error
+	}
+
+	material, ok := serviceResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return query.CropMaterialQueryResult{}, CropError{Code: CropMaterialErrorInvalidMaterial}
+	}
+
+	if material.TypeCode != "AGROCHEMICAL" {
+		return query.CropMaterialQueryResult{}, CropError{Code: CropCareErrorInvalidMaterialType}
+	}
+
+	isExpectedChemical := false
+	for _, v := range chemicalTypes {
+		if material.ChemicalTypeCode == v {
+			isExpectedChemical = true
+		}
+	}
+
+	if !isExpectedChemical {
+		return query.CropMaterialQueryResult{}, CropError{Code: CropCareErrorInvalidChemicalType}
+	}
+
+	if dose <= 0 {
+		return query.CropMaterialQueryResult{}, CropError{Code: CropCareErrorInvalidDose}
+	}
+
+	if doseUnit == "" {
+		return query.CropMaterialQueryResult{}, CropError{Code: CropCareErrorInvalidDoseUnit}
+	}
+
+	return material, nil
+}
+
 func (c *Crop) Water(cropService CropService, sourceAreaUID uuid.UUID, wateringDate time.Time) error {
 	serviceResult := cropService.FindAreaByID(sourceAreaUID)
 	if serviceResult.Error != nil {
@@ -1028,6 +1443,26 @@ func (c *Crop) ChangeCropStatus(cropStatus string) error {
 	return nil
 }
 
+// Archive ends the crop batch, like when its remaining plants are no longer tracked.
+func (c *Crop) Archive(archivedDate time.Time) error {
+	if c.Status.Code == CropArchived {
+		return CropError{Code: CropArchiveErrorAlreadyArchived}
+	}
+
+	if archivedDate.IsZero() {
+		return CropError{Code: CropArchiveErrorInvalidDate}
+	}
+
+	c.TrackChange(CropBatchArchived{
+		UID:           c.UID,
+		BatchID:       c.BatchID,
+		ContainerType: c.Container.Type.Code(),
+		ArchivedDate:  archivedDate,
+	})
+
+	return nil
+}
+
 func (c *Crop) ChangeContainer(quantity int, containerType CropContainerType) error {
 	err := validateContainer(quantity, containerType)
 	if err != nil {
@@ -1058,7 +1493,14 @@ func (c *Crop) ChangeInventory(cropService CropService, inventoryUID uuid.UUID)
 
 	inventory := serviceResult.Result.(query.CropMaterialQueryResult)
 
-	batchID, err := generateBatchID(cropService, inventory, c.InitialArea.CreatedDate)
+	serviceResult = cropService.FindAreaByID(c.InitialArea.AreaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	area := serviceResult.Result.(query.CropAreaQueryResult)
+
+	batchID, err := generateBatchID(cropService, inventory, area, c.InitialArea.CreatedDate)
 	if err != nil {
 		return err
 	}
@@ -1166,38 +1608,6 @@ func (c Crop) CalculateDaysSinceSeeding() int {
 	return days
 }
 
-func generateBatchID(cropService CropService, inventory query.CropMaterialQueryResult, createdDate time.Time) (string, error) {
-	// Generate Batch ID
-	// Format the date to become daymonth format like 25jan
-	dateFormat := strings.ToLower(createdDate.Format("2Jan"))
-
-	// Get variety name and split it to slice
-	varietySlice := strings.Fields(inventory.Name)
-	varietyFormat := ""
-	for _, v := range varietySlice {
-		// 	// For every value, get only the first three characters
-		format := ""
-		if len(v) > 3 {
-			format = strings.ToLower(string(v[0:3]))
-		} else {
-			format = strings.ToLower(string(v))
-		}
-
-		varietyFormat = stringhelper.Join(varietyFormat, format, "-")
-	}
-
-	// Join that variety and date
-	batchID := stringhelper.Join(varietyFormat, dateFormat)
-
-	// Validate Uniqueness of Batch ID.
-	serviceResult := cropService.FindByBatchID(batchID)
-	if serviceResult.Error != nil {
-		return "", serviceResult.Error
-	}
-
-	return batchID, nil
-}
-
 func validateContainer(quantity int, containerType CropContainerType) error {
 	if quantity <= 0 {
 		return CropError{Code: CropContainerErrorInvalidQuantity}
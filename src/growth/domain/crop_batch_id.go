@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/helper/stringhelper"
+)
+
+const (
+	BatchIDTokenVariety  = "{variety}"
+	BatchIDTokenDate     = "{date}"
+	BatchIDTokenArea     = "{area}"
+	BatchIDTokenSequence = "{seq}"
+)
+
+// DefaultBatchIDTemplate builds batch IDs like let-rom-25jan.
+// It is used when the farm has no batch ID template.
+const DefaultBatchIDTemplate = BatchIDTokenVariety + "-" + BatchIDTokenDate
+
+// maxBatchIDSequence is how many batches with the same rendered template
+// can be created before giving up on finding a unique batch ID
+const maxBatchIDSequence = 99
+
+func generateBatchID(cropService CropService, inventory query.CropMaterialQueryResult, area query.CropAreaQueryResult, createdDate time.Time) (string, error) {
+	serviceResult := cropService.FindFarmByID(area.FarmUID)
+	if serviceResult.Error != nil {
+		return "", serviceResult.Error
+	}
+
+	farm, ok := serviceResult.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return "", CropError{Code: CropErrorInvalidFarm}
+	}
+
+	template := farm.BatchIDTemplate
+	if template == "" {
+		template = DefaultBatchIDTemplate
+	}
+
+	// Every batch rendered from the same template on the same day gets the next sequence.
+	// Templates without the sequence token only get it appended after the first batch.
+	for seq := 1; seq <= maxBatchIDSequence; seq++ {
+		batchID := renderBatchIDTemplate(template, inventory, area, createdDate, seq)
+		if !strings.Contains(template, BatchIDTokenSequence) && seq > 1 {
+			batchID = fmt.Sprintf("%s-%02d", batchID, seq)
+		}
+
+		// Validate Uniqueness of Batch ID.
+		serviceResult := cropService.FindByBatchID(batchID)
+		if serviceResult.Error == (CropError{Code: CropErrorBatchIDAlreadyCreated}) {
+			continue
+		}
+
+		if serviceResult.Error != nil {
+			return "", serviceResult.Error
+		}
+
+		// Another batch can take the same batch ID before this one is saved,
+		// so the reservation decides and a conflict moves on to the next sequence.
+		serviceResult = cropService.ReserveBatchID(batchID)
+		if serviceResult.Error == (CropError{Code: CropErrorBatchIDAlreadyCreated}) {
+			continue
+		}
+
+		if serviceResult.Error != nil {
+			return "", serviceResult.Error
+		}
+
+		return batchID, nil
+	}
+
+	return "", CropError{Code: CropErrorBatchIDAlreadyCreated}
+}
+
+func renderBatchIDTemplate(template string, inventory query.CropMaterialQueryResult, area query.CropAreaQueryResult, createdDate time.Time, seq int) string {
+	return strings.NewReplacer(
+		BatchIDTokenVariety, batchIDAbbreviation(inventory.Name),
+		// Format the date to become daymonth format like 25jan
+		BatchIDTokenDate, strings.ToLower(createdDate.Format("2Jan")),
+		BatchIDTokenArea, batchIDAbbreviation(area.Name),
+		BatchIDTokenSequence, fmt.Sprintf("%02d", seq),
+	).Replace(template)
+}
+
+// batchIDAbbreviation joins the first three characters of every word of the name, like let-rom
+func batchIDAbbreviation(name string) string {
+	abbreviation := ""
+	for i, v := range strings.Fields(name) {
+		format := ""
+		if len(v) > 3 {
+			format = strings.ToLower(string(v[0:3]))
+		} else {
+			format = strings.ToLower(string(v))
+		}
+
+		if i > 0 {
+			abbreviation = stringhelper.Join(abbreviation, "-")
+		}
+
+		abbreviation = stringhelper.Join(abbreviation, format)
+	}
+
+	return abbreviation
+}
@@ -0,0 +1,266 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	CropCorrectionHarvest  = "HARVEST"
+	CropCorrectionDump     = "DUMP"
+	CropCorrectionMove     = "MOVE"
+	CropCorrectionWatering = "WATERING"
+)
+
+// CropCorrection records that the event of the crop with the original version was a mistake.
+// The original event stays in the crop's history, the correction event reverses its effects.
+type CropCorrection struct {
+	OriginalVersion int       `json:"original_version"`
+	Type            string    `json:"type"`
+	Reason          string    `json:"reason"`
+	CorrectionDate  time.Time `json:"correction_date"`
+}
+
+// CorrectHarvest reverses a harvest recorded by mistake. The harvested plants go back to their area,
+// the produced quantity is taken out of the harvested storage and its harvest lot is marked as corrected.
+func (c *Crop) CorrectHarvest(history []interface{}, version int, reason string) error {
+	// Validate //
+	event, err := c.correctableEvent(history, version, reason)
+	if err != nil {
+		return err
+	}
+
+	original, ok := event.(CropBatchHarvested)
+	if !ok {
+		return CropError{Code: CropCorrectionErrorInvalidEvent}
+	}
+
+	srcAreaUID := original.UpdatedHarvestedStorage.SourceAreaUID
+	if _, ok := c.areaQuantity(srcAreaUID); !ok {
+		return CropError{Code: CropCorrectionErrorAreaNotFound}
+	}
+
+	// Process //
+	correctionDate := time.Now()
+
+	// A partial harvest doesn't take the plants out of the area
+	var restoredArea interface{}
+	restoredAreaCode := ""
+	if original.HarvestedQuantity > 0 {
+		restoredArea, restoredAreaCode = c.changeAreaQuantity(srcAreaUID, original.HarvestedQuantity, correctionDate)
+	}
+
+	harvestedStorage := HarvestedStorage{SourceAreaUID: srcAreaUID}
+	for _, v := range c.HarvestedStorage {
+		if v.SourceAreaUID == srcAreaUID {
+			harvestedStorage = v
+		}
+	}
+
+	harvestedStorage.Quantity -= original.HarvestedQuantity
+	harvestedStorage.ProducedGramQuantity -= original.ProducedGramQuantity
+	harvestedStorage.LastUpdated = correctionDate
+
+	status := c.Status.Code
+	if original.HarvestedQuantity > 0 {
+		status = CropActive
+	}
+
+	c.TrackChange(CropBatchHarvestCorrected{
+		UID:                     c.UID,
+		BatchID:                 c.BatchID,
+		ContainerType:           c.Container.Type.Code(),
+		CropStatus:              status,
+		OriginalVersion:         version,
+		HarvestType:             original.HarvestType,
+		HarvestedQuantity:       original.HarvestedQuantity,
+		ProducedGramQuantity:    original.ProducedGramQuantity,
+		HarvestLotCode:          original.HarvestLot.Code,
+		UpdatedHarvestedStorage: harvestedStorage,
+		RestoredArea:            restoredArea,
+		RestoredAreaCode:        restoredAreaCode,
+		HarvestDate:             original.HarvestDate,
+		Reason:                  reason,
+		CorrectionDate:          correctionDate,
+	})
+
+	return nil
+}
+
+// CorrectDump reverses a dump recorded by mistake. The dumped plants go back to their area.
+func (c *Crop) CorrectDump(history []interface{}, version int, reason string) error {
+	// Validate //
+	event, err := c.correctableEvent(history, version, reason)
+	if err != nil {
+		return err
+	}
+
+	original, ok := event.(CropBatchDumped)
+	if !ok {
+		return CropError{Code: CropCorrectionErrorInvalidEvent}
+	}
+
+	srcAreaUID := original.UpdatedTrash.SourceAreaUID
+	if _, ok := c.areaQuantity(srcAreaUID); !ok {
+		return CropError{Code: CropCorrectionErrorAreaNotFound}
+	}
+
+	// Process //
+	correctionDate := time.Now()
+
+	restoredArea, restoredAreaCode := c.changeAreaQuantity(srcAreaUID, original.Quantity, correctionDate)
+
+	trash := Trash{SourceAreaUID: srcAreaUID}
+	for _, v := range c.Trash {
+		if v.SourceAreaUID == srcAreaUID {
+			trash = v
+		}
+	}
+
+	trash.Quantity -= original.Quantity
+	trash.LastUpdated = correctionDate
+
+	c.TrackChange(CropBatchDumpCorrected{
+		UID:              c.UID,
+		BatchID:          c.BatchID,
+		ContainerType:    c.Container.Type.Code(),
+		CropStatus:       CropActive,
+		OriginalVersion:  version,
+		Quantity:         original.Quantity,
+		UpdatedTrash:     trash,
+		RestoredArea:     restoredArea,
+		RestoredAreaCode: restoredAreaCode,
+		DumpDate:         original.DumpDate,
+		Reason:           reason,
+		CorrectionDate:   correctionDate,
+	})
+
+	return nil
+}
+
+// CorrectMove reverses a move recorded by mistake. The moved plants go back from
+// the destination area to the source area, so they must still be in the destination area.
+func (c *Crop) CorrectMove(history []interface{}, version int, reason string) error {
+	// Validate //
+	event, err := c.correctableEvent(history, version, reason)
+	if err != nil {
+		return err
+	}
+
+	original, ok := event.(CropBatchMoved)
+	if !ok {
+		return CropError{Code: CropCorrectionErrorInvalidEvent}
+	}
+
+	if _, ok := c.areaQuantity(original.SrcAreaUID); !ok {
+		return CropError{Code: CropCorrectionErrorAreaNotFound}
+	}
+
+	dstQuantity, ok := c.areaQuantity(original.DstAreaUID)
+	if !ok {
+		return CropError{Code: CropCorrectionErrorAreaNotFound}
+	}
+
+	if dstQuantity < original.Quantity {
+		return CropError{Code: CropCorrectionErrorNotEnoughQuantity}
+	}
+
+	// Process //
+	correctionDate := time.Now()
+
+	updatedSrcArea, updatedSrcAreaCode := c.changeAreaQuantity(original.SrcAreaUID, original.Quantity, correctionDate)
+	updatedDstArea, updatedDstAreaCode := c.changeAreaQuantity(original.DstAreaUID, -original.Quantity, correctionDate)
+
+	c.TrackChange(CropBatchMoveCorrected{
+		UID:                c.UID,
+		BatchID:            c.BatchID,
+		ContainerType:      c.Container.Type.Code(),
+		OriginalVersion:    version,
+		Quantity:           original.Quantity,
+		SrcAreaUID:         original.SrcAreaUID,
+		DstAreaUID:         original.DstAreaUID,
+		UpdatedSrcArea:     updatedSrcArea,
+		UpdatedSrcAreaCode: updatedSrcAreaCode,
+		UpdatedDstArea:     updatedDstArea,
+		UpdatedDstAreaCode: updatedDstAreaCode,
+		MovedDate:          original.MovedDate,
+		Reason:             reason,
+		CorrectionDate:     correctionDate,
+	})
+
+	return nil
+}
+
+// CorrectWatering reverses a watering recorded by mistake.
+// The area's last watering goes back to its latest watering which was not corrected.
+func (c *Crop) CorrectWatering(history []interface{}, version int, reason string) error {
+	// Validate //
+	event, err := c.correctableEvent(history, version, reason)
+	if err != nil {
+		return err
+	}
+
+	original, ok := event.(CropBatchWatered)
+	if !ok {
+		return CropError{Code: CropCorrectionErrorInvalidEvent}
+	}
+
+	if _, ok := c.areaQuantity(original.AreaUID); !ok {
+		return CropError{Code: CropCorrectionErrorAreaNotFound}
+	}
+
+	// Process //
+	restoredLastWatered := time.Time{}
+	for i, v := range history {
+		watered, ok := v.(CropBatchWatered)
+		if !ok || watered.AreaUID != original.AreaUID || i+1 == version || c.isCorrected(i+1) {
+			continue
+		}
+
+		if watered.WateringDate.After(restoredLastWatered) {
+			restoredLastWatered = watered.WateringDate
+		}
+	}
+
+	c.TrackChange(CropBatchWateringCorrected{
+		UID:                 c.UID,
+		BatchID:             c.BatchID,
+		ContainerType:       c.Container.Type.Code(),
+		OriginalVersion:     version,
+		AreaUID:             original.AreaUID,
+		AreaName:            original.AreaName,
+		WateringDate:        original.WateringDate,
+		RestoredLastWatered: restoredLastWatered,
+		Reason:              reason,
+		CorrectionDate:      time.Now(),
+	})
+
+	return nil
+}
+
+// correctableEvent returns the event of the crop's history with the version,
+// as long as it has not been corrected yet
+func (c *Crop) correctableEvent(history []interface{}, version int, reason string) (interface{}, error) {
+	if reason == "" {
+		return nil, CropError{Code: CropCorrectionErrorInvalidReason}
+	}
+
+	if version < 1 || version > len(history) {
+		return nil, CropError{Code: CropCorrectionErrorEventNotFound}
+	}
+
+	if c.isCorrected(version) {
+		return nil, CropError{Code: CropCorrectionErrorAlreadyCorrected}
+	}
+
+	return history[version-1], nil
+}
+
+func (c *Crop) isCorrected(version int) bool {
+	for _, v := range c.Corrections {
+		if v.OriginalVersion == version {
+			return true
+		}
+	}
+
+	return false
+}
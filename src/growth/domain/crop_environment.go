@@ -0,0 +1,318 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+)
+
+const (
+	// DefaultBaseTemperature is the base temperature, in Celsius, of the varieties
+	// whose crop plan doesn't tell theirs. It is the usual one of warm season crops.
+	DefaultBaseTemperature = 10
+
+	// Sensor types of the devices module the environment of a crop is computed from
+	AreaSensorTemperature = "TEMPERATURE"
+	AreaSensorHumidity    = "HUMIDITY"
+	AreaSensorLight       = "LIGHT"
+
+	// ppfdToDailyLightIntegral converts a mean PPFD in µmol/m²/s to a daily light integral in mol/m²/d
+	ppfdToDailyLightIntegral = 0.0864
+
+	// radiationToDailyLightIntegral converts a shortwave radiation sum in MJ/m² to a daily light integral
+	// in mol/m²/d. About 45% of the sunlight is photosynthetically active, at 4.57 µmol per joule.
+	radiationToDailyLightIntegral = 0.45 * 4.57
+
+	// predictionRateDays is how many of the last days the growing degree days rate is averaged on
+	// to extrapolate a milestone past the known days
+	predictionRateDays = 7
+)
+
+// DailyEnvironment is the climate of a crop on one day. The readings of the sensors in its areas
+// take precedence over the weather of its farm. A value is nil when neither has data for it.
+type DailyEnvironment struct {
+	Date           time.Time `json:"date"`
+	TemperatureMin *float32  `json:"temperature_min"`
+	TemperatureMax *float32  `json:"temperature_max"`
+	Humidity       *float32  `json:"humidity"`
+
+	// LightIntegral is the daily light integral, in mol/m²/d
+	LightIntegral *float32 `json:"light_integral"`
+}
+
+// CropEnvironment is the climate a crop batch had from its seeding, accumulated
+type CropEnvironment struct {
+	BaseTemperature   float32              `json:"base_temperature"`
+	Days              int                  `json:"days"`
+	GrowingDegreeDays float32              `json:"growing_degree_days"`
+	LightIntegral     float32              `json:"light_integral"`
+	AverageHumidity   *float32             `json:"average_humidity"`
+	Harvests          []HarvestEnvironment `json:"harvests"`
+}
+
+// HarvestEnvironment is the climate a crop batch had between its seeding and one of its harvests
+type HarvestEnvironment struct {
+	HarvestDate       time.Time `json:"harvest_date"`
+	Days              int       `json:"days"`
+	GrowingDegreeDays float32   `json:"growing_degree_days"`
+	LightIntegral     float32   `json:"light_integral"`
+	AverageHumidity   *float32  `json:"average_humidity"`
+}
+
+// DailyEnvironments builds the climate of each day from the first to the last day included,
+// from the weather of the farm and the sensor readings of the areas
+func DailyEnvironments(
+	from, to time.Time,
+	weather []query.CropWeatherQueryResult,
+	readings []query.CropAreaReadingQueryResult,
+) []DailyEnvironment {
+	weatherByDay := make(map[time.Time]query.CropWeatherQueryResult)
+	for _, v := range weather {
+		weatherByDay[day(v.Date)] = v
+	}
+
+	readingsByDay := make(map[time.Time][]query.CropAreaReadingQueryResult)
+	for _, v := range readings {
+		readingsByDay[day(v.RecordedDate)] = append(readingsByDay[day(v.RecordedDate)], v)
+	}
+
+	days := []DailyEnvironment{}
+	for date := day(from); !date.After(day(to)); date = date.AddDate(0, 0, 1) {
+		env := DailyEnvironment{Date: date}
+
+		if w, ok := weatherByDay[date]; ok {
+			env.TemperatureMin = w.TemperatureMin
+			env.TemperatureMax = w.TemperatureMax
+			env.Humidity = w.Humidity
+
+			if w.Radiation != nil {
+				env.LightIntegral = float32Ptr(*w.Radiation * radiationToDailyLightIntegral)
+			}
+		}
+
+		temperatures := sensorValues(readingsByDay[date], AreaSensorTemperature)
+		if len(temperatures) > 0 {
+			min, max := temperatures[0], temperatures[0]
+			for _, v := range temperatures {
+				min = float32(math.Min(float64(min), float64(v)))
+				max = float32(math.Max(float64(max), float64(v)))
+			}
+
+			env.TemperatureMin = float32Ptr(min)
+			env.TemperatureMax = float32Ptr(max)
+		}
+
+		humidities := sensorValues(readingsByDay[date], AreaSensorHumidity)
+		if len(humidities) > 0 {
+			env.Humidity = float32Ptr(mean(humidities))
+		}
+
+		lights := sensorValues(readingsByDay[date], AreaSensorLight)
+		if len(lights) > 0 {
+			env.LightIntegral = float32Ptr(mean(lights) * ppfdToDailyLightIntegral)
+		}
+
+		days = append(days, env)
+	}
+
+	return days
+}
+
+// GrowingDegreeDays is the thermal time of the day above the base temperature,
+// from the mean of its minimum and maximum temperatures. It is nil without both.
+func (e DailyEnvironment) GrowingDegreeDays(baseTemperature float32) *float32 {
+	if e.TemperatureMin == nil || e.TemperatureMax == nil {
+		return nil
+	}
+
+	gdd := (*e.TemperatureMin+*e.TemperatureMax)/2 - baseTemperature
+	if gdd < 0 {
+		gdd = 0
+	}
+
+	return &gdd
+}
+
+// NewCropEnvironment accumulates the climate of the days from the seeding to the last day included.
+// The climate of each harvest is accumulated until the day before it.
+func NewCropEnvironment(
+	seedingDate, to time.Time,
+	baseTemperature float32,
+	days []DailyEnvironment,
+	harvestDates []time.Time,
+) CropEnvironment {
+	env := CropEnvironment{
+		BaseTemperature: baseTemperature,
+		Harvests:        []HarvestEnvironment{},
+	}
+
+	env.Days, env.GrowingDegreeDays, env.LightIntegral, env.AverageHumidity =
+		accumulate(days, baseTemperature, day(seedingDate), day(to))
+
+	seen := make(map[time.Time]bool)
+	for _, v := range harvestDates {
+		harvestDay := day(v)
+		if seen[harvestDay] || harvestDay.Before(day(seedingDate)) {
+			continue
+		}
+		seen[harvestDay] = true
+
+		h := HarvestEnvironment{HarvestDate: harvestDay}
+		h.Days, h.GrowingDegreeDays, h.LightIntegral, h.AverageHumidity =
+			accumulate(days, baseTemperature, day(seedingDate), harvestDay.AddDate(0, 0, -1))
+
+		env.Harvests = append(env.Harvests, h)
+	}
+
+	return env
+}
+
+func accumulate(days []DailyEnvironment, baseTemperature float32, from, to time.Time) (int, float32, float32, *float32) {
+	count := 0
+	gdd := float32(0)
+	light := float32(0)
+	humidities := []float32{}
+
+	for _, v := range days {
+		if v.Date.Before(from) || v.Date.After(to) {
+			continue
+		}
+
+		count++
+
+		if d := v.GrowingDegreeDays(baseTemperature); d != nil {
+			gdd += *d
+		}
+
+		if v.LightIntegral != nil {
+			light += *v.LightIntegral
+		}
+
+		if v.Humidity != nil {
+			humidities = append(humidities, *v.Humidity)
+		}
+	}
+
+	var humidity *float32
+	if len(humidities) > 0 {
+		humidity = float32Ptr(mean(humidities))
+	}
+
+	return count, gdd, light, humidity
+}
+
+// PredictMilestones predicts when the batch reaches the milestones of its plan from the growing degree days
+// it accumulates, forecasts included. A milestone is expected once the batch has accumulated the share of
+// the thermal time to first harvest its plan gives it in days. Past the known days, the thermal time is
+// extrapolated at the rate of the last ones. Nothing is predicted when the plan has no thermal time.
+func (c Crop) PredictMilestones(plan query.CropPlan, days []DailyEnvironment) []CropPlanMilestone {
+	milestones := c.PlanMilestones(plan)
+	if plan.GDDToFirstHarvest <= 0 || plan.DaysToFirstHarvest <= 0 {
+		return milestones
+	}
+
+	seedingDay := day(c.InitialArea.CreatedDate)
+
+	dates := []time.Time{}
+	accumulated := []float32{}
+	rates := []float32{}
+	total := float32(0)
+	for _, v := range days {
+		if v.Date.Before(seedingDay) {
+			continue
+		}
+
+		// The days without temperature, like the ones past the forecasts, are left to the extrapolation
+		d := v.GrowingDegreeDays(plan.BaseTemperature)
+		if d == nil {
+			continue
+		}
+
+		total += *d
+		rates = append(rates, *d)
+		dates = append(dates, v.Date)
+		accumulated = append(accumulated, total)
+	}
+
+	if len(rates) > predictionRateDays {
+		rates = rates[len(rates)-predictionRateDays:]
+	}
+
+	for i, m := range milestones {
+		if m.Reached {
+			continue
+		}
+
+		target := plan.GDDToFirstHarvest * float32(planDays(plan, m.Code)) / float32(plan.DaysToFirstHarvest)
+
+		for j := range dates {
+			if accumulated[j] >= target {
+				predicted := dates[j]
+				milestones[i].PredictedDate = &predicted
+				break
+			}
+		}
+
+		if milestones[i].PredictedDate != nil || len(dates) == 0 {
+			continue
+		}
+
+		rate := mean(rates)
+		if rate <= 0 {
+			continue
+		}
+
+		remaining := int(math.Ceil(float64((target - total) / rate)))
+		predicted := dates[len(dates)-1].AddDate(0, 0, remaining)
+		milestones[i].PredictedDate = &predicted
+	}
+
+	return milestones
+}
+
+// planDays is the number of days since seeding the plan gives to reach the milestone
+func planDays(plan query.CropPlan, milestone string) int {
+	switch milestone {
+	case CropPlanMilestoneGermination:
+		return plan.DaysToGermination
+	case CropPlanMilestoneTransplant:
+		return plan.DaysToTransplant
+	case CropPlanMilestoneFirstHarvest:
+		return plan.DaysToFirstHarvest
+	case CropPlanMilestoneEndOfHarvest:
+		return plan.DaysToEndOfHarvest
+	}
+
+	return 0
+}
+
+func sensorValues(readings []query.CropAreaReadingQueryResult, sensorType string) []float32 {
+	values := []float32{}
+	for _, v := range readings {
+		if v.SensorType == sensorType {
+			values = append(values, v.Value)
+		}
+	}
+
+	return values
+}
+
+func mean(values []float32) float32 {
+	sum := float32(0)
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float32(len(values))
+}
+
+// day truncates the date to the start of its day in UTC, which is how the weather days are keyed
+func day(date time.Time) time.Time {
+	y, m, d := date.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
@@ -0,0 +1,98 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func float32Ptr(v float32) *float32 {
+	return &v
+}
+
+func TestCropEnvironment(t *testing.T) {
+	// Given
+	seedingDate := time.Date(2018, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	weather := []query.CropWeatherQueryResult{}
+	for i := 0; i < 4; i++ {
+		weather = append(weather, query.CropWeatherQueryResult{
+			Date:           seedingDate.AddDate(0, 0, i).Truncate(24 * time.Hour),
+			TemperatureMin: float32Ptr(14),
+			TemperatureMax: float32Ptr(26),
+			Humidity:       float32Ptr(70),
+			Radiation:      float32Ptr(20),
+		})
+	}
+
+	// The sensors of the area tell better than the weather of the farm on the third day
+	readings := []query.CropAreaReadingQueryResult{
+		{SensorType: AreaSensorTemperature, Value: 18, RecordedDate: seedingDate.AddDate(0, 0, 2)},
+		{SensorType: AreaSensorTemperature, Value: 30, RecordedDate: seedingDate.AddDate(0, 0, 2).Add(5 * time.Hour)},
+		{SensorType: AreaSensorHumidity, Value: 90, RecordedDate: seedingDate.AddDate(0, 0, 2)},
+		{SensorType: AreaSensorLight, Value: 500, RecordedDate: seedingDate.AddDate(0, 0, 2)},
+	}
+
+	// When
+	days := DailyEnvironments(seedingDate, seedingDate.AddDate(0, 0, 3), weather, readings)
+	env := NewCropEnvironment(seedingDate, seedingDate.AddDate(0, 0, 3), 10, days, []time.Time{
+		seedingDate.AddDate(0, 0, 2),
+	})
+
+	// Then
+	assert.Len(t, days, 4)
+	assert.Equal(t, float32(18), *days[2].TemperatureMin)
+	assert.Equal(t, float32(30), *days[2].TemperatureMax)
+	assert.InDelta(t, 43.2, *days[2].LightIntegral, 0.01)
+	assert.InDelta(t, 41.13, *days[0].LightIntegral, 0.01)
+
+	assert.Equal(t, 4, env.Days)
+	assert.Equal(t, float32(10+10+14+10), env.GrowingDegreeDays)
+	assert.Equal(t, float32(75), *env.AverageHumidity)
+	assert.Len(t, env.Harvests, 1)
+	assert.Equal(t, 2, env.Harvests[0].Days)
+	assert.Equal(t, float32(20), env.Harvests[0].GrowingDegreeDays)
+}
+
+func TestPredictMilestones(t *testing.T) {
+	// Given
+	seedingDate := time.Date(2018, time.March, 1, 0, 0, 0, 0, time.UTC)
+	crop := Crop{InitialArea: InitialArea{CreatedDate: seedingDate}}
+
+	plan := query.CropPlan{
+		DaysToGermination:  5,
+		DaysToFirstHarvest: 50,
+		DaysToEndOfHarvest: 60,
+		BaseTemperature:    10,
+		GDDToFirstHarvest:  500,
+	}
+
+	// 20 GDD a day for the first 10 days, then nothing is known
+	days := []DailyEnvironment{}
+	for i := 0; i < 10; i++ {
+		days = append(days, DailyEnvironment{
+			Date:           seedingDate.AddDate(0, 0, i),
+			TemperatureMin: float32Ptr(20),
+			TemperatureMax: float32Ptr(40),
+		})
+	}
+
+	// When
+	milestones := crop.PredictMilestones(plan, days)
+	withoutThermalTime := crop.PredictMilestones(query.CropPlan{
+		DaysToGermination:  5,
+		DaysToFirstHarvest: 50,
+		DaysToEndOfHarvest: 60,
+	}, days)
+
+	// Then
+	assert.Len(t, milestones, 3)
+	assert.Equal(t, seedingDate.AddDate(0, 0, 2), *milestones[0].PredictedDate)
+	assert.Equal(t, seedingDate.AddDate(0, 0, 24), *milestones[1].PredictedDate)
+	assert.Equal(t, seedingDate.AddDate(0, 0, 29), *milestones[2].PredictedDate)
+	assert.Equal(t, seedingDate.AddDate(0, 0, 50), milestones[1].ExpectedDate)
+	assert.Nil(t, withoutThermalTime[1].PredictedDate)
+}
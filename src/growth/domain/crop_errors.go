@@ -22,6 +22,9 @@ const (
 	CropHarvestErrorInvalidQuantity
 	CropHarvestErrorNotEnoughQuantity
 	CropHarvestErrorInvalidHarvestType
+	CropHarvestErrorWithinPreHarvestInterval
+	CropHarvestErrorInvalidGrade
+	CropHarvestLotErrorNotFound
 
 	// Crop dump errors
 	CropDumpErrorInvalidSourceArea
@@ -34,9 +37,42 @@ const (
 	CropWaterErrorInvalidSourceArea
 	CropWaterErrorSourceAreaNotFound
 
+	// Crop stage errors
+	CropStageErrorInvalidStage
+	CropStageErrorInvalidDate
+	CropStageErrorInvalidTransition
+
+	// Crop split and merge errors
+	CropSplitErrorInvalidSourceArea
+	CropSplitErrorSourceAreaNotFound
+	CropSplitErrorInvalidQuantity
+	CropSplitErrorNotEnoughQuantity
+	CropSplitErrorWholeBatch
+	CropMergeErrorInvalidArea
+	CropMergeErrorAreaNotFound
+	CropMergeErrorSameBatch
+	CropMergeErrorDifferentVariety
+	CropMergeErrorNotEnoughQuantity
+	CropMergeErrorArchived
+
+	// Crop forecast errors
+	CropForecastErrorInvalidRange
+	CropForecastErrorInvalidRecord
+
+	// Crop fertilize, prune and pesticide errors
+	CropCareErrorInvalidDate
+	CropCareErrorInvalidSourceArea
+	CropCareErrorSourceAreaNotFound
+	CropCareErrorInvalidMaterialType
+	CropCareErrorInvalidChemicalType
+	CropCareErrorInvalidDose
+	CropCareErrorInvalidDoseUnit
+
 	// Crop Batch ID errors
 	CropErrorInvalidBatchID
 	CropErrorBatchIDAlreadyCreated
+	CropErrorInvalidFarm
+	CropErrorFarmNotFound
 
 	// Crop Photo errros
 	CropErrorPhotoInvalidFilename
@@ -51,9 +87,23 @@ const (
 
 	CropMaterialErrorInvalidMaterial
 	CropMaterialErrorNotFound
+	CropMaterialErrorInsufficientSeed
+	CropMaterialErrorSeedsPerUnitRequired
 
 	CropNoteErrorInvalidContent
 	CropNoteErrorNotFound
+
+	// Crop correction errors
+	CropCorrectionErrorInvalidReason
+	CropCorrectionErrorEventNotFound
+	CropCorrectionErrorInvalidEvent
+	CropCorrectionErrorAlreadyCorrected
+	CropCorrectionErrorAreaNotFound
+	CropCorrectionErrorNotEnoughQuantity
+
+	// Crop archive errors
+	CropArchiveErrorAlreadyArchived
+	CropArchiveErrorInvalidDate
 )
 
 // CropError is a custom error from Go built-in error
@@ -73,6 +123,10 @@ func (e CropError) Error() string {
 		return "Invalid crop batch ID"
 	case CropErrorBatchIDAlreadyCreated:
 		return "Crop batch ID already created"
+	case CropErrorInvalidFarm:
+		return "Invalid farm"
+	case CropErrorFarmNotFound:
+		return "Farm not found"
 
 	case CropMoveToAreaErrorInvalidSourceArea:
 		return "Crop source area is invalid"
@@ -103,6 +157,12 @@ func (e CropError) Error() string {
 		return "Not enough quantity"
 	case CropHarvestErrorInvalidHarvestType:
 		return "Invalid harvest type"
+	case CropHarvestErrorWithinPreHarvestInterval:
+		return "Crop is still within the pre-harvest interval of an agrochemical. Provide a reason to override it"
+	case CropHarvestErrorInvalidGrade:
+		return "Invalid harvest grade"
+	case CropHarvestLotErrorNotFound:
+		return "Harvest lot not found"
 
 	case CropDumpErrorInvalidSourceArea:
 		return "Invalid source area"
@@ -120,6 +180,56 @@ func (e CropError) Error() string {
 	case CropWaterErrorSourceAreaNotFound:
 		return "Source area not found"
 
+	case CropStageErrorInvalidStage:
+		return "Invalid crop stage"
+	case CropStageErrorInvalidDate:
+		return "Invalid stage changed date"
+	case CropStageErrorInvalidTransition:
+		return "Invalid crop stage. Crop stage can only move forward"
+
+	case CropSplitErrorInvalidSourceArea:
+		return "Invalid source area"
+	case CropSplitErrorSourceAreaNotFound:
+		return "Source area not found"
+	case CropSplitErrorInvalidQuantity:
+		return "Invalid quantity"
+	case CropSplitErrorNotEnoughQuantity:
+		return "Not enough quantity in the source area"
+	case CropSplitErrorWholeBatch:
+		return "Cannot split all the plants of the crop batch"
+	case CropMergeErrorInvalidArea:
+		return "Invalid area"
+	case CropMergeErrorAreaNotFound:
+		return "Both crop batches must be in the area"
+	case CropMergeErrorSameBatch:
+		return "Cannot merge a crop batch with itself"
+	case CropMergeErrorDifferentVariety:
+		return "Only crop batches of the same variety can be merged"
+	case CropMergeErrorNotEnoughQuantity:
+		return "The crop batch to merge has no plants in the area"
+	case CropMergeErrorArchived:
+		return "Archived crop batches cannot be merged"
+
+	case CropForecastErrorInvalidRange:
+		return "Invalid forecast range"
+	case CropForecastErrorInvalidRecord:
+		return "Invalid crop yield record"
+
+	case CropCareErrorInvalidDate:
+		return "Invalid date"
+	case CropCareErrorInvalidSourceArea:
+		return "Invalid source area"
+	case CropCareErrorSourceAreaNotFound:
+		return "Source area not found"
+	case CropCareErrorInvalidMaterialType:
+		return "Invalid material type. Material must be an agrochemical"
+	case CropCareErrorInvalidChemicalType:
+		return "Invalid chemical type for this activity"
+	case CropCareErrorInvalidDose:
+		return "Invalid dose"
+	case CropCareErrorInvalidDoseUnit:
+		return "Invalid dose unit"
+
 	case CropErrorPhotoInvalidFilename:
 		return "Invalid filename"
 	case CropErrorPhotoInvalidMimeType:
@@ -142,11 +252,31 @@ func (e CropError) Error() string {
 		return "Invalid crop material"
 	case CropMaterialErrorNotFound:
 		return "Crop inventory material not found"
+	case CropMaterialErrorInsufficientSeed:
+		return "Not enough seed left in the inventory material"
+	case CropMaterialErrorSeedsPerUnitRequired:
+		return "Seeds per unit of the inventory material is required to check the seed stock"
 
 	case CropNoteErrorInvalidContent:
 		return "Invalid crop note content"
 	case CropNoteErrorNotFound:
 		return "Crop note not found"
+	case CropCorrectionErrorInvalidReason:
+		return "Correction reason is required"
+	case CropCorrectionErrorEventNotFound:
+		return "Crop event to correct not found"
+	case CropCorrectionErrorInvalidEvent:
+		return "Crop event cannot be corrected this way"
+	case CropCorrectionErrorAlreadyCorrected:
+		return "Crop event has already been corrected"
+	case CropCorrectionErrorAreaNotFound:
+		return "Area of the crop event is no longer used by this crop"
+	case CropCorrectionErrorNotEnoughQuantity:
+		return "Not enough quantity left in the area to correct the crop event"
+	case CropArchiveErrorAlreadyArchived:
+		return "Crop is already archived"
+	case CropArchiveErrorInvalidDate:
+		return "Invalid archive date"
 	default:
 		return "Unrecognized Crop Error Code"
 	}
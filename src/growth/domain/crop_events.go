@@ -17,6 +17,12 @@ type CropBatchCreated struct {
 	CreatedDate    time.Time
 	InitialAreaUID uuid.UUID
 	Quantity       int
+
+	// Only set when the batch is split from another batch
+	ParentUID       uuid.UUID
+	ParentBatchID   string
+	SplitDate       time.Time
+	SafeHarvestDate time.Time
 }
 
 type CropBatchTypeChanged struct {
@@ -24,6 +30,15 @@ type CropBatchTypeChanged struct {
 	Type CropType
 }
 
+type CropBatchStageChanged struct {
+	UID           uuid.UUID
+	BatchID       string
+	ContainerType string
+	PreviousStage string
+	Stage         string
+	ChangedDate   time.Time
+}
+
 type CropBatchInventoryChanged struct {
 	UID          uuid.UUID
 	InventoryUID uuid.UUID
@@ -48,16 +63,18 @@ type CropBatchMoved struct {
 }
 
 type CropBatchHarvested struct {
-	UID                     uuid.UUID
-	CropStatus              string // Values: ACTIVE / ARCHIVED
-	HarvestType             string
-	HarvestedQuantity       int
-	ProducedGramQuantity    float32
-	UpdatedHarvestedStorage HarvestedStorage
-	HarvestedArea           interface{}
-	HarvestedAreaCode       string // Values: INITIAL_AREA / MOVED_AREA
-	HarvestDate             time.Time
-	Notes                   string
+	UID                      uuid.UUID
+	CropStatus               string // Values: ACTIVE / ARCHIVED
+	HarvestType              string
+	HarvestedQuantity        int
+	ProducedGramQuantity     float32
+	UpdatedHarvestedStorage  HarvestedStorage
+	HarvestLot               HarvestLot
+	HarvestedArea            interface{}
+	HarvestedAreaCode        string // Values: INITIAL_AREA / MOVED_AREA
+	HarvestDate              time.Time
+	Notes                    string
+	PreHarvestOverrideReason string // Only set when harvested within the pre-harvest interval
 }
 
 type CropBatchDumped struct {
@@ -71,6 +88,110 @@ type CropBatchDumped struct {
 	Notes          string
 }
 
+type CropBatchHarvestCorrected struct {
+	UID                     uuid.UUID
+	BatchID                 string
+	ContainerType           string
+	CropStatus              string // Values: ACTIVE / ARCHIVED
+	OriginalVersion         int
+	HarvestType             string
+	HarvestedQuantity       int
+	ProducedGramQuantity    float32
+	HarvestLotCode          string
+	UpdatedHarvestedStorage HarvestedStorage
+	RestoredArea            interface{}
+	RestoredAreaCode        string // Values: INITIAL_AREA / MOVED_AREA, empty for partial harvest
+	HarvestDate             time.Time
+	Reason                  string
+	CorrectionDate          time.Time
+}
+
+type CropBatchDumpCorrected struct {
+	UID              uuid.UUID
+	BatchID          string
+	ContainerType    string
+	CropStatus       string // Values: ACTIVE / ARCHIVED
+	OriginalVersion  int
+	Quantity         int
+	UpdatedTrash     Trash
+	RestoredArea     interface{}
+	RestoredAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	DumpDate         time.Time
+	Reason           string
+	CorrectionDate   time.Time
+}
+
+type CropBatchMoveCorrected struct {
+	UID                uuid.UUID
+	BatchID            string
+	ContainerType      string
+	OriginalVersion    int
+	Quantity           int
+	SrcAreaUID         uuid.UUID
+	DstAreaUID         uuid.UUID
+	UpdatedSrcAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	UpdatedSrcArea     interface{}
+	UpdatedDstAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	UpdatedDstArea     interface{}
+	MovedDate          time.Time
+	Reason             string
+	CorrectionDate     time.Time
+}
+
+type CropBatchWateringCorrected struct {
+	UID                 uuid.UUID
+	BatchID             string
+	ContainerType       string
+	OriginalVersion     int
+	AreaUID             uuid.UUID
+	AreaName            string
+	WateringDate        time.Time
+	RestoredLastWatered time.Time
+	Reason              string
+	CorrectionDate      time.Time
+}
+
+type CropBatchSplit struct {
+	UID                uuid.UUID
+	BatchID            string
+	ContainerType      string
+	SplitCropUID       uuid.UUID
+	SplitBatchID       string
+	SrcAreaUID         uuid.UUID
+	Quantity           int
+	UpdatedSrcAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	UpdatedSrcArea     interface{}
+	SplitDate          time.Time
+}
+
+type CropBatchMerged struct {
+	UID             uuid.UUID
+	BatchID         string
+	ContainerType   string
+	MergedCropUID   uuid.UUID
+	MergedBatchID   string
+	AreaUID         uuid.UUID
+	Quantity        int
+	UpdatedAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	UpdatedArea     interface{}
+	SafeHarvestDate time.Time
+	MergeDate       time.Time
+}
+
+type CropBatchMergedInto struct {
+	UID             uuid.UUID
+	BatchID         string
+	ContainerType   string
+	CropStatus      string // Values: ACTIVE / ARCHIVED
+	TargetCropUID   uuid.UUID
+	TargetBatchID   string
+	AreaUID         uuid.UUID
+	Quantity        int
+	UpdatedAreaCode string // Values: INITIAL_AREA / MOVED_AREA
+	UpdatedArea     interface{}
+	MergeDate       time.Time
+}
+
 type CropBatchWatered struct {
 	UID           uuid.UUID
 	BatchID       string
@@ -80,6 +201,51 @@ type CropBatchWatered struct {
 	WateringDate  time.Time
 }
 
+type CropBatchArchived struct {
+	UID           uuid.UUID
+	BatchID       string
+	ContainerType string
+	ArchivedDate  time.Time
+}
+
+type CropBatchFertilized struct {
+	UID             uuid.UUID
+	BatchID         string
+	ContainerType   string
+	AreaUID         uuid.UUID
+	AreaName        string
+	MaterialUID     uuid.UUID
+	MaterialName    string
+	Dose            float32
+	DoseUnit        string
+	FertilizingDate time.Time
+	SafeHarvestDate time.Time
+}
+
+type CropBatchPruned struct {
+	UID           uuid.UUID
+	BatchID       string
+	ContainerType string
+	AreaUID       uuid.UUID
+	AreaName      string
+	PruningDate   time.Time
+	Notes         string
+}
+
+type CropBatchPesticided struct {
+	UID             uuid.UUID
+	BatchID         string
+	ContainerType   string
+	AreaUID         uuid.UUID
+	AreaName        string
+	MaterialUID     uuid.UUID
+	MaterialName    string
+	Dose            float32
+	DoseUnit        string
+	PesticidingDate time.Time
+	SafeHarvestDate time.Time
+}
+
 type CropBatchNoteCreated struct {
 	UID         uuid.UUID
 	CropUID     uuid.UUID
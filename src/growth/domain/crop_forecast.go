@@ -0,0 +1,258 @@
+package domain
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// CropForecastService provides the harvest history the yield forecast is built from
+type CropForecastService interface {
+	FindCropYieldRecordsByFarm(farmUID uuid.UUID) ServiceResult
+}
+
+// CropYieldRecord is the harvest history of a single crop batch
+type CropYieldRecord struct {
+	CropUID         uuid.UUID
+	BatchID         string
+	Status          string
+	InventoryUID    uuid.UUID
+	InventoryName   string
+	SeedingDate     time.Time
+	InitialQuantity int
+	CurrentQuantity int
+	Harvests        []CropYieldHarvest
+}
+
+type CropYieldHarvest struct {
+	HarvestType          string
+	ProducedGramQuantity float32
+	HarvestDate          time.Time
+}
+
+// CropYieldModel is what the forecast has learned about a variety from its archived batches
+type CropYieldModel struct {
+	InventoryUID          uuid.UUID `json:"inventory_id"`
+	InventoryName         string    `json:"inventory_name"`
+	SampleCount           int       `json:"sample_count"`
+	MeanGramPerPlant      float32   `json:"mean_gram_per_plant"`
+	StdDevGramPerPlant    float32   `json:"std_dev_gram_per_plant"`
+	MeanDaysToHarvest     int       `json:"mean_days_to_harvest"`
+	ForecastedBatchCount  int       `json:"forecasted_batch_count"`
+	UnforecastedBatchUIDs []string  `json:"unforecasted_batch_ids"`
+}
+
+// HarvestForecastWeek is the expected harvest of a variety in the week starting on WeekStart.
+// The lower and upper quantities are one standard deviation of the gram per plant around the mean.
+type HarvestForecastWeek struct {
+	WeekStart              time.Time `json:"week_start"`
+	InventoryUID           uuid.UUID `json:"inventory_id"`
+	InventoryName          string    `json:"inventory_name"`
+	BatchCount             int       `json:"batch_count"`
+	ExpectedGramQuantity   float32   `json:"expected_gram_quantity"`
+	LowerBoundGramQuantity float32   `json:"lower_bound_gram_quantity"`
+	UpperBoundGramQuantity float32   `json:"upper_bound_gram_quantity"`
+}
+
+type HarvestForecast struct {
+	From   time.Time             `json:"from"`
+	To     time.Time             `json:"to"`
+	Models []CropYieldModel      `json:"models"`
+	Weeks  []HarvestForecastWeek `json:"weeks"`
+}
+
+// ForecastHarvest estimates the harvest of the farm's active batches between from and to.
+// Archived batches which were harvested are the samples of their variety's model.
+func ForecastHarvest(forecastService CropForecastService, farmUID uuid.UUID, from, to, now time.Time) (HarvestForecast, error) {
+	if from.IsZero() || to.IsZero() || !from.Before(to) {
+		return HarvestForecast{}, CropError{Code: CropForecastErrorInvalidRange}
+	}
+
+	serviceResult := forecastService.FindCropYieldRecordsByFarm(farmUID)
+	if serviceResult.Error != nil {
+		return HarvestForecast{}, serviceResult.Error
+	}
+
+	records, ok := serviceResult.Result.([]CropYieldRecord)
+	if !ok {
+		return HarvestForecast{}, CropError{Code: CropForecastErrorInvalidRecord}
+	}
+
+	models := BuildCropYieldModels(records)
+
+	weeks := map[uuid.UUID]map[time.Time]*HarvestForecastWeek{}
+	for _, r := range records {
+		if r.Status != CropActive || r.CurrentQuantity <= 0 {
+			continue
+		}
+
+		model, ok := models[r.InventoryUID]
+		if !ok {
+			model = &CropYieldModel{
+				InventoryUID:  r.InventoryUID,
+				InventoryName: r.InventoryName,
+			}
+			models[r.InventoryUID] = model
+		}
+
+		if model.SampleCount == 0 {
+			model.UnforecastedBatchUIDs = append(model.UnforecastedBatchUIDs, r.CropUID.String())
+			continue
+		}
+
+		model.ForecastedBatchCount++
+
+		// A batch that is late for its harvest is expected to be harvested now
+		harvestDate := r.SeedingDate.AddDate(0, 0, model.MeanDaysToHarvest)
+		if harvestDate.Before(now) {
+			harvestDate = now
+		}
+
+		if harvestDate.Before(from) || !harvestDate.Before(to) {
+			continue
+		}
+
+		// Plants which were all harvested are not counted in the current quantity anymore,
+		// so only the partial harvests are deducted from what the remaining plants will produce
+		produced := float32(0)
+		for _, h := range r.Harvests {
+			if h.HarvestType == HarvestTypePartial {
+				produced += h.ProducedGramQuantity
+			}
+		}
+
+		plants := float32(r.CurrentQuantity)
+		remaining := func(gramPerPlant float32) float32 {
+			return float32(math.Max(0, float64(plants*gramPerPlant-produced)))
+		}
+
+		weekStart := startOfWeek(harvestDate)
+		if _, ok := weeks[r.InventoryUID]; !ok {
+			weeks[r.InventoryUID] = map[time.Time]*HarvestForecastWeek{}
+		}
+
+		w, ok := weeks[r.InventoryUID][weekStart]
+		if !ok {
+			w = &HarvestForecastWeek{
+				WeekStart:     weekStart,
+				InventoryUID:  r.InventoryUID,
+				InventoryName: model.InventoryName,
+			}
+			weeks[r.InventoryUID][weekStart] = w
+		}
+
+		w.BatchCount++
+		w.ExpectedGramQuantity += remaining(model.MeanGramPerPlant)
+		w.LowerBoundGramQuantity += remaining(model.MeanGramPerPlant - model.StdDevGramPerPlant)
+		w.UpperBoundGramQuantity += remaining(model.MeanGramPerPlant + model.StdDevGramPerPlant)
+	}
+
+	forecast := HarvestForecast{
+		From:   from,
+		To:     to,
+		Models: []CropYieldModel{},
+		Weeks:  []HarvestForecastWeek{},
+	}
+
+	for _, m := range models {
+		forecast.Models = append(forecast.Models, *m)
+	}
+
+	for _, v := range weeks {
+		for _, w := range v {
+			forecast.Weeks = append(forecast.Weeks, *w)
+		}
+	}
+
+	sort.Slice(forecast.Models, func(i, j int) bool {
+		return forecast.Models[i].InventoryName < forecast.Models[j].InventoryName
+	})
+
+	sort.Slice(forecast.Weeks, func(i, j int) bool {
+		if forecast.Weeks[i].WeekStart.Equal(forecast.Weeks[j].WeekStart) {
+			return forecast.Weeks[i].InventoryName < forecast.Weeks[j].InventoryName
+		}
+
+		return forecast.Weeks[i].WeekStart.Before(forecast.Weeks[j].WeekStart)
+	})
+
+	return forecast, nil
+}
+
+// BuildCropYieldModels computes the gram per plant and the days from seeding to harvest of each variety.
+// Every archived batch with at least one harvest is a sample, and its days to harvest
+// are weighted by the grams produced on each harvest.
+func BuildCropYieldModels(records []CropYieldRecord) map[uuid.UUID]*CropYieldModel {
+	samples := map[uuid.UUID][]float64{}
+	days := map[uuid.UUID][]float64{}
+	models := map[uuid.UUID]*CropYieldModel{}
+
+	for _, r := range records {
+		if r.Status != CropArchived || r.InitialQuantity <= 0 {
+			continue
+		}
+
+		produced := float64(0)
+		weightedDays := float64(0)
+		for _, h := range r.Harvests {
+			produced += float64(h.ProducedGramQuantity)
+			weightedDays += float64(h.ProducedGramQuantity) * h.HarvestDate.Sub(r.SeedingDate).Hours() / 24
+		}
+
+		if produced <= 0 {
+			continue
+		}
+
+		if _, ok := models[r.InventoryUID]; !ok {
+			models[r.InventoryUID] = &CropYieldModel{
+				InventoryUID:  r.InventoryUID,
+				InventoryName: r.InventoryName,
+			}
+		}
+
+		samples[r.InventoryUID] = append(samples[r.InventoryUID], produced/float64(r.InitialQuantity))
+		days[r.InventoryUID] = append(days[r.InventoryUID], weightedDays/produced)
+	}
+
+	for uid, m := range models {
+		mean, stdDev := meanAndStdDev(samples[uid])
+		meanDays, _ := meanAndStdDev(days[uid])
+
+		m.SampleCount = len(samples[uid])
+		m.MeanGramPerPlant = float32(mean)
+		m.StdDevGramPerPlant = float32(stdDev)
+		m.MeanDaysToHarvest = int(meanDays + 0.5)
+	}
+
+	return models
+}
+
+func meanAndStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	sum := float64(0)
+	for _, v := range values {
+		sum += v
+	}
+
+	mean := sum / float64(len(values))
+
+	variance := float64(0)
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
+
+// startOfWeek returns the midnight of the Monday of the date's week
+func startOfWeek(date time.Time) time.Time {
+	offset := (int(date.Weekday()) + 6) % 7
+	y, m, d := date.AddDate(0, 0, -offset).Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, date.Location())
+}
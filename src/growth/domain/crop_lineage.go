@@ -0,0 +1,312 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	CropLineageSplitFrom  = "SPLIT_FROM"
+	CropLineageSplitInto  = "SPLIT_INTO"
+	CropLineageMergedFrom = "MERGED_FROM"
+	CropLineageMergedInto = "MERGED_INTO"
+)
+
+// CropLineage links the crop batch to a batch it was split from or merged with
+type CropLineage struct {
+	Type     string    `json:"type"`
+	CropUID  uuid.UUID `json:"crop_id"`
+	BatchID  string    `json:"batch_id"`
+	AreaUID  uuid.UUID `json:"area_id"`
+	Quantity int       `json:"quantity"`
+	Date     time.Time `json:"date"`
+}
+
+// Split takes some plants of the source area out of the crop into a new crop batch,
+// so they can be cared for separately. The new batch keeps the seeding date,
+// the variety and the stage of this batch.
+func (c *Crop) Split(cropService CropService, sourceAreaUID uuid.UUID, quantity int) (*Crop, error) {
+	// Validate //
+	serviceResult := cropService.FindAreaByID(sourceAreaUID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	srcArea, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return nil, CropError{Code: CropSplitErrorInvalidSourceArea}
+	}
+
+	if srcArea.UID == (uuid.UUID{}) {
+		return nil, CropError{Code: CropSplitErrorSourceAreaNotFound}
+	}
+
+	if quantity <= 0 {
+		return nil, CropError{Code: CropSplitErrorInvalidQuantity}
+	}
+
+	areaQuantity, ok := c.areaQuantity(srcArea.UID)
+	if !ok {
+		return nil, CropError{Code: CropSplitErrorSourceAreaNotFound}
+	}
+
+	if quantity > areaQuantity {
+		return nil, CropError{Code: CropSplitErrorNotEnoughQuantity}
+	}
+
+	// Splitting all the plants would leave an empty batch behind
+	if quantity >= c.totalQuantity() {
+		return nil, CropError{Code: CropSplitErrorWholeBatch}
+	}
+
+	// Process //
+	splitDate := time.Now()
+
+	splits := 0
+	for _, v := range c.Lineage {
+		if v.Type == CropLineageSplitInto {
+			splits++
+		}
+	}
+
+	batchID := fmt.Sprintf("%s-s%d", c.BatchID, splits+1)
+
+	serviceResult = cropService.FindByBatchID(batchID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	serviceResult = cropService.ReserveBatchID(batchID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	updatedSrcArea, updatedSrcAreaCode := c.changeAreaQuantity(srcArea.UID, -quantity, splitDate)
+
+	split := &Crop{}
+
+	split.TrackChange(CropBatchCreated{
+		UID:     uid,
+		BatchID: batchID,
+		Status:  GetCropStatus(CropActive),
+		Type:    c.Type,
+		Container: CropContainer{
+			Quantity: quantity,
+			Type:     c.Container.Type,
+		},
+		InventoryUID:   c.InventoryUID,
+		CreatedDate:    c.InitialArea.CreatedDate,
+		InitialAreaUID: srcArea.UID,
+		Quantity:       quantity,
+		FarmUID:        c.FarmUID,
+		ParentUID:      c.UID,
+		ParentBatchID:  c.BatchID,
+		SplitDate:      splitDate,
+
+		// The split plants keep the chemicals applied to them in the source area
+		SafeHarvestDate: c.AreaSafeHarvestDate(srcArea.UID),
+	})
+
+	if c.Stage.order() > split.Stage.order() {
+		split.TrackChange(CropBatchStageChanged{
+			UID:           uid,
+			BatchID:       batchID,
+			ContainerType: c.Container.Type.Code(),
+			PreviousStage: split.Stage.Code,
+			Stage:         c.Stage.Code,
+			ChangedDate:   splitDate,
+		})
+	}
+
+	c.TrackChange(CropBatchSplit{
+		UID:                c.UID,
+		BatchID:            c.BatchID,
+		ContainerType:      c.Container.Type.Code(),
+		SplitCropUID:       uid,
+		SplitBatchID:       batchID,
+		SrcAreaUID:         srcArea.UID,
+		Quantity:           quantity,
+		UpdatedSrcAreaCode: updatedSrcAreaCode,
+		UpdatedSrcArea:     updatedSrcArea,
+		SplitDate:          splitDate,
+	})
+
+	return split, nil
+}
+
+// Merge moves all the plants of the source batch in the area into this batch.
+// Both batches must be active, of the same variety and have plants in the area.
+func (c *Crop) Merge(cropService CropService, source *Crop, areaUID uuid.UUID) error {
+	// Validate //
+	serviceResult := cropService.FindAreaByID(areaUID)
+	if serviceResult.Error != nil {
+		return serviceResult.Error
+	}
+
+	area, ok := serviceResult.Result.(query.CropAreaQueryResult)
+	if !ok {
+		return CropError{Code: CropMergeErrorInvalidArea}
+	}
+
+	if area.UID == (uuid.UUID{}) {
+		return CropError{Code: CropMergeErrorAreaNotFound}
+	}
+
+	if source.UID == c.UID {
+		return CropError{Code: CropMergeErrorSameBatch}
+	}
+
+	if source.InventoryUID != c.InventoryUID {
+		return CropError{Code: CropMergeErrorDifferentVariety}
+	}
+
+	if c.Status.Code == CropArchived || source.Status.Code == CropArchived {
+		return CropError{Code: CropMergeErrorArchived}
+	}
+
+	if targetQuantity, ok := c.areaQuantity(area.UID); !ok || targetQuantity <= 0 {
+		return CropError{Code: CropMergeErrorAreaNotFound}
+	}
+
+	quantity, ok := source.areaQuantity(area.UID)
+	if !ok {
+		return CropError{Code: CropMergeErrorAreaNotFound}
+	}
+
+	if quantity <= 0 {
+		return CropError{Code: CropMergeErrorNotEnoughQuantity}
+	}
+
+	// Process //
+	mergeDate := time.Now()
+
+	updatedArea, updatedAreaCode := c.changeAreaQuantity(area.UID, quantity, mergeDate)
+	updatedSrcArea, updatedSrcAreaCode := source.changeAreaQuantity(area.UID, -quantity, mergeDate)
+
+	// The merged plants keep the chemicals applied to them in the source batch
+	safeHarvestDate := c.AreaSafeHarvestDate(area.UID)
+	if source.AreaSafeHarvestDate(area.UID).After(safeHarvestDate) {
+		safeHarvestDate = source.AreaSafeHarvestDate(area.UID)
+		updatedArea = withSafeHarvestDate(updatedArea, safeHarvestDate)
+	}
+
+	sourceStatus := CropActive
+	if source.totalQuantity() == quantity {
+		sourceStatus = CropArchived
+	}
+
+	c.TrackChange(CropBatchMerged{
+		UID:             c.UID,
+		BatchID:         c.BatchID,
+		ContainerType:   c.Container.Type.Code(),
+		MergedCropUID:   source.UID,
+		MergedBatchID:   source.BatchID,
+		AreaUID:         area.UID,
+		Quantity:        quantity,
+		UpdatedAreaCode: updatedAreaCode,
+		UpdatedArea:     updatedArea,
+		SafeHarvestDate: safeHarvestDate,
+		MergeDate:       mergeDate,
+	})
+
+	source.TrackChange(CropBatchMergedInto{
+		UID:             source.UID,
+		BatchID:         source.BatchID,
+		ContainerType:   source.Container.Type.Code(),
+		CropStatus:      sourceStatus,
+		TargetCropUID:   c.UID,
+		TargetBatchID:   c.BatchID,
+		AreaUID:         area.UID,
+		Quantity:        quantity,
+		UpdatedAreaCode: updatedSrcAreaCode,
+		UpdatedArea:     updatedSrcArea,
+		MergeDate:       mergeDate,
+	})
+
+	return nil
+}
+
+// areaQuantity returns the current quantity of the crop in the area,
+// and whether the crop has ever been in the area
+func (c Crop) areaQuantity(areaUID uuid.UUID) (int, bool) {
+	if c.InitialArea.AreaUID == areaUID {
+		return c.InitialArea.CurrentQuantity, true
+	}
+
+	for _, v := range c.MovedArea {
+		if v.AreaUID == areaUID {
+			return v.CurrentQuantity, true
+		}
+	}
+
+	return 0, false
+}
+
+func (c Crop) totalQuantity() int {
+	total := c.InitialArea.CurrentQuantity
+	for _, v := range c.MovedArea {
+		total += v.CurrentQuantity
+	}
+
+	return total
+}
+
+// changeAreaQuantity returns a copy of the area with its quantity changed, with its area code
+func (c Crop) changeAreaQuantity(areaUID uuid.UUID, quantity int, date time.Time) (interface{}, string) {
+	if c.InitialArea.AreaUID == areaUID {
+		ia := c.InitialArea
+		ia.CurrentQuantity += quantity
+		ia.LastUpdated = date
+
+		return ia, "INITIAL_AREA"
+	}
+
+	for _, v := range c.MovedArea {
+		if v.AreaUID == areaUID {
+			ma := v
+			ma.CurrentQuantity += quantity
+			ma.LastUpdated = date
+
+			return ma, "MOVED_AREA"
+		}
+	}
+
+	return nil, ""
+}
+
+// withSafeHarvestDate returns a copy of the area returned by changeAreaQuantity with its safe harvest date changed
+func withSafeHarvestDate(area interface{}, date time.Time) interface{} {
+	switch a := area.(type) {
+	case InitialArea:
+		a.SafeHarvestDate = date
+		return a
+	case MovedArea:
+		a.SafeHarvestDate = date
+		return a
+	}
+
+	return area
+}
+
+// replaceArea applies an area updated by changeAreaQuantity to the crop
+func (state *Crop) replaceArea(code string, area interface{}) {
+	if code == "INITIAL_AREA" {
+		state.InitialArea = area.(InitialArea)
+	} else if code == "MOVED_AREA" {
+		ma := area.(MovedArea)
+
+		for i, v := range state.MovedArea {
+			if v.AreaUID == ma.AreaUID {
+				state.MovedArea[i] = ma
+			}
+		}
+	}
+}
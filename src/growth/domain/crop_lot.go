@@ -0,0 +1,361 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	HarvestGradeA      = "GRADE_A"
+	HarvestGradeB      = "GRADE_B"
+	HarvestGradeC      = "GRADE_C"
+	HarvestGradeReject = "REJECT"
+)
+
+type HarvestGrade struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+func HarvestGrades() []HarvestGrade {
+	return []HarvestGrade{
+		{Code: HarvestGradeA, Label: "Grade A"},
+		{Code: HarvestGradeB, Label: "Grade B"},
+		{Code: HarvestGradeC, Label: "Grade C"},
+		{Code: HarvestGradeReject, Label: "Reject"},
+	}
+}
+
+func GetHarvestGrade(code string) HarvestGrade {
+	for _, v := range HarvestGrades() {
+		if v.Code == code {
+			return v
+		}
+	}
+
+	return HarvestGrade{}
+}
+
+// HarvestLot is a single harvest of the crop batch, traceable by its code
+type HarvestLot struct {
+	UID                  uuid.UUID `json:"uid"`
+	Code                 string    `json:"code"`
+	SourceAreaUID        uuid.UUID `json:"source_area_id"`
+	HarvestType          string    `json:"harvest_type"`
+	Quantity             int       `json:"quantity"`
+	ProducedGramQuantity float32   `json:"produced_gram_quantity"`
+	Grade                string    `json:"grade"`
+	Destination          string    `json:"destination"`
+	HarvestDate          time.Time `json:"harvest_date"`
+
+	// CorrectedDate is set when the harvest of the lot was recorded by mistake
+	CorrectedDate time.Time `json:"corrected_date"`
+}
+
+// harvestLotCode builds the lot code from the batch ID, the harvest date and the lot sequence in the batch
+func (c Crop) harvestLotCode(harvestDate time.Time) string {
+	return fmt.Sprintf("%s-%s-%02d", c.BatchID, harvestDate.Format("060102"), len(c.HarvestLots)+1)
+}
+
+const (
+	HarvestLotTraceSeed      = "SEED"
+	HarvestLotTraceSplit     = "SPLIT"
+	HarvestLotTraceMerge     = "MERGE"
+	HarvestLotTraceInventory = "INVENTORY"
+	HarvestLotTraceMove      = "MOVE"
+	HarvestLotTraceWater     = "WATER"
+	HarvestLotTraceFertilize = "FERTILIZE"
+	HarvestLotTracePesticide = "PESTICIDE"
+	HarvestLotTracePrune     = "PRUNE"
+	HarvestLotTraceHarvest   = "HARVEST"
+)
+
+// HarvestLotTraceEntry is something that happened to the plants of the lot before they were harvested
+type HarvestLotTraceEntry struct {
+	Type         string    `json:"type"`
+	Date         time.Time `json:"date"`
+	BatchID      string    `json:"batch_id"`
+	AreaUID      uuid.UUID `json:"area_id"`
+	AreaName     string    `json:"area_name"`
+	MaterialUID  uuid.UUID `json:"material_id"`
+	MaterialName string    `json:"material_name"`
+	Dose         float32   `json:"dose"`
+	DoseUnit     string    `json:"dose_unit"`
+	Quantity     int       `json:"quantity"`
+	Notes        string    `json:"notes"`
+}
+
+type HarvestLotTrace struct {
+	Lot          HarvestLot             `json:"lot"`
+	CropUID      uuid.UUID              `json:"crop_id"`
+	BatchID      string                 `json:"batch_id"`
+	FarmUID      uuid.UUID              `json:"farm_id"`
+	InventoryUID uuid.UUID              `json:"inventory_id"`
+	SeedingDate  time.Time              `json:"seeding_date"`
+	Entries      []HarvestLotTraceEntry `json:"entries"`
+}
+
+// CropEventsFinder returns the events of a crop batch, used to trace the batches it was split from or merged with
+type CropEventsFinder func(cropUID uuid.UUID) ([]interface{}, error)
+
+// TraceHarvestLot collects the seed material, the areas and the treatments the plants of the lot went through.
+// Only the areas the plants passed through are traced, and the batches the crop was split from
+// or merged with are followed with findEvents up to the split or the merge.
+// Events reversed by a correction are left out, even when the correction came after the harvest.
+func TraceHarvestLot(events []interface{}, lotCode string, findEvents CropEventsFinder) (HarvestLotTrace, error) {
+	state := &Crop{}
+
+	for i, event := range events {
+		state.Transition(event)
+
+		e, ok := event.(CropBatchHarvested)
+		if !ok || e.HarvestLot.Code != lotCode {
+			continue
+		}
+
+		lot := e.HarvestLot
+		for _, v := range events[i+1:] {
+			if corrected, ok := v.(CropBatchHarvestCorrected); ok && corrected.HarvestLotCode == lotCode {
+				lot.CorrectedDate = corrected.CorrectionDate
+			}
+		}
+
+		trace := HarvestLotTrace{
+			Lot:          lot,
+			CropUID:      state.UID,
+			BatchID:      state.BatchID,
+			FarmUID:      state.FarmUID,
+			InventoryUID: state.InventoryUID,
+			SeedingDate:  state.InitialArea.CreatedDate,
+		}
+
+		entries, err := traceLotEntries(events, i, state.BatchID, e.HarvestLot.SourceAreaUID, findEvents)
+		if err != nil {
+			return HarvestLotTrace{}, err
+		}
+
+		trace.Entries = append(entries, HarvestLotTraceEntry{
+			Type:     HarvestLotTraceHarvest,
+			Date:     e.HarvestDate,
+			BatchID:  state.BatchID,
+			AreaUID:  e.HarvestLot.SourceAreaUID,
+			Quantity: e.HarvestLot.Quantity,
+			Notes:    e.Notes,
+		})
+
+		return trace, nil
+	}
+
+	return HarvestLotTrace{}, CropError{Code: CropHarvestLotErrorNotFound}
+}
+
+// traceLotEntries walks the events of the batch backwards from the event at end, when the plants were in the area,
+// following the moves to the areas the plants came from. The entries are returned oldest first.
+func traceLotEntries(events []interface{}, end int, batchID string, areaUID uuid.UUID, findEvents CropEventsFinder) ([]HarvestLotTraceEntry, error) {
+	areas := map[uuid.UUID]bool{areaUID: true}
+	reversed := []HarvestLotTraceEntry{}
+	corrected := correctedVersions(events)
+
+	for i := end - 1; i >= 0; i-- {
+		// The version of the event is its position in the crop's history
+		if corrected[i+1] {
+			continue
+		}
+
+		switch e := events[i].(type) {
+		case CropBatchCreated:
+			if e.ParentUID == (uuid.UUID{}) {
+				reversed = append(reversed, HarvestLotTraceEntry{
+					Type:        HarvestLotTraceSeed,
+					Date:        e.CreatedDate,
+					BatchID:     batchID,
+					AreaUID:     e.InitialAreaUID,
+					MaterialUID: e.InventoryUID,
+					Quantity:    e.Quantity,
+				})
+
+				continue
+			}
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:     HarvestLotTraceSplit,
+				Date:     e.SplitDate,
+				BatchID:  batchID,
+				AreaUID:  e.InitialAreaUID,
+				Quantity: e.Quantity,
+				Notes:    "Split from " + e.ParentBatchID,
+			})
+
+			parentEntries, err := traceLineageEntries(e.ParentUID, e.ParentBatchID, e.InitialAreaUID, findEvents, func(event interface{}) bool {
+				split, ok := event.(CropBatchSplit)
+				return ok && split.SplitCropUID == e.UID
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			reversed = appendReversed(reversed, parentEntries)
+
+		case CropBatchMerged:
+			if !areas[e.AreaUID] {
+				continue
+			}
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:     HarvestLotTraceMerge,
+				Date:     e.MergeDate,
+				BatchID:  batchID,
+				AreaUID:  e.AreaUID,
+				Quantity: e.Quantity,
+				Notes:    "Merged from " + e.MergedBatchID,
+			})
+
+			mergedEntries, err := traceLineageEntries(e.MergedCropUID, e.MergedBatchID, e.AreaUID, findEvents, func(event interface{}) bool {
+				merged, ok := event.(CropBatchMergedInto)
+				return ok && merged.TargetCropUID == e.UID && merged.MergeDate.Equal(e.MergeDate)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			reversed = appendReversed(reversed, mergedEntries)
+
+		case CropBatchInventoryChanged:
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:        HarvestLotTraceInventory,
+				BatchID:     batchID,
+				MaterialUID: e.InventoryUID,
+			})
+
+		case CropBatchMoved:
+			if !areas[e.DstAreaUID] {
+				continue
+			}
+
+			// The plants in the destination area may have come from the source area
+			areas[e.SrcAreaUID] = true
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:     HarvestLotTraceMove,
+				Date:     e.MovedDate,
+				BatchID:  batchID,
+				AreaUID:  e.DstAreaUID,
+				Quantity: e.Quantity,
+			})
+
+		case CropBatchWatered:
+			if !areas[e.AreaUID] {
+				continue
+			}
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:     HarvestLotTraceWater,
+				Date:     e.WateringDate,
+				BatchID:  batchID,
+				AreaUID:  e.AreaUID,
+				AreaName: e.AreaName,
+			})
+
+		case CropBatchFertilized:
+			if !areas[e.AreaUID] {
+				continue
+			}
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:         HarvestLotTraceFertilize,
+				Date:         e.FertilizingDate,
+				BatchID:      batchID,
+				AreaUID:      e.AreaUID,
+				AreaName:     e.AreaName,
+				MaterialUID:  e.MaterialUID,
+				MaterialName: e.MaterialName,
+				Dose:         e.Dose,
+				DoseUnit:     e.DoseUnit,
+			})
+
+		case CropBatchPesticided:
+			if !areas[e.AreaUID] {
+				continue
+			}
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:         HarvestLotTracePesticide,
+				Date:         e.PesticidingDate,
+				BatchID:      batchID,
+				AreaUID:      e.AreaUID,
+				AreaName:     e.AreaName,
+				MaterialUID:  e.MaterialUID,
+				MaterialName: e.MaterialName,
+				Dose:         e.Dose,
+				DoseUnit:     e.DoseUnit,
+			})
+
+		case CropBatchPruned:
+			if !areas[e.AreaUID] {
+				continue
+			}
+
+			reversed = append(reversed, HarvestLotTraceEntry{
+				Type:     HarvestLotTracePrune,
+				Date:     e.PruningDate,
+				BatchID:  batchID,
+				AreaUID:  e.AreaUID,
+				AreaName: e.AreaName,
+				Notes:    e.Notes,
+			})
+		}
+	}
+
+	entries := []HarvestLotTraceEntry{}
+	return appendReversed(entries, reversed), nil
+}
+
+// traceLineageEntries traces the plants of another batch in the area, up to the event where they left that batch
+func traceLineageEntries(cropUID uuid.UUID, batchID string, areaUID uuid.UUID, findEvents CropEventsFinder, isLeaving func(interface{}) bool) ([]HarvestLotTraceEntry, error) {
+	if findEvents == nil {
+		return nil, CropError{Code: CropHarvestLotErrorNotFound}
+	}
+
+	events, err := findEvents(cropUID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range events {
+		if isLeaving(v) {
+			return traceLotEntries(events, i, batchID, areaUID, findEvents)
+		}
+	}
+
+	return nil, CropError{Code: CropHarvestLotErrorNotFound}
+}
+
+// correctedVersions returns the versions of the events that were reversed by a correction
+func correctedVersions(events []interface{}) map[int]bool {
+	versions := map[int]bool{}
+
+	for _, v := range events {
+		switch e := v.(type) {
+		case CropBatchHarvestCorrected:
+			versions[e.OriginalVersion] = true
+		case CropBatchDumpCorrected:
+			versions[e.OriginalVersion] = true
+		case CropBatchMoveCorrected:
+			versions[e.OriginalVersion] = true
+		case CropBatchWateringCorrected:
+			versions[e.OriginalVersion] = true
+		}
+	}
+
+	return versions
+}
+
+func appendReversed(entries []HarvestLotTraceEntry, other []HarvestLotTraceEntry) []HarvestLotTraceEntry {
+	for i := len(other) - 1; i >= 0; i-- {
+		entries = append(entries, other[i])
+	}
+
+	return entries
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/query"
+)
+
+// SeedCount is the number of seeds sown into the container, one seed per cell for trays
+func (c CropContainer) SeedCount() int {
+	if t, ok := c.Type.(Tray); ok {
+		return c.Quantity * t.Cell
+	}
+
+	return c.Quantity
+}
+
+// ValidateSeedStock checks the seed material has enough quantity left to sow the container.
+// Materials counted in packets or grams need a seeds per unit setting to be checked.
+func ValidateSeedStock(material query.CropMaterialQueryResult, container CropContainer) error {
+	if material.TypeCode != "SEED" {
+		return nil
+	}
+
+	seeds := float32(container.SeedCount())
+	if material.QuantityUnit != "SEEDS" {
+		if material.SeedsPerUnit <= 0 {
+			return CropError{Code: CropMaterialErrorSeedsPerUnitRequired}
+		}
+
+		seeds = seeds / material.SeedsPerUnit
+	}
+
+	if seeds > material.Quantity {
+		return CropError{Code: CropMaterialErrorInsufficientSeed}
+	}
+
+	return nil
+}
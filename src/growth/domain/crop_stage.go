@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+)
+
+const (
+	CropStageGerminating = "GERMINATING"
+	CropStageVegetative  = "VEGETATIVE"
+	CropStageFlowering   = "FLOWERING"
+	CropStageFruiting    = "FRUITING"
+	CropStageHarvesting  = "HARVESTING"
+	CropStageFinished    = "FINISHED"
+)
+
+type CropStage struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+// CropStages returns the crop stages in the order a crop goes through them
+func CropStages() []CropStage {
+	return []CropStage{
+		{Code: CropStageGerminating, Label: "Germinating"},
+		{Code: CropStageVegetative, Label: "Vegetative"},
+		{Code: CropStageFlowering, Label: "Flowering"},
+		{Code: CropStageFruiting, Label: "Fruiting"},
+		{Code: CropStageHarvesting, Label: "Harvesting"},
+		{Code: CropStageFinished, Label: "Finished"},
+	}
+}
+
+func GetCropStage(code string) CropStage {
+	for _, v := range CropStages() {
+		if code == v.Code {
+			return v
+		}
+	}
+
+	return CropStage{}
+}
+
+// order returns the position of the stage in the crop lifecycle, or -1 if it is unknown
+func (s CropStage) order() int {
+	for i, v := range CropStages() {
+		if s.Code == v.Code {
+			return i
+		}
+	}
+
+	return -1
+}
+
+const (
+	CropPlanMilestoneGermination  = "GERMINATION"
+	CropPlanMilestoneTransplant   = "TRANSPLANT"
+	CropPlanMilestoneFirstHarvest = "FIRST_HARVEST"
+	CropPlanMilestoneEndOfHarvest = "END_OF_HARVEST"
+)
+
+// CropPlanMilestone is a point of the crop plan the batch is expected to reach by a certain date
+type CropPlanMilestone struct {
+	Code         string    `json:"code"`
+	ExpectedDate time.Time `json:"expected_date"`
+	Reached      bool      `json:"reached"`
+
+	// PredictedDate is when the batch is expected to reach the milestone from the thermal time it had
+	PredictedDate *time.Time `json:"predicted_date"`
+}
+
+// CropPlanDeviation warns that the batch has not reached a milestone of its plan in time
+type CropPlanDeviation struct {
+	Milestone    string    `json:"milestone"`
+	ExpectedDate time.Time `json:"expected_date"`
+	DaysLate     int       `json:"days_late"`
+}
+
+// ChangeStage moves the crop forward in its lifecycle. Stages can be skipped,
+// since not every crop flowers or fruits, but they cannot go backward.
+func (c *Crop) ChangeStage(stage string, changedDate time.Time) error {
+	cs := GetCropStage(stage)
+	if cs == (CropStage{}) {
+		return CropError{Code: CropStageErrorInvalidStage}
+	}
+
+	if changedDate.IsZero() {
+		return CropError{Code: CropStageErrorInvalidDate}
+	}
+
+	if cs.order() <= c.Stage.order() {
+		return CropError{Code: CropStageErrorInvalidTransition}
+	}
+
+	c.TrackChange(CropBatchStageChanged{
+		UID:           c.UID,
+		BatchID:       c.BatchID,
+		ContainerType: c.Container.Type.Code(),
+		PreviousStage: c.Stage.Code,
+		Stage:         cs.Code,
+		ChangedDate:   changedDate,
+	})
+
+	return nil
+}
+
+// PlanMilestones lists the milestones of the crop plan with the date the batch is expected to reach them
+func (c Crop) PlanMilestones(plan query.CropPlan) []CropPlanMilestone {
+	milestones := []CropPlanMilestone{}
+	if plan == (query.CropPlan{}) {
+		return milestones
+	}
+
+	seedingDate := c.InitialArea.CreatedDate
+
+	milestones = append(milestones, CropPlanMilestone{
+		Code:         CropPlanMilestoneGermination,
+		ExpectedDate: seedingDate.AddDate(0, 0, plan.DaysToGermination),
+		Reached:      c.Stage.order() > GetCropStage(CropStageGerminating).order(),
+	})
+
+	if plan.DaysToTransplant > 0 {
+		milestones = append(milestones, CropPlanMilestone{
+			Code:         CropPlanMilestoneTransplant,
+			ExpectedDate: seedingDate.AddDate(0, 0, plan.DaysToTransplant),
+			Reached:      len(c.MovedArea) > 0,
+		})
+	}
+
+	milestones = append(milestones, CropPlanMilestone{
+		Code:         CropPlanMilestoneFirstHarvest,
+		ExpectedDate: seedingDate.AddDate(0, 0, plan.DaysToFirstHarvest),
+		Reached:      len(c.HarvestedStorage) > 0 || c.Stage.order() >= GetCropStage(CropStageHarvesting).order(),
+	})
+
+	milestones = append(milestones, CropPlanMilestone{
+		Code:         CropPlanMilestoneEndOfHarvest,
+		ExpectedDate: seedingDate.AddDate(0, 0, plan.DaysToEndOfHarvest),
+		Reached:      c.Stage.Code == CropStageFinished || c.Status.Code == CropArchived,
+	})
+
+	return milestones
+}
+
+// PlanDeviations returns the milestones the batch should have reached by now but has not
+func (c Crop) PlanDeviations(plan query.CropPlan, now time.Time) []CropPlanDeviation {
+	deviations := []CropPlanDeviation{}
+
+	for _, v := range c.PlanMilestones(plan) {
+		if v.Reached {
+			continue
+		}
+
+		daysLate := int(now.Sub(v.ExpectedDate).Hours()) / 24
+		if daysLate <= 0 {
+			continue
+		}
+
+		deviations = append(deviations, CropPlanDeviation{
+			Milestone:    v.Code,
+			ExpectedDate: v.ExpectedDate,
+			DaysLate:     daysLate,
+		})
+	}
+
+	return deviations
+}
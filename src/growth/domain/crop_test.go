@@ -25,10 +25,18 @@ func (m CropServiceMock) FindByBatchID(batchID string) ServiceResult {
 	args := m.Called(batchID)
 	return args.Get(0).(ServiceResult)
 }
+func (m *CropServiceMock) ReserveBatchID(batchID string) ServiceResult {
+	args := m.Called(batchID)
+	return args.Get(0).(ServiceResult)
+}
 func (m CropServiceMock) FindAreaByID(uid uuid.UUID) ServiceResult {
 	args := m.Called(uid)
 	return args.Get(0).(ServiceResult)
 }
+func (m *CropServiceMock) FindFarmByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
 
 func TestCreateCropBatch(t *testing.T) {
 	// Given
@@ -57,6 +65,8 @@ func TestCreateCropBatch(t *testing.T) {
 	date := strings.ToLower(time.Now().Format("2Jan"))
 	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
 	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	containerType := Tray{Cell: 15}
 
@@ -64,9 +74,6 @@ func TestCreateCropBatch(t *testing.T) {
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
 	crop.Dump(cropServiceMock, areaBUID, 5, "Notes")
-	crop.Fertilize()
-	crop.Pesticide()
-	crop.Prune()
 
 	// Then
 	cropServiceMock.AssertExpectations(t)
@@ -80,6 +87,57 @@ func TestCreateCropBatch(t *testing.T) {
 	assert.Equal(t, 5, crop.Trash[0].Quantity)
 }
 
+func TestCreateCropBatchWithBatchIDTemplate(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	farmUID, _ := uuid.NewV4()
+	farmServiceResult := ServiceResult{
+		Result: query.CropFarmQueryResult{UID: farmUID, BatchIDTemplate: "{area}-{variety}-{seq}"},
+	}
+	cropServiceMock.On("FindFarmByID", farmUID).Return(farmServiceResult)
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaAServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Name: "Green House A", Type: "SEEDING", FarmUID: farmUID},
+	}
+	areaBServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Name: "Green House B", Type: "SEEDING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Lettuce Romaine"},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	alreadyCreated := ServiceResult{Error: CropError{Code: CropErrorBatchIDAlreadyCreated}}
+	cropServiceMock.On("FindByBatchID", "gre-hou-a-let-rom-01").Return(alreadyCreated)
+	cropServiceMock.On("FindByBatchID", "gre-hou-a-let-rom-02").Return(ServiceResult{})
+	cropServiceMock.On("FindByBatchID", "gre-hou-a-let-rom-03").Return(ServiceResult{})
+	cropServiceMock.On("FindByBatchID", "let-rom-"+date).Return(alreadyCreated)
+	cropServiceMock.On("FindByBatchID", "let-rom-"+date+"-02").Return(ServiceResult{})
+
+	// Another batch reserved gre-hou-a-let-rom-02 after it was found free
+	cropServiceMock.On("ReserveBatchID", "gre-hou-a-let-rom-02").Return(alreadyCreated)
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+
+	// When
+	templateCrop, errTemplate := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+	defaultCrop, errDefault := CreateCropBatch(cropServiceMock, areaBUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+
+	// Then
+	assert.Nil(t, errTemplate)
+	assert.Equal(t, "gre-hou-a-let-rom-03", templateCrop.BatchID)
+	assert.Nil(t, errDefault)
+	assert.Equal(t, "let-rom-"+date+"-02", defaultCrop.BatchID)
+}
+
 func TestHarvestCropBatch(t *testing.T) {
 	// Given
 	cropServiceMock := new(CropServiceMock)
@@ -107,14 +165,18 @@ func TestHarvestCropBatch(t *testing.T) {
 	date := strings.ToLower(time.Now().Format("2Jan"))
 	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
 	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	containerType := Tray{Cell: 15}
 
 	// When
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
-	err1 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "Notes")
-	err2 := crop.Harvest(cropServiceMock, areaAUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "Notes")
+	crop.Water(cropServiceMock, areaBUID, time.Now())
+	crop.Water(cropServiceMock, areaBUID, time.Now())
+	err1 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Market", "Notes", "")
+	err2 := crop.Harvest(cropServiceMock, areaAUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Market", "Notes", "")
 
 	// Then
 	cropServiceMock.AssertExpectations(t)
@@ -127,13 +189,61 @@ func TestHarvestCropBatch(t *testing.T) {
 	assert.NotNil(t, err2)
 
 	// When
-	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), "Notes")
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), HarvestGradeA, "Market", "Notes", "")
 
 	// Then
 	assert.Equal(t, 0, crop.MovedArea[0].CurrentQuantity)
 	assert.Equal(t, 15, crop.HarvestedStorage[0].Quantity)
 	assert.Equal(t, float32(12000), crop.HarvestedStorage[0].ProducedGramQuantity)
 
+	lotDate := time.Now().Format("060102")
+	assert.Equal(t, 2, len(crop.HarvestLots))
+	assert.Equal(t, fmt.Sprintf("%s-%s-01", batchID, lotDate), crop.HarvestLots[0].Code)
+	assert.Equal(t, fmt.Sprintf("%s-%s-02", batchID, lotDate), crop.HarvestLots[1].Code)
+	assert.Equal(t, float32(2000), crop.HarvestLots[1].ProducedGramQuantity)
+	assert.Equal(t, 15, crop.HarvestLots[1].Quantity)
+	assert.Equal(t, "Market", crop.HarvestLots[1].Destination)
+
+	// When
+	err3 := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), "GRADE_Z", "Market", "Notes", "")
+	trace, errTrace := TraceHarvestLot(crop.UncommittedChanges, crop.HarvestLots[1].Code, nil)
+	_, errNotFound := TraceHarvestLot(crop.UncommittedChanges, "unknown-lot", nil)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropHarvestErrorInvalidGrade}, err3)
+
+	assert.Nil(t, errTrace)
+	assert.Equal(t, crop.HarvestLots[1], trace.Lot)
+	assert.Equal(t, inventoryUID, trace.InventoryUID)
+	assert.Equal(t, HarvestLotTraceSeed, trace.Entries[0].Type)
+	assert.Equal(t, HarvestLotTraceMove, trace.Entries[1].Type)
+	assert.Equal(t, areaBUID, trace.Entries[1].AreaUID)
+	assert.Equal(t, HarvestLotTraceWater, trace.Entries[2].Type)
+	assert.Equal(t, HarvestLotTraceWater, trace.Entries[3].Type)
+	assert.Equal(t, HarvestLotTraceHarvest, trace.Entries[len(trace.Entries)-1].Type)
+	assert.Equal(t, CropError{Code: CropHarvestLotErrorNotFound}, errNotFound)
+
+	// When
+	harvestVersion := 0
+	for i, v := range crop.UncommittedChanges {
+		if e, ok := v.(CropBatchHarvested); ok && e.HarvestLot.Code == crop.HarvestLots[1].Code {
+			harvestVersion = i + 1
+		}
+	}
+
+	errCorrectWatering := crop.CorrectWatering(crop.UncommittedChanges, 3, "Watered the wrong area")
+	errCorrectHarvest := crop.CorrectHarvest(crop.UncommittedChanges, harvestVersion, "Recorded twice")
+	correctedTrace, errCorrectedTrace := TraceHarvestLot(crop.UncommittedChanges, crop.HarvestLots[1].Code, nil)
+
+	// Then
+	assert.Nil(t, errCorrectWatering)
+	assert.Nil(t, errCorrectHarvest)
+	assert.Nil(t, errCorrectedTrace)
+	assert.False(t, correctedTrace.Lot.CorrectedDate.IsZero())
+	assert.Equal(t, crop.HarvestLots[1], correctedTrace.Lot)
+	assert.Equal(t, len(trace.Entries)-1, len(correctedTrace.Entries))
+	assert.Equal(t, HarvestLotTraceWater, correctedTrace.Entries[2].Type)
+	assert.Equal(t, HarvestLotTraceHarvest, correctedTrace.Entries[3].Type)
 }
 
 func TestWaterCrop(t *testing.T) {
@@ -163,6 +273,8 @@ func TestWaterCrop(t *testing.T) {
 	date := strings.ToLower(time.Now().Format("2Jan"))
 	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
 	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	containerType := Tray{Cell: 15}
 
@@ -186,6 +298,479 @@ func TestWaterCrop(t *testing.T) {
 	assert.Equal(t, wDate, crop.MovedArea[0].LastWatered)
 }
 
+func TestCareCrop(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaAServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	}
+	areaBServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "GROWING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	fertilizerUID, _ := uuid.NewV4()
+	fertilizerServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:              fertilizerUID,
+			Name:             "NPK 16-16-16",
+			TypeCode:         "AGROCHEMICAL",
+			ChemicalTypeCode: "FERTILIZER",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", fertilizerUID).Return(fertilizerServiceResult)
+
+	pesticideUID, _ := uuid.NewV4()
+	pesticideServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:              pesticideUID,
+			Name:             "Neem Oil",
+			TypeCode:         "AGROCHEMICAL",
+			ChemicalTypeCode: "PESTICIDE",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", pesticideUID).Return(pesticideServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	containerType := Tray{Cell: 15}
+
+	careDate, _ := time.Parse("2006-Jan-02", "2018-Jan-15")
+
+	// When
+	crop, errCrop := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
+	errMove := crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	errFertilize := crop.Fertilize(cropServiceMock, areaBUID, fertilizerUID, 2.5, "GRAM", careDate)
+	errPesticide := crop.Pesticide(cropServiceMock, areaAUID, pesticideUID, 10, "ML", careDate)
+	errPrune := crop.Prune(cropServiceMock, areaBUID, careDate, "Remove lower leaves")
+	errSeedAsFertilizer := crop.Fertilize(cropServiceMock, areaAUID, inventoryUID, 2.5, "GRAM", careDate)
+	errFertilizerAsPesticide := crop.Pesticide(cropServiceMock, areaAUID, fertilizerUID, 10, "ML", careDate)
+	errPesticideAsFertilizer := crop.Fertilize(cropServiceMock, areaBUID, pesticideUID, 2.5, "GRAM", careDate)
+	errInvalidDose := crop.Pesticide(cropServiceMock, areaAUID, pesticideUID, 0, "ML", careDate)
+
+	// Then
+	cropServiceMock.AssertExpectations(t)
+
+	assert.Nil(t, errCrop)
+	assert.Nil(t, errMove)
+	assert.Nil(t, errFertilize)
+	assert.Nil(t, errPesticide)
+	assert.Nil(t, errPrune)
+	assert.Equal(t, CropError{Code: CropCareErrorInvalidMaterialType}, errSeedAsFertilizer)
+	assert.Equal(t, CropError{Code: CropCareErrorInvalidChemicalType}, errFertilizerAsPesticide)
+	assert.Equal(t, CropError{Code: CropCareErrorInvalidChemicalType}, errPesticideAsFertilizer)
+	assert.Equal(t, CropError{Code: CropCareErrorInvalidDose}, errInvalidDose)
+
+	assert.True(t, crop.InitialArea.LastFertilized.IsZero())
+	assert.Equal(t, careDate, crop.MovedArea[0].LastFertilized)
+	assert.Equal(t, careDate, crop.InitialArea.LastPesticided)
+	assert.True(t, crop.MovedArea[0].LastPesticided.IsZero())
+	assert.Equal(t, careDate, crop.MovedArea[0].LastPruned)
+	assert.Equal(t, careDate, crop.LastFertilized)
+}
+
+func TestHarvestWithinPreHarvestInterval(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaAServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	}
+	areaBServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "GROWING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+
+	areaCUID, _ := uuid.NewV4()
+	areaCServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaCUID, Type: "GROWING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaCUID).Return(areaCServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	pesticideUID, _ := uuid.NewV4()
+	pesticideServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:                pesticideUID,
+			Name:               "Neem Oil",
+			TypeCode:           "AGROCHEMICAL",
+			ChemicalTypeCode:   "PESTICIDE",
+			PreHarvestInterval: 7,
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", pesticideUID).Return(pesticideServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	containerType := Tray{Cell: 15}
+
+	pesticidingDate := time.Now().AddDate(0, 0, -2)
+
+	// When
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	errPesticide := crop.Pesticide(cropServiceMock, areaBUID, pesticideUID, 10, "ML", pesticidingDate)
+	errHarvest := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Market", "Notes", "")
+	errOverride := crop.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 10, GetProducedUnit(Kg), HarvestGradeA, "Market", "Notes", "Lab test passed")
+	overrideEvent, _ := crop.UncommittedChanges[len(crop.UncommittedChanges)-1].(CropBatchHarvested)
+
+	// Moving the sprayed plants to another area keeps them unsafe to harvest
+	errMoveAfterSpray := crop.MoveToArea(cropServiceMock, areaBUID, areaCUID, 2)
+	errHarvestMoved := crop.Harvest(cropServiceMock, areaCUID, HarvestTypePartial, 1, GetProducedUnit(Kg), HarvestGradeA, "Market", "Notes", "")
+
+	// Then
+	assert.Nil(t, errPesticide)
+	assert.Equal(t, CropError{Code: CropHarvestErrorWithinPreHarvestInterval}, errHarvest)
+	assert.Nil(t, errOverride)
+	assert.Equal(t, pesticidingDate.AddDate(0, 0, 7), crop.SafeHarvestDate())
+	assert.True(t, crop.AreaSafeHarvestDate(areaAUID).IsZero())
+	assert.Equal(t, "Lab test passed", overrideEvent.PreHarvestOverrideReason)
+
+	assert.Nil(t, errMoveAfterSpray)
+	assert.Equal(t, pesticidingDate.AddDate(0, 0, 7), crop.AreaSafeHarvestDate(areaCUID))
+	assert.Equal(t, CropError{Code: CropHarvestErrorWithinPreHarvestInterval}, errHarvestMoved)
+}
+
+func TestCropStageAndPlanDeviations(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	areaServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaUID).Return(areaServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	plan := query.CropPlan{
+		DaysToGermination:  7,
+		DaysToFirstHarvest: 60,
+		DaysToEndOfHarvest: 90,
+	}
+
+	// When
+	crop, errCrop := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+	deviations := crop.PlanDeviations(plan, time.Now().AddDate(0, 0, 10))
+
+	errInvalid := crop.ChangeStage("BLOOMING", time.Now())
+	errStage := crop.ChangeStage(CropStageFlowering, time.Now())
+	errBackward := crop.ChangeStage(CropStageVegetative, time.Now())
+
+	// Then
+	assert.Nil(t, errCrop)
+	assert.Equal(t, 1, len(deviations))
+	assert.Equal(t, CropPlanMilestoneGermination, deviations[0].Milestone)
+	assert.Equal(t, 3, deviations[0].DaysLate)
+
+	assert.Equal(t, CropError{Code: CropStageErrorInvalidStage}, errInvalid)
+	assert.Nil(t, errStage)
+	assert.Equal(t, CropStageFlowering, crop.Stage.Code)
+	assert.Equal(t, CropError{Code: CropStageErrorInvalidTransition}, errBackward)
+	assert.Equal(t, 0, len(crop.PlanDeviations(plan, time.Now().AddDate(0, 0, 10))))
+}
+
+func TestSplitAndMergeCropBatch(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	areaServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaUID).Return(areaServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	pesticideUID, _ := uuid.NewV4()
+	pesticideServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:                pesticideUID,
+			Name:               "Neem Oil",
+			TypeCode:           "AGROCHEMICAL",
+			ChemicalTypeCode:   "PESTICIDE",
+			PreHarvestInterval: 7,
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", pesticideUID).Return(pesticideServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+	cropServiceMock.On("FindByBatchID", batchID+"-s1").Return(ServiceResult{})
+
+	crop, errCrop := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+
+	cropPesticidingDate := time.Now().AddDate(0, 0, -3)
+	crop.Pesticide(cropServiceMock, areaUID, pesticideUID, 10, "ML", cropPesticidingDate)
+
+	// When
+	_, errNotEnough := crop.Split(cropServiceMock, areaUID, 25)
+	_, errWhole := crop.Split(cropServiceMock, areaUID, 20)
+	split, errSplit := crop.Split(cropServiceMock, areaUID, 5)
+
+	// Then
+	assert.Nil(t, errCrop)
+	assert.Equal(t, CropError{Code: CropSplitErrorNotEnoughQuantity}, errNotEnough)
+	assert.Equal(t, CropError{Code: CropSplitErrorWholeBatch}, errWhole)
+	assert.Nil(t, errSplit)
+
+	assert.Equal(t, batchID+"-s1", split.BatchID)
+	assert.Equal(t, 5, split.InitialArea.CurrentQuantity)
+	assert.Equal(t, 15, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, CropLineageSplitFrom, split.Lineage[0].Type)
+	assert.Equal(t, crop.UID, split.Lineage[0].CropUID)
+	assert.Equal(t, CropLineageSplitInto, crop.Lineage[0].Type)
+	assert.Equal(t, split.UID, crop.Lineage[0].CropUID)
+
+	// The split plants are still within the pre-harvest interval of the parent batch
+	assert.Equal(t, cropPesticidingDate.AddDate(0, 0, 7), split.InitialArea.SafeHarvestDate)
+	assert.Equal(t, cropPesticidingDate.AddDate(0, 0, 7), split.UncommittedChanges[0].(CropBatchCreated).SafeHarvestDate)
+
+	// When
+	splitPesticidingDate := time.Now()
+	split.Pesticide(cropServiceMock, areaUID, pesticideUID, 10, "ML", splitPesticidingDate)
+
+	errSame := crop.Merge(cropServiceMock, crop, areaUID)
+	errMerge := crop.Merge(cropServiceMock, split, areaUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropMergeErrorSameBatch}, errSame)
+	assert.Nil(t, errMerge)
+	assert.Equal(t, 20, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, 0, split.InitialArea.CurrentQuantity)
+	assert.Equal(t, CropArchived, split.Status.Code)
+	assert.Equal(t, CropLineageMergedFrom, crop.Lineage[1].Type)
+	assert.Equal(t, CropLineageMergedInto, split.Lineage[1].Type)
+
+	// The merged plants bring the later pre-harvest interval of the source batch
+	assert.Equal(t, splitPesticidingDate.AddDate(0, 0, 7), crop.InitialArea.SafeHarvestDate)
+	assert.Equal(t, splitPesticidingDate.AddDate(0, 0, 7), crop.SafeHarvestDate())
+
+	// When
+	errArchived := split.Merge(cropServiceMock, crop, areaUID)
+
+	// Then
+	assert.Equal(t, CropError{Code: CropMergeErrorArchived}, errArchived)
+}
+
+func TestTraceSplitHarvestLot(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaCUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	})
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "GROWING"},
+	})
+	cropServiceMock.On("FindAreaByID", areaCUID).Return(ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaCUID, Type: "GROWING"},
+	})
+
+	inventoryUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: inventoryUID, Name: "Tomato Super One"},
+	})
+
+	fertilizerUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", fertilizerUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: fertilizerUID, Name: "NPK 16-16-16", TypeCode: "AGROCHEMICAL", ChemicalTypeCode: "FERTILIZER"},
+	})
+
+	pesticideUID, _ := uuid.NewV4()
+	cropServiceMock.On("FindMaterialByID", pesticideUID).Return(ServiceResult{
+		Result: query.CropMaterialQueryResult{UID: pesticideUID, Name: "Neem Oil", TypeCode: "AGROCHEMICAL", ChemicalTypeCode: "PESTICIDE"},
+	})
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindByBatchID", batchID+"-s1").Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	careDate := time.Now().AddDate(0, 0, -1)
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 10)
+	crop.Pesticide(cropServiceMock, areaBUID, pesticideUID, 10, "ML", careDate)
+	crop.MoveToArea(cropServiceMock, areaAUID, areaCUID, 5)
+	crop.Fertilize(cropServiceMock, areaCUID, fertilizerUID, 2.5, "GRAM", careDate)
+	split, _ := crop.Split(cropServiceMock, areaBUID, 5)
+	crop.Pesticide(cropServiceMock, areaBUID, pesticideUID, 10, "ML", time.Now())
+	crop.Harvest(cropServiceMock, areaCUID, HarvestTypePartial, 1, GetProducedUnit(Kg), HarvestGradeA, "Market", "", "")
+	split.Harvest(cropServiceMock, areaBUID, HarvestTypePartial, 1, GetProducedUnit(Kg), HarvestGradeA, "Market", "", "")
+
+	findEvents := func(cropUID uuid.UUID) ([]interface{}, error) {
+		if cropUID == crop.UID {
+			return crop.UncommittedChanges, nil
+		}
+
+		return nil, nil
+	}
+
+	// When
+	cropTrace, errCropTrace := TraceHarvestLot(crop.UncommittedChanges, crop.HarvestLots[0].Code, findEvents)
+	splitTrace, errSplitTrace := TraceHarvestLot(split.UncommittedChanges, split.HarvestLots[0].Code, findEvents)
+	_, errNoFinder := TraceHarvestLot(split.UncommittedChanges, split.HarvestLots[0].Code, nil)
+
+	// Then
+	traceTypes := func(trace HarvestLotTrace) []string {
+		types := []string{}
+		for _, v := range trace.Entries {
+			types = append(types, v.Type)
+		}
+
+		return types
+	}
+
+	// The lot harvested in area C never went through area B
+	assert.Nil(t, errCropTrace)
+	assert.Equal(t, []string{HarvestLotTraceSeed, HarvestLotTraceMove, HarvestLotTraceFertilize, HarvestLotTraceHarvest}, traceTypes(cropTrace))
+	assert.Equal(t, areaCUID, cropTrace.Entries[1].AreaUID)
+
+	// The split lot keeps the seed and the treatments of the parent batch before the split
+	assert.Nil(t, errSplitTrace)
+	assert.Equal(t, []string{HarvestLotTraceSeed, HarvestLotTraceMove, HarvestLotTracePesticide, HarvestLotTraceSplit, HarvestLotTraceHarvest}, traceTypes(splitTrace))
+	assert.Equal(t, crop.BatchID, splitTrace.Entries[0].BatchID)
+	assert.Equal(t, inventoryUID, splitTrace.Entries[0].MaterialUID)
+	assert.Equal(t, areaBUID, splitTrace.Entries[2].AreaUID)
+	assert.Equal(t, careDate, splitTrace.Entries[2].Date)
+	assert.Equal(t, split.BatchID, splitTrace.Entries[4].BatchID)
+	assert.Equal(t, CropError{Code: CropHarvestLotErrorNotFound}, errNoFinder)
+}
+
+type CropForecastServiceMock struct {
+	mock.Mock
+}
+
+func (m *CropForecastServiceMock) FindCropYieldRecordsByFarm(farmUID uuid.UUID) ServiceResult {
+	args := m.Called(farmUID)
+	return args.Get(0).(ServiceResult)
+}
+
+func TestForecastHarvest(t *testing.T) {
+	// Given
+	forecastServiceMock := new(CropForecastServiceMock)
+
+	farmUID, _ := uuid.NewV4()
+	lettuceUID, _ := uuid.NewV4()
+	tomatoUID, _ := uuid.NewV4()
+
+	// Monday
+	now := time.Date(2018, time.March, 5, 9, 0, 0, 0, time.UTC)
+	seeded := now.AddDate(0, 0, -60)
+
+	records := []CropYieldRecord{
+		{
+			Status: CropArchived, InventoryUID: lettuceUID, InventoryName: "Lettuce",
+			SeedingDate: seeded, InitialQuantity: 10,
+			Harvests: []CropYieldHarvest{
+				{HarvestType: HarvestTypeAll, ProducedGramQuantity: 1000, HarvestDate: seeded.AddDate(0, 0, 30)},
+			},
+		},
+		{
+			Status: CropArchived, InventoryUID: lettuceUID, InventoryName: "Lettuce",
+			SeedingDate: seeded, InitialQuantity: 10,
+			Harvests: []CropYieldHarvest{
+				{HarvestType: HarvestTypeAll, ProducedGramQuantity: 3000, HarvestDate: seeded.AddDate(0, 0, 30)},
+			},
+		},
+		{
+			Status: CropActive, InventoryUID: lettuceUID, InventoryName: "Lettuce",
+			SeedingDate: now.AddDate(0, 0, -20), InitialQuantity: 5, CurrentQuantity: 5,
+		},
+		{
+			Status: CropActive, InventoryUID: tomatoUID, InventoryName: "Tomato",
+			SeedingDate: now.AddDate(0, 0, -20), InitialQuantity: 5, CurrentQuantity: 5,
+		},
+	}
+	forecastServiceMock.On("FindCropYieldRecordsByFarm", farmUID).Return(ServiceResult{Result: records})
+
+	// When
+	forecast, err := ForecastHarvest(forecastServiceMock, farmUID, now, now.AddDate(0, 0, 28), now)
+	_, errRange := ForecastHarvest(forecastServiceMock, farmUID, now, now, now)
+
+	// Then
+	forecastServiceMock.AssertExpectations(t)
+
+	assert.Nil(t, err)
+	assert.Equal(t, CropError{Code: CropForecastErrorInvalidRange}, errRange)
+
+	assert.Equal(t, 2, len(forecast.Models))
+	assert.Equal(t, 2, forecast.Models[0].SampleCount)
+	assert.Equal(t, float32(200), forecast.Models[0].MeanGramPerPlant)
+	assert.Equal(t, float32(100), forecast.Models[0].StdDevGramPerPlant)
+	assert.Equal(t, 30, forecast.Models[0].MeanDaysToHarvest)
+	assert.Equal(t, 0, forecast.Models[1].SampleCount)
+	assert.Equal(t, 1, len(forecast.Models[1].UnforecastedBatchUIDs))
+
+	assert.Equal(t, 1, len(forecast.Weeks))
+	assert.Equal(t, lettuceUID, forecast.Weeks[0].InventoryUID)
+	assert.Equal(t, time.Date(2018, time.March, 12, 0, 0, 0, 0, time.UTC), forecast.Weeks[0].WeekStart)
+	assert.Equal(t, float32(1000), forecast.Weeks[0].ExpectedGramQuantity)
+	assert.Equal(t, float32(500), forecast.Weeks[0].LowerBoundGramQuantity)
+	assert.Equal(t, float32(1500), forecast.Weeks[0].UpperBoundGramQuantity)
+}
+
 func TestCropHarvestArchiveStatus(t *testing.T) {
 	// Given
 	cropServiceMock := new(CropServiceMock)
@@ -213,20 +798,22 @@ func TestCropHarvestArchiveStatus(t *testing.T) {
 	date := strings.ToLower(time.Now().Format("2Jan"))
 	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
 	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	containerType := Tray{Cell: 15}
 
 	// When
 	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, containerType)
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
-	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), "Notes")
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 2000, GetProducedUnit(Gr), HarvestGradeA, "Market", "Notes", "")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropActive)
 
 	// When
 	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 5)
-	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 3000, GetProducedUnit(Gr), "Notes")
+	crop.Harvest(cropServiceMock, areaBUID, HarvestTypeAll, 3000, GetProducedUnit(Gr), HarvestGradeA, "Market", "Notes", "")
 
 	// Then
 	assert.Equal(t, crop.Status.Code, CropArchived)
@@ -259,6 +846,8 @@ func TestCropDumpArchiveStatus(t *testing.T) {
 	date := strings.ToLower(time.Now().Format("2Jan"))
 	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
 	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
 
 	containerType := Tray{Cell: 15}
 
@@ -276,3 +865,133 @@ func TestCropDumpArchiveStatus(t *testing.T) {
 	// Then
 	assert.Equal(t, crop.Status.Code, CropArchived)
 }
+
+func TestCorrectCropOperations(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaAUID, _ := uuid.NewV4()
+	areaBUID, _ := uuid.NewV4()
+	areaAServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaAUID, Type: "SEEDING"},
+	}
+	areaBServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaBUID, Type: "GROWING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaAUID).Return(areaAServiceResult)
+	cropServiceMock.On("FindAreaByID", areaBUID).Return(areaBServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	wDate1 := time.Date(2018, time.January, 15, 8, 0, 0, 0, time.UTC)
+	wDate2 := time.Date(2018, time.January, 16, 8, 0, 0, 0, time.UTC)
+
+	// Versions 1 to 5
+	crop, _ := CreateCropBatch(cropServiceMock, areaAUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+	crop.MoveToArea(cropServiceMock, areaAUID, areaBUID, 15)
+	crop.Water(cropServiceMock, areaBUID, wDate1)
+	crop.Water(cropServiceMock, areaBUID, wDate2)
+	crop.Dump(cropServiceMock, areaAUID, 5, "Notes")
+
+	history := crop.UncommittedChanges
+
+	// When
+	errReason := crop.CorrectDump(history, 5, "")
+	errNotFound := crop.CorrectDump(history, 9, "Dumped the wrong batch")
+	errInvalid := crop.CorrectDump(history, 2, "Dumped the wrong batch")
+	errDump := crop.CorrectDump(history, 5, "Dumped the wrong batch")
+	errAgain := crop.CorrectDump(history, 5, "Dumped the wrong batch")
+
+	// Then
+	assert.Equal(t, CropError{Code: CropCorrectionErrorInvalidReason}, errReason)
+	assert.Equal(t, CropError{Code: CropCorrectionErrorEventNotFound}, errNotFound)
+	assert.Equal(t, CropError{Code: CropCorrectionErrorInvalidEvent}, errInvalid)
+	assert.Nil(t, errDump)
+	assert.Equal(t, CropError{Code: CropCorrectionErrorAlreadyCorrected}, errAgain)
+	assert.Equal(t, 5, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, 0, crop.Trash[0].Quantity)
+
+	// When
+	errWater := crop.CorrectWatering(history, 4, "Watered another area")
+	errMove := crop.CorrectMove(history, 2, "Moved to the wrong area")
+
+	// Then
+	assert.Nil(t, errWater)
+	assert.Equal(t, wDate1, crop.MovedArea[0].LastWatered)
+	assert.Nil(t, errMove)
+	assert.Equal(t, 20, crop.InitialArea.CurrentQuantity)
+	assert.Equal(t, 0, crop.MovedArea[0].CurrentQuantity)
+	assert.Len(t, crop.Corrections, 3)
+}
+
+func TestArchiveCrop(t *testing.T) {
+	// Given
+	cropServiceMock := new(CropServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	areaServiceResult := ServiceResult{
+		Result: query.CropAreaQueryResult{UID: areaUID, Type: "SEEDING"},
+	}
+	cropServiceMock.On("FindAreaByID", areaUID).Return(areaServiceResult)
+
+	inventoryUID, _ := uuid.NewV4()
+	inventoryServiceResult := ServiceResult{
+		Result: query.CropMaterialQueryResult{
+			UID:  inventoryUID,
+			Name: "Tomato Super One",
+		},
+	}
+	cropServiceMock.On("FindMaterialByID", inventoryUID).Return(inventoryServiceResult)
+
+	date := strings.ToLower(time.Now().Format("2Jan"))
+	batchID := fmt.Sprintf("%s%s", "tom-sup-one-", date)
+	cropServiceMock.On("FindByBatchID", batchID).Return(ServiceResult{})
+	cropServiceMock.On("ReserveBatchID", mock.Anything).Return(ServiceResult{})
+	cropServiceMock.On("FindFarmByID", uuid.UUID{}).Return(ServiceResult{Result: query.CropFarmQueryResult{}})
+
+	crop, _ := CreateCropBatch(cropServiceMock, areaUID, CropTypeSeeding, inventoryUID, 20, Tray{Cell: 15})
+
+	// When
+	errDate := crop.Archive(time.Time{})
+	errArchive := crop.Archive(time.Now())
+	errAgain := crop.Archive(time.Now())
+
+	// Then
+	assert.Equal(t, CropError{Code: CropArchiveErrorInvalidDate}, errDate)
+	assert.Nil(t, errArchive)
+	assert.Equal(t, CropArchived, crop.Status.Code)
+	assert.Equal(t, CropError{Code: CropArchiveErrorAlreadyArchived}, errAgain)
+}
+
+func TestValidateSeedStock(t *testing.T) {
+	// Given
+	container := CropContainer{Quantity: 2, Type: Tray{Cell: 50}}
+
+	seeds := query.CropMaterialQueryResult{TypeCode: "SEED", Quantity: 150, QuantityUnit: "SEEDS"}
+	packets := query.CropMaterialQueryResult{TypeCode: "SEED", Quantity: 3, QuantityUnit: "PACKETS", SeedsPerUnit: 25}
+	unknown := query.CropMaterialQueryResult{TypeCode: "SEED", Quantity: 1, QuantityUnit: "GRAM"}
+
+	// When
+	errSeeds := ValidateSeedStock(seeds, container)
+	errPackets := ValidateSeedStock(packets, container)
+	errUnknown := ValidateSeedStock(unknown, container)
+
+	// Then
+	assert.Equal(t, 100, container.SeedCount())
+	assert.Nil(t, errSeeds)
+	assert.Equal(t, CropError{Code: CropMaterialErrorInsufficientSeed}, errPackets)
+	assert.Equal(t, CropError{Code: CropMaterialErrorSeedsPerUnitRequired}, errUnknown)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type CropForecastService struct {
+	CropReadQuery     query.CropReadQuery
+	CropActivityQuery query.CropActivityQuery
+}
+
+func (s CropForecastService) FindCropYieldRecordsByFarm(farmUID uuid.UUID) domain.ServiceResult {
+	crops := []storage.CropRead{}
+
+	// Fetch every batch in a single page by using the total as the limit
+	countResult := <-s.CropReadQuery.CountAllCropsByFarm(farmUID, domain.CropActive)
+	if countResult.Error != nil {
+		return domain.ServiceResult{Error: countResult.Error}
+	}
+
+	total, ok := countResult.Result.(int)
+	if !ok {
+		return domain.ServiceResult{Error: domain.CropError{Code: domain.CropForecastErrorInvalidRecord}}
+	}
+
+	if total > 0 {
+		result := <-s.CropReadQuery.FindAllCropsByFarm(farmUID, domain.CropActive, 1, total)
+		if result.Error != nil {
+			return domain.ServiceResult{Error: result.Error}
+		}
+
+		active, ok := result.Result.([]storage.CropRead)
+		if !ok {
+			return domain.ServiceResult{Error: domain.CropError{Code: domain.CropForecastErrorInvalidRecord}}
+		}
+
+		crops = append(crops, active...)
+	}
+
+	countResult = <-s.CropReadQuery.CountAllArchivedCropsByFarm(farmUID)
+	if countResult.Error != nil {
+		return domain.ServiceResult{Error: countResult.Error}
+	}
+
+	total, ok = countResult.Result.(int)
+	if !ok {
+		return domain.ServiceResult{Error: domain.CropError{Code: domain.CropForecastErrorInvalidRecord}}
+	}
+
+	if total > 0 {
+		result := <-s.CropReadQuery.FindAllCropsArchives(farmUID, 1, total)
+		if result.Error != nil {
+			return domain.ServiceResult{Error: result.Error}
+		}
+
+		archives, ok := result.Result.([]storage.CropRead)
+		if !ok {
+			return domain.ServiceResult{Error: domain.CropError{Code: domain.CropForecastErrorInvalidRecord}}
+		}
+
+		crops = append(crops, archives...)
+	}
+
+	// Fetch the harvests of every batch at once instead of querying them batch by batch
+	result := <-s.CropActivityQuery.FindAllByFarmIDAndActivityType(farmUID, storage.HarvestActivity{})
+	if result.Error != nil {
+		return domain.ServiceResult{Error: result.Error}
+	}
+
+	activities, ok := result.Result.([]storage.CropActivity)
+	if !ok {
+		return domain.ServiceResult{Error: domain.CropError{Code: domain.CropForecastErrorInvalidRecord}}
+	}
+
+	harvests := make(map[uuid.UUID][]storage.CropActivity)
+	for _, v := range activities {
+		harvests[v.UID] = append(harvests[v.UID], v)
+	}
+
+	records := []domain.CropYieldRecord{}
+	for _, crop := range crops {
+		record := domain.CropYieldRecord{
+			CropUID:         crop.UID,
+			BatchID:         crop.BatchID,
+			Status:          crop.Status,
+			InventoryUID:    crop.Inventory.UID,
+			InventoryName:   crop.Inventory.Name,
+			SeedingDate:     crop.InitialArea.CreatedDate,
+			InitialQuantity: crop.InitialArea.InitialQuantity,
+			CurrentQuantity: crop.InitialArea.CurrentQuantity,
+		}
+
+		for _, v := range crop.MovedArea {
+			record.CurrentQuantity += v.CurrentQuantity
+		}
+
+		// A correction keeps the original harvest activity, so leave out the reversed lots
+		corrected := make(map[string]bool)
+		for _, v := range crop.HarvestLots {
+			if v.CorrectedDate != nil {
+				corrected[v.Code] = true
+			}
+		}
+
+		for _, v := range harvests[crop.UID] {
+			harvest, ok := v.ActivityType.(storage.HarvestActivity)
+			if !ok {
+				continue
+			}
+
+			if harvest.LotCode != "" && corrected[harvest.LotCode] {
+				continue
+			}
+
+			record.Harvests = append(record.Harvests, domain.CropYieldHarvest{
+				HarvestType:          harvest.Type,
+				ProducedGramQuantity: harvest.ProducedGramQuantity,
+				HarvestDate:          harvest.HarvestDate,
+			})
+		}
+
+		records = append(records, record)
+	}
+
+	return domain.ServiceResult{
+		Result: records,
+	}
+}
@@ -1,8 +1,11 @@
 package service
 
 import (
+	"time"
+
 	"github.com/Tanibox/tania-core/src/growth/domain"
 	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/repository"
 	"github.com/Tanibox/tania-core/src/growth/storage"
 	uuid "github.com/satori/go.uuid"
 )
@@ -11,6 +14,8 @@ type CropServiceInMemory struct {
 	MaterialReadQuery query.MaterialReadQuery
 	CropReadQuery     query.CropReadQuery
 	AreaReadQuery     query.AreaReadQuery
+	FarmReadQuery     query.FarmReadQuery
+	CropBatchIDRepo   repository.CropBatchIDRepository
 }
 
 func (s CropServiceInMemory) FindMaterialByID(uid uuid.UUID) domain.ServiceResult {
@@ -67,6 +72,31 @@ func (s CropServiceInMemory) FindByBatchID(batchID string) domain.ServiceResult
 	}
 }
 
+// ReserveBatchID fails when another crop batch already reserved the batch ID,
+// even if that crop batch is not in the read model yet
+func (s CropServiceInMemory) ReserveBatchID(batchID string) domain.ServiceResult {
+	result := <-s.CropBatchIDRepo.Reserve(&storage.CropBatchID{
+		BatchID:     batchID,
+		CreatedDate: time.Now(),
+	})
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	if reserved, ok := result.Result.(bool); !ok || !reserved {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropErrorBatchIDAlreadyCreated},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: batchID,
+	}
+}
+
 func (s CropServiceInMemory) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
 	result := <-s.AreaReadQuery.FindByID(uid)
 
@@ -93,3 +123,30 @@ func (s CropServiceInMemory) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
 		Result: area,
 	}
 }
+
+func (s CropServiceInMemory) FindFarmByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.FarmReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropErrorInvalidFarm},
+		}
+	}
+
+	if farm == (query.CropFarmQueryResult{}) {
+		return domain.ServiceResult{
+			Error: domain.CropError{Code: domain.CropErrorFarmNotFound},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: farm,
+	}
+}
@@ -0,0 +1,53 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/devices/storage"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AreaReadingQueryInMemory struct {
+	Storage *storage.DeviceReadingStorage
+}
+
+func NewAreaReadingQueryInMemory(s *storage.DeviceReadingStorage) query.AreaReadingQuery {
+	return AreaReadingQueryInMemory{Storage: s}
+}
+
+func (s AreaReadingQueryInMemory) FindAllByAreaID(areaUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		readings := []query.CropAreaReadingQueryResult{}
+		for _, val := range s.Storage.DeviceReadings {
+			if val.AttachmentType != domain.DeviceAttachmentArea || val.AttachmentUID != areaUID {
+				continue
+			}
+
+			if !val.RecordedDate.Before(from) && !val.RecordedDate.After(to) {
+				readings = append(readings, query.CropAreaReadingQueryResult{
+					SensorType:   val.SensorType,
+					Value:        val.Value,
+					RecordedDate: val.RecordedDate,
+				})
+			}
+		}
+
+		sort.Slice(readings, func(i, j int) bool {
+			return readings[i].RecordedDate.Before(readings[j].RecordedDate)
+		})
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
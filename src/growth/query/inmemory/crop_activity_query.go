@@ -1,17 +1,20 @@
 package inmemory
 
 import (
+	"errors"
+
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/storage"
 	uuid "github.com/satori/go.uuid"
 )
 
 type CropActivityQueryInMemory struct {
-	Storage *storage.CropActivityStorage
+	Storage         *storage.CropActivityStorage
+	CropReadStorage *storage.CropReadStorage
 }
 
-func NewCropActivityQueryInMemory(s *storage.CropActivityStorage) query.CropActivityQuery {
-	return CropActivityQueryInMemory{Storage: s}
+func NewCropActivityQueryInMemory(s *storage.CropActivityStorage, cropReadStorage *storage.CropReadStorage) query.CropActivityQuery {
+	return CropActivityQueryInMemory{Storage: s, CropReadStorage: cropReadStorage}
 }
 
 func (s CropActivityQueryInMemory) FindAllByCropID(uid uuid.UUID) <-chan query.QueryResult {
@@ -63,3 +66,42 @@ func (s CropActivityQueryInMemory) FindByCropIDAndActivityType(uid uuid.UUID, ac
 
 	return result
 }
+
+// FindAllByFarmIDAndActivityType returns the activities of the given type of every crop in the farm
+func (s CropActivityQueryInMemory) FindAllByFarmIDAndActivityType(farmUID uuid.UUID, activityType interface{}) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		at, ok := activityType.(storage.ActivityType)
+		if !ok {
+			result <- query.QueryResult{Error: errors.New("Wrong activity type")}
+			close(result)
+			return
+		}
+
+		s.CropReadStorage.Lock.RLock()
+		farmCrops := make(map[uuid.UUID]bool)
+		for _, val := range s.CropReadStorage.CropReadMap {
+			if val.FarmUID == farmUID {
+				farmCrops[val.UID] = true
+			}
+		}
+		s.CropReadStorage.Lock.RUnlock()
+
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		activities := []storage.CropActivity{}
+		for _, val := range s.Storage.CropActivityMap {
+			if farmCrops[val.UID] && val.ActivityType != nil && val.ActivityType.Code() == at.Code() {
+				activities = append(activities, val)
+			}
+		}
+
+		result <- query.QueryResult{Result: activities}
+
+		close(result)
+	}()
+
+	return result
+}
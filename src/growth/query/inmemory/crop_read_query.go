@@ -58,6 +58,30 @@ func (s CropReadQueryInMemory) FindByBatchID(batchID string) <-chan query.QueryR
 	return result
 }
 
+func (s CropReadQueryInMemory) FindByHarvestLotCode(code string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		crop := storage.CropRead{}
+		for _, val := range s.Storage.CropReadMap {
+			for _, lot := range val.HarvestLots {
+				if lot.Code == code {
+					crop = val
+				}
+			}
+		}
+
+		result <- query.QueryResult{Result: crop}
+
+		close(result)
+	}()
+
+	return result
+}
+
 func (s CropReadQueryInMemory) FindAllCropsByFarm(farmUID uuid.UUID, status string, page, limit int) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
@@ -26,6 +26,7 @@ func (s FarmReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult
 			if val.UID == uid {
 				farm.UID = uid
 				farm.Name = val.Name
+				farm.BatchIDTemplate = val.BatchIDTemplate
 			}
 		}
 
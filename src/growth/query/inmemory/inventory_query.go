@@ -28,6 +28,13 @@ func (s MaterialReadQueryInMemory) FindByID(inventoryUID uuid.UUID) <-chan query
 				ci.UID = val.UID
 				ci.Name = val.Name
 				ci.TypeCode = val.Type.Code()
+				ci.Quantity = val.Quantity.Value
+				ci.QuantityUnit = val.Quantity.Unit.Code
+				ci.SeedsPerUnit = val.SeedsPerUnit
+
+				if val.CropPlan != nil {
+					ci.CropPlan = query.CropPlan(*val.CropPlan)
+				}
 
 				// WARNING, domain leakage
 				switch v := val.Type.(type) {
@@ -35,6 +42,9 @@ func (s MaterialReadQueryInMemory) FindByID(inventoryUID uuid.UUID) <-chan query
 					ci.PlantTypeCode = v.PlantType.Code
 				case assetsdomain.MaterialTypePlant:
 					ci.PlantTypeCode = v.PlantType.Code
+				case assetsdomain.MaterialTypeAgrochemical:
+					ci.ChemicalTypeCode = v.ChemicalType.Code
+					ci.PreHarvestInterval = v.PreHarvestInterval
 				}
 			}
 		}
@@ -0,0 +1,54 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WeatherReadQueryInMemory struct {
+	Storage *storage.WeatherReadStorage
+}
+
+func NewWeatherReadQueryInMemory(s *storage.WeatherReadStorage) query.WeatherReadQuery {
+	return WeatherReadQueryInMemory{Storage: s}
+}
+
+func (s WeatherReadQueryInMemory) FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		weather := []query.CropWeatherQueryResult{}
+		for _, val := range s.Storage.WeatherReads {
+			if val.FarmUID == farmUID && !val.Date.Before(from) && !val.Date.After(to) {
+				weather = append(weather, query.CropWeatherQueryResult{
+					Date:               val.Date,
+					Kind:               val.Kind,
+					TemperatureMin:     val.TemperatureMin,
+					TemperatureMax:     val.TemperatureMax,
+					Precipitation:      val.Precipitation,
+					Humidity:           val.Humidity,
+					WindSpeedMax:       val.WindSpeedMax,
+					Evapotranspiration: val.Evapotranspiration,
+					Radiation:          val.Radiation,
+				})
+			}
+		}
+
+		sort.Slice(weather, func(i, j int) bool {
+			return weather[i].Date.Before(weather[j].Date)
+		})
+
+		result <- query.QueryResult{Result: weather}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AreaReadingQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewAreaReadingQueryMysql(db *sql.DB) query.AreaReadingQuery {
+	return AreaReadingQueryMysql{DB: db}
+}
+
+type areaReadingResult struct {
+	SensorType   string
+	Value        float32
+	RecordedDate time.Time
+}
+
+func (s AreaReadingQueryMysql) FindAllByAreaID(areaUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		readings := []query.CropAreaReadingQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT SENSOR_TYPE, VALUE, RECORDED_DATE
+			FROM DEVICE_READING
+			WHERE ATTACHMENT_TYPE = ? AND ATTACHMENT_UID = ? AND RECORDED_DATE >= ? AND RECORDED_DATE <= ?
+			ORDER BY RECORDED_DATE ASC`,
+			domain.DeviceAttachmentArea, areaUID.Bytes(), from, to)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := areaReadingResult{}
+			err := rows.Scan(&rowsData.SensorType, &rowsData.Value, &rowsData.RecordedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			readings = append(readings, query.CropAreaReadingQueryResult{
+				SensorType:   rowsData.SensorType,
+				Value:        rowsData.Value,
+				RecordedDate: rowsData.RecordedDate.UTC(),
+			})
+		}
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
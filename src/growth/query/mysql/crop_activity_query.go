@@ -144,3 +144,80 @@ func (s CropActivityQueryMysql) FindByCropIDAndActivityType(uid uuid.UUID, activ
 
 	return result
 }
+
+// FindAllByFarmIDAndActivityType returns the activities of the given type of every crop in the farm
+func (s CropActivityQueryMysql) FindAllByFarmIDAndActivityType(farmUID uuid.UUID, activityType interface{}) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		cropActivities := []storage.CropActivity{}
+		rowsData := cropActivityResult{}
+
+		at, ok := activityType.(storage.ActivityType)
+		if !ok {
+			result <- query.QueryResult{Error: errors.New("Wrong activity type")}
+			close(result)
+			return
+		}
+
+		rows, err := s.DB.Query(`SELECT CA.* FROM CROP_ACTIVITY CA
+			JOIN CROP_READ CR ON CR.UID = CA.CROP_UID
+			WHERE CR.FARM_UID = ? AND CA.ACTIVITY_TYPE_CODE = ?
+			ORDER BY CA.CREATED_DATE DESC`, farmUID.Bytes(), at.Code())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			err = rows.Scan(
+				&rowsData.ID,
+				&rowsData.CropUID,
+				&rowsData.BatchID,
+				&rowsData.ContainerType,
+				&rowsData.ActivityType,
+				&rowsData.ActivityTypeCode,
+				&rowsData.CreatedDate,
+				&rowsData.Description,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			wrapper := decoder.CropActivityTypeWrapper{}
+			json.Unmarshal(rowsData.ActivityType, &wrapper)
+
+			activityType, ok := wrapper.Data.(storage.ActivityType)
+			if !ok {
+				result <- query.QueryResult{Error: errors.New("Error type assertion")}
+				close(result)
+				return
+			}
+
+			cropUID, err := uuid.FromBytes(rowsData.CropUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			cropActivities = append(cropActivities, storage.CropActivity{
+				UID:           cropUID,
+				BatchID:       rowsData.BatchID,
+				ContainerType: rowsData.ContainerType,
+				ActivityType:  activityType,
+				CreatedDate:   rowsData.CreatedDate,
+				Description:   rowsData.Description,
+			})
+		}
+
+		result <- query.QueryResult{Result: cropActivities}
+		close(result)
+	}()
+
+	return result
+}
@@ -16,8 +16,9 @@ func NewFarmReadQueryMysql(db *sql.DB) query.FarmReadQuery {
 }
 
 type farmReadResult struct {
-	UID  []byte
-	Name string
+	UID             []byte
+	Name            string
+	BatchIDTemplate sql.NullString
 }
 
 func (s FarmReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
@@ -27,9 +28,10 @@ func (s FarmReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 		farmRead := query.CropFarmQueryResult{}
 		rowsData := farmReadResult{}
 
-		err := s.DB.QueryRow("SELECT UID, NAME FROM FARM_READ WHERE UID = ?", uid.Bytes()).Scan(
+		err := s.DB.QueryRow("SELECT UID, NAME, BATCH_ID_TEMPLATE FROM FARM_READ WHERE UID = ?", uid.Bytes()).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
+			&rowsData.BatchIDTemplate,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -47,6 +49,7 @@ func (s FarmReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 
 		farmRead.UID = farmUID
 		farmRead.Name = rowsData.Name
+		farmRead.BatchIDTemplate = rowsData.BatchIDTemplate.String
 
 		result <- query.QueryResult{Result: farmRead}
 		close(result)
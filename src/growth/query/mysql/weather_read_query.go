@@ -0,0 +1,91 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WeatherReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewWeatherReadQueryMysql(db *sql.DB) query.WeatherReadQuery {
+	return WeatherReadQueryMysql{DB: db}
+}
+
+type weatherReadResult struct {
+	Date               time.Time
+	Kind               string
+	TemperatureMin     sql.NullFloat64
+	TemperatureMax     sql.NullFloat64
+	Precipitation      sql.NullFloat64
+	Humidity           sql.NullFloat64
+	WindSpeedMax       sql.NullFloat64
+	Evapotranspiration sql.NullFloat64
+	Radiation          sql.NullFloat64
+}
+
+func (s WeatherReadQueryMysql) FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		weather := []query.CropWeatherQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT DATE, KIND, TEMPERATURE_MIN, TEMPERATURE_MAX, PRECIPITATION,
+			HUMIDITY, WIND_SPEED_MAX, EVAPOTRANSPIRATION, RADIATION
+			FROM WEATHER_READ WHERE FARM_UID = ? AND DATE >= ? AND DATE <= ?
+			ORDER BY DATE ASC`,
+			farmUID.Bytes(), from, to)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := weatherReadResult{}
+			err := rows.Scan(
+				&rowsData.Date, &rowsData.Kind,
+				&rowsData.TemperatureMin, &rowsData.TemperatureMax, &rowsData.Precipitation,
+				&rowsData.Humidity, &rowsData.WindSpeedMax, &rowsData.Evapotranspiration,
+				&rowsData.Radiation,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			weather = append(weather, query.CropWeatherQueryResult{
+				Date:               rowsData.Date.UTC(),
+				Kind:               rowsData.Kind,
+				TemperatureMin:     nullFloat32(rowsData.TemperatureMin),
+				TemperatureMax:     nullFloat32(rowsData.TemperatureMax),
+				Precipitation:      nullFloat32(rowsData.Precipitation),
+				Humidity:           nullFloat32(rowsData.Humidity),
+				WindSpeedMax:       nullFloat32(rowsData.WindSpeedMax),
+				Evapotranspiration: nullFloat32(rowsData.Evapotranspiration),
+				Radiation:          nullFloat32(rowsData.Radiation),
+			})
+		}
+
+		result <- query.QueryResult{Result: weather}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func nullFloat32(v sql.NullFloat64) *float32 {
+	if !v.Valid {
+		return nil
+	}
+
+	f := float32(v.Float64)
+	return &f
+}
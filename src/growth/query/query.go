@@ -23,6 +23,7 @@ type CropEventQuery interface {
 type CropReadQuery interface {
 	FindByID(uid uuid.UUID) <-chan QueryResult
 	FindByBatchID(batchID string) <-chan QueryResult
+	FindByHarvestLotCode(code string) <-chan QueryResult
 	FindAllCropsByFarm(farmUID uuid.UUID, status string, page, limit int) <-chan QueryResult
 	CountAllCropsByFarm(farmUID uuid.UUID, status string) <-chan QueryResult
 	FindAllCropsByArea(areaUID uuid.UUID) <-chan QueryResult
@@ -35,6 +36,7 @@ type CropReadQuery interface {
 type CropActivityQuery interface {
 	FindAllByCropID(uid uuid.UUID) <-chan QueryResult
 	FindByCropIDAndActivityType(uid uuid.UUID, activityType interface{}) <-chan QueryResult
+	FindAllByFarmIDAndActivityType(farmUID uuid.UUID, activityType interface{}) <-chan QueryResult
 }
 
 type MaterialReadQuery interface {
@@ -50,16 +52,40 @@ type TaskReadQuery interface {
 	FindByID(taskUID uuid.UUID) <-chan QueryResult
 }
 
+type WeatherReadQuery interface {
+	FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan QueryResult
+}
+
+type AreaReadingQuery interface {
+	FindAllByAreaID(areaUID uuid.UUID, from, to time.Time) <-chan QueryResult
+}
+
 type QueryResult struct {
 	Result interface{}
 	Error  error
 }
 
 type CropMaterialQueryResult struct {
-	UID           uuid.UUID `json:"uid"`
-	TypeCode      string    `json:"type"`
-	PlantTypeCode string    `json:"plant_type"`
-	Name          string    `json:"name"`
+	UID                uuid.UUID `json:"uid"`
+	TypeCode           string    `json:"type"`
+	PlantTypeCode      string    `json:"plant_type"`
+	ChemicalTypeCode   string    `json:"chemical_type"`
+	Name               string    `json:"name"`
+	PreHarvestInterval int       `json:"pre_harvest_interval"`
+	CropPlan           CropPlan  `json:"crop_plan"`
+	Quantity           float32   `json:"quantity"`
+	QuantityUnit       string    `json:"quantity_unit"`
+	SeedsPerUnit       float32   `json:"seeds_per_unit"`
+}
+
+// CropPlan is the expected schedule of a seed variety, counted in days since seeding
+type CropPlan struct {
+	DaysToGermination  int     `json:"days_to_germination"`
+	DaysToTransplant   int     `json:"days_to_transplant"`
+	DaysToFirstHarvest int     `json:"days_to_first_harvest"`
+	DaysToEndOfHarvest int     `json:"days_to_end_of_harvest"`
+	BaseTemperature    float32 `json:"base_temperature"`
+	GDDToFirstHarvest  float32 `json:"gdd_to_first_harvest"`
 }
 
 type CropAreaQueryResult struct {
@@ -117,8 +143,9 @@ type CropInformationQueryResult struct {
 }
 
 type CropFarmQueryResult struct {
-	UID  uuid.UUID
-	Name string
+	UID             uuid.UUID
+	Name            string
+	BatchIDTemplate string
 }
 
 type CountTotalBatchQueryResult struct {
@@ -137,3 +164,23 @@ type CropTaskQueryResult struct {
 	MaterialUID uuid.UUID
 	AreaUID     uuid.UUID
 }
+
+// CropWeatherQueryResult is the weather of a day at the farm of a crop
+type CropWeatherQueryResult struct {
+	Date               time.Time `json:"date"`
+	Kind               string    `json:"kind"`
+	TemperatureMin     *float32  `json:"temperature_min"`
+	TemperatureMax     *float32  `json:"temperature_max"`
+	Precipitation      *float32  `json:"precipitation"`
+	Humidity           *float32  `json:"humidity"`
+	WindSpeedMax       *float32  `json:"wind_speed_max"`
+	Evapotranspiration *float32  `json:"evapotranspiration"`
+	Radiation          *float32  `json:"radiation"`
+}
+
+// CropAreaReadingQueryResult is a sensor reading of a device attached to the area of a crop
+type CropAreaReadingQueryResult struct {
+	SensorType   string    `json:"sensor_type"`
+	Value        float32   `json:"value"`
+	RecordedDate time.Time `json:"recorded_date"`
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/devices/domain"
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AreaReadingQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewAreaReadingQuerySqlite(db *sql.DB) query.AreaReadingQuery {
+	return AreaReadingQuerySqlite{DB: db}
+}
+
+type areaReadingResult struct {
+	SensorType   string
+	Value        float32
+	RecordedDate string
+}
+
+func (s AreaReadingQuerySqlite) FindAllByAreaID(areaUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		readings := []query.CropAreaReadingQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT SENSOR_TYPE, VALUE, RECORDED_DATE
+			FROM DEVICE_READING
+			WHERE ATTACHMENT_TYPE = ? AND ATTACHMENT_UID = ? AND RECORDED_DATE >= ? AND RECORDED_DATE <= ?
+			ORDER BY RECORDED_DATE ASC`,
+			domain.DeviceAttachmentArea, areaUID, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := areaReadingResult{}
+			err := rows.Scan(&rowsData.SensorType, &rowsData.Value, &rowsData.RecordedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			recordedDate, err := time.Parse(time.RFC3339, rowsData.RecordedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			readings = append(readings, query.CropAreaReadingQueryResult{
+				SensorType:   rowsData.SensorType,
+				Value:        rowsData.Value,
+				RecordedDate: recordedDate,
+			})
+		}
+
+		result <- query.QueryResult{Result: readings}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -154,3 +154,87 @@ func (s CropActivityQuerySqlite) FindByCropIDAndActivityType(uid uuid.UUID, acti
 
 	return result
 }
+
+// FindAllByFarmIDAndActivityType returns the activities of the given type of every crop in the farm
+func (s CropActivityQuerySqlite) FindAllByFarmIDAndActivityType(farmUID uuid.UUID, activityType interface{}) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		cropActivities := []storage.CropActivity{}
+		rowsData := cropActivityResult{}
+
+		at, ok := activityType.(storage.ActivityType)
+		if !ok {
+			result <- query.QueryResult{Error: errors.New("Wrong activity type")}
+			close(result)
+			return
+		}
+
+		rows, err := s.DB.Query(`SELECT CA.* FROM CROP_ACTIVITY CA
+			JOIN CROP_READ CR ON CR.UID = CA.CROP_UID
+			WHERE CR.FARM_UID = ? AND CA.ACTIVITY_TYPE_CODE = ?
+			ORDER BY CA.CREATED_DATE DESC`, farmUID, at.Code())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			err = rows.Scan(
+				&rowsData.ID,
+				&rowsData.CropUID,
+				&rowsData.BatchID,
+				&rowsData.ContainerType,
+				&rowsData.ActivityType,
+				&rowsData.ActivityTypeCode,
+				&rowsData.CreatedDate,
+				&rowsData.Description,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			wrapper := decoder.CropActivityTypeWrapper{}
+			json.Unmarshal(rowsData.ActivityType, &wrapper)
+
+			activityType, ok := wrapper.Data.(storage.ActivityType)
+			if !ok {
+				result <- query.QueryResult{Error: errors.New("Error type assertion")}
+				close(result)
+				return
+			}
+
+			cropUID, err := uuid.FromString(rowsData.CropUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			cropActivities = append(cropActivities, storage.CropActivity{
+				UID:           cropUID,
+				BatchID:       rowsData.BatchID,
+				ContainerType: rowsData.ContainerType,
+				ActivityType:  activityType,
+				CreatedDate:   createdDate,
+				Description:   rowsData.Description,
+			})
+		}
+
+		result <- query.QueryResult{Result: cropActivities}
+		close(result)
+	}()
+
+	return result
+}
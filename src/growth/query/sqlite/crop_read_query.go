@@ -45,6 +45,8 @@ type cropReadResult struct {
 	InitialAreaLastPruned      sql.NullString
 	InitialAreaCreatedDate     string
 	InitialAreaLastUpdated     string
+	SafeHarvestDate            sql.NullString
+	Stage                      sql.NullString
 }
 
 type cropReadPhotoResult struct {
@@ -84,6 +86,21 @@ type cropReadHarvestedStorageResult struct {
 	LastUpdated          string
 }
 
+type cropReadHarvestLotResult struct {
+	UID                  string
+	CropUID              string
+	Code                 string
+	SourceAreaUID        string
+	SourceAreaName       string
+	HarvestType          string
+	Quantity             int
+	ProducedGramQuantity float32
+	Grade                string
+	Destination          string
+	HarvestDate          string
+	CorrectedDate        sql.NullString
+}
+
 type cropReadTrashResult struct {
 	ID             int
 	CropUID        string
@@ -127,6 +144,11 @@ func (s CropReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 			result <- query.QueryResult{Error: err}
 		}
 
+		err = s.populateCropHarvestLots(uid, &cropRead)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
 		err = s.populateCropTrash(uid, &cropRead)
 		if err != nil {
 			result <- query.QueryResult{Error: err}
@@ -179,6 +201,34 @@ func (s CropReadQuerySqlite) FindByBatchID(batchID string) <-chan query.QueryRes
 	return result
 }
 
+func (s CropReadQuerySqlite) FindByHarvestLotCode(code string) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		uid := ""
+		err := s.DB.QueryRow(`SELECT CROP_UID FROM CROP_READ_HARVEST_LOT WHERE CODE = ?`, code).Scan(&uid)
+		if err != nil && err != sql.ErrNoRows {
+			result <- query.QueryResult{Error: err}
+		}
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: storage.CropRead{}}
+			close(result)
+			return
+		}
+
+		cropUID, err := uuid.FromString(uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		result <- <-s.FindByID(cropUID)
+		close(result)
+	}()
+
+	return result
+}
+
 func (s CropReadQuerySqlite) FindAllCropsByFarm(farmUID uuid.UUID, status string, page, limit int) <-chan query.QueryResult {
 	result := make(chan query.QueryResult)
 
@@ -236,6 +286,11 @@ func (s CropReadQuerySqlite) FindAllCropsByFarm(farmUID uuid.UUID, status string
 				result <- query.QueryResult{Error: err}
 			}
 
+			err = s.populateCropHarvestLots(cropUID, &cropRead)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			err = s.populateCropTrash(cropUID, &cropRead)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
@@ -335,6 +390,11 @@ func (s CropReadQuerySqlite) FindAllCropsArchives(farmUID uuid.UUID, page, limit
 				result <- query.QueryResult{Error: err}
 			}
 
+			err = s.populateCropHarvestLots(cropUID, &cropRead)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
 			err = s.populateCropTrash(cropUID, &cropRead)
 			if err != nil {
 				result <- query.QueryResult{Error: err}
@@ -629,7 +689,8 @@ func (s CropReadQuerySqlite) populateCrop(cropUID uuid.UUID, cropRead *storage.C
 		INITIAL_AREA_UID, INITIAL_AREA_NAME,
 		INITIAL_AREA_INITIAL_QUANTITY, INITIAL_AREA_CURRENT_QUANTITY,
 		INITIAL_AREA_LAST_WATERED, INITIAL_AREA_LAST_FERTILIZED, INITIAL_AREA_LAST_PESTICIDED,
-		INITIAL_AREA_LAST_PRUNED, INITIAL_AREA_CREATED_DATE, INITIAL_AREA_LAST_UPDATED
+		INITIAL_AREA_LAST_PRUNED, INITIAL_AREA_CREATED_DATE, INITIAL_AREA_LAST_UPDATED,
+		SAFE_HARVEST_DATE, STAGE
 		FROM CROP_READ WHERE UID = ?`, cropUID).Scan(
 		&rowsData.UID,
 		&rowsData.BatchID,
@@ -656,6 +717,8 @@ func (s CropReadQuerySqlite) populateCrop(cropUID uuid.UUID, cropRead *storage.C
 		&rowsData.InitialAreaLastPruned,
 		&rowsData.InitialAreaCreatedDate,
 		&rowsData.InitialAreaLastUpdated,
+		&rowsData.SafeHarvestDate,
+		&rowsData.Stage,
 	)
 
 	if err != nil && err != sql.ErrNoRows {
@@ -721,6 +784,16 @@ func (s CropReadQuerySqlite) populateCrop(cropUID uuid.UUID, cropRead *storage.C
 		initialAreaLastPruned = &date
 	}
 
+	var safeHarvestDate *time.Time
+	if rowsData.SafeHarvestDate.Valid && rowsData.SafeHarvestDate.String != "" {
+		date, err := time.Parse(time.RFC3339, rowsData.SafeHarvestDate.String)
+		if err != nil {
+			return err
+		}
+
+		safeHarvestDate = &date
+	}
+
 	initialAreaCreatedDate, err := time.Parse(time.RFC3339, rowsData.InitialAreaCreatedDate)
 	if err != nil {
 		return err
@@ -735,6 +808,7 @@ func (s CropReadQuerySqlite) populateCrop(cropUID uuid.UUID, cropRead *storage.C
 	cropRead.BatchID = rowsData.BatchID
 	cropRead.Status = rowsData.Status
 	cropRead.Type = rowsData.Type
+	cropRead.Stage = rowsData.Stage.String
 	cropRead.Container.Quantity = rowsData.ContainerQuantity
 	cropRead.Container.Type = rowsData.ContainerType
 	cropRead.Container.Cell = rowsData.ContainerCell
@@ -746,6 +820,7 @@ func (s CropReadQuerySqlite) populateCrop(cropUID uuid.UUID, cropRead *storage.C
 	cropRead.AreaStatus.Growing = rowsData.AreaStatusGrowing
 	cropRead.AreaStatus.Dumped = rowsData.AreaStatusDumped
 	cropRead.FarmUID = farmUID
+	cropRead.SafeHarvestDate = safeHarvestDate
 	cropRead.InitialArea.AreaUID = initialAreaUID
 	cropRead.InitialArea.Name = rowsData.InitialAreaName
 	cropRead.InitialArea.InitialQuantity = rowsData.InitialAreaInitialQuantity
@@ -959,6 +1034,79 @@ func (s CropReadQuerySqlite) populateCropHarvestedStorage(uid uuid.UUID, cropRea
 	return nil
 }
 
+func (s CropReadQuerySqlite) populateCropHarvestLots(uid uuid.UUID, cropRead *storage.CropRead) error {
+	lotRowsData := cropReadHarvestLotResult{}
+
+	rows, err := s.DB.Query("SELECT * FROM CROP_READ_HARVEST_LOT WHERE CROP_UID = ? ORDER BY HARVEST_DATE", uid)
+	if err != nil {
+		return err
+	}
+
+	harvestLots := []storage.HarvestLot{}
+	for rows.Next() {
+		err = rows.Scan(
+			&lotRowsData.UID,
+			&lotRowsData.CropUID,
+			&lotRowsData.Code,
+			&lotRowsData.SourceAreaUID,
+			&lotRowsData.SourceAreaName,
+			&lotRowsData.HarvestType,
+			&lotRowsData.Quantity,
+			&lotRowsData.ProducedGramQuantity,
+			&lotRowsData.Grade,
+			&lotRowsData.Destination,
+			&lotRowsData.HarvestDate,
+			&lotRowsData.CorrectedDate)
+		if err != nil {
+			return err
+		}
+
+		lotUID, err := uuid.FromString(lotRowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		sourceAreaUID, err := uuid.FromString(lotRowsData.SourceAreaUID)
+		if err != nil {
+			return err
+		}
+
+		harvestDate, err := time.Parse(time.RFC3339, lotRowsData.HarvestDate)
+		if err != nil {
+			return err
+		}
+
+		var correctedDate *time.Time
+		if lotRowsData.CorrectedDate.Valid && lotRowsData.CorrectedDate.String != "" {
+			date, err := time.Parse(time.RFC3339, lotRowsData.CorrectedDate.String)
+			if err != nil {
+				return err
+			}
+
+			correctedDate = &date
+		}
+
+		harvestLots = append(harvestLots, storage.HarvestLot{
+			UID:                  lotUID,
+			Code:                 lotRowsData.Code,
+			SourceAreaUID:        sourceAreaUID,
+			SourceAreaName:       lotRowsData.SourceAreaName,
+			HarvestType:          lotRowsData.HarvestType,
+			Quantity:             lotRowsData.Quantity,
+			ProducedGramQuantity: lotRowsData.ProducedGramQuantity,
+			Grade:                lotRowsData.Grade,
+			Destination:          lotRowsData.Destination,
+			HarvestDate:          harvestDate,
+
+			CorrectedDate: correctedDate,
+		})
+	}
+
+	cropRead.HarvestLots = harvestLots
+
+	return nil
+}
+
 func (s CropReadQuerySqlite) populateCropTrash(uid uuid.UUID, cropRead *storage.CropRead) error {
 	trashRowsData := cropReadTrashResult{}
 
@@ -16,8 +16,9 @@ func NewFarmReadQuerySqlite(db *sql.DB) query.FarmReadQuery {
 }
 
 type farmReadResult struct {
-	UID  string
-	Name string
+	UID             string
+	Name            string
+	BatchIDTemplate sql.NullString
 }
 
 func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
@@ -27,9 +28,10 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 		farmRead := query.CropFarmQueryResult{}
 		rowsData := farmReadResult{}
 
-		err := s.DB.QueryRow("SELECT UID, NAME FROM FARM_READ WHERE UID = ?", uid).Scan(
+		err := s.DB.QueryRow("SELECT UID, NAME, BATCH_ID_TEMPLATE FROM FARM_READ WHERE UID = ?", uid).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
+			&rowsData.BatchIDTemplate,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -47,6 +49,7 @@ func (s FarmReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
 
 		farmRead.UID = farmUID
 		farmRead.Name = rowsData.Name
+		farmRead.BatchIDTemplate = rowsData.BatchIDTemplate.String
 
 		result <- query.QueryResult{Result: farmRead}
 		close(result)
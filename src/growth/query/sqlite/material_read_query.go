@@ -20,6 +20,19 @@ type materialReadResult struct {
 	Name     string
 	Type     string
 	TypeData string
+
+	PreHarvestInterval sql.NullInt64
+
+	CropPlanDaysToGermination  sql.NullInt64
+	CropPlanDaysToTransplant   sql.NullInt64
+	CropPlanDaysToFirstHarvest sql.NullInt64
+	CropPlanDaysToEndOfHarvest sql.NullInt64
+	CropPlanBaseTemperature    sql.NullFloat64
+	CropPlanGDDToFirstHarvest  sql.NullFloat64
+
+	Quantity     float32
+	QuantityUnit string
+	SeedsPerUnit sql.NullFloat64
 }
 
 func (s MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.QueryResult {
@@ -29,12 +42,27 @@ func (s MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 		materialQueryResult := query.CropMaterialQueryResult{}
 		rowsData := materialReadResult{}
 
-		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA FROM MATERIAL_READ
+		err := s.DB.QueryRow(`SELECT UID, NAME, TYPE, TYPE_DATA, PRE_HARVEST_INTERVAL,
+			CROP_PLAN_DAYS_TO_GERMINATION, CROP_PLAN_DAYS_TO_TRANSPLANT,
+			CROP_PLAN_DAYS_TO_FIRST_HARVEST, CROP_PLAN_DAYS_TO_END_OF_HARVEST,
+			CROP_PLAN_BASE_TEMPERATURE, CROP_PLAN_GDD_TO_FIRST_HARVEST,
+			QUANTITY, QUANTITY_UNIT, SEEDS_PER_UNIT
+			FROM MATERIAL_READ
 			WHERE UID = ?`, materialUID).Scan(
 			&rowsData.UID,
 			&rowsData.Name,
 			&rowsData.Type,
 			&rowsData.TypeData,
+			&rowsData.PreHarvestInterval,
+			&rowsData.CropPlanDaysToGermination,
+			&rowsData.CropPlanDaysToTransplant,
+			&rowsData.CropPlanDaysToFirstHarvest,
+			&rowsData.CropPlanDaysToEndOfHarvest,
+			&rowsData.CropPlanBaseTemperature,
+			&rowsData.CropPlanGDDToFirstHarvest,
+			&rowsData.Quantity,
+			&rowsData.QuantityUnit,
+			&rowsData.SeedsPerUnit,
 		)
 
 		if err != nil && err != sql.ErrNoRows {
@@ -54,6 +82,21 @@ func (s MaterialReadQuerySqlite) FindByID(materialUID uuid.UUID) <-chan query.Qu
 		materialQueryResult.Name = rowsData.Name
 		materialQueryResult.TypeCode = rowsData.Type
 		materialQueryResult.PlantTypeCode = rowsData.TypeData
+		if rowsData.Type == "AGROCHEMICAL" {
+			materialQueryResult.ChemicalTypeCode = rowsData.TypeData
+		}
+		materialQueryResult.PreHarvestInterval = int(rowsData.PreHarvestInterval.Int64)
+		materialQueryResult.CropPlan = query.CropPlan{
+			DaysToGermination:  int(rowsData.CropPlanDaysToGermination.Int64),
+			DaysToTransplant:   int(rowsData.CropPlanDaysToTransplant.Int64),
+			DaysToFirstHarvest: int(rowsData.CropPlanDaysToFirstHarvest.Int64),
+			DaysToEndOfHarvest: int(rowsData.CropPlanDaysToEndOfHarvest.Int64),
+			BaseTemperature:    float32(rowsData.CropPlanBaseTemperature.Float64),
+			GDDToFirstHarvest:  float32(rowsData.CropPlanGDDToFirstHarvest.Float64),
+		}
+		materialQueryResult.Quantity = rowsData.Quantity
+		materialQueryResult.QuantityUnit = rowsData.QuantityUnit
+		materialQueryResult.SeedsPerUnit = float32(rowsData.SeedsPerUnit.Float64)
 
 		result <- query.QueryResult{Result: materialQueryResult}
 		close(result)
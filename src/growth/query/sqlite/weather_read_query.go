@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WeatherReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewWeatherReadQuerySqlite(db *sql.DB) query.WeatherReadQuery {
+	return WeatherReadQuerySqlite{DB: db}
+}
+
+type weatherReadResult struct {
+	Date               string
+	Kind               string
+	TemperatureMin     sql.NullFloat64
+	TemperatureMax     sql.NullFloat64
+	Precipitation      sql.NullFloat64
+	Humidity           sql.NullFloat64
+	WindSpeedMax       sql.NullFloat64
+	Evapotranspiration sql.NullFloat64
+	Radiation          sql.NullFloat64
+}
+
+func (s WeatherReadQuerySqlite) FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		weather := []query.CropWeatherQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT DATE, KIND, TEMPERATURE_MIN, TEMPERATURE_MAX, PRECIPITATION,
+			HUMIDITY, WIND_SPEED_MAX, EVAPOTRANSPIRATION, RADIATION
+			FROM WEATHER_READ WHERE FARM_UID = ? AND DATE >= ? AND DATE <= ?
+			ORDER BY DATE ASC`,
+			farmUID, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := weatherReadResult{}
+			err := rows.Scan(
+				&rowsData.Date, &rowsData.Kind,
+				&rowsData.TemperatureMin, &rowsData.TemperatureMax, &rowsData.Precipitation,
+				&rowsData.Humidity, &rowsData.WindSpeedMax, &rowsData.Evapotranspiration,
+				&rowsData.Radiation,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			date, err := time.Parse(time.RFC3339, rowsData.Date)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			weather = append(weather, query.CropWeatherQueryResult{
+				Date:               date,
+				Kind:               rowsData.Kind,
+				TemperatureMin:     nullFloat32(rowsData.TemperatureMin),
+				TemperatureMax:     nullFloat32(rowsData.TemperatureMax),
+				Precipitation:      nullFloat32(rowsData.Precipitation),
+				Humidity:           nullFloat32(rowsData.Humidity),
+				WindSpeedMax:       nullFloat32(rowsData.WindSpeedMax),
+				Evapotranspiration: nullFloat32(rowsData.Evapotranspiration),
+				Radiation:          nullFloat32(rowsData.Radiation),
+			})
+		}
+
+		result <- query.QueryResult{Result: weather}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func nullFloat32(v sql.NullFloat64) *float32 {
+	if !v.Valid {
+		return nil
+	}
+
+	f := float32(v.Float64)
+	return &f
+}
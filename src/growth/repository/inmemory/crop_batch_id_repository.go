@@ -0,0 +1,35 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+)
+
+type CropBatchIDRepositoryInMemory struct {
+	Storage *storage.CropBatchIDStorage
+}
+
+func NewCropBatchIDRepositoryInMemory(s *storage.CropBatchIDStorage) repository.CropBatchIDRepository {
+	return &CropBatchIDRepositoryInMemory{Storage: s}
+}
+
+// Reserve is to save the batch ID when it is not reserved yet
+func (f *CropBatchIDRepositoryInMemory) Reserve(cropBatchID *storage.CropBatchID) <-chan repository.RepositoryResult {
+	result := make(chan repository.RepositoryResult)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		_, ok := f.Storage.CropBatchIDMap[cropBatchID.BatchID]
+		if !ok {
+			f.Storage.CropBatchIDMap[cropBatchID.BatchID] = *cropBatchID
+		}
+
+		result <- repository.RepositoryResult{Result: !ok}
+
+		close(result)
+	}()
+
+	return result
+}
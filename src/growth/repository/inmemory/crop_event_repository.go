@@ -38,3 +38,31 @@ func (f *CropEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, eve
 
 	return result
 }
+
+// SaveAll saves the events of every crop while holding the lock once
+func (f *CropEventRepositoryInMemory) SaveAll(streams []repository.CropEventStream) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, stream := range streams {
+			latestVersion := stream.LatestVersion
+			for _, v := range stream.Events {
+				latestVersion++
+				f.Storage.CropEvents = append(f.Storage.CropEvents, storage.CropEvent{
+					CropUID: stream.UID,
+					Version: latestVersion,
+					Event:   v,
+				})
+			}
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
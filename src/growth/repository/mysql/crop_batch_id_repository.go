@@ -0,0 +1,44 @@
+package sqlite
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+)
+
+type CropBatchIDRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewCropBatchIDRepositoryMysql(db *sql.DB) repository.CropBatchIDRepository {
+	return &CropBatchIDRepositoryMysql{DB: db}
+}
+
+// Reserve relies on the unique BATCH_ID so only one of the concurrent reservations is inserted
+func (f *CropBatchIDRepositoryMysql) Reserve(cropBatchID *storage.CropBatchID) <-chan repository.RepositoryResult {
+	result := make(chan repository.RepositoryResult)
+
+	go func() {
+		res, err := f.DB.Exec(`INSERT IGNORE INTO CROP_BATCH_ID (BATCH_ID, CREATED_DATE) VALUES (?, ?)`,
+			cropBatchID.BatchID,
+			cropBatchID.CreatedDate)
+		if err != nil {
+			result <- repository.RepositoryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			result <- repository.RepositoryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- repository.RepositoryResult{Result: rows == 1}
+		close(result)
+	}()
+
+	return result
+}
@@ -36,7 +36,8 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 				INITIAL_AREA_INITIAL_QUANTITY = ?, INITIAL_AREA_CURRENT_QUANTITY = ?,
 				INITIAL_AREA_LAST_WATERED = ?, INITIAL_AREA_LAST_FERTILIZED = ?,
 				INITIAL_AREA_LAST_PESTICIDED = ?, INITIAL_AREA_LAST_PRUNED = ?,
-				INITIAL_AREA_CREATED_DATE = ?, INITIAL_AREA_LAST_UPDATED = ?
+				INITIAL_AREA_CREATED_DATE = ?, INITIAL_AREA_LAST_UPDATED = ?,
+				SAFE_HARVEST_DATE = ?, STAGE = ?
 				WHERE UID = ?`,
 				cropRead.BatchID,
 				cropRead.Status,
@@ -62,6 +63,8 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 				cropRead.InitialArea.LastPruned,
 				cropRead.InitialArea.CreatedDate,
 				cropRead.InitialArea.LastUpdated,
+				cropRead.SafeHarvestDate,
+				cropRead.Stage,
 				cropRead.UID.Bytes())
 
 			if err != nil {
@@ -173,6 +176,39 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 				}
 			}
 
+			if len(cropRead.HarvestLots) > 0 {
+				for _, v := range cropRead.HarvestLots {
+					// A harvest lot only changes when its harvest is corrected
+					count := 0
+					err := f.DB.QueryRow(`SELECT COUNT(*) FROM CROP_READ_HARVEST_LOT WHERE UID = ?`, v.UID.Bytes()).Scan(&count)
+					if err != nil {
+						result <- err
+					}
+
+					if count == 0 {
+						_, err = f.DB.Exec(`INSERT INTO CROP_READ_HARVEST_LOT (
+							UID, CROP_UID, CODE, SOURCE_AREA_UID, SOURCE_AREA_NAME,
+							HARVEST_TYPE, QUANTITY, PRODUCED_GRAM_QUANTITY,
+							GRADE, DESTINATION, HARVEST_DATE, CORRECTED_DATE)
+							VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+							v.UID.Bytes(), cropRead.UID.Bytes(), v.Code, v.SourceAreaUID.Bytes(), v.SourceAreaName,
+							v.HarvestType, v.Quantity, v.ProducedGramQuantity,
+							v.Grade, v.Destination, v.HarvestDate, v.CorrectedDate)
+
+						if err != nil {
+							result <- err
+						}
+					} else {
+						_, err = f.DB.Exec(`UPDATE CROP_READ_HARVEST_LOT SET CORRECTED_DATE = ? WHERE UID = ?`,
+							v.CorrectedDate, v.UID.Bytes())
+
+						if err != nil {
+							result <- err
+						}
+					}
+				}
+			}
+
 			if len(cropRead.Trash) > 0 {
 				for _, v := range cropRead.Trash {
 					res, err := f.DB.Exec(`UPDATE CROP_READ_TRASH
@@ -233,8 +269,9 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 				INITIAL_AREA_UID, INITIAL_AREA_NAME,
 				INITIAL_AREA_INITIAL_QUANTITY, INITIAL_AREA_CURRENT_QUANTITY,
 				INITIAL_AREA_LAST_WATERED, INITIAL_AREA_LAST_FERTILIZED, INITIAL_AREA_LAST_PESTICIDED,
-				INITIAL_AREA_LAST_PRUNED, INITIAL_AREA_CREATED_DATE, INITIAL_AREA_LAST_UPDATED)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				INITIAL_AREA_LAST_PRUNED, INITIAL_AREA_CREATED_DATE, INITIAL_AREA_LAST_UPDATED,
+				SAFE_HARVEST_DATE, STAGE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				cropRead.UID.Bytes(),
 				cropRead.BatchID,
 				cropRead.Status,
@@ -259,7 +296,9 @@ func (f *CropReadRepositoryMysql) Save(cropRead *storage.CropRead) <-chan error
 				cropRead.InitialArea.LastPesticided,
 				cropRead.InitialArea.LastPruned,
 				cropRead.InitialArea.CreatedDate,
-				cropRead.InitialArea.LastUpdated)
+				cropRead.InitialArea.LastUpdated,
+				cropRead.SafeHarvestDate,
+				cropRead.Stage)
 
 			if err != nil {
 				result <- err
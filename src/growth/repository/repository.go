@@ -15,12 +15,27 @@ type RepositoryResult struct {
 
 type CropEventRepository interface {
 	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+	// SaveAll saves the events of every crop at once, or none of them
+	SaveAll(streams []CropEventStream) <-chan error
+}
+
+// CropEventStream is the uncommitted events of one crop
+type CropEventStream struct {
+	UID           uuid.UUID
+	LatestVersion int
+	Events        []interface{}
 }
 
 type CropReadRepository interface {
 	Save(cropRead *storage.CropRead) <-chan error
 }
 
+// CropBatchIDRepository reserves the batch IDs so two crop batches never get the same one
+type CropBatchIDRepository interface {
+	// Reserve results in false when the batch ID is already reserved
+	Reserve(cropBatchID *storage.CropBatchID) <-chan RepositoryResult
+}
+
 func NewCropBatchFromHistory(events []storage.CropEvent) *domain.Crop {
 	state := &domain.Crop{}
 	for _, v := range events {
@@ -0,0 +1,45 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/growth/repository"
+	"github.com/Tanibox/tania-core/src/growth/storage"
+)
+
+type CropBatchIDRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewCropBatchIDRepositorySqlite(db *sql.DB) repository.CropBatchIDRepository {
+	return &CropBatchIDRepositorySqlite{DB: db}
+}
+
+// Reserve relies on the unique BATCH_ID so only one of the concurrent reservations is inserted
+func (f *CropBatchIDRepositorySqlite) Reserve(cropBatchID *storage.CropBatchID) <-chan repository.RepositoryResult {
+	result := make(chan repository.RepositoryResult)
+
+	go func() {
+		res, err := f.DB.Exec(`INSERT OR IGNORE INTO CROP_BATCH_ID (BATCH_ID, CREATED_DATE) VALUES (?, ?)`,
+			cropBatchID.BatchID,
+			cropBatchID.CreatedDate.Format(time.RFC3339))
+		if err != nil {
+			result <- repository.RepositoryResult{Error: err}
+			close(result)
+			return
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			result <- repository.RepositoryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- repository.RepositoryResult{Result: rows == 1}
+		close(result)
+	}()
+
+	return result
+}
@@ -48,3 +48,54 @@ func (f *CropEventRepositorySqlite) Save(uid uuid.UUID, latestVersion int, event
 
 	return result
 }
+
+// SaveAll saves the events of every crop in one transaction
+func (f *CropEventRepositorySqlite) SaveAll(streams []repository.CropEventStream) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		defer close(result)
+
+		tx, err := f.DB.Begin()
+		if err != nil {
+			result <- err
+			return
+		}
+
+		stmt, err := tx.Prepare(`INSERT INTO CROP_EVENT (CROP_UID, VERSION, CREATED_DATE, EVENT) VALUES (?, ?, ?, ?)`)
+		if err != nil {
+			tx.Rollback()
+			result <- err
+			return
+		}
+		defer stmt.Close()
+
+		for _, stream := range streams {
+			latestVersion := stream.LatestVersion
+			for _, v := range stream.Events {
+				latestVersion++
+
+				e, err := json.Marshal(decoder.InterfaceWrapper{
+					Name: structhelper.GetName(v),
+					Data: v,
+				})
+				if err != nil {
+					tx.Rollback()
+					result <- err
+					return
+				}
+
+				_, err = stmt.Exec(stream.UID, latestVersion, time.Now().Format(time.RFC3339), e)
+				if err != nil {
+					tx.Rollback()
+					result <- err
+					return
+				}
+			}
+		}
+
+		result <- tx.Commit()
+	}()
+
+	return result
+}
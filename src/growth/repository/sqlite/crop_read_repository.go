@@ -46,6 +46,11 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 			initialAreaLastPruned = cropRead.InitialArea.LastPruned.Format(time.RFC3339)
 		}
 
+		var safeHarvestDate string
+		if cropRead.SafeHarvestDate != nil && !cropRead.SafeHarvestDate.IsZero() {
+			safeHarvestDate = cropRead.SafeHarvestDate.Format(time.RFC3339)
+		}
+
 		if count > 0 {
 			_, err = f.DB.Exec(`UPDATE CROP_READ SET
 				BATCH_ID = ?, STATUS = ?, TYPE = ?,
@@ -57,7 +62,8 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 				INITIAL_AREA_INITIAL_QUANTITY = ?, INITIAL_AREA_CURRENT_QUANTITY = ?,
 				INITIAL_AREA_LAST_WATERED = ?, INITIAL_AREA_LAST_FERTILIZED = ?,
 				INITIAL_AREA_LAST_PESTICIDED = ?, INITIAL_AREA_LAST_PRUNED = ?,
-				INITIAL_AREA_CREATED_DATE = ?, INITIAL_AREA_LAST_UPDATED = ?
+				INITIAL_AREA_CREATED_DATE = ?, INITIAL_AREA_LAST_UPDATED = ?,
+				SAFE_HARVEST_DATE = ?, STAGE = ?
 				WHERE UID = ?`,
 				cropRead.BatchID,
 				cropRead.Status,
@@ -83,6 +89,8 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 				initialAreaLastPruned,
 				cropRead.InitialArea.CreatedDate.Format(time.RFC3339),
 				cropRead.InitialArea.LastUpdated.Format(time.RFC3339),
+				safeHarvestDate,
+				cropRead.Stage,
 				cropRead.UID)
 
 			if err != nil {
@@ -220,6 +228,44 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 				}
 			}
 
+			if len(cropRead.HarvestLots) > 0 {
+				for _, v := range cropRead.HarvestLots {
+					// A harvest lot only changes when its harvest is corrected
+					var correctedDate string
+					if v.CorrectedDate != nil && !v.CorrectedDate.IsZero() {
+						correctedDate = v.CorrectedDate.Format(time.RFC3339)
+					}
+
+					count := 0
+					err := f.DB.QueryRow(`SELECT COUNT(*) FROM CROP_READ_HARVEST_LOT WHERE UID = ?`, v.UID).Scan(&count)
+					if err != nil {
+						result <- err
+					}
+
+					if count == 0 {
+						_, err = f.DB.Exec(`INSERT INTO CROP_READ_HARVEST_LOT (
+							UID, CROP_UID, CODE, SOURCE_AREA_UID, SOURCE_AREA_NAME,
+							HARVEST_TYPE, QUANTITY, PRODUCED_GRAM_QUANTITY,
+							GRADE, DESTINATION, HARVEST_DATE, CORRECTED_DATE)
+							VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+							v.UID, cropRead.UID, v.Code, v.SourceAreaUID, v.SourceAreaName,
+							v.HarvestType, v.Quantity, v.ProducedGramQuantity,
+							v.Grade, v.Destination, v.HarvestDate.Format(time.RFC3339), correctedDate)
+
+						if err != nil {
+							result <- err
+						}
+					} else {
+						_, err = f.DB.Exec(`UPDATE CROP_READ_HARVEST_LOT SET CORRECTED_DATE = ? WHERE UID = ?`,
+							correctedDate, v.UID)
+
+						if err != nil {
+							result <- err
+						}
+					}
+				}
+			}
+
 			if len(cropRead.Trash) > 0 {
 				for _, v := range cropRead.Trash {
 					cd := v.CreatedDate.Format(time.RFC3339)
@@ -282,8 +328,9 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 				INITIAL_AREA_UID, INITIAL_AREA_NAME,
 				INITIAL_AREA_INITIAL_QUANTITY, INITIAL_AREA_CURRENT_QUANTITY,
 				INITIAL_AREA_LAST_WATERED, INITIAL_AREA_LAST_FERTILIZED, INITIAL_AREA_LAST_PESTICIDED,
-				INITIAL_AREA_LAST_PRUNED, INITIAL_AREA_CREATED_DATE, INITIAL_AREA_LAST_UPDATED)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				INITIAL_AREA_LAST_PRUNED, INITIAL_AREA_CREATED_DATE, INITIAL_AREA_LAST_UPDATED,
+				SAFE_HARVEST_DATE, STAGE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				cropRead.UID,
 				cropRead.BatchID,
 				cropRead.Status,
@@ -308,7 +355,9 @@ func (f *CropReadRepositorySqlite) Save(cropRead *storage.CropRead) <-chan error
 				initialAreaLastPesticided,
 				initialAreaLastPruned,
 				cropRead.InitialArea.CreatedDate.Format(time.RFC3339),
-				cropRead.InitialArea.LastUpdated.Format(time.RFC3339))
+				cropRead.InitialArea.LastUpdated.Format(time.RFC3339),
+				safeHarvestDate,
+				cropRead.Stage)
 
 			if err != nil {
 				result <- err
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/config"
@@ -16,35 +17,46 @@ import (
 	repoInMem "github.com/Tanibox/tania-core/src/growth/repository/inmemory"
 	repoMysql "github.com/Tanibox/tania-core/src/growth/repository/mysql"
 	repoSqlite "github.com/Tanibox/tania-core/src/growth/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/helper/filehelper"
 	"github.com/Tanibox/tania-core/src/helper/imagehelper"
 	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
 	"github.com/Tanibox/tania-core/src/helper/stringhelper"
 	"github.com/Tanibox/tania-core/src/helper/structhelper"
 
 	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	devicestorage "github.com/Tanibox/tania-core/src/devices/storage"
 	"github.com/Tanibox/tania-core/src/growth/query"
 	"github.com/Tanibox/tania-core/src/growth/repository"
 	storage "github.com/Tanibox/tania-core/src/growth/storage"
 	taskstorage "github.com/Tanibox/tania-core/src/tasks/storage"
+	weatherstorage "github.com/Tanibox/tania-core/src/weather/storage"
 	"github.com/labstack/echo"
 	uuid "github.com/satori/go.uuid"
+	qrcode "github.com/skip2/go-qrcode"
 )
 
+// forecastHorizonDays is how many days ahead the crop plan looks for forecasts to predict its milestones.
+// It covers the forecasts fetched by the weather module.
+const forecastHorizonDays = 16
+
 // GrowthServer ties the routes and handlers with injected dependencies
 type GrowthServer struct {
-	CropEventRepo     repository.CropEventRepository
-	CropEventQuery    query.CropEventQuery
-	CropReadRepo      repository.CropReadRepository
-	CropReadQuery     query.CropReadQuery
-	CropActivityRepo  repository.CropActivityRepository
-	CropActivityQuery query.CropActivityQuery
-	CropService       domain.CropService
-	AreaReadQuery     query.AreaReadQuery
-	MaterialReadQuery query.MaterialReadQuery
-	FarmReadQuery     query.FarmReadQuery
-	TaskReadQuery     query.TaskReadQuery
-	EventBus          eventbus.TaniaEventBus
-	File              File
+	CropEventRepo       repository.CropEventRepository
+	CropEventQuery      query.CropEventQuery
+	CropReadRepo        repository.CropReadRepository
+	CropReadQuery       query.CropReadQuery
+	CropActivityRepo    repository.CropActivityRepository
+	CropActivityQuery   query.CropActivityQuery
+	CropService         domain.CropService
+	CropForecastService domain.CropForecastService
+	AreaReadQuery       query.AreaReadQuery
+	MaterialReadQuery   query.MaterialReadQuery
+	FarmReadQuery       query.FarmReadQuery
+	TaskReadQuery       query.TaskReadQuery
+	WeatherReadQuery    query.WeatherReadQuery
+	AreaReadingQuery    query.AreaReadingQuery
+	EventBus            eventbus.TaniaEventBus
+	File                filehelper.File
 }
 
 // NewGrowthServer initializes GrowthServer's dependencies and create new GrowthServer struct
@@ -54,13 +66,16 @@ func NewGrowthServer(
 	cropEventStorage *storage.CropEventStorage,
 	cropReadStorage *storage.CropReadStorage,
 	cropActivityStorage *storage.CropActivityStorage,
+	cropBatchIDStorage *storage.CropBatchIDStorage,
 	areaReadStorage *assetsstorage.AreaReadStorage,
 	materialReadStorage *assetsstorage.MaterialReadStorage,
 	farmReadStorage *assetsstorage.FarmReadStorage,
 	taskReadStorage *taskstorage.TaskReadStorage,
+	weatherReadStorage *weatherstorage.WeatherReadStorage,
+	deviceReadingStorage *devicestorage.DeviceReadingStorage,
 ) (*GrowthServer, error) {
 	growthServer := &GrowthServer{
-		File:     LocalFile{},
+		File:     filehelper.LocalFile{},
 		EventBus: bus,
 	}
 
@@ -71,18 +86,26 @@ func NewGrowthServer(
 		growthServer.CropReadRepo = repoInMem.NewCropReadRepositoryInMemory(cropReadStorage)
 		growthServer.CropReadQuery = queryInMem.NewCropReadQueryInMemory(cropReadStorage)
 		growthServer.CropActivityRepo = repoInMem.NewCropActivityRepositoryInMemory(cropActivityStorage)
-		growthServer.CropActivityQuery = queryInMem.NewCropActivityQueryInMemory(cropActivityStorage)
+		growthServer.CropActivityQuery = queryInMem.NewCropActivityQueryInMemory(cropActivityStorage, cropReadStorage)
 
 		growthServer.AreaReadQuery = queryInMem.NewAreaReadQueryInMemory(areaReadStorage)
 		growthServer.MaterialReadQuery = queryInMem.NewMaterialReadQueryInMemory(materialReadStorage)
 		growthServer.FarmReadQuery = queryInMem.NewFarmReadQueryInMemory(farmReadStorage)
 		growthServer.TaskReadQuery = queryInMem.NewTaskReadQueryInMemory(taskReadStorage)
+		growthServer.WeatherReadQuery = queryInMem.NewWeatherReadQueryInMemory(weatherReadStorage)
+		growthServer.AreaReadingQuery = queryInMem.NewAreaReadingQueryInMemory(deviceReadingStorage)
 
 		// TODO: CropServiceInMemory should be renamed. It doesn't need InMemory name
 		growthServer.CropService = service.CropServiceInMemory{
 			MaterialReadQuery: growthServer.MaterialReadQuery,
 			CropReadQuery:     growthServer.CropReadQuery,
 			AreaReadQuery:     growthServer.AreaReadQuery,
+			FarmReadQuery:     growthServer.FarmReadQuery,
+			CropBatchIDRepo:   repoInMem.NewCropBatchIDRepositoryInMemory(cropBatchIDStorage),
+		}
+		growthServer.CropForecastService = service.CropForecastService{
+			CropReadQuery:     growthServer.CropReadQuery,
+			CropActivityQuery: growthServer.CropActivityQuery,
 		}
 	case config.DB_SQLITE:
 		growthServer.CropEventRepo = repoSqlite.NewCropEventRepositorySqlite(db)
@@ -96,12 +119,20 @@ func NewGrowthServer(
 		growthServer.MaterialReadQuery = querySqlite.NewMaterialReadQuerySqlite(db)
 		growthServer.FarmReadQuery = querySqlite.NewFarmReadQuerySqlite(db)
 		growthServer.TaskReadQuery = querySqlite.NewTaskReadQuerySqlite(db)
+		growthServer.WeatherReadQuery = querySqlite.NewWeatherReadQuerySqlite(db)
+		growthServer.AreaReadingQuery = querySqlite.NewAreaReadingQuerySqlite(db)
 
 		// TODO: CropServiceInMemory should be renamed. It doesn't need InMemory name
 		growthServer.CropService = service.CropServiceInMemory{
 			MaterialReadQuery: growthServer.MaterialReadQuery,
 			CropReadQuery:     growthServer.CropReadQuery,
 			AreaReadQuery:     growthServer.AreaReadQuery,
+			FarmReadQuery:     growthServer.FarmReadQuery,
+			CropBatchIDRepo:   repoSqlite.NewCropBatchIDRepositorySqlite(db),
+		}
+		growthServer.CropForecastService = service.CropForecastService{
+			CropReadQuery:     growthServer.CropReadQuery,
+			CropActivityQuery: growthServer.CropActivityQuery,
 		}
 
 	case config.DB_MYSQL:
@@ -116,12 +147,20 @@ func NewGrowthServer(
 		growthServer.MaterialReadQuery = queryMysql.NewMaterialReadQueryMysql(db)
 		growthServer.FarmReadQuery = queryMysql.NewFarmReadQueryMysql(db)
 		growthServer.TaskReadQuery = queryMysql.NewTaskReadQueryMysql(db)
+		growthServer.WeatherReadQuery = queryMysql.NewWeatherReadQueryMysql(db)
+		growthServer.AreaReadingQuery = queryMysql.NewAreaReadingQueryMysql(db)
 
 		// TODO: CropServiceInMemory should be renamed. It doesn't need InMemory name
 		growthServer.CropService = service.CropServiceInMemory{
 			MaterialReadQuery: growthServer.MaterialReadQuery,
 			CropReadQuery:     growthServer.CropReadQuery,
 			AreaReadQuery:     growthServer.AreaReadQuery,
+			FarmReadQuery:     growthServer.FarmReadQuery,
+			CropBatchIDRepo:   repoMysql.NewCropBatchIDRepositoryMysql(db),
+		}
+		growthServer.CropForecastService = service.CropForecastService{
+			CropReadQuery:     growthServer.CropReadQuery,
+			CropActivityQuery: growthServer.CropActivityQuery,
 		}
 	}
 
@@ -141,18 +180,43 @@ func (s *GrowthServer) InitSubscriber() {
 	s.EventBus.Subscribe("CropBatchContainerChanged", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchMoved", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchMoved", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchSplit", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchSplit", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchMerged", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchMerged", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchMergedInto", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchMergedInto", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchHarvested", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchHarvested", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchDumped", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchDumped", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchWatered", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchWatered", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchHarvestCorrected", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchHarvestCorrected", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchDumpCorrected", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchDumpCorrected", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchMoveCorrected", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchMoveCorrected", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchWateringCorrected", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchWateringCorrected", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchArchived", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchStageChanged", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchStageChanged", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchFertilized", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchFertilized", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchPruned", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchPruned", s.SaveToCropActivityReadModel)
+	s.EventBus.Subscribe("CropBatchPesticided", s.SaveToCropReadModel)
+	s.EventBus.Subscribe("CropBatchPesticided", s.SaveToCropActivityReadModel)
 	s.EventBus.Subscribe("CropBatchNoteCreated", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchNoteRemoved", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchPhotoCreated", s.SaveToCropReadModel)
 	s.EventBus.Subscribe("CropBatchPhotoCreated", s.SaveToCropActivityReadModel)
 
 	s.EventBus.Subscribe("TaskCompleted", s.SaveToCropActivityReadModel)
+
+	s.EventBus.SubscribeAsync("IrrigationRunCompleted", s.WaterCropsFromIrrigation)
 }
 
 // Mount defines the GrowthServer's endpoints with its handlers
@@ -160,19 +224,35 @@ func (s *GrowthServer) Mount(g *echo.Group) {
 	g.GET("/:id/crops", s.FindAllCrops)
 	g.GET("/:id/crops/archives", s.FindAllCropArchives)
 	g.GET("/:id/crops/total_batch", s.GetBatchQuantity)
+	g.GET("/:id/crops/forecast", s.GetHarvestForecast)
 	g.GET("/areas/:id/crops", s.FindAllCropsByArea)
 	g.POST("/areas/:id/crops", s.SaveAreaCropBatch)
 	g.PUT("/crops/:id", s.UpdateCropBatch)
 	g.GET("/crops/:id", s.FindCropByID)
+	g.POST("/crops/bulk/water", s.BulkWaterCrops)
+	g.POST("/crops/bulk/fertilize", s.BulkFertilizeCrops)
+	g.POST("/crops/bulk/move", s.BulkMoveCrops)
+	g.POST("/crops/bulk/archive", s.BulkArchiveCrops)
 	g.POST("/crops/:id/move", s.MoveCrop)
+	g.POST("/crops/:id/split", s.SplitCrop)
+	g.POST("/crops/:id/merge", s.MergeCrop)
 	g.POST("/crops/:id/harvest", s.HarvestCrop)
 	g.POST("/crops/:id/dump", s.DumpCrop)
+	g.GET("/crops/lots/:code", s.FindHarvestLotByCode)
 	g.POST("/crops/:id/water", s.WaterCrop)
+	g.POST("/crops/:id/corrections", s.CorrectCrop)
+	g.POST("/crops/:id/stage", s.ChangeCropStage)
+	g.GET("/crops/:id/plan", s.GetCropPlan)
+	g.POST("/crops/:id/fertilize", s.FertilizeCrop)
+	g.POST("/crops/:id/prune", s.PruneCrop)
+	g.POST("/crops/:id/pesticide", s.PesticideCrop)
 	g.POST("/crops/:id/notes", s.SaveCropNotes)
 	g.DELETE("/crops/:crop_id/notes/:note_id", s.RemoveCropNotes)
 	g.POST("/crops/:id/photos", s.UploadCropPhotos)
 	g.GET("/crops/:crop_id/photos/:photo_id", s.GetCropPhotos)
+	g.GET("/crops/:id/label", s.GetCropLabel)
 	g.GET("/crops/:id/activities", s.GetCropActivities)
+	g.GET("/crops/:id/weather", s.GetCropWeather)
 	g.GET("/:id/crops/information", s.GetCropsInformation)
 
 }
@@ -244,6 +324,28 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	// The seeds are consumed by the assets module once the crop batch is created,
+	// so the stock is checked here while it can still be rejected
+	queryResult = <-s.MaterialReadQuery.FindByID(material.UID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	seedMaterial, ok := queryResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	warnings := []string{}
+	err = domain.ValidateSeedStock(seedMaterial, cropBatch.Container)
+	if err != nil {
+		if *config.Config.BlockInsufficientSeed {
+			return Error(c, err)
+		}
+
+		warnings = append(warnings, err.Error())
+	}
+
 	// Persists //
 	err = <-s.CropEventRepo.Save(cropBatch.UID, 0, cropBatch.UncommittedChanges)
 	if err != nil {
@@ -253,13 +355,16 @@ func (s *GrowthServer) SaveAreaCropBatch(c echo.Context) error {
 	// Trigger Events
 	s.publishUncommittedEvents(cropBatch)
 
-	data := make(map[string]storage.CropRead)
+	data := make(map[string]interface{})
 	cr, err := MapToCropRead(s, *cropBatch)
 	if err != nil {
 		return Error(c, err)
 	}
 
 	data["data"] = cr
+	if len(warnings) > 0 {
+		data["warnings"] = warnings
+	}
 
 	return c.JSON(http.StatusOK, data)
 }
@@ -413,6 +518,13 @@ func (s *GrowthServer) FindCropByID(c echo.Context) error {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
+	environment, err := s.cropEnvironment(crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	crop.Environment = &environment
+
 	data := make(map[string]storage.CropRead)
 	data["data"] = crop
 
@@ -494,17 +606,14 @@ func (s *GrowthServer) MoveCrop(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) HarvestCrop(c echo.Context) error {
+func (s *GrowthServer) SplitCrop(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
 	srcAreaID := c.FormValue("source_area_id")
-	harvestType := c.FormValue("harvest_type")
-	producedQuantity := c.FormValue("produced_quantity")
-	producedUnit := c.FormValue("produced_unit")
-	notes := c.FormValue("notes")
+	quantity := c.FormValue("quantity")
 
 	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
@@ -523,26 +632,12 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 
 	srcAreaUID, err := uuid.FromString(srcAreaID)
 	if err != nil {
-		return Error(c, err)
-	}
-
-	ht := domain.GetHarvestType(harvestType)
-	if ht == (domain.HarvestType{}) {
-		return Error(c, NewRequestValidationError(INVALID_OPTION, "harvest_type"))
-	}
-
-	if producedQuantity == "" {
-		return Error(c, NewRequestValidationError(REQUIRED, "produced_quantity"))
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
 	}
 
-	prodQty, err := strconv.ParseFloat(producedQuantity, 32)
+	qty, err := strconv.Atoi(quantity)
 	if err != nil {
-		return Error(c, err)
-	}
-
-	prodUnit := domain.GetProducedUnit(producedUnit)
-	if prodUnit == (domain.ProducedUnit{}) {
-		return Error(c, NewRequestValidationError(INVALID_OPTION, "produced_unit"))
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "quantity"))
 	}
 
 	// PROCESS //
@@ -555,22 +650,28 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.Harvest(s.CropService, srcAreaUID, harvestType, float32(prodQty), prodUnit, notes)
+	split, err := crop.Split(s.CropService, srcAreaUID, qty)
 	if err != nil {
 		return Error(c, err)
 	}
 
 	// PERSIST //
+	err = <-s.CropEventRepo.Save(split.UID, 0, split.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
 	}
 
 	// TRIGGER EVENTS
+	s.publishUncommittedEvents(split)
 	s.publishUncommittedEvents(crop)
 
 	data := make(map[string]storage.CropRead)
-	cr, err := MapToCropRead(s, *crop)
+	cr, err := MapToCropRead(s, *split)
 	if err != nil {
 		return Error(c, err)
 	}
@@ -580,15 +681,14 @@ func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) DumpCrop(c echo.Context) error {
+func (s *GrowthServer) MergeCrop(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	srcAreaID := c.FormValue("source_area_id")
-	quantity := c.FormValue("quantity")
-	notes := c.FormValue("notes")
+	sourceCropID := c.FormValue("source_crop_id")
+	areaID := c.FormValue("area_id")
 
 	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
@@ -605,14 +705,28 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	srcAreaUID, err := uuid.FromString(srcAreaID)
+	sourceCropUID, err := uuid.FromString(sourceCropID)
 	if err != nil {
-		return Error(c, err)
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "source_crop_id"))
 	}
 
-	qty, err := strconv.Atoi(quantity)
+	result = <-s.CropReadQuery.FindByID(sourceCropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	sourceCropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if sourceCropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "source_crop_id"))
+	}
+
+	areaUID, err := uuid.FromString(areaID)
 	if err != nil {
-		return Error(c, err)
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "area_id"))
 	}
 
 	// PROCESS //
@@ -621,11 +735,16 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 		return Error(c, eventQueryResult.Error)
 	}
 
-	events := eventQueryResult.Result.([]storage.CropEvent)
+	crop := repository.NewCropBatchFromHistory(eventQueryResult.Result.([]storage.CropEvent))
 
-	crop := repository.NewCropBatchFromHistory(events)
+	eventQueryResult = <-s.CropEventQuery.FindAllByCropID(sourceCropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
 
-	err = crop.Dump(s.CropService, srcAreaUID, qty, notes)
+	source := repository.NewCropBatchFromHistory(eventQueryResult.Result.([]storage.CropEvent))
+
+	err = crop.Merge(s.CropService, source, areaUID)
 	if err != nil {
 		return Error(c, err)
 	}
@@ -636,8 +755,14 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	err = <-s.CropEventRepo.Save(source.UID, source.Version, source.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
 	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
+	s.publishUncommittedEvents(source)
 
 	data := make(map[string]storage.CropRead)
 	cr, err := MapToCropRead(s, *crop)
@@ -650,14 +775,20 @@ func (s *GrowthServer) DumpCrop(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) WaterCrop(c echo.Context) error {
+func (s *GrowthServer) HarvestCrop(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
 	srcAreaID := c.FormValue("source_area_id")
-	wateringDate := c.FormValue("watering_date")
+	harvestType := c.FormValue("harvest_type")
+	producedQuantity := c.FormValue("produced_quantity")
+	producedUnit := c.FormValue("produced_unit")
+	grade := c.FormValue("grade")
+	destination := c.FormValue("destination")
+	notes := c.FormValue("notes")
+	preHarvestOverrideReason := c.FormValue("pre_harvest_override_reason")
 
 	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
@@ -679,9 +810,31 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	wDate, err := time.Parse("2006-01-02 15:04", wateringDate)
+	ht := domain.GetHarvestType(harvestType)
+	if ht == (domain.HarvestType{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "harvest_type"))
+	}
+
+	if producedQuantity == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "produced_quantity"))
+	}
+
+	prodQty, err := strconv.ParseFloat(producedQuantity, 32)
 	if err != nil {
-		return Error(c, NewRequestValidationError(PARSE_FAILED, "watering_date"))
+		return Error(c, err)
+	}
+
+	prodUnit := domain.GetProducedUnit(producedUnit)
+	if prodUnit == (domain.ProducedUnit{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "produced_unit"))
+	}
+
+	if grade == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "grade"))
+	}
+
+	if domain.GetHarvestGrade(grade) == (domain.HarvestGrade{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "grade"))
 	}
 
 	// PROCESS //
@@ -694,7 +847,7 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.Water(s.CropService, srcAreaUID, wDate)
+	err = crop.Harvest(s.CropService, srcAreaUID, harvestType, float32(prodQty), prodUnit, grade, destination, notes, preHarvestOverrideReason)
 	if err != nil {
 		return Error(c, err)
 	}
@@ -705,7 +858,7 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	// TRIGGER EVENTS //
+	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
 	data := make(map[string]storage.CropRead)
@@ -719,15 +872,103 @@ func (s *GrowthServer) WaterCrop(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
+func (s *GrowthServer) FindHarvestLotByCode(c echo.Context) error {
+	code := c.Param("code")
+
+	// VALIDATE //
+	result := <-s.CropReadQuery.FindByHarvestLotCode(code)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "code"))
+	}
+
+	// PROCESS //
+	events, err := s.findCropEvents(cropRead.UID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	trace, err := domain.TraceHarvestLot(events, code, s.findCropEvents)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The seeding and moving events only carry the IDs, so the names are looked up here
+	for i, v := range trace.Entries {
+		if v.AreaName == "" && v.AreaUID != (uuid.UUID{}) {
+			queryResult := <-s.AreaReadQuery.FindByID(v.AreaUID)
+			if queryResult.Error != nil {
+				return Error(c, queryResult.Error)
+			}
+
+			area, ok := queryResult.Result.(query.CropAreaQueryResult)
+			if !ok {
+				return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+			}
+
+			trace.Entries[i].AreaName = area.Name
+		}
+
+		if v.MaterialName == "" && v.MaterialUID != (uuid.UUID{}) {
+			queryResult := <-s.MaterialReadQuery.FindByID(v.MaterialUID)
+			if queryResult.Error != nil {
+				return Error(c, queryResult.Error)
+			}
+
+			material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+			if !ok {
+				return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+			}
+
+			trace.Entries[i].MaterialName = material.Name
+		}
+	}
+
+	data := make(map[string]domain.HarvestLotTrace)
+	data["data"] = trace
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// findCropEvents returns the events of the crop batch, so the harvest lot can be traced to the batches it was split from
+func (s *GrowthServer) findCropEvents(cropUID uuid.UUID) ([]interface{}, error) {
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	cropEvents, ok := eventQueryResult.Result.([]storage.CropEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	events := []interface{}{}
+	for _, v := range cropEvents {
+		events = append(events, v.Event)
+	}
+
+	return events, nil
+}
+
+func (s *GrowthServer) DumpCrop(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	content := c.FormValue("content")
+	srcAreaID := c.FormValue("source_area_id")
+	quantity := c.FormValue("quantity")
+	notes := c.FormValue("notes")
 
-	// Validate //
+	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
@@ -735,18 +976,24 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 
 	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
 	}
 
 	if cropRead.UID == (uuid.UUID{}) {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	if content == "" {
-		return Error(c, NewRequestValidationError(REQUIRED, "content"))
+	srcAreaUID, err := uuid.FromString(srcAreaID)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// Process //
+	qty, err := strconv.Atoi(quantity)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// PROCESS //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
 		return Error(c, eventQueryResult.Error)
@@ -756,18 +1003,18 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.AddNewNote(content)
+	err = crop.Dump(s.CropService, srcAreaUID, qty, notes)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Persists //
-	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
-	if resultSave != nil {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
 	}
 
-	// TRIGGER EVENTS //
+	// TRIGGER EVENTS
 	s.publishUncommittedEvents(crop)
 
 	data := make(map[string]storage.CropRead)
@@ -781,18 +1028,16 @@ func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
-	cropUID, err := uuid.FromString(c.Param("crop_id"))
+func (s *GrowthServer) WaterCrop(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	noteUID, err := uuid.FromString(c.Param("note_id"))
-	if err != nil {
-		return Error(c, err)
-	}
+	srcAreaID := c.FormValue("source_area_id")
+	wateringDate := c.FormValue("watering_date")
 
-	// Validate //
+	// VALIDATE //
 	result := <-s.CropReadQuery.FindByID(cropUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
@@ -800,14 +1045,24 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 
 	cropRead, ok := result.Result.(storage.CropRead)
 	if !ok {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
 	}
 
 	if cropRead.UID == (uuid.UUID{}) {
 		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
 	}
 
-	// Process //
+	srcAreaUID, err := uuid.FromString(srcAreaID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	wDate, err := time.Parse("2006-01-02 15:04", wateringDate)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "watering_date"))
+	}
+
+	// PROCESS //
 	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
 	if eventQueryResult.Error != nil {
 		return Error(c, eventQueryResult.Error)
@@ -817,15 +1072,15 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 
 	crop := repository.NewCropBatchFromHistory(events)
 
-	err = crop.RemoveNote(noteUID)
+	err = crop.Water(s.CropService, srcAreaUID, wDate)
 	if err != nil {
 		return Error(c, err)
 	}
 
-	// Persists //
-	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
-	if resultSave != nil {
-		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
 	}
 
 	// TRIGGER EVENTS //
@@ -842,23 +1097,689 @@ func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
-func (s *GrowthServer) FindAllCrops(c echo.Context) error {
-	data := make(map[string]interface{})
-
-	// Params //
-	farmID := c.Param("id")
-
-	status := c.QueryParam("status")
-	page := c.QueryParam("page")
-	limit := c.QueryParam("limit")
-
-	// Validate //
-	farmUID, err := uuid.FromString(farmID)
+// CorrectCrop reverses a harvest, dump, move or watering of the crop which was recorded by mistake.
+// The version is the version of the crop's event to correct.
+func (s *GrowthServer) CorrectCrop(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
 		return Error(c, err)
 	}
 
-	result := <-s.FarmReadQuery.FindByID(farmUID)
+	correctionType := c.FormValue("type")
+	version := c.FormValue("version")
+	reason := c.FormValue("reason")
+
+	// VALIDATE //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	if correctionType == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "type"))
+	}
+
+	if version == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "version"))
+	}
+
+	ver, err := strconv.Atoi(version)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "version"))
+	}
+
+	if reason == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "reason"))
+	}
+
+	// PROCESS //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	history := []interface{}{}
+	for _, v := range events {
+		history = append(history, v.Event)
+	}
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	switch correctionType {
+	case domain.CropCorrectionHarvest:
+		err = crop.CorrectHarvest(history, ver, reason)
+	case domain.CropCorrectionDump:
+		err = crop.CorrectDump(history, ver, reason)
+	case domain.CropCorrectionMove:
+		err = crop.CorrectMove(history, ver, reason)
+	case domain.CropCorrectionWatering:
+		err = crop.CorrectWatering(history, ver, reason)
+	default:
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "type"))
+	}
+
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) ChangeCropStage(c echo.Context) error {
+	stage := c.FormValue("stage")
+	changedDate := c.FormValue("changed_date")
+
+	if stage == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "stage"))
+	}
+
+	if domain.GetCropStage(stage) == (domain.CropStage{}) {
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "stage"))
+	}
+
+	cDate := time.Now()
+	if changedDate != "" {
+		var err error
+		cDate, err = time.Parse("2006-01-02 15:04", changedDate)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "changed_date"))
+		}
+	}
+
+	return s.careCrop(c, func(crop *domain.Crop) error {
+		return crop.ChangeStage(stage, cDate)
+	})
+}
+
+func (s *GrowthServer) GetCropPlan(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// VALIDATE //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// PROCESS //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	serviceResult := s.CropService.FindMaterialByID(crop.InventoryUID)
+	if serviceResult.Error != nil {
+		return Error(c, serviceResult.Error)
+	}
+
+	material, ok := serviceResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// The forecasts of the next days help to predict the milestones
+	days, err := s.cropEnvironmentDays(
+		cropRead,
+		dayOf(crop.InitialArea.CreatedDate),
+		dayOf(time.Now()).AddDate(0, 0, forecastHorizonDays),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]CropPlan)
+	data["data"] = CropPlan{
+		Stage:      crop.Stage,
+		Plan:       material.CropPlan,
+		Milestones: crop.PredictMilestones(material.CropPlan, days),
+		Deviations: crop.PlanDeviations(material.CropPlan, time.Now()),
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FertilizeCrop(c echo.Context) error {
+	srcAreaUID, materialUID, dose, doseUnit, fDate, err := parseCropCareParams(c, "fertilizing_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.careCrop(c, func(crop *domain.Crop) error {
+		return crop.Fertilize(s.CropService, srcAreaUID, materialUID, dose, doseUnit, fDate)
+	})
+}
+
+func (s *GrowthServer) PesticideCrop(c echo.Context) error {
+	srcAreaUID, materialUID, dose, doseUnit, pDate, err := parseCropCareParams(c, "pesticiding_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.careCrop(c, func(crop *domain.Crop) error {
+		return crop.Pesticide(s.CropService, srcAreaUID, materialUID, dose, doseUnit, pDate)
+	})
+}
+
+func (s *GrowthServer) PruneCrop(c echo.Context) error {
+	srcAreaID := c.FormValue("source_area_id")
+	pruningDate := c.FormValue("pruning_date")
+	notes := c.FormValue("notes")
+
+	srcAreaUID, err := uuid.FromString(srcAreaID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+	}
+
+	pDate, err := time.Parse("2006-01-02 15:04", pruningDate)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "pruning_date"))
+	}
+
+	return s.careCrop(c, func(crop *domain.Crop) error {
+		return crop.Prune(s.CropService, srcAreaUID, pDate, notes)
+	})
+}
+
+// parseCropCareParams reads the form values shared by the fertilize and pesticide endpoints.
+func parseCropCareParams(c echo.Context, dateField string) (uuid.UUID, uuid.UUID, float32, string, time.Time, error) {
+	srcAreaUID, err := uuid.FromString(c.FormValue("source_area_id"))
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, 0, "", time.Time{}, NewRequestValidationError(PARSE_FAILED, "source_area_id")
+	}
+
+	materialUID, err := uuid.FromString(c.FormValue("material_id"))
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, 0, "", time.Time{}, NewRequestValidationError(PARSE_FAILED, "material_id")
+	}
+
+	if c.FormValue("dose") == "" {
+		return uuid.UUID{}, uuid.UUID{}, 0, "", time.Time{}, NewRequestValidationError(REQUIRED, "dose")
+	}
+
+	dose, err := strconv.ParseFloat(c.FormValue("dose"), 32)
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, 0, "", time.Time{}, NewRequestValidationError(PARSE_FAILED, "dose")
+	}
+
+	doseUnit := c.FormValue("dose_unit")
+	if doseUnit == "" {
+		return uuid.UUID{}, uuid.UUID{}, 0, "", time.Time{}, NewRequestValidationError(REQUIRED, "dose_unit")
+	}
+
+	date, err := time.Parse("2006-01-02 15:04", c.FormValue(dateField))
+	if err != nil {
+		return uuid.UUID{}, uuid.UUID{}, 0, "", time.Time{}, NewRequestValidationError(PARSE_FAILED, dateField)
+	}
+
+	return srcAreaUID, materialUID, float32(dose), doseUnit, date, nil
+}
+
+// careCrop rebuilds the crop from its events, applies the care operation,
+// then persists and publishes the resulting events.
+func (s *GrowthServer) careCrop(c echo.Context, care func(crop *domain.Crop) error) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// VALIDATE //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// PROCESS //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	err = care(crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// PERSIST //
+	err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) BulkWaterCrops(c echo.Context) error {
+	srcAreaUID, err := uuid.FromString(c.FormValue("source_area_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+	}
+
+	wDate, err := time.Parse("2006-01-02 15:04", c.FormValue("watering_date"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "watering_date"))
+	}
+
+	return s.bulkCrops(c, srcAreaUID, func(crop *domain.Crop) error {
+		return crop.Water(s.CropService, srcAreaUID, wDate)
+	})
+}
+
+func (s *GrowthServer) BulkFertilizeCrops(c echo.Context) error {
+	srcAreaUID, materialUID, dose, doseUnit, fDate, err := parseCropCareParams(c, "fertilizing_date")
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.bulkCrops(c, srcAreaUID, func(crop *domain.Crop) error {
+		return crop.Fertilize(s.CropService, srcAreaUID, materialUID, dose, doseUnit, fDate)
+	})
+}
+
+// BulkMoveCrops moves every plant of the crops in the source area to the destination area.
+func (s *GrowthServer) BulkMoveCrops(c echo.Context) error {
+	srcAreaUID, err := uuid.FromString(c.FormValue("source_area_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+	}
+
+	dstAreaUID, err := uuid.FromString(c.FormValue("destination_area_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "destination_area_id"))
+	}
+
+	return s.bulkCrops(c, srcAreaUID, func(crop *domain.Crop) error {
+		quantity := 0
+		if crop.InitialArea.AreaUID == srcAreaUID {
+			quantity = crop.InitialArea.CurrentQuantity
+		}
+
+		for _, v := range crop.MovedArea {
+			if v.AreaUID == srcAreaUID {
+				quantity = v.CurrentQuantity
+			}
+		}
+
+		return crop.MoveToArea(s.CropService, srcAreaUID, dstAreaUID, quantity)
+	})
+}
+
+// BulkArchiveCrops archives the crops, selected by their IDs or by the area they are in.
+func (s *GrowthServer) BulkArchiveCrops(c echo.Context) error {
+	areaUID := uuid.UUID{}
+	if c.FormValue("source_area_id") != "" {
+		uid, err := uuid.FromString(c.FormValue("source_area_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "source_area_id"))
+		}
+
+		areaUID = uid
+	}
+
+	archivedDate := time.Now()
+
+	return s.bulkCrops(c, areaUID, func(crop *domain.Crop) error {
+		return crop.Archive(archivedDate)
+	})
+}
+
+// bulkCrops rebuilds each selected crop from its events and applies the operation to it.
+// The crops are selected by the comma separated crop_ids form value, or else all active crops
+// that still have plants in the area.
+// When all_or_nothing is true, no crop is persisted unless the operation succeeded on every crop,
+// and the crops are saved together so either all of them are saved or none is.
+func (s *GrowthServer) bulkCrops(c echo.Context, areaUID uuid.UUID, operation func(crop *domain.Crop) error) error {
+	allOrNothing := c.FormValue("all_or_nothing") == "true"
+
+	// VALIDATE //
+	cropUIDs := []uuid.UUID{}
+	isAreaSelection := false
+	if cropIDs := c.FormValue("crop_ids"); cropIDs != "" {
+		isAdded := make(map[uuid.UUID]bool)
+		for _, v := range strings.Split(cropIDs, ",") {
+			uid, err := uuid.FromString(strings.TrimSpace(v))
+			if err != nil {
+				return Error(c, NewRequestValidationError(PARSE_FAILED, "crop_ids"))
+			}
+
+			if !isAdded[uid] {
+				cropUIDs = append(cropUIDs, uid)
+				isAdded[uid] = true
+			}
+		}
+	} else if areaUID != (uuid.UUID{}) {
+		result := <-s.CropReadQuery.FindAllCropsByArea(areaUID)
+		if result.Error != nil {
+			return Error(c, result.Error)
+		}
+
+		crops, ok := result.Result.([]query.CropAreaByAreaQueryResult)
+		if !ok {
+			return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+		}
+
+		// Only the active crops that still have plants in the area, as WaterCropsFromIrrigation does
+		isAdded := make(map[uuid.UUID]bool)
+		for _, v := range crops {
+			if v.Area.CurrentQuantity > 0 && !isAdded[v.UID] {
+				cropUIDs = append(cropUIDs, v.UID)
+				isAdded[v.UID] = true
+			}
+		}
+
+		isAreaSelection = true
+	} else {
+		return Error(c, NewRequestValidationError(REQUIRED, "crop_ids"))
+	}
+
+	// PROCESS //
+	crops := []*domain.Crop{}
+	results := []BulkCropResult{}
+	isFailed := false
+	for _, uid := range cropUIDs {
+		eventQueryResult := <-s.CropEventQuery.FindAllByCropID(uid)
+		if eventQueryResult.Error != nil {
+			return Error(c, eventQueryResult.Error)
+		}
+
+		events := eventQueryResult.Result.([]storage.CropEvent)
+		if len(events) == 0 {
+			results = append(results, BulkCropResult{CropUID: uid, Error: "Crop not found"})
+			isFailed = true
+			continue
+		}
+
+		crop := repository.NewCropBatchFromHistory(events)
+		if isAreaSelection && crop.Status.Code != domain.CropActive {
+			continue
+		}
+
+		err := operation(crop)
+		if err != nil {
+			results = append(results, BulkCropResult{CropUID: uid, BatchID: crop.BatchID, Error: err.Error()})
+			isFailed = true
+			continue
+		}
+
+		crops = append(crops, crop)
+		results = append(results, BulkCropResult{CropUID: uid, BatchID: crop.BatchID, Success: true})
+	}
+
+	data := make(map[string][]BulkCropResult)
+	data["data"] = results
+
+	if isFailed && allOrNothing {
+		for i, v := range results {
+			if v.Success {
+				results[i].Success = false
+				results[i].Error = "Not applied because another crop failed"
+			}
+		}
+
+		return c.JSON(http.StatusBadRequest, data)
+	}
+
+	// PERSIST //
+	if allOrNothing {
+		streams := []repository.CropEventStream{}
+		for _, crop := range crops {
+			streams = append(streams, repository.CropEventStream{
+				UID:           crop.UID,
+				LatestVersion: crop.Version,
+				Events:        crop.UncommittedChanges,
+			})
+		}
+
+		err := <-s.CropEventRepo.SaveAll(streams)
+		if err != nil {
+			for i := range results {
+				results[i].Success = false
+				results[i].Error = err.Error()
+			}
+
+			return c.JSON(http.StatusInternalServerError, data)
+		}
+
+		// TRIGGER EVENTS //
+		for _, crop := range crops {
+			s.publishUncommittedEvents(crop)
+		}
+
+		return c.JSON(http.StatusOK, data)
+	}
+
+	isSaveFailed := false
+	for _, crop := range crops {
+		err := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+		if err == nil {
+			// TRIGGER EVENTS //
+			s.publishUncommittedEvents(crop)
+
+			continue
+		}
+
+		isSaveFailed = true
+		for i, v := range results {
+			if v.CropUID == crop.UID {
+				results[i].Success = false
+				results[i].Error = err.Error()
+			}
+		}
+	}
+
+	if isSaveFailed {
+		return c.JSON(http.StatusInternalServerError, data)
+	}
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) SaveCropNotes(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	content := c.FormValue("content")
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	if content == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "content"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	err = crop.AddNewNote(content)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if resultSave != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) RemoveCropNotes(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("crop_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	noteUID, err := uuid.FromString(c.Param("note_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	eventQueryResult := <-s.CropEventQuery.FindAllByCropID(cropUID)
+	if eventQueryResult.Error != nil {
+		return Error(c, eventQueryResult.Error)
+	}
+
+	events := eventQueryResult.Result.([]storage.CropEvent)
+
+	crop := repository.NewCropBatchFromHistory(events)
+
+	err = crop.RemoveNote(noteUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	resultSave := <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+	if resultSave != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	// TRIGGER EVENTS //
+	s.publishUncommittedEvents(crop)
+
+	data := make(map[string]storage.CropRead)
+	cr, err := MapToCropRead(s, *crop)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data["data"] = cr
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *GrowthServer) FindAllCrops(c echo.Context) error {
+	data := make(map[string]interface{})
+
+	// Params //
+	farmID := c.Param("id")
+
+	status := c.QueryParam("status")
+	page := c.QueryParam("page")
+	limit := c.QueryParam("limit")
+
+	// Validate //
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
 	if result.Error != nil {
 		return Error(c, result.Error)
 	}
@@ -1012,6 +1933,64 @@ func (s *GrowthServer) FindAllCropsByArea(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+func (s *GrowthServer) GetHarvestForecast(c echo.Context) error {
+	// Params //
+	farmID := c.Param("id")
+	from := c.QueryParam("from")
+	to := c.QueryParam("to")
+
+	// Validate //
+	farmUID, err := uuid.FromString(farmID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.FarmReadQuery.FindByID(farmUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	farm, ok := result.Result.(query.CropFarmQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	now := time.Now()
+
+	// The forecast covers the next four weeks when no range is given
+	y, m, d := now.Date()
+	fromDate := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	if from != "" {
+		fromDate, err = time.ParseInLocation("2006-01-02", from, now.Location())
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "from"))
+		}
+	}
+
+	toDate := fromDate.AddDate(0, 0, 28)
+	if to != "" {
+		toDate, err = time.ParseInLocation("2006-01-02", to, now.Location())
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "to"))
+		}
+	}
+
+	// Process //
+	forecast, err := domain.ForecastHarvest(s.CropForecastService, farm.UID, fromDate, toDate, now)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]domain.HarvestForecast)
+	data["data"] = forecast
+
+	return c.JSON(http.StatusOK, data)
+}
+
 func (s *GrowthServer) GetBatchQuantity(c echo.Context) error {
 	// Params //
 	farmID := c.Param("id")
@@ -1179,6 +2158,45 @@ func (s *GrowthServer) GetCropPhotos(c echo.Context) error {
 	return c.File(srcPath)
 }
 
+// GetCropLabel renders the batch ID of the crop as a QR code PNG to be printed on its tray label
+func (s *GrowthServer) GetCropLabel(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	size := 256
+	if c.QueryParam("size") != "" {
+		size, err = strconv.Atoi(c.QueryParam("size"))
+		if err != nil || size < 64 || size > 1024 {
+			return Error(c, NewRequestValidationError(INVALID_OPTION, "size"))
+		}
+	}
+
+	// Validate //
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	cropRead, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if cropRead.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	// Process //
+	png, err := qrcode.Encode(cropRead.BatchID, qrcode.Medium, size)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return c.Blob(http.StatusOK, "image/png", png)
+}
+
 func (s *GrowthServer) GetCropActivities(c echo.Context) error {
 	cropUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
@@ -1215,6 +2233,167 @@ func (s *GrowthServer) GetCropActivities(c echo.Context) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// GetCropWeather shows the crop timeline day by day, from its seeding, along the weather of its farm.
+// It helps to correlate how the crop grows and yields with the weather it had.
+func (s *GrowthServer) GetCropWeather(c echo.Context) error {
+	cropUID, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	result := <-s.CropReadQuery.FindByID(cropUID)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	crop, ok := result.Result.(storage.CropRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	if crop.UID == (uuid.UUID{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	queryResult := <-s.CropActivityQuery.FindAllByCropID(cropUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	activities := queryResult.Result.([]storage.CropActivity)
+
+	from := dayOf(crop.InitialArea.CreatedDate)
+	to := timelineEnd(crop, activities, time.Now())
+
+	queryResult = <-s.WeatherReadQuery.FindAllByFarmID(crop.FarmUID, from, to)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	weather, ok := queryResult.Result.([]query.CropWeatherQueryResult)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]CropWeatherDay)
+	data["data"] = MapToCropWeatherDays(from, to, weather, activities)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// dayOf truncates the date to the start of its day in UTC, which is how the weather days are keyed
+func dayOf(date time.Time) time.Time {
+	y, m, d := date.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// timelineEnd is the last day of the crop timeline. The timeline of an archived crop ends with its last activity.
+func timelineEnd(crop storage.CropRead, activities []storage.CropActivity, now time.Time) time.Time {
+	if crop.Status != domain.CropArchived || len(activities) == 0 {
+		return dayOf(now)
+	}
+
+	to := dayOf(crop.InitialArea.CreatedDate)
+	for _, v := range activities {
+		if dayOf(v.CreatedDate).After(to) {
+			to = dayOf(v.CreatedDate)
+		}
+	}
+
+	return to
+}
+
+// cropEnvironment accumulates the climate the crop had from its seeding, until yesterday
+// while it grows. The base temperature is the one of its crop plan when the plan has a thermal time.
+func (s *GrowthServer) cropEnvironment(crop storage.CropRead) (domain.CropEnvironment, error) {
+	baseTemperature := float32(domain.DefaultBaseTemperature)
+
+	// A crop whose material is gone is still shown, with the default base temperature
+	queryResult := <-s.MaterialReadQuery.FindByID(crop.Inventory.UID)
+	if queryResult.Error != nil {
+		return domain.CropEnvironment{}, queryResult.Error
+	}
+
+	material, ok := queryResult.Result.(query.CropMaterialQueryResult)
+	if !ok {
+		return domain.CropEnvironment{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if material.CropPlan.GDDToFirstHarvest > 0 {
+		baseTemperature = material.CropPlan.BaseTemperature
+	}
+
+	queryResult = <-s.CropActivityQuery.FindAllByCropID(crop.UID)
+	if queryResult.Error != nil {
+		return domain.CropEnvironment{}, queryResult.Error
+	}
+
+	activities := queryResult.Result.([]storage.CropActivity)
+
+	from := dayOf(crop.InitialArea.CreatedDate)
+	to := timelineEnd(crop, activities, time.Now())
+	if crop.Status != domain.CropArchived {
+		// Today is not over yet
+		to = to.AddDate(0, 0, -1)
+	}
+
+	days, err := s.cropEnvironmentDays(crop, from, to)
+	if err != nil {
+		return domain.CropEnvironment{}, err
+	}
+
+	harvestDates := []time.Time{}
+	for _, v := range crop.HarvestLots {
+		if v.CorrectedDate == nil {
+			harvestDates = append(harvestDates, v.HarvestDate)
+		}
+	}
+
+	return domain.NewCropEnvironment(from, to, baseTemperature, days, harvestDates), nil
+}
+
+// cropEnvironmentDays builds the climate of the crop day by day, from the weather of its farm
+// and the sensor readings of every area it has grown in
+func (s *GrowthServer) cropEnvironmentDays(crop storage.CropRead, from, to time.Time) ([]domain.DailyEnvironment, error) {
+	queryResult := <-s.WeatherReadQuery.FindAllByFarmID(crop.FarmUID, from, to)
+	if queryResult.Error != nil {
+		return nil, queryResult.Error
+	}
+
+	weather, ok := queryResult.Result.([]query.CropWeatherQueryResult)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	areaUIDs := []uuid.UUID{crop.InitialArea.AreaUID}
+	for _, v := range crop.MovedArea {
+		areaUIDs = append(areaUIDs, v.AreaUID)
+	}
+
+	readings := []query.CropAreaReadingQueryResult{}
+	seen := make(map[uuid.UUID]bool)
+	for _, areaUID := range areaUIDs {
+		if seen[areaUID] {
+			continue
+		}
+		seen[areaUID] = true
+
+		queryResult := <-s.AreaReadingQuery.FindAllByAreaID(areaUID, from, to.AddDate(0, 0, 1).Add(-time.Second))
+		if queryResult.Error != nil {
+			return nil, queryResult.Error
+		}
+
+		areaReadings, ok := queryResult.Result.([]query.CropAreaReadingQueryResult)
+		if !ok {
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		}
+
+		readings = append(readings, areaReadings...)
+	}
+
+	return domain.DailyEnvironments(from, to, weather, readings), nil
+}
+
 func (s *GrowthServer) GetCropsInformation(c echo.Context) error {
 	farmUID, err := uuid.FromString(c.Param("id"))
 	if err != nil {
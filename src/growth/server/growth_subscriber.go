@@ -7,7 +7,9 @@ import (
 
 	"github.com/Tanibox/tania-core/src/growth/domain"
 	"github.com/Tanibox/tania-core/src/growth/query"
+	"github.com/Tanibox/tania-core/src/growth/repository"
 	"github.com/Tanibox/tania-core/src/growth/storage"
+	irrigationevents "github.com/Tanibox/tania-core/src/irrigation/domain"
 	taskevents "github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/labstack/gommon/log"
 	uuid "github.com/satori/go.uuid"
@@ -42,6 +44,7 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 		cropRead.BatchID = e.BatchID
 		cropRead.Status = e.Status.Code
 		cropRead.Type = e.Type.Code
+		cropRead.Stage = domain.CropStageGerminating
 
 		switch v := e.Container.Type.(type) {
 		case domain.Tray:
@@ -88,6 +91,10 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 
 		cropRead.FarmUID = e.FarmUID
 
+		if !e.SafeHarvestDate.IsZero() {
+			cropRead.SafeHarvestDate = &e.SafeHarvestDate
+		}
+
 	case domain.CropBatchTypeChanged:
 		queryResult := <-s.CropReadQuery.FindByID(e.UID)
 		if queryResult.Error != nil {
@@ -103,6 +110,36 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 
 		cropRead.Type = e.Type.Code
 
+	case domain.CropBatchArchived:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		cropRead.Status = domain.CropArchived
+
+	case domain.CropBatchStageChanged:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		cropRead.Stage = e.Stage
+
 	case domain.CropBatchInventoryChanged:
 		queryResult := <-s.CropReadQuery.FindByID(e.UID)
 		if queryResult.Error != nil {
@@ -324,6 +361,21 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 			cropRead.HarvestedStorage = append(cropRead.HarvestedStorage, hs)
 		}
 
+		if e.HarvestLot.UID != (uuid.UUID{}) {
+			cropRead.HarvestLots = append(cropRead.HarvestLots, storage.HarvestLot{
+				UID:                  e.HarvestLot.UID,
+				Code:                 e.HarvestLot.Code,
+				SourceAreaUID:        srcArea.UID,
+				SourceAreaName:       srcArea.Name,
+				HarvestType:          e.HarvestLot.HarvestType,
+				Quantity:             e.HarvestLot.Quantity,
+				ProducedGramQuantity: e.HarvestLot.ProducedGramQuantity,
+				Grade:                e.HarvestLot.Grade,
+				Destination:          e.HarvestLot.Destination,
+				HarvestDate:          e.HarvestLot.HarvestDate,
+			})
+		}
+
 		if e.HarvestedAreaCode == "INITIAL_AREA" {
 			ha := e.HarvestedArea.(domain.InitialArea)
 			cropRead.InitialArea.CurrentQuantity = ha.CurrentQuantity
@@ -419,6 +471,109 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 
 		cropRead.Status = e.CropStatus
 
+	case domain.CropBatchSplit:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.SrcAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		updateCropReadArea(cropRead, e.UpdatedSrcAreaCode, e.UpdatedSrcArea)
+
+		if srcArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding -= e.Quantity
+		}
+		if srcArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing -= e.Quantity
+		}
+
+	case domain.CropBatchMerged:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		updateCropReadArea(cropRead, e.UpdatedAreaCode, e.UpdatedArea)
+
+		if !e.SafeHarvestDate.IsZero() &&
+			(cropRead.SafeHarvestDate == nil || e.SafeHarvestDate.After(*cropRead.SafeHarvestDate)) {
+			cropRead.SafeHarvestDate = &e.SafeHarvestDate
+		}
+
+		if area.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += e.Quantity
+		}
+		if area.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += e.Quantity
+		}
+
+	case domain.CropBatchMergedInto:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		updateCropReadArea(cropRead, e.UpdatedAreaCode, e.UpdatedArea)
+
+		if area.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding -= e.Quantity
+		}
+		if area.Type == "GROWING" {
+			cropRead.AreaStatus.Growing -= e.Quantity
+		}
+
+		cropRead.Status = e.CropStatus
+
 	case domain.CropBatchWatered:
 		queryResult := <-s.CropReadQuery.FindByID(e.UID)
 		if queryResult.Error != nil {
@@ -442,6 +597,240 @@ func (s *GrowthServer) SaveToCropReadModel(event interface{}) error {
 			}
 		}
 
+	case domain.CropBatchHarvestCorrected:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		for i, v := range cropRead.HarvestedStorage {
+			if v.SourceAreaUID == e.UpdatedHarvestedStorage.SourceAreaUID {
+				cropRead.HarvestedStorage[i].Quantity = e.UpdatedHarvestedStorage.Quantity
+				cropRead.HarvestedStorage[i].ProducedGramQuantity = e.UpdatedHarvestedStorage.ProducedGramQuantity
+				cropRead.HarvestedStorage[i].LastUpdated = e.UpdatedHarvestedStorage.LastUpdated
+			}
+		}
+
+		for i, v := range cropRead.HarvestLots {
+			if v.Code == e.HarvestLotCode {
+				cropRead.HarvestLots[i].CorrectedDate = &e.CorrectionDate
+			}
+		}
+
+		updateCropReadArea(cropRead, e.RestoredAreaCode, e.RestoredArea)
+
+		// Because Harvest should only be done in the GROWING area
+		cropRead.AreaStatus.Growing += e.HarvestedQuantity
+
+		cropRead.Status = e.CropStatus
+
+	case domain.CropBatchDumpCorrected:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.UpdatedTrash.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		for i, v := range cropRead.Trash {
+			if v.SourceAreaUID == e.UpdatedTrash.SourceAreaUID {
+				cropRead.Trash[i].Quantity = e.UpdatedTrash.Quantity
+				cropRead.Trash[i].LastUpdated = e.UpdatedTrash.LastUpdated
+			}
+		}
+
+		updateCropReadArea(cropRead, e.RestoredAreaCode, e.RestoredArea)
+
+		if srcArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += e.Quantity
+		}
+		if srcArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += e.Quantity
+		}
+
+		cropRead.AreaStatus.Dumped -= e.Quantity
+
+		cropRead.Status = e.CropStatus
+
+	case domain.CropBatchMoveCorrected:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.SrcAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.DstAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		dstArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		updateCropReadArea(cropRead, e.UpdatedSrcAreaCode, e.UpdatedSrcArea)
+		updateCropReadArea(cropRead, e.UpdatedDstAreaCode, e.UpdatedDstArea)
+
+		if srcArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding += e.Quantity
+		}
+		if srcArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing += e.Quantity
+		}
+		if dstArea.Type == "SEEDING" {
+			cropRead.AreaStatus.Seeding -= e.Quantity
+		}
+		if dstArea.Type == "GROWING" {
+			cropRead.AreaStatus.Growing -= e.Quantity
+		}
+
+	case domain.CropBatchWateringCorrected:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cr, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cr
+
+		// The area was never watered if only the corrected watering was recorded
+		var lastWatered *time.Time
+		if !e.RestoredLastWatered.IsZero() {
+			lastWatered = &e.RestoredLastWatered
+		}
+
+		if cropRead.InitialArea.AreaUID == e.AreaUID {
+			cropRead.InitialArea.LastWatered = lastWatered
+		}
+
+		for i, v := range cropRead.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				cropRead.MovedArea[i].LastWatered = lastWatered
+			}
+		}
+
+	case domain.CropBatchFertilized:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cl, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cl
+
+		if cropRead.InitialArea.AreaUID == e.AreaUID {
+			cropRead.InitialArea.LastFertilized = &e.FertilizingDate
+		}
+
+		for i, v := range cropRead.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				cropRead.MovedArea[i].LastFertilized = &e.FertilizingDate
+			}
+		}
+
+		if !e.SafeHarvestDate.IsZero() &&
+			(cropRead.SafeHarvestDate == nil || e.SafeHarvestDate.After(*cropRead.SafeHarvestDate)) {
+			cropRead.SafeHarvestDate = &e.SafeHarvestDate
+		}
+
+	case domain.CropBatchPruned:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cl, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cl
+
+		if cropRead.InitialArea.AreaUID == e.AreaUID {
+			cropRead.InitialArea.LastPruned = &e.PruningDate
+		}
+
+		for i, v := range cropRead.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				cropRead.MovedArea[i].LastPruned = &e.PruningDate
+			}
+		}
+
+	case domain.CropBatchPesticided:
+		queryResult := <-s.CropReadQuery.FindByID(e.UID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		cl, ok := queryResult.Result.(storage.CropRead)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropRead = &cl
+
+		if cropRead.InitialArea.AreaUID == e.AreaUID {
+			cropRead.InitialArea.LastPesticided = &e.PesticidingDate
+		}
+
+		for i, v := range cropRead.MovedArea {
+			if v.AreaUID == e.AreaUID {
+				cropRead.MovedArea[i].LastPesticided = &e.PesticidingDate
+			}
+		}
+
+		if !e.SafeHarvestDate.IsZero() &&
+			(cropRead.SafeHarvestDate == nil || e.SafeHarvestDate.After(*cropRead.SafeHarvestDate)) {
+			cropRead.SafeHarvestDate = &e.SafeHarvestDate
+		}
+
 	case domain.CropBatchNoteCreated:
 		queryResult := <-s.CropReadQuery.FindByID(e.CropUID)
 		if queryResult.Error != nil {
@@ -549,6 +938,20 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			SeedingDate: e.CreatedDate,
 		}
 
+		// A batch split from another batch was not seeded, so its first activity is the split
+		if e.ParentUID != (uuid.UUID{}) {
+			cropActivity.ActivityType = storage.SplitActivity{
+				ParentCropUID: e.ParentUID,
+				ParentBatchID: e.ParentBatchID,
+				SplitCropUID:  e.UID,
+				SplitBatchID:  e.BatchID,
+				AreaUID:       srcArea.UID,
+				AreaName:      srcArea.Name,
+				Quantity:      e.Quantity,
+				SplitDate:     e.SplitDate,
+			}
+		}
+
 	case domain.CropBatchContainerChanged:
 		queryResult := <-s.CropActivityQuery.FindByCropIDAndActivityType(e.UID, storage.SeedActivity{})
 		if queryResult.Error != nil {
@@ -670,6 +1073,9 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			SrcAreaName:          srcArea.Name,
 			Quantity:             e.HarvestedQuantity,
 			ProducedGramQuantity: e.ProducedGramQuantity,
+			LotCode:              e.HarvestLot.Code,
+			Grade:                e.HarvestLot.Grade,
+			Destination:          e.HarvestLot.Destination,
 			HarvestDate:          e.HarvestDate,
 		}
 
@@ -706,6 +1112,84 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			DumpDate:    e.DumpDate,
 		}
 
+	case domain.CropBatchSplit:
+		queryResult := <-s.AreaReadQuery.FindByID(e.SrcAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.ActivityType = storage.SplitActivity{
+			ParentCropUID: e.UID,
+			ParentBatchID: e.BatchID,
+			SplitCropUID:  e.SplitCropUID,
+			SplitBatchID:  e.SplitBatchID,
+			AreaUID:       srcArea.UID,
+			AreaName:      srcArea.Name,
+			Quantity:      e.Quantity,
+			SplitDate:     e.SplitDate,
+		}
+
+	case domain.CropBatchMerged:
+		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.ActivityType = storage.MergeActivity{
+			TargetCropUID: e.UID,
+			TargetBatchID: e.BatchID,
+			SourceCropUID: e.MergedCropUID,
+			SourceBatchID: e.MergedBatchID,
+			AreaUID:       area.UID,
+			AreaName:      area.Name,
+			Quantity:      e.Quantity,
+			MergeDate:     e.MergeDate,
+		}
+
+	case domain.CropBatchMergedInto:
+		queryResult := <-s.AreaReadQuery.FindByID(e.AreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.ActivityType = storage.MergeActivity{
+			TargetCropUID: e.TargetCropUID,
+			TargetBatchID: e.TargetBatchID,
+			SourceCropUID: e.UID,
+			SourceBatchID: e.BatchID,
+			AreaUID:       area.UID,
+			AreaName:      area.Name,
+			Quantity:      e.Quantity,
+			MergeDate:     e.MergeDate,
+		}
+
 	case domain.CropBatchWatered:
 		cropActivity.UID = e.UID
 		cropActivity.BatchID = e.BatchID
@@ -717,6 +1201,166 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 			WateringDate: e.WateringDate,
 		}
 
+	case domain.CropBatchHarvestCorrected:
+		queryResult := <-s.AreaReadQuery.FindByID(e.UpdatedHarvestedStorage.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Reason
+		cropActivity.ActivityType = storage.CorrectionActivity{
+			OriginalVersion:      e.OriginalVersion,
+			CorrectedActivity:    storage.HarvestActivityCode,
+			AreaUID:              srcArea.UID,
+			AreaName:             srcArea.Name,
+			Quantity:             e.HarvestedQuantity,
+			ProducedGramQuantity: e.ProducedGramQuantity,
+			HarvestLotCode:       e.HarvestLotCode,
+			Reason:               e.Reason,
+			CorrectionDate:       e.CorrectionDate,
+		}
+
+	case domain.CropBatchDumpCorrected:
+		queryResult := <-s.AreaReadQuery.FindByID(e.UpdatedTrash.SourceAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Reason
+		cropActivity.ActivityType = storage.CorrectionActivity{
+			OriginalVersion:   e.OriginalVersion,
+			CorrectedActivity: storage.DumpActivityCode,
+			AreaUID:           srcArea.UID,
+			AreaName:          srcArea.Name,
+			Quantity:          e.Quantity,
+			Reason:            e.Reason,
+			CorrectionDate:    e.CorrectionDate,
+		}
+
+	case domain.CropBatchMoveCorrected:
+		queryResult := <-s.AreaReadQuery.FindByID(e.SrcAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		srcArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		queryResult = <-s.AreaReadQuery.FindByID(e.DstAreaUID)
+		if queryResult.Error != nil {
+			log.Error(queryResult.Error)
+		}
+
+		dstArea, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+		}
+
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Reason
+		cropActivity.ActivityType = storage.CorrectionActivity{
+			OriginalVersion:   e.OriginalVersion,
+			CorrectedActivity: storage.MoveActivityCode,
+			AreaUID:           srcArea.UID,
+			AreaName:          srcArea.Name,
+			DstAreaUID:        dstArea.UID,
+			DstAreaName:       dstArea.Name,
+			Quantity:          e.Quantity,
+			Reason:            e.Reason,
+			CorrectionDate:    e.CorrectionDate,
+		}
+
+	case domain.CropBatchWateringCorrected:
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Reason
+		cropActivity.ActivityType = storage.CorrectionActivity{
+			OriginalVersion:   e.OriginalVersion,
+			CorrectedActivity: storage.WaterActivityCode,
+			AreaUID:           e.AreaUID,
+			AreaName:          e.AreaName,
+			Reason:            e.Reason,
+			CorrectionDate:    e.CorrectionDate,
+		}
+
+	case domain.CropBatchStageChanged:
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.ActivityType = storage.StageActivity{
+			PreviousStage: e.PreviousStage,
+			Stage:         e.Stage,
+			ChangedDate:   e.ChangedDate,
+		}
+
+	case domain.CropBatchFertilized:
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.ActivityType = storage.FertilizeActivity{
+			AreaUID:         e.AreaUID,
+			AreaName:        e.AreaName,
+			MaterialUID:     e.MaterialUID,
+			MaterialName:    e.MaterialName,
+			Dose:            e.Dose,
+			DoseUnit:        e.DoseUnit,
+			FertilizingDate: e.FertilizingDate,
+		}
+
+	case domain.CropBatchPruned:
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.Description = e.Notes
+		cropActivity.ActivityType = storage.PruneActivity{
+			AreaUID:     e.AreaUID,
+			AreaName:    e.AreaName,
+			PruningDate: e.PruningDate,
+		}
+
+	case domain.CropBatchPesticided:
+		cropActivity.UID = e.UID
+		cropActivity.BatchID = e.BatchID
+		cropActivity.ContainerType = e.ContainerType
+		cropActivity.CreatedDate = time.Now()
+		cropActivity.ActivityType = storage.PesticideActivity{
+			AreaUID:         e.AreaUID,
+			AreaName:        e.AreaName,
+			MaterialUID:     e.MaterialUID,
+			MaterialName:    e.MaterialName,
+			Dose:            e.Dose,
+			DoseUnit:        e.DoseUnit,
+			PesticidingDate: e.PesticidingDate,
+		}
+
 	case domain.CropBatchPhotoCreated:
 		queryResult := <-s.CropReadQuery.FindByID(e.CropUID)
 		if queryResult.Error != nil {
@@ -850,3 +1494,90 @@ func (s *GrowthServer) SaveToCropActivityReadModel(event interface{}) error {
 
 	return nil
 }
+
+// updateCropReadArea applies an area whose quantity was changed in the domain to the read model
+func updateCropReadArea(cropRead *storage.CropRead, areaCode string, area interface{}) {
+	if areaCode == "INITIAL_AREA" {
+		ia, ok := area.(domain.InitialArea)
+		if ok {
+			cropRead.InitialArea.CurrentQuantity = ia.CurrentQuantity
+			cropRead.InitialArea.LastUpdated = ia.LastUpdated
+		}
+	} else if areaCode == "MOVED_AREA" {
+		ma, ok := area.(domain.MovedArea)
+		if ok {
+			for i, v := range cropRead.MovedArea {
+				if v.AreaUID == ma.AreaUID {
+					cropRead.MovedArea[i].CurrentQuantity = ma.CurrentQuantity
+					cropRead.MovedArea[i].LastUpdated = ma.LastUpdated
+				}
+			}
+		}
+	}
+}
+
+// WaterCropsFromIrrigation waters the active crop batches of the area when an irrigation run is completed.
+// It publishes the watering of the crops, so it has to be subscribed asynchronously.
+func (s *GrowthServer) WaterCropsFromIrrigation(event interface{}) error {
+	// TODO:
+	// We cannot listen to this events without refer to the original struct.
+	// This is considered as domain boundary leak.
+	e, ok := event.(irrigationevents.IrrigationRunCompleted)
+	if !ok {
+		return nil
+	}
+
+	queryResult := <-s.CropReadQuery.FindAllCropsByArea(e.AreaUID)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return nil
+	}
+
+	crops, ok := queryResult.Result.([]query.CropAreaByAreaQueryResult)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+		return nil
+	}
+
+	isWatered := make(map[uuid.UUID]bool)
+	for _, v := range crops {
+		if v.Area.CurrentQuantity <= 0 || isWatered[v.UID] {
+			continue
+		}
+
+		eventQueryResult := <-s.CropEventQuery.FindAllByCropID(v.UID)
+		if eventQueryResult.Error != nil {
+			log.Error(eventQueryResult.Error)
+			continue
+		}
+
+		events, ok := eventQueryResult.Result.([]storage.CropEvent)
+		if !ok {
+			log.Error(errors.New("Internal server error. Error type assertion"))
+			continue
+		}
+
+		crop := repository.NewCropBatchFromHistory(events)
+		if crop.Status.Code != domain.CropActive {
+			continue
+		}
+
+		err := crop.Water(s.CropService, e.AreaUID, e.CompletedDate)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		err = <-s.CropEventRepo.Save(crop.UID, crop.Version, crop.UncommittedChanges)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		s.publishUncommittedEvents(crop)
+
+		isWatered[crop.UID] = true
+	}
+
+	return nil
+}
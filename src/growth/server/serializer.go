@@ -33,6 +33,14 @@ type InitialArea struct {
 	Name    string    `json:"name"`
 }
 
+// CropPlan shows how a crop batch is progressing compared to the plan of its seed variety
+type CropPlan struct {
+	Stage      domain.CropStage           `json:"stage"`
+	Plan       query.CropPlan             `json:"plan"`
+	Milestones []domain.CropPlanMilestone `json:"milestones"`
+	Deviations []domain.CropPlanDeviation `json:"deviations"`
+}
+
 type SortedCropNotes []domain.CropNote
 
 // Len is part of sort.Interface.
@@ -51,6 +59,13 @@ type HarvestActivity struct{ *storage.HarvestActivity }
 type DumpActivity struct{ *storage.DumpActivity }
 type PhotoActivity struct{ *storage.PhotoActivity }
 type WaterActivity struct{ *storage.WaterActivity }
+type SplitActivity struct{ *storage.SplitActivity }
+type MergeActivity struct{ *storage.MergeActivity }
+type CorrectionActivity struct{ *storage.CorrectionActivity }
+type StageActivity struct{ *storage.StageActivity }
+type FertilizeActivity struct{ *storage.FertilizeActivity }
+type PruneActivity struct{ *storage.PruneActivity }
+type PesticideActivity struct{ *storage.PesticideActivity }
 type TaskCropActivity struct{ *storage.TaskCropActivity }
 type TaskNutrientActivity struct{ *storage.TaskNutrientActivity }
 type TaskPestControlActivity struct {
@@ -61,6 +76,21 @@ type TaskSanitationActivity struct {
 	*storage.TaskSanitationActivity
 }
 
+// CropWeatherDay is a day of the crop timeline. Weather is nil when it hasn't been fetched for that day.
+type CropWeatherDay struct {
+	Date       time.Time                     `json:"date"`
+	Weather    *query.CropWeatherQueryResult `json:"weather"`
+	Activities []CropActivity                `json:"activities"`
+}
+
+// BulkCropResult is the outcome of a bulk operation on one crop
+type BulkCropResult struct {
+	CropUID uuid.UUID `json:"crop_id"`
+	BatchID string    `json:"batch_id"`
+	Success bool      `json:"success"`
+	Error   string    `json:"error,omitempty"`
+}
+
 func MapToCropActivity(activity storage.CropActivity) CropActivity {
 	ca := CropActivity(activity)
 
@@ -77,6 +107,20 @@ func MapToCropActivity(activity storage.CropActivity) CropActivity {
 		ca.ActivityType = PhotoActivity{&v}
 	case storage.WaterActivity:
 		ca.ActivityType = WaterActivity{&v}
+	case storage.SplitActivity:
+		ca.ActivityType = SplitActivity{&v}
+	case storage.MergeActivity:
+		ca.ActivityType = MergeActivity{&v}
+	case storage.CorrectionActivity:
+		ca.ActivityType = CorrectionActivity{&v}
+	case storage.StageActivity:
+		ca.ActivityType = StageActivity{&v}
+	case storage.FertilizeActivity:
+		ca.ActivityType = FertilizeActivity{&v}
+	case storage.PruneActivity:
+		ca.ActivityType = PruneActivity{&v}
+	case storage.PesticideActivity:
+		ca.ActivityType = PesticideActivity{&v}
 	case storage.TaskCropActivity:
 		ca.ActivityType = TaskCropActivity{&v}
 	case storage.TaskNutrientActivity:
@@ -92,6 +136,30 @@ func MapToCropActivity(activity storage.CropActivity) CropActivity {
 	return ca
 }
 
+// MapToCropWeatherDays lays the weather and the activities out on every day from the first to the last one
+func MapToCropWeatherDays(from, to time.Time, weather []query.CropWeatherQueryResult, activities []storage.CropActivity) []CropWeatherDay {
+	days := []CropWeatherDay{}
+	index := make(map[time.Time]int)
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		index[date] = len(days)
+		days = append(days, CropWeatherDay{Date: date, Activities: []CropActivity{}})
+	}
+
+	for i := range weather {
+		if j, ok := index[dayOf(weather[i].Date)]; ok {
+			days[j].Weather = &weather[i]
+		}
+	}
+
+	for _, v := range activities {
+		if j, ok := index[dayOf(v.CreatedDate)]; ok {
+			days[j].Activities = append(days[j].Activities, MapToCropActivity(v))
+		}
+	}
+
+	return days
+}
+
 func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error) {
 	queryResult := <-s.MaterialReadQuery.FindByID(crop.InventoryUID)
 	if queryResult.Error != nil {
@@ -197,6 +265,38 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 		})
 	}
 
+	harvestLots := []storage.HarvestLot{}
+	for _, v := range crop.HarvestLots {
+		queryResult = <-s.AreaReadQuery.FindByID(v.SourceAreaUID)
+		if queryResult.Error != nil {
+			return storage.CropRead{}, queryResult.Error
+		}
+
+		area, ok := queryResult.Result.(query.CropAreaQueryResult)
+		if !ok {
+			return storage.CropRead{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+		}
+
+		var correctedDate *time.Time
+		if !v.CorrectedDate.IsZero() {
+			correctedDate = &v.CorrectedDate
+		}
+
+		harvestLots = append(harvestLots, storage.HarvestLot{
+			UID:                  v.UID,
+			Code:                 v.Code,
+			SourceAreaUID:        area.UID,
+			SourceAreaName:       area.Name,
+			HarvestType:          v.HarvestType,
+			Quantity:             v.Quantity,
+			ProducedGramQuantity: v.ProducedGramQuantity,
+			Grade:                v.Grade,
+			Destination:          v.Destination,
+			HarvestDate:          v.HarvestDate,
+			CorrectedDate:        correctedDate,
+		})
+	}
+
 	trash := []storage.Trash{}
 	for _, v := range crop.Trash {
 		queryResult = <-s.AreaReadQuery.FindByID(v.SourceAreaUID)
@@ -225,6 +325,7 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 	cropRead.BatchID = crop.BatchID
 	cropRead.Status = crop.Status.Code
 	cropRead.Type = crop.Type.Code
+	cropRead.Stage = crop.Stage.Code
 
 	containerCell := 0
 	switch v := crop.Container.Type.(type) {
@@ -265,6 +366,11 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 
 	cropRead.FarmUID = crop.FarmUID
 
+	safeHarvestDate := crop.SafeHarvestDate()
+	if !safeHarvestDate.IsZero() {
+		cropRead.SafeHarvestDate = &safeHarvestDate
+	}
+
 	var lastWatered *time.Time
 	if !crop.InitialArea.LastWatered.IsZero() {
 		lastWatered = &crop.InitialArea.LastWatered
@@ -300,6 +406,7 @@ func MapToCropRead(s *GrowthServer, crop domain.Crop) (storage.CropRead, error)
 
 	cropRead.MovedArea = movedAreas
 	cropRead.HarvestedStorage = harvestedStorage
+	cropRead.HarvestLots = harvestLots
 	cropRead.Trash = trash
 
 	for _, v := range crop.Notes {
@@ -408,6 +515,83 @@ func (a WaterActivity) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func (a SplitActivity) MarshalJSON() ([]byte, error) {
+	type Alias SplitActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a MergeActivity) MarshalJSON() ([]byte, error) {
+	type Alias MergeActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a CorrectionActivity) MarshalJSON() ([]byte, error) {
+	type Alias CorrectionActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a StageActivity) MarshalJSON() ([]byte, error) {
+	type Alias StageActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a FertilizeActivity) MarshalJSON() ([]byte, error) {
+	type Alias FertilizeActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a PruneActivity) MarshalJSON() ([]byte, error) {
+	type Alias PruneActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
+func (a PesticideActivity) MarshalJSON() ([]byte, error) {
+	type Alias PesticideActivity
+	return json.Marshal(struct {
+		*Alias
+		Code string `json:"code"`
+	}{
+		Alias: (*Alias)(&a),
+		Code:  a.Code(),
+	})
+}
+
 func (a TaskCropActivity) MarshalJSON() ([]byte, error) {
 	type Alias TaskCropActivity
 	return json.Marshal(struct {
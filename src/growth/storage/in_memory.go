@@ -42,3 +42,18 @@ func CreateCropActivityStorage() *CropActivityStorage {
 
 	return &CropActivityStorage{CropActivityMap: []CropActivity{}, Lock: &rwMutex}
 }
+
+type CropBatchIDStorage struct {
+	Lock           *deadlock.RWMutex
+	CropBatchIDMap map[string]CropBatchID
+}
+
+func CreateCropBatchIDStorage() *CropBatchIDStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("CROP BATCH ID STORAGE DEADLOCK!")
+	}
+
+	return &CropBatchIDStorage{CropBatchIDMap: make(map[string]CropBatchID), Lock: &rwMutex}
+}
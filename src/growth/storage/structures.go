@@ -16,6 +16,12 @@ type CropEvent struct {
 	Event       interface{}
 }
 
+// CropBatchID is a batch ID reserved for a crop batch
+type CropBatchID struct {
+	BatchID     string
+	CreatedDate time.Time
+}
+
 func CreateCropEventStorage() *CropEventStorage {
 	rwMutex := deadlock.RWMutex{}
 	deadlock.Opts.DeadlockTimeout = time.Second * 10
@@ -31,20 +37,29 @@ type CropRead struct {
 	BatchID    string      `json:"batch_id"`
 	Status     string      `json:"status"`
 	Type       string      `json:"type"`
+	Stage      string      `json:"stage"`
 	Container  Container   `json:"container"`
 	Inventory  Inventory   `json:"inventory"`
 	AreaStatus AreaStatus  `json:"area_status"`
 	Photos     []CropPhoto `json:"photos"`
 	FarmUID    uuid.UUID   `json:"farm_id"`
 
+	// SafeHarvestDate is the date the crop is safe to harvest from
+	// after the last agrochemical application
+	SafeHarvestDate *time.Time `json:"safe_harvest_date"`
+
 	// Fields to track crop's movement
 	InitialArea      InitialArea        `json:"initial_area"`
 	MovedArea        []MovedArea        `json:"moved_area"`
 	HarvestedStorage []HarvestedStorage `json:"harvested_storage"`
+	HarvestLots      []HarvestLot       `json:"harvest_lots"`
 	Trash            []Trash            `json:"trash"`
 
 	// Notes
 	Notes []domain.CropNote `json:"notes"`
+
+	// Environment is computed when the crop is read, it is not stored
+	Environment *domain.CropEnvironment `json:"environment,omitempty"`
 }
 
 type InitialArea struct {
@@ -82,6 +97,20 @@ type HarvestedStorage struct {
 	LastUpdated          time.Time `json:"last_updated"`
 }
 
+type HarvestLot struct {
+	UID                  uuid.UUID  `json:"uid"`
+	Code                 string     `json:"code"`
+	SourceAreaUID        uuid.UUID  `json:"source_area_id"`
+	SourceAreaName       string     `json:"source_area_name"`
+	HarvestType          string     `json:"harvest_type"`
+	Quantity             int        `json:"quantity"`
+	ProducedGramQuantity float32    `json:"produced_gram_quantity"`
+	Grade                string     `json:"grade"`
+	Destination          string     `json:"destination"`
+	HarvestDate          time.Time  `json:"harvest_date"`
+	CorrectedDate        *time.Time `json:"corrected_date"`
+}
+
 type Trash struct {
 	Quantity       int       `json:"quantity"`
 	SourceAreaUID  uuid.UUID `json:"source_area_id"`
@@ -122,10 +151,17 @@ type CropPhoto struct {
 const (
 	SeedActivityCode            = "SEED"
 	MoveActivityCode            = "MOVE"
+	SplitActivityCode           = "SPLIT"
+	MergeActivityCode           = "MERGE"
 	HarvestActivityCode         = "HARVEST"
 	DumpActivityCode            = "DUMP"
+	CorrectionActivityCode      = "CORRECTION"
 	PhotoActivityCode           = "PHOTO"
 	WaterActivityCode           = "WATER"
+	FertilizeActivityCode       = "FERTILIZE"
+	StageActivityCode           = "STAGE"
+	PruneActivityCode           = "PRUNE"
+	PesticideActivityCode       = "PESTICIDE"
 	TaskCropActivityCode        = "TASK_CROP"
 	TaskNutrientActivityCode    = "TASK_NUTRIENT"
 	TaskPestControlActivityCode = "TASK_PEST_CONTROL"
@@ -157,6 +193,38 @@ func (a SeedActivity) Code() string {
 	return SeedActivityCode
 }
 
+// SplitActivity is recorded on both the batch which was split and the new batch
+type SplitActivity struct {
+	ParentCropUID uuid.UUID `json:"parent_crop_id"`
+	ParentBatchID string    `json:"parent_batch_id"`
+	SplitCropUID  uuid.UUID `json:"split_crop_id"`
+	SplitBatchID  string    `json:"split_batch_id"`
+	AreaUID       uuid.UUID `json:"area_id"`
+	AreaName      string    `json:"area_name"`
+	Quantity      int       `json:"quantity"`
+	SplitDate     time.Time `json:"split_date"`
+}
+
+func (a SplitActivity) Code() string {
+	return SplitActivityCode
+}
+
+// MergeActivity is recorded on both the batch which was merged into and the merged batch
+type MergeActivity struct {
+	TargetCropUID uuid.UUID `json:"target_crop_id"`
+	TargetBatchID string    `json:"target_batch_id"`
+	SourceCropUID uuid.UUID `json:"source_crop_id"`
+	SourceBatchID string    `json:"source_batch_id"`
+	AreaUID       uuid.UUID `json:"area_id"`
+	AreaName      string    `json:"area_name"`
+	Quantity      int       `json:"quantity"`
+	MergeDate     time.Time `json:"merge_date"`
+}
+
+func (a MergeActivity) Code() string {
+	return MergeActivityCode
+}
+
 type MoveActivity struct {
 	SrcAreaUID  uuid.UUID `json:"source_area_id"`
 	SrcAreaName string    `json:"source_area_name"`
@@ -176,6 +244,9 @@ type HarvestActivity struct {
 	SrcAreaName          string    `json:"source_area_name"`
 	Quantity             int       `json:"quantity"`
 	ProducedGramQuantity float32   `json:"produced_gram_quantity"`
+	LotCode              string    `json:"lot_code"`
+	Grade                string    `json:"grade"`
+	Destination          string    `json:"destination"`
 	HarvestDate          time.Time `json:"harvest_date"`
 }
 
@@ -194,6 +265,26 @@ func (a DumpActivity) Code() string {
 	return DumpActivityCode
 }
 
+// CorrectionActivity is recorded when a harvest, dump, move or watering activity was a mistake.
+// The corrected activity stays in the timeline.
+type CorrectionActivity struct {
+	OriginalVersion      int       `json:"original_version"`
+	CorrectedActivity    string    `json:"corrected_activity"`
+	AreaUID              uuid.UUID `json:"area_id"`
+	AreaName             string    `json:"area_name"`
+	DstAreaUID           uuid.UUID `json:"destination_area_id"`
+	DstAreaName          string    `json:"destination_area_name"`
+	Quantity             int       `json:"quantity"`
+	ProducedGramQuantity float32   `json:"produced_gram_quantity"`
+	HarvestLotCode       string    `json:"harvest_lot_code"`
+	Reason               string    `json:"reason"`
+	CorrectionDate       time.Time `json:"correction_date"`
+}
+
+func (a CorrectionActivity) Code() string {
+	return CorrectionActivityCode
+}
+
 type WaterActivity struct {
 	AreaUID      uuid.UUID `json:"area_id"`
 	AreaName     string    `json:"area_name"`
@@ -204,6 +295,54 @@ func (a WaterActivity) Code() string {
 	return WaterActivityCode
 }
 
+type StageActivity struct {
+	PreviousStage string    `json:"previous_stage"`
+	Stage         string    `json:"stage"`
+	ChangedDate   time.Time `json:"changed_date"`
+}
+
+func (a StageActivity) Code() string {
+	return StageActivityCode
+}
+
+type FertilizeActivity struct {
+	AreaUID         uuid.UUID `json:"area_id"`
+	AreaName        string    `json:"area_name"`
+	MaterialUID     uuid.UUID `json:"material_id"`
+	MaterialName    string    `json:"material_name"`
+	Dose            float32   `json:"dose"`
+	DoseUnit        string    `json:"dose_unit"`
+	FertilizingDate time.Time `json:"fertilizing_date"`
+}
+
+func (a FertilizeActivity) Code() string {
+	return FertilizeActivityCode
+}
+
+type PruneActivity struct {
+	AreaUID     uuid.UUID `json:"area_id"`
+	AreaName    string    `json:"area_name"`
+	PruningDate time.Time `json:"pruning_date"`
+}
+
+func (a PruneActivity) Code() string {
+	return PruneActivityCode
+}
+
+type PesticideActivity struct {
+	AreaUID         uuid.UUID `json:"area_id"`
+	AreaName        string    `json:"area_name"`
+	MaterialUID     uuid.UUID `json:"material_id"`
+	MaterialName    string    `json:"material_name"`
+	Dose            float32   `json:"dose"`
+	DoseUnit        string    `json:"dose_unit"`
+	PesticidingDate time.Time `json:"pesticiding_date"`
+}
+
+func (a PesticideActivity) Code() string {
+	return PesticideActivityCode
+}
+
 type PhotoActivity struct {
 	UID         uuid.UUID `json:"uid"`
 	Filename    string    `json:"filename"`
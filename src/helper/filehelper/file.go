@@ -1,4 +1,4 @@
-package server
+package filehelper
 
 import (
 	"io"
@@ -14,6 +14,7 @@ import (
 type File interface {
 	GetFile(src string) ([]byte, error)
 	Upload(file *multipart.FileHeader, destPath string) error
+	Remove(srcPath string) error
 }
 
 type LocalFile struct {
@@ -58,3 +59,13 @@ func (f LocalFile) Upload(file *multipart.FileHeader, destPath string) error {
 
 	return nil
 }
+
+// Remove deletes the file. A file which is already gone is not an error.
+func (f LocalFile) Remove(srcPath string) error {
+	err := os.Remove(srcPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
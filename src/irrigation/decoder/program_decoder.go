@@ -0,0 +1,105 @@
+package decoder
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	"github.com/mitchellh/mapstructure"
+)
+
+type IrrigationProgramEventWrapper EventWrapper
+
+func (w *IrrigationProgramEventWrapper) UnmarshalJSON(b []byte) error {
+	wrapper := EventWrapper{}
+
+	err := json.Unmarshal(b, &wrapper)
+	if err != nil {
+		return err
+	}
+
+	mapped, ok := wrapper.EventData.(map[string]interface{})
+	if !ok {
+		return errors.New("Error type assertion")
+	}
+
+	f := mapstructure.ComposeDecodeHookFunc(
+		UIDHook(),
+		TimeHook(time.RFC3339),
+	)
+
+	switch wrapper.EventName {
+	case "IrrigationProgramCreated":
+		e := domain.IrrigationProgramCreated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "IrrigationProgramChanged":
+		e := domain.IrrigationProgramChanged{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "IrrigationProgramActivated":
+		e := domain.IrrigationProgramActivated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "IrrigationProgramDeactivated":
+		e := domain.IrrigationProgramDeactivated{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "IrrigationRunStarted":
+		e := domain.IrrigationRunStarted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "IrrigationRunCompleted":
+		e := domain.IrrigationRunCompleted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+
+	case "IrrigationRunFailed":
+		e := domain.IrrigationRunFailed{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.EventData = e
+	}
+
+	return nil
+}
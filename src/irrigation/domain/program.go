@@ -0,0 +1,363 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/validationhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+// IrrigationProgram waters an area on a weekly schedule by opening its valve through an actuator.
+type IrrigationProgram struct {
+	UID         uuid.UUID
+	Name        string
+	AreaUID     uuid.UUID
+	FarmUID     uuid.UUID
+	Schedule    ProgramSchedule
+	IsActive    bool
+	CurrentRun  *ProgramRun
+	LastRunDate time.Time
+	CreatedDate time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+// IrrigationService handles irrigation program behaviours that needs external interaction to be worked
+type IrrigationService interface {
+	FindAreaByID(uid uuid.UUID) ServiceResult
+}
+
+// ServiceResult is the container for service result
+type ServiceResult struct {
+	Result interface{}
+	Error  error
+}
+
+// AreaServiceResult is the area watered by the program
+type AreaServiceResult struct {
+	UID     uuid.UUID
+	Name    string
+	FarmUID uuid.UUID
+}
+
+// ProgramSchedule tells when the program runs. The start times are in the HH:MM format
+// and the duration is in minutes.
+type ProgramSchedule struct {
+	StartTimes []string `json:"start_times"`
+	Duration   int      `json:"duration"`
+	Days       []string `json:"days"`
+}
+
+// ProgramRun is a watering of the area which is in progress
+type ProgramRun struct {
+	UID         uuid.UUID `json:"uid"`
+	Duration    int       `json:"duration"`
+	StartedDate time.Time `json:"started_date"`
+}
+
+// Statuses of a run of the program
+const (
+	IrrigationRunStatusRunning   = "RUNNING"
+	IrrigationRunStatusCompleted = "COMPLETED"
+	IrrigationRunStatusFailed    = "FAILED"
+)
+
+// MaxRunDuration is the longest a run can last, in minutes
+const MaxRunDuration = 24 * 60
+
+// Days of the week a program can run, they follow the time.Weekday names
+const (
+	ProgramDaySunday    = "SUNDAY"
+	ProgramDayMonday    = "MONDAY"
+	ProgramDayTuesday   = "TUESDAY"
+	ProgramDayWednesday = "WEDNESDAY"
+	ProgramDayThursday  = "THURSDAY"
+	ProgramDayFriday    = "FRIDAY"
+	ProgramDaySaturday  = "SATURDAY"
+)
+
+func ProgramDays() []string {
+	return []string{
+		ProgramDaySunday,
+		ProgramDayMonday,
+		ProgramDayTuesday,
+		ProgramDayWednesday,
+		ProgramDayThursday,
+		ProgramDayFriday,
+		ProgramDaySaturday,
+	}
+}
+
+func (state *IrrigationProgram) TrackChange(event interface{}) {
+	state.UncommittedChanges = append(state.UncommittedChanges, event)
+	state.Transition(event)
+}
+
+func (state *IrrigationProgram) Transition(event interface{}) {
+	switch e := event.(type) {
+	case IrrigationProgramCreated:
+		state.UID = e.UID
+		state.Name = e.Name
+		state.AreaUID = e.AreaUID
+		state.FarmUID = e.FarmUID
+		state.Schedule = e.Schedule
+		state.IsActive = true
+		state.CreatedDate = e.CreatedDate
+
+	case IrrigationProgramChanged:
+		state.Name = e.Name
+		state.Schedule = e.Schedule
+
+	case IrrigationProgramActivated:
+		state.IsActive = true
+
+	case IrrigationProgramDeactivated:
+		state.IsActive = false
+
+	case IrrigationRunStarted:
+		state.CurrentRun = &ProgramRun{
+			UID:         e.UID,
+			Duration:    e.Duration,
+			StartedDate: e.StartedDate,
+		}
+		state.LastRunDate = e.StartedDate
+
+	case IrrigationRunCompleted:
+		state.CurrentRun = nil
+
+	case IrrigationRunFailed:
+		state.CurrentRun = nil
+
+	}
+}
+
+func CreateIrrigationProgram(irrigationService IrrigationService, name string, areaUID uuid.UUID, schedule ProgramSchedule) (*IrrigationProgram, error) {
+	err := validateProgramName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err = normalizeSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceResult := irrigationService.FindAreaByID(areaUID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	area, ok := serviceResult.Result.(AreaServiceResult)
+	if !ok || area.UID == (uuid.UUID{}) {
+		return nil, IrrigationProgramError{IrrigationProgramErrorAreaNotFoundCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	program := &IrrigationProgram{}
+
+	program.TrackChange(IrrigationProgramCreated{
+		UID:         uid,
+		Name:        name,
+		AreaUID:     area.UID,
+		FarmUID:     area.FarmUID,
+		Schedule:    schedule,
+		CreatedDate: time.Now(),
+	})
+
+	return program, nil
+}
+
+// Change replaces the name and the schedule of the program
+func (p *IrrigationProgram) Change(name string, schedule ProgramSchedule) error {
+	err := validateProgramName(name)
+	if err != nil {
+		return err
+	}
+
+	schedule, err = normalizeSchedule(schedule)
+	if err != nil {
+		return err
+	}
+
+	p.TrackChange(IrrigationProgramChanged{
+		ProgramUID: p.UID,
+		Name:       name,
+		Schedule:   schedule,
+	})
+
+	return nil
+}
+
+func (p *IrrigationProgram) Activate() error {
+	if p.IsActive {
+		return IrrigationProgramError{IrrigationProgramErrorAlreadyActiveCode}
+	}
+
+	p.TrackChange(IrrigationProgramActivated{ProgramUID: p.UID})
+
+	return nil
+}
+
+func (p *IrrigationProgram) Deactivate() error {
+	if !p.IsActive {
+		return IrrigationProgramError{IrrigationProgramErrorAlreadyInactiveCode}
+	}
+
+	p.TrackChange(IrrigationProgramDeactivated{ProgramUID: p.UID})
+
+	return nil
+}
+
+// IsDueAt tells whether one of the start times of the program falls in the minute of the date.
+// The weekday and the time of the day are taken in the location of the date.
+func (p *IrrigationProgram) IsDueAt(date time.Time) bool {
+	if !p.IsActive || p.CurrentRun != nil {
+		return false
+	}
+
+	if !containsString(p.Schedule.Days, strings.ToUpper(date.Weekday().String())) {
+		return false
+	}
+
+	if !containsString(p.Schedule.StartTimes, date.Format("15:04")) {
+		return false
+	}
+
+	// The scheduler looks at the programs more than once a minute,
+	// so a start time must not trigger a second run in the same minute.
+	return !p.LastRunDate.Truncate(time.Minute).Equal(date.Truncate(time.Minute))
+}
+
+// StartRun opens the watering of the area for the duration of the schedule.
+// A program can be run by hand even when it is inactive, but only one run can be in progress.
+func (p *IrrigationProgram) StartRun(startedDate time.Time) error {
+	if p.CurrentRun != nil {
+		return IrrigationProgramError{IrrigationProgramErrorRunInProgressCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	p.TrackChange(IrrigationRunStarted{
+		ProgramUID:  p.UID,
+		UID:         uid,
+		AreaUID:     p.AreaUID,
+		Duration:    p.Schedule.Duration,
+		StartedDate: startedDate,
+	})
+
+	return nil
+}
+
+func (p *IrrigationProgram) CompleteRun(completedDate time.Time) error {
+	if p.CurrentRun == nil {
+		return IrrigationProgramError{IrrigationProgramErrorNoRunInProgressCode}
+	}
+
+	p.TrackChange(IrrigationRunCompleted{
+		ProgramUID:    p.UID,
+		UID:           p.CurrentRun.UID,
+		AreaUID:       p.AreaUID,
+		StartedDate:   p.CurrentRun.StartedDate,
+		CompletedDate: completedDate,
+	})
+
+	return nil
+}
+
+// FailRun stops the run in progress when the actuator couldn't water the area.
+// The crops of the area aren't considered watered.
+func (p *IrrigationProgram) FailRun(reason string, failedDate time.Time) error {
+	if p.CurrentRun == nil {
+		return IrrigationProgramError{IrrigationProgramErrorNoRunInProgressCode}
+	}
+
+	p.TrackChange(IrrigationRunFailed{
+		ProgramUID:  p.UID,
+		UID:         p.CurrentRun.UID,
+		AreaUID:     p.AreaUID,
+		Reason:      reason,
+		StartedDate: p.CurrentRun.StartedDate,
+		FailedDate:  failedDate,
+	})
+
+	return nil
+}
+
+// normalizeSchedule validates the schedule and formats its start times as HH:MM
+func normalizeSchedule(schedule ProgramSchedule) (ProgramSchedule, error) {
+	if len(schedule.StartTimes) == 0 {
+		return schedule, IrrigationProgramError{IrrigationProgramErrorStartTimesEmptyCode}
+	}
+
+	startTimes := []string{}
+	for _, v := range schedule.StartTimes {
+		t, err := time.Parse("15:04", strings.TrimSpace(v))
+		if err != nil {
+			return schedule, IrrigationProgramError{IrrigationProgramErrorInvalidStartTimeCode}
+		}
+
+		if !containsString(startTimes, t.Format("15:04")) {
+			startTimes = append(startTimes, t.Format("15:04"))
+		}
+	}
+
+	if schedule.Duration <= 0 || schedule.Duration > MaxRunDuration {
+		return schedule, IrrigationProgramError{IrrigationProgramErrorInvalidDurationCode}
+	}
+
+	if len(schedule.Days) == 0 {
+		return schedule, IrrigationProgramError{IrrigationProgramErrorDaysEmptyCode}
+	}
+
+	days := []string{}
+	for _, v := range schedule.Days {
+		day := strings.ToUpper(strings.TrimSpace(v))
+		if !containsString(ProgramDays(), day) {
+			return schedule, IrrigationProgramError{IrrigationProgramErrorInvalidDayCode}
+		}
+
+		if !containsString(days, day) {
+			days = append(days, day)
+		}
+	}
+
+	return ProgramSchedule{
+		StartTimes: startTimes,
+		Duration:   schedule.Duration,
+		Days:       days,
+	}, nil
+}
+
+func validateProgramName(name string) error {
+	if name == "" {
+		return IrrigationProgramError{IrrigationProgramErrorNameEmptyCode}
+	}
+	if !validationhelper.IsAlphanumSpaceHyphenUnderscore(name) {
+		return IrrigationProgramError{IrrigationProgramErrorNameAlphanumericOnlyCode}
+	}
+	if len(name) > 100 {
+		return IrrigationProgramError{IrrigationProgramErrorNameExceedMaximumCharacterCode}
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
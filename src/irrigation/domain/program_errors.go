@@ -0,0 +1,57 @@
+package domain
+
+const (
+	IrrigationProgramErrorNameEmptyCode = iota
+	IrrigationProgramErrorNameAlphanumericOnlyCode
+	IrrigationProgramErrorNameExceedMaximumCharacterCode
+	IrrigationProgramErrorAreaNotFoundCode
+	IrrigationProgramErrorStartTimesEmptyCode
+	IrrigationProgramErrorInvalidStartTimeCode
+	IrrigationProgramErrorInvalidDurationCode
+	IrrigationProgramErrorDaysEmptyCode
+	IrrigationProgramErrorInvalidDayCode
+	IrrigationProgramErrorAlreadyActiveCode
+	IrrigationProgramErrorAlreadyInactiveCode
+
+	// Run errors
+	IrrigationProgramErrorRunInProgressCode
+	IrrigationProgramErrorNoRunInProgressCode
+)
+
+// IrrigationProgramError is a custom error from Go built-in error
+type IrrigationProgramError struct {
+	Code int
+}
+
+func (e IrrigationProgramError) Error() string {
+	switch e.Code {
+	case IrrigationProgramErrorNameEmptyCode:
+		return "Irrigation program name is required"
+	case IrrigationProgramErrorNameAlphanumericOnlyCode:
+		return "Irrigation program name should be alphanumeric, space, hypen, or underscore"
+	case IrrigationProgramErrorNameExceedMaximumCharacterCode:
+		return "Irrigation program name cannot more than 100 characters"
+	case IrrigationProgramErrorAreaNotFoundCode:
+		return "Area of the irrigation program not found"
+	case IrrigationProgramErrorStartTimesEmptyCode:
+		return "Start times are required"
+	case IrrigationProgramErrorInvalidStartTimeCode:
+		return "Start time should be in the HH:MM format"
+	case IrrigationProgramErrorInvalidDurationCode:
+		return "Duration should be between 1 and 1440 minutes"
+	case IrrigationProgramErrorDaysEmptyCode:
+		return "Days are required"
+	case IrrigationProgramErrorInvalidDayCode:
+		return "Invalid day of the week"
+	case IrrigationProgramErrorAlreadyActiveCode:
+		return "Irrigation program is already active"
+	case IrrigationProgramErrorAlreadyInactiveCode:
+		return "Irrigation program is already inactive"
+	case IrrigationProgramErrorRunInProgressCode:
+		return "Irrigation program is already running"
+	case IrrigationProgramErrorNoRunInProgressCode:
+		return "Irrigation program is not running"
+	default:
+		return "Unrecognized Irrigation Program Error Code"
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramCreated struct {
+	UID         uuid.UUID
+	Name        string
+	AreaUID     uuid.UUID
+	FarmUID     uuid.UUID
+	Schedule    ProgramSchedule
+	CreatedDate time.Time
+}
+
+type IrrigationProgramChanged struct {
+	ProgramUID uuid.UUID
+	Name       string
+	Schedule   ProgramSchedule
+}
+
+type IrrigationProgramActivated struct {
+	ProgramUID uuid.UUID
+}
+
+type IrrigationProgramDeactivated struct {
+	ProgramUID uuid.UUID
+}
+
+type IrrigationRunStarted struct {
+	ProgramUID  uuid.UUID
+	UID         uuid.UUID
+	AreaUID     uuid.UUID
+	Duration    int
+	StartedDate time.Time
+}
+
+// IrrigationRunCompleted is published when the valve of the area is closed at the end of the run,
+// the crop batches of the area are watered with it.
+type IrrigationRunCompleted struct {
+	ProgramUID    uuid.UUID
+	UID           uuid.UUID
+	AreaUID       uuid.UUID
+	StartedDate   time.Time
+	CompletedDate time.Time
+}
+
+type IrrigationRunFailed struct {
+	ProgramUID  uuid.UUID
+	UID         uuid.UUID
+	AreaUID     uuid.UUID
+	Reason      string
+	StartedDate time.Time
+	FailedDate  time.Time
+}
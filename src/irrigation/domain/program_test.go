@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type IrrigationServiceMock struct {
+	mock.Mock
+}
+
+func (m *IrrigationServiceMock) FindAreaByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
+
+func TestCreateIrrigationProgram(t *testing.T) {
+	// Given
+	irrigationServiceMock := new(IrrigationServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	farmUID, _ := uuid.NewV4()
+	irrigationServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: AreaServiceResult{UID: areaUID, Name: "Area 1", FarmUID: farmUID},
+	})
+
+	schedule := ProgramSchedule{
+		StartTimes: []string{"6:00", "18:30", "06:00"},
+		Duration:   15,
+		Days:       []string{"monday", ProgramDayThursday},
+	}
+
+	// When
+	program, err := CreateIrrigationProgram(irrigationServiceMock, "Morning and Evening", areaUID, schedule)
+	_, errTime := CreateIrrigationProgram(irrigationServiceMock, "Morning", areaUID, ProgramSchedule{
+		StartTimes: []string{"25:00"}, Duration: 15, Days: []string{ProgramDayMonday},
+	})
+	_, errDuration := CreateIrrigationProgram(irrigationServiceMock, "Morning", areaUID, ProgramSchedule{
+		StartTimes: []string{"06:00"}, Duration: 0, Days: []string{ProgramDayMonday},
+	})
+	_, errDay := CreateIrrigationProgram(irrigationServiceMock, "Morning", areaUID, ProgramSchedule{
+		StartTimes: []string{"06:00"}, Duration: 15, Days: []string{"SOMEDAY"},
+	})
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, farmUID, program.FarmUID)
+	assert.True(t, program.IsActive)
+	assert.Equal(t, []string{"06:00", "18:30"}, program.Schedule.StartTimes)
+	assert.Equal(t, []string{ProgramDayMonday, ProgramDayThursday}, program.Schedule.Days)
+	assert.Equal(t, IrrigationProgramError{IrrigationProgramErrorInvalidStartTimeCode}, errTime)
+	assert.Equal(t, IrrigationProgramError{IrrigationProgramErrorInvalidDurationCode}, errDuration)
+	assert.Equal(t, IrrigationProgramError{IrrigationProgramErrorInvalidDayCode}, errDay)
+}
+
+func TestIrrigationProgramRun(t *testing.T) {
+	// Given
+	irrigationServiceMock := new(IrrigationServiceMock)
+
+	areaUID, _ := uuid.NewV4()
+	irrigationServiceMock.On("FindAreaByID", areaUID).Return(ServiceResult{
+		Result: AreaServiceResult{UID: areaUID, Name: "Area 1"},
+	})
+
+	program, _ := CreateIrrigationProgram(irrigationServiceMock, "Morning", areaUID, ProgramSchedule{
+		StartTimes: []string{"06:00"},
+		Duration:   10,
+		Days:       []string{ProgramDayMonday},
+	})
+
+	// 2 January 2017 is a monday
+	monday := time.Date(2017, time.January, 2, 6, 0, 20, 0, time.UTC)
+
+	// Then
+	assert.True(t, program.IsDueAt(monday))
+	assert.False(t, program.IsDueAt(monday.Add(time.Minute)))
+	assert.False(t, program.IsDueAt(monday.AddDate(0, 0, 1)))
+
+	// When
+	err := program.StartRun(monday)
+	errRunning := program.StartRun(monday)
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, IrrigationProgramError{IrrigationProgramErrorRunInProgressCode}, errRunning)
+	assert.Equal(t, 10, program.CurrentRun.Duration)
+
+	// When
+	runUID := program.CurrentRun.UID
+	err = program.CompleteRun(monday.Add(10 * time.Minute))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Nil(t, program.CurrentRun)
+	assert.Equal(t, runUID, program.UncommittedChanges[len(program.UncommittedChanges)-1].(IrrigationRunCompleted).UID)
+	assert.False(t, program.IsDueAt(monday.Add(30*time.Second)))
+	assert.True(t, program.IsDueAt(monday.AddDate(0, 0, 7)))
+
+	// When
+	err = program.Deactivate()
+
+	// Then
+	assert.Nil(t, err)
+	assert.False(t, program.IsDueAt(monday.AddDate(0, 0, 7)))
+	assert.Equal(t, IrrigationProgramError{IrrigationProgramErrorNoRunInProgressCode}, program.FailRun("Valve is stuck", monday))
+}
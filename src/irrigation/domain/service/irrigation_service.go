@@ -0,0 +1,27 @@
+package service
+
+import (
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationServiceImpl struct {
+	AreaQuery query.AreaQuery
+}
+
+func (s IrrigationServiceImpl) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.AreaQuery.FindByID(uid)
+	if result.Error != nil {
+		return domain.ServiceResult{Error: result.Error}
+	}
+
+	area, ok := result.Result.(query.AreaQueryResult)
+	if !ok {
+		return domain.ServiceResult{Error: domain.IrrigationProgramError{Code: domain.IrrigationProgramErrorAreaNotFoundCode}}
+	}
+
+	return domain.ServiceResult{
+		Result: domain.AreaServiceResult(area),
+	}
+}
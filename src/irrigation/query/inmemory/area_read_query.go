@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type AreaQueryInMemory struct {
+	Storage *storage.AreaReadStorage
+}
+
+func NewAreaQueryInMemory(s *storage.AreaReadStorage) query.AreaQuery {
+	return AreaQueryInMemory{Storage: s}
+}
+
+func (s AreaQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		area := query.AreaQueryResult{}
+		if val, ok := s.Storage.AreaReadMap[uid]; ok {
+			area.UID = val.UID
+			area.Name = val.Name
+			area.FarmUID = val.Farm.UID
+		}
+
+		result <- query.QueryResult{Result: area}
+
+		close(result)
+	}()
+
+	return result
+}
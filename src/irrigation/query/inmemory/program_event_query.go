@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEventQueryInMemory struct {
+	Storage *storage.IrrigationProgramEventStorage
+}
+
+func NewIrrigationProgramEventQueryInMemory(s *storage.IrrigationProgramEventStorage) query.IrrigationProgramEventQuery {
+	return &IrrigationProgramEventQueryInMemory{Storage: s}
+}
+
+func (f *IrrigationProgramEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.IrrigationProgramEvent{}
+		for _, v := range f.Storage.IrrigationProgramEvents {
+			if v.ProgramUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
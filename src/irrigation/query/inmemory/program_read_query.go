@@ -0,0 +1,60 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramReadQueryInMemory struct {
+	Storage *storage.IrrigationProgramReadStorage
+}
+
+func NewIrrigationProgramReadQueryInMemory(s *storage.IrrigationProgramReadStorage) query.IrrigationProgramReadQuery {
+	return &IrrigationProgramReadQueryInMemory{Storage: s}
+}
+
+func (f *IrrigationProgramReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.IrrigationProgramReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *IrrigationProgramReadQueryInMemory) FindAll(areaUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		programs := []storage.IrrigationProgramRead{}
+		for _, v := range f.Storage.IrrigationProgramReadMap {
+			if areaUID != (uuid.UUID{}) && v.Area.UID != areaUID {
+				continue
+			}
+
+			programs = append(programs, v)
+		}
+
+		sort.Slice(programs, func(i, j int) bool {
+			return programs[i].CreatedDate.Before(programs[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: programs}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,59 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationRunReadQueryInMemory struct {
+	Storage *storage.IrrigationRunReadStorage
+}
+
+func NewIrrigationRunReadQueryInMemory(s *storage.IrrigationRunReadStorage) query.IrrigationRunReadQuery {
+	return &IrrigationRunReadQueryInMemory{Storage: s}
+}
+
+func (f *IrrigationRunReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.IrrigationRunReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllByProgramID returns the runs of the program, the most recent first
+func (f *IrrigationRunReadQueryInMemory) FindAllByProgramID(programUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		runs := []storage.IrrigationRunRead{}
+		for _, v := range f.Storage.IrrigationRunReadMap {
+			if v.ProgramUID == programUID {
+				runs = append(runs, v)
+			}
+		}
+
+		sort.Slice(runs, func(i, j int) bool {
+			return runs[i].StartedDate.After(runs[j].StartedDate)
+		})
+
+		result <- query.QueryResult{Result: runs}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/decoder"
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewIrrigationProgramEventQueryMysql(db *sql.DB) query.IrrigationProgramEventQuery {
+	return &IrrigationProgramEventQueryMysql{DB: db}
+}
+
+func (f *IrrigationProgramEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.IrrigationProgramEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM IRRIGATION_PROGRAM_EVENT WHERE PROGRAM_UID = ? ORDER BY VERSION ASC", uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			ProgramUID  []byte
+			Version     int
+			CreatedDate time.Time
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.ProgramUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.IrrigationProgramEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			programUID, err := uuid.FromBytes(rowsData.ProgramUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate := rowsData.CreatedDate
+
+			events = append(events, storage.IrrigationProgramEvent{
+				ProgramUID:  programUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,137 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationRunReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewIrrigationRunReadQueryMysql(db *sql.DB) query.IrrigationRunReadQuery {
+	return &IrrigationRunReadQueryMysql{DB: db}
+}
+
+type runReadResult struct {
+	UID         []byte
+	ProgramUID  []byte
+	AreaUID     []byte
+	Duration    int
+	Status      string
+	Reason      string
+	StartedDate time.Time
+	EndedDate   *time.Time
+}
+
+func (f *IrrigationRunReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM IRRIGATION_RUN_READ WHERE UID = ?`, uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		runs, err := scanRunReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		run := storage.IrrigationRunRead{}
+		if len(runs) > 0 {
+			run = runs[0]
+		}
+
+		result <- query.QueryResult{Result: run}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllByProgramID returns the runs of the program, the most recent first
+func (f *IrrigationRunReadQueryMysql) FindAllByProgramID(programUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM IRRIGATION_RUN_READ WHERE PROGRAM_UID = ?
+			ORDER BY STARTED_DATE DESC`, programUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		runs, err := scanRunReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: runs}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanRunReads(rows *sql.Rows) ([]storage.IrrigationRunRead, error) {
+	defer rows.Close()
+
+	runs := []storage.IrrigationRunRead{}
+	for rows.Next() {
+		rowsData := runReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.ProgramUID,
+			&rowsData.AreaUID,
+			&rowsData.Duration,
+			&rowsData.Status,
+			&rowsData.Reason,
+			&rowsData.StartedDate,
+			&rowsData.EndedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		programUID, err := uuid.FromBytes(rowsData.ProgramUID)
+		if err != nil {
+			return nil, err
+		}
+
+		areaUID, err := uuid.FromBytes(rowsData.AreaUID)
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, storage.IrrigationRunRead{
+			UID:         uid,
+			ProgramUID:  programUID,
+			AreaUID:     areaUID,
+			Duration:    rowsData.Duration,
+			Status:      rowsData.Status,
+			Reason:      rowsData.Reason,
+			StartedDate: rowsData.StartedDate,
+			EndedDate:   rowsData.EndedDate,
+		})
+	}
+
+	return runs, rows.Err()
+}
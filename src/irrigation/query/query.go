@@ -0,0 +1,36 @@
+package query
+
+import (
+	uuid "github.com/satori/go.uuid"
+)
+
+type QueryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type IrrigationProgramEventQuery interface {
+	FindAllByID(programUID uuid.UUID) <-chan QueryResult
+}
+
+type IrrigationProgramReadQuery interface {
+	FindByID(programUID uuid.UUID) <-chan QueryResult
+	FindAll(areaUID uuid.UUID) <-chan QueryResult
+}
+
+type IrrigationRunReadQuery interface {
+	FindByID(runUID uuid.UUID) <-chan QueryResult
+	FindAllByProgramID(programUID uuid.UUID) <-chan QueryResult
+}
+
+type AreaQuery interface {
+	FindByID(areaUID uuid.UUID) <-chan QueryResult
+}
+
+// QUERY RESULTS
+
+type AreaQueryResult struct {
+	UID     uuid.UUID `json:"uid"`
+	Name    string    `json:"name"`
+	FarmUID uuid.UUID `json:"farm_id"`
+}
@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/decoder"
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEventQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewIrrigationProgramEventQuerySqlite(db *sql.DB) query.IrrigationProgramEventQuery {
+	return &IrrigationProgramEventQuerySqlite{DB: db}
+}
+
+func (f *IrrigationProgramEventQuerySqlite) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.IrrigationProgramEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM IRRIGATION_PROGRAM_EVENT WHERE PROGRAM_UID = ? ORDER BY VERSION ASC", uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			ProgramUID  string
+			Version     int
+			CreatedDate string
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.ProgramUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.IrrigationProgramEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			programUID, err := uuid.FromString(rowsData.ProgramUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			events = append(events, storage.IrrigationProgramEvent{
+				ProgramUID:  programUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,185 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewIrrigationProgramReadQuerySqlite(db *sql.DB) query.IrrigationProgramReadQuery {
+	return &IrrigationProgramReadQuerySqlite{DB: db}
+}
+
+type programReadResult struct {
+	UID         string
+	Name        string
+	AreaUID     string
+	AreaName    string
+	FarmUID     string
+	StartTimes  string
+	Duration    int
+	Days        string
+	IsActive    bool
+	IsRunning   bool
+	LastRunDate sql.NullString
+	CreatedDate string
+}
+
+func (f *IrrigationProgramReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM IRRIGATION_PROGRAM_READ WHERE UID = ?`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		programs, err := scanProgramReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		program := storage.IrrigationProgramRead{}
+		if len(programs) > 0 {
+			program = programs[0]
+		}
+
+		result <- query.QueryResult{Result: program}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *IrrigationProgramReadQuerySqlite) FindAll(areaUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		sql := "SELECT * FROM IRRIGATION_PROGRAM_READ WHERE 1 = 1"
+		params := []interface{}{}
+
+		if areaUID != (uuid.UUID{}) {
+			sql += " AND AREA_UID = ?"
+			params = append(params, areaUID)
+		}
+
+		sql += " ORDER BY CREATED_DATE ASC"
+
+		rows, err := f.DB.Query(sql, params...)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		programs, err := scanProgramReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: programs}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanProgramReads(rows *sql.Rows) ([]storage.IrrigationProgramRead, error) {
+	defer rows.Close()
+
+	programs := []storage.IrrigationProgramRead{}
+	for rows.Next() {
+		rowsData := programReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.Name,
+			&rowsData.AreaUID,
+			&rowsData.AreaName,
+			&rowsData.FarmUID,
+			&rowsData.StartTimes,
+			&rowsData.Duration,
+			&rowsData.Days,
+			&rowsData.IsActive,
+			&rowsData.IsRunning,
+			&rowsData.LastRunDate,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		areaUID, err := uuid.FromString(rowsData.AreaUID)
+		if err != nil {
+			return nil, err
+		}
+
+		farmUID, err := uuid.FromString(rowsData.FarmUID)
+		if err != nil {
+			return nil, err
+		}
+
+		lastRunDate, err := parseNullDate(rowsData.LastRunDate)
+		if err != nil {
+			return nil, err
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		programs = append(programs, storage.IrrigationProgramRead{
+			UID:  uid,
+			Name: rowsData.Name,
+			Area: storage.ProgramArea{
+				UID:  areaUID,
+				Name: rowsData.AreaName,
+			},
+			FarmUID: farmUID,
+			Schedule: storage.ProgramSchedule{
+				StartTimes: strings.Split(rowsData.StartTimes, ","),
+				Duration:   rowsData.Duration,
+				Days:       strings.Split(rowsData.Days, ","),
+			},
+			IsActive:    rowsData.IsActive,
+			IsRunning:   rowsData.IsRunning,
+			LastRunDate: lastRunDate,
+			CreatedDate: createdDate,
+		})
+	}
+
+	return programs, rows.Err()
+}
+
+func parseNullDate(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
@@ -0,0 +1,147 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationRunReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewIrrigationRunReadQuerySqlite(db *sql.DB) query.IrrigationRunReadQuery {
+	return &IrrigationRunReadQuerySqlite{DB: db}
+}
+
+type runReadResult struct {
+	UID         string
+	ProgramUID  string
+	AreaUID     string
+	Duration    int
+	Status      string
+	Reason      string
+	StartedDate string
+	EndedDate   sql.NullString
+}
+
+func (f *IrrigationRunReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM IRRIGATION_RUN_READ WHERE UID = ?`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		runs, err := scanRunReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		run := storage.IrrigationRunRead{}
+		if len(runs) > 0 {
+			run = runs[0]
+		}
+
+		result <- query.QueryResult{Result: run}
+		close(result)
+	}()
+
+	return result
+}
+
+// FindAllByProgramID returns the runs of the program, the most recent first
+func (f *IrrigationRunReadQuerySqlite) FindAllByProgramID(programUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM IRRIGATION_RUN_READ WHERE PROGRAM_UID = ?
+			ORDER BY STARTED_DATE DESC`, programUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		runs, err := scanRunReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: runs}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanRunReads(rows *sql.Rows) ([]storage.IrrigationRunRead, error) {
+	defer rows.Close()
+
+	runs := []storage.IrrigationRunRead{}
+	for rows.Next() {
+		rowsData := runReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.ProgramUID,
+			&rowsData.AreaUID,
+			&rowsData.Duration,
+			&rowsData.Status,
+			&rowsData.Reason,
+			&rowsData.StartedDate,
+			&rowsData.EndedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		programUID, err := uuid.FromString(rowsData.ProgramUID)
+		if err != nil {
+			return nil, err
+		}
+
+		areaUID, err := uuid.FromString(rowsData.AreaUID)
+		if err != nil {
+			return nil, err
+		}
+
+		startedDate, err := time.Parse(time.RFC3339, rowsData.StartedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		endedDate, err := parseNullDate(rowsData.EndedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		runs = append(runs, storage.IrrigationRunRead{
+			UID:         uid,
+			ProgramUID:  programUID,
+			AreaUID:     areaUID,
+			Duration:    rowsData.Duration,
+			Status:      rowsData.Status,
+			Reason:      rowsData.Reason,
+			StartedDate: startedDate,
+			EndedDate:   endedDate,
+		})
+	}
+
+	return runs, rows.Err()
+}
@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEventRepositoryInMemory struct {
+	Storage *storage.IrrigationProgramEventStorage
+}
+
+func NewIrrigationProgramEventRepositoryInMemory(s *storage.IrrigationProgramEventStorage) repository.IrrigationProgramEventRepository {
+	return &IrrigationProgramEventRepositoryInMemory{Storage: s}
+}
+
+func (f *IrrigationProgramEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.IrrigationProgramEvents = append(f.Storage.IrrigationProgramEvents, storage.IrrigationProgramEvent{
+				ProgramUID: uid,
+				Version:    latestVersion,
+				Event:      v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
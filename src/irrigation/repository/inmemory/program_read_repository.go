@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+type IrrigationProgramReadRepositoryInMemory struct {
+	Storage *storage.IrrigationProgramReadStorage
+}
+
+func NewIrrigationProgramReadRepositoryInMemory(s *storage.IrrigationProgramReadStorage) repository.IrrigationProgramReadRepository {
+	return &IrrigationProgramReadRepositoryInMemory{Storage: s}
+}
+
+func (f *IrrigationProgramReadRepositoryInMemory) Save(programRead *storage.IrrigationProgramRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.IrrigationProgramReadMap[programRead.UID] = *programRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+type IrrigationRunReadRepositoryInMemory struct {
+	Storage *storage.IrrigationRunReadStorage
+}
+
+func NewIrrigationRunReadRepositoryInMemory(s *storage.IrrigationRunReadStorage) repository.IrrigationRunReadRepository {
+	return &IrrigationRunReadRepositoryInMemory{Storage: s}
+}
+
+func (f *IrrigationRunReadRepositoryInMemory) Save(runRead *storage.IrrigationRunRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.IrrigationRunReadMap[runRead.UID] = *runRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,57 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	"github.com/Tanibox/tania-core/src/irrigation/decoder"
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEventRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewIrrigationProgramEventRepositoryMysql(db *sql.DB) repository.IrrigationProgramEventRepository {
+	return &IrrigationProgramEventRepositoryMysql{DB: db}
+}
+
+func (f *IrrigationProgramEventRepositoryMysql) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			stmt, err := f.DB.Prepare(`INSERT INTO IRRIGATION_PROGRAM_EVENT
+				(PROGRAM_UID, VERSION, CREATED_DATE, EVENT)
+				VALUES (?, ?, ?, ?)`)
+
+			if err != nil {
+				result <- err
+			}
+
+			e, err := json.Marshal(decoder.EventWrapper{
+				EventName: structhelper.GetName(v),
+				EventData: v,
+			})
+
+			if err != nil {
+				panic(err)
+			}
+
+			_, err = stmt.Exec(uid.Bytes(), latestVersion, time.Now(), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,79 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+type IrrigationProgramReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewIrrigationProgramReadRepositoryMysql(db *sql.DB) repository.IrrigationProgramReadRepository {
+	return &IrrigationProgramReadRepositoryMysql{DB: db}
+}
+
+func (f *IrrigationProgramReadRepositoryMysql) Save(programRead *storage.IrrigationProgramRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM IRRIGATION_PROGRAM_READ WHERE UID = ?`, programRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE IRRIGATION_PROGRAM_READ SET
+				NAME = ?, AREA_UID = ?, AREA_NAME = ?, FARM_UID = ?, START_TIMES = ?, DURATION = ?,
+				DAYS = ?, IS_ACTIVE = ?, IS_RUNNING = ?, LAST_RUN_DATE = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				programRead.Name,
+				programRead.Area.UID.Bytes(),
+				programRead.Area.Name,
+				programRead.FarmUID.Bytes(),
+				strings.Join(programRead.Schedule.StartTimes, ","),
+				programRead.Schedule.Duration,
+				strings.Join(programRead.Schedule.Days, ","),
+				programRead.IsActive,
+				programRead.IsRunning,
+				programRead.LastRunDate,
+				programRead.CreatedDate,
+				programRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO IRRIGATION_PROGRAM_READ
+				(UID, NAME, AREA_UID, AREA_NAME, FARM_UID, START_TIMES, DURATION, DAYS,
+				IS_ACTIVE, IS_RUNNING, LAST_RUN_DATE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				programRead.UID.Bytes(),
+				programRead.Name,
+				programRead.Area.UID.Bytes(),
+				programRead.Area.Name,
+				programRead.FarmUID.Bytes(),
+				strings.Join(programRead.Schedule.StartTimes, ","),
+				programRead.Schedule.Duration,
+				strings.Join(programRead.Schedule.Days, ","),
+				programRead.IsActive,
+				programRead.IsRunning,
+				programRead.LastRunDate,
+				programRead.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,69 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+type IrrigationRunReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewIrrigationRunReadRepositoryMysql(db *sql.DB) repository.IrrigationRunReadRepository {
+	return &IrrigationRunReadRepositoryMysql{DB: db}
+}
+
+func (f *IrrigationRunReadRepositoryMysql) Save(runRead *storage.IrrigationRunRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM IRRIGATION_RUN_READ WHERE UID = ?`, runRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE IRRIGATION_RUN_READ SET
+				PROGRAM_UID = ?, AREA_UID = ?, DURATION = ?, STATUS = ?, REASON = ?,
+				STARTED_DATE = ?, ENDED_DATE = ?
+				WHERE UID = ?`,
+				runRead.ProgramUID.Bytes(),
+				runRead.AreaUID.Bytes(),
+				runRead.Duration,
+				runRead.Status,
+				runRead.Reason,
+				runRead.StartedDate,
+				runRead.EndedDate,
+				runRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO IRRIGATION_RUN_READ
+				(UID, PROGRAM_UID, AREA_UID, DURATION, STATUS, REASON, STARTED_DATE, ENDED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+				runRead.UID.Bytes(),
+				runRead.ProgramUID.Bytes(),
+				runRead.AreaUID.Bytes(),
+				runRead.Duration,
+				runRead.Status,
+				runRead.Reason,
+				runRead.StartedDate,
+				runRead.EndedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RepositoryResult is a struct to wrap repository result
+// so its easy to use it in channel
+type RepositoryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type IrrigationProgramEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type IrrigationProgramReadRepository interface {
+	Save(programRead *storage.IrrigationProgramRead) <-chan error
+}
+
+type IrrigationRunReadRepository interface {
+	Save(runRead *storage.IrrigationRunRead) <-chan error
+}
+
+func NewIrrigationProgramFromHistory(events []storage.IrrigationProgramEvent) *domain.IrrigationProgram {
+	state := &domain.IrrigationProgram{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
@@ -0,0 +1,86 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+type IrrigationProgramReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewIrrigationProgramReadRepositorySqlite(db *sql.DB) repository.IrrigationProgramReadRepository {
+	return &IrrigationProgramReadRepositorySqlite{DB: db}
+}
+
+func (f *IrrigationProgramReadRepositorySqlite) Save(programRead *storage.IrrigationProgramRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM IRRIGATION_PROGRAM_READ WHERE UID = ?`, programRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		var lastRunDate *string
+		if programRead.LastRunDate != nil {
+			d := programRead.LastRunDate.Format(time.RFC3339)
+			lastRunDate = &d
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE IRRIGATION_PROGRAM_READ SET
+				NAME = ?, AREA_UID = ?, AREA_NAME = ?, FARM_UID = ?, START_TIMES = ?, DURATION = ?,
+				DAYS = ?, IS_ACTIVE = ?, IS_RUNNING = ?, LAST_RUN_DATE = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				programRead.Name,
+				programRead.Area.UID,
+				programRead.Area.Name,
+				programRead.FarmUID,
+				strings.Join(programRead.Schedule.StartTimes, ","),
+				programRead.Schedule.Duration,
+				strings.Join(programRead.Schedule.Days, ","),
+				programRead.IsActive,
+				programRead.IsRunning,
+				lastRunDate,
+				programRead.CreatedDate.Format(time.RFC3339),
+				programRead.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO IRRIGATION_PROGRAM_READ
+				(UID, NAME, AREA_UID, AREA_NAME, FARM_UID, START_TIMES, DURATION, DAYS,
+				IS_ACTIVE, IS_RUNNING, LAST_RUN_DATE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				programRead.UID,
+				programRead.Name,
+				programRead.Area.UID,
+				programRead.Area.Name,
+				programRead.FarmUID,
+				strings.Join(programRead.Schedule.StartTimes, ","),
+				programRead.Schedule.Duration,
+				strings.Join(programRead.Schedule.Days, ","),
+				programRead.IsActive,
+				programRead.IsRunning,
+				lastRunDate,
+				programRead.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
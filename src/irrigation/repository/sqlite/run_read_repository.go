@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+type IrrigationRunReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewIrrigationRunReadRepositorySqlite(db *sql.DB) repository.IrrigationRunReadRepository {
+	return &IrrigationRunReadRepositorySqlite{DB: db}
+}
+
+func (f *IrrigationRunReadRepositorySqlite) Save(runRead *storage.IrrigationRunRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM IRRIGATION_RUN_READ WHERE UID = ?`, runRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		var endedDate *string
+		if runRead.EndedDate != nil {
+			d := runRead.EndedDate.Format(time.RFC3339)
+			endedDate = &d
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE IRRIGATION_RUN_READ SET
+				PROGRAM_UID = ?, AREA_UID = ?, DURATION = ?, STATUS = ?, REASON = ?,
+				STARTED_DATE = ?, ENDED_DATE = ?
+				WHERE UID = ?`,
+				runRead.ProgramUID,
+				runRead.AreaUID,
+				runRead.Duration,
+				runRead.Status,
+				runRead.Reason,
+				runRead.StartedDate.Format(time.RFC3339),
+				endedDate,
+				runRead.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO IRRIGATION_RUN_READ
+				(UID, PROGRAM_UID, AREA_UID, DURATION, STATUS, REASON, STARTED_DATE, ENDED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+				runRead.UID,
+				runRead.ProgramUID,
+				runRead.AreaUID,
+				runRead.Duration,
+				runRead.Status,
+				runRead.Reason,
+				runRead.StartedDate.Format(time.RFC3339),
+				endedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"sync"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Actuator drives the valve which waters an area. A device driver implements it
+// to switch the valve of the area on the irrigation controller.
+type Actuator interface {
+	Open(areaUID uuid.UUID) error
+	Close(areaUID uuid.UUID) error
+}
+
+// SimulatedActuator only remembers which valves are open.
+// It is used when no irrigation controller is connected, like in development and tests.
+type SimulatedActuator struct {
+	lock   sync.Mutex
+	valves map[uuid.UUID]bool
+}
+
+func NewSimulatedActuator() *SimulatedActuator {
+	return &SimulatedActuator{valves: make(map[uuid.UUID]bool)}
+}
+
+func (a *SimulatedActuator) Open(areaUID uuid.UUID) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.valves[areaUID] = true
+
+	return nil
+}
+
+func (a *SimulatedActuator) Close(areaUID uuid.UUID) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	delete(a.valves, areaUID)
+
+	return nil
+}
+
+// IsOpen tells whether the valve of the area is open
+func (a *SimulatedActuator) IsOpen(areaUID uuid.UUID) bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return a.valves[areaUID]
+}
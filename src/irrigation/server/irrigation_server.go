@@ -0,0 +1,602 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/eventbus"
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	"github.com/Tanibox/tania-core/src/irrigation/domain/service"
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	queryInMem "github.com/Tanibox/tania-core/src/irrigation/query/inmemory"
+	queryMysql "github.com/Tanibox/tania-core/src/irrigation/query/mysql"
+	querySqlite "github.com/Tanibox/tania-core/src/irrigation/query/sqlite"
+	"github.com/Tanibox/tania-core/src/irrigation/repository"
+	repoInMem "github.com/Tanibox/tania-core/src/irrigation/repository/inmemory"
+	repoMysql "github.com/Tanibox/tania-core/src/irrigation/repository/mysql"
+	repoSqlite "github.com/Tanibox/tania-core/src/irrigation/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+// SchedulerInterval is how often the scheduler looks for the programs to run.
+// It has to be shorter than a minute, otherwise start times could be missed.
+const SchedulerInterval = 30 * time.Second
+
+// IrrigationServer ties the routes and handlers with injected dependencies
+type IrrigationServer struct {
+	ProgramEventRepo  repository.IrrigationProgramEventRepository
+	ProgramEventQuery query.IrrigationProgramEventQuery
+	ProgramReadRepo   repository.IrrigationProgramReadRepository
+	ProgramReadQuery  query.IrrigationProgramReadQuery
+	RunReadRepo       repository.IrrigationRunReadRepository
+	RunReadQuery      query.IrrigationRunReadQuery
+	AreaQuery         query.AreaQuery
+	IrrigationService domain.IrrigationService
+	Actuator          Actuator
+	EventBus          eventbus.TaniaEventBus
+
+	// DurationUnit is the unit of the program durations. It is shortened in tests.
+	DurationUnit time.Duration
+
+	// programLocks serializes the changes of each program. The scheduler, the manual runs
+	// and the end of the runs rebuild the program from its events and save new ones,
+	// so they must not interleave.
+	programLocks     map[uuid.UUID]*sync.Mutex
+	programLocksLock sync.Mutex
+}
+
+// NewIrrigationServer initializes IrrigationServer's dependencies and create new IrrigationServer struct
+func NewIrrigationServer(
+	db *sql.DB,
+	bus eventbus.TaniaEventBus,
+	areaReadStorage *assetsstorage.AreaReadStorage,
+	programEventStorage *storage.IrrigationProgramEventStorage,
+	programReadStorage *storage.IrrigationProgramReadStorage,
+	runReadStorage *storage.IrrigationRunReadStorage,
+) (*IrrigationServer, error) {
+	irrigationServer := &IrrigationServer{
+		Actuator:     NewSimulatedActuator(),
+		EventBus:     bus,
+		DurationUnit: time.Minute,
+		programLocks: make(map[uuid.UUID]*sync.Mutex),
+	}
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DB_INMEMORY:
+		irrigationServer.ProgramEventRepo = repoInMem.NewIrrigationProgramEventRepositoryInMemory(programEventStorage)
+		irrigationServer.ProgramEventQuery = queryInMem.NewIrrigationProgramEventQueryInMemory(programEventStorage)
+		irrigationServer.ProgramReadRepo = repoInMem.NewIrrigationProgramReadRepositoryInMemory(programReadStorage)
+		irrigationServer.ProgramReadQuery = queryInMem.NewIrrigationProgramReadQueryInMemory(programReadStorage)
+		irrigationServer.RunReadRepo = repoInMem.NewIrrigationRunReadRepositoryInMemory(runReadStorage)
+		irrigationServer.RunReadQuery = queryInMem.NewIrrigationRunReadQueryInMemory(runReadStorage)
+
+		irrigationServer.AreaQuery = queryInMem.NewAreaQueryInMemory(areaReadStorage)
+
+	case config.DB_SQLITE:
+		irrigationServer.ProgramEventRepo = repoSqlite.NewIrrigationProgramEventRepositorySqlite(db)
+		irrigationServer.ProgramEventQuery = querySqlite.NewIrrigationProgramEventQuerySqlite(db)
+		irrigationServer.ProgramReadRepo = repoSqlite.NewIrrigationProgramReadRepositorySqlite(db)
+		irrigationServer.ProgramReadQuery = querySqlite.NewIrrigationProgramReadQuerySqlite(db)
+		irrigationServer.RunReadRepo = repoSqlite.NewIrrigationRunReadRepositorySqlite(db)
+		irrigationServer.RunReadQuery = querySqlite.NewIrrigationRunReadQuerySqlite(db)
+
+		irrigationServer.AreaQuery = querySqlite.NewAreaQuerySqlite(db)
+
+	case config.DB_MYSQL:
+		irrigationServer.ProgramEventRepo = repoMysql.NewIrrigationProgramEventRepositoryMysql(db)
+		irrigationServer.ProgramEventQuery = queryMysql.NewIrrigationProgramEventQueryMysql(db)
+		irrigationServer.ProgramReadRepo = repoMysql.NewIrrigationProgramReadRepositoryMysql(db)
+		irrigationServer.ProgramReadQuery = queryMysql.NewIrrigationProgramReadQueryMysql(db)
+		irrigationServer.RunReadRepo = repoMysql.NewIrrigationRunReadRepositoryMysql(db)
+		irrigationServer.RunReadQuery = queryMysql.NewIrrigationRunReadQueryMysql(db)
+
+		irrigationServer.AreaQuery = queryMysql.NewAreaQueryMysql(db)
+	}
+
+	irrigationServer.IrrigationService = service.IrrigationServiceImpl{
+		AreaQuery: irrigationServer.AreaQuery,
+	}
+
+	irrigationServer.InitSubscriber()
+
+	return irrigationServer, nil
+}
+
+// InitSubscriber defines the mapping of which event this domain listen with their handler
+func (s *IrrigationServer) InitSubscriber() {
+	s.EventBus.Subscribe("IrrigationProgramCreated", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationProgramChanged", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationProgramActivated", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationProgramDeactivated", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationRunStarted", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationRunStarted", s.SaveToIrrigationRunReadModel)
+	s.EventBus.Subscribe("IrrigationRunCompleted", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationRunCompleted", s.SaveToIrrigationRunReadModel)
+	s.EventBus.Subscribe("IrrigationRunFailed", s.SaveToIrrigationProgramReadModel)
+	s.EventBus.Subscribe("IrrigationRunFailed", s.SaveToIrrigationRunReadModel)
+}
+
+// Mount defines the IrrigationServer's endpoints with its handlers
+func (s *IrrigationServer) Mount(g *echo.Group) {
+	g.POST("/programs", s.SaveProgram)
+	g.GET("/programs", s.FindAllPrograms)
+	g.GET("/programs/:id", s.FindProgramByID)
+	g.PUT("/programs/:id", s.UpdateProgram)
+	g.PUT("/programs/:id/activate", s.ActivateProgram)
+	g.PUT("/programs/:id/deactivate", s.DeactivateProgram)
+	g.POST("/programs/:id/runs", s.RunProgram)
+	g.GET("/programs/:id/runs", s.FindAllProgramRuns)
+}
+
+// StartScheduler runs the programs when one of their start times is reached.
+// It is started once the server is mounted, not by NewIrrigationServer.
+func (s *IrrigationServer) StartScheduler() {
+	go func() {
+		ticker := time.NewTicker(SchedulerInterval)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			err := s.RunDuePrograms(now)
+			if err != nil {
+				log.Error(err)
+			}
+		}
+	}()
+}
+
+// RunDuePrograms starts the programs which are due at the date. It also fails the runs which should
+// have ended a while ago, like when the server was restarted while the valve was open.
+func (s *IrrigationServer) RunDuePrograms(date time.Time) error {
+	queryResult := <-s.ProgramReadQuery.FindAll(uuid.UUID{})
+	if queryResult.Error != nil {
+		return queryResult.Error
+	}
+
+	programs, ok := queryResult.Result.([]storage.IrrigationProgramRead)
+	if !ok {
+		return errors.New("Internal server error. Error type assertion")
+	}
+
+	for _, v := range programs {
+		if !v.IsActive && !v.IsRunning {
+			continue
+		}
+
+		err := s.runDueProgram(v.UID, date)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func (s *IrrigationServer) runDueProgram(programUID uuid.UUID, date time.Time) error {
+	unlock := s.lockProgram(programUID)
+	defer unlock()
+
+	program, err := s.findProgramByUID(programUID)
+	if err != nil {
+		return err
+	}
+
+	if program.CurrentRun != nil {
+		end := program.CurrentRun.StartedDate.Add(time.Duration(program.CurrentRun.Duration) * s.DurationUnit)
+		if date.Sub(end) > time.Minute {
+			// The timer closing the valve is gone, so close it before failing the run
+			err := s.Actuator.Close(program.AreaUID)
+			if err != nil {
+				log.Error(err)
+			}
+
+			return s.endRun(program.UID, program.CurrentRun.UID, errors.New("Run was interrupted before its end"))
+		}
+
+		return nil
+	}
+
+	if program.IsDueAt(date) {
+		return s.startRun(program, date)
+	}
+
+	return nil
+}
+
+func (s *IrrigationServer) SaveProgram(c echo.Context) error {
+	areaUID, err := uuid.FromString(c.FormValue("area_id"))
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "area_id"))
+	}
+
+	schedule, err := s.parseSchedule(c, domain.ProgramSchedule{})
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	program, err := domain.CreateIrrigationProgram(s.IrrigationService, c.FormValue("name"), areaUID, schedule)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.ProgramEventRepo.Save(program.UID, 0, program.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(program)
+
+	data := make(map[string]storage.IrrigationProgramRead)
+	data["data"] = MapToIrrigationProgramRead(s, *program)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *IrrigationServer) FindAllPrograms(c echo.Context) error {
+	areaUID := uuid.UUID{}
+	if c.QueryParam("area_id") != "" {
+		uid, err := uuid.FromString(c.QueryParam("area_id"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "area_id"))
+		}
+
+		areaUID = uid
+	}
+
+	queryResult := <-s.ProgramReadQuery.FindAll(areaUID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	programs, ok := queryResult.Result.([]storage.IrrigationProgramRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.IrrigationProgramRead)
+	data["data"] = programs
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *IrrigationServer) FindProgramByID(c echo.Context) error {
+	programRead, err := s.findProgramRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.IrrigationProgramRead)
+	data["data"] = programRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateProgram changes the program. The fields which are not sent keep their current value.
+func (s *IrrigationServer) UpdateProgram(c echo.Context) error {
+	program, unlock, err := s.findProgram(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+	defer unlock()
+
+	schedule, err := s.parseSchedule(c, program.Schedule)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	name := program.Name
+	if c.FormValue("name") != "" {
+		name = c.FormValue("name")
+	}
+
+	err = program.Change(name, schedule)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.ProgramEventRepo.Save(program.UID, program.Version, program.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(program)
+
+	data := make(map[string]storage.IrrigationProgramRead)
+	data["data"] = MapToIrrigationProgramRead(s, *program)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *IrrigationServer) ActivateProgram(c echo.Context) error {
+	program, unlock, err := s.findProgram(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+	defer unlock()
+
+	err = program.Activate()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.ProgramEventRepo.Save(program.UID, program.Version, program.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(program)
+
+	data := make(map[string]storage.IrrigationProgramRead)
+	data["data"] = MapToIrrigationProgramRead(s, *program)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *IrrigationServer) DeactivateProgram(c echo.Context) error {
+	program, unlock, err := s.findProgram(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+	defer unlock()
+
+	err = program.Deactivate()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.ProgramEventRepo.Save(program.UID, program.Version, program.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(program)
+
+	data := make(map[string]storage.IrrigationProgramRead)
+	data["data"] = MapToIrrigationProgramRead(s, *program)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// RunProgram starts a run of the program right away, outside of its schedule
+func (s *IrrigationServer) RunProgram(c echo.Context) error {
+	program, unlock, err := s.findProgram(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+	defer unlock()
+
+	err = s.startRun(program, time.Now())
+	if err != nil {
+		return Error(c, err)
+	}
+
+	programRead, err := s.findProgramRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.IrrigationProgramRead)
+	data["data"] = programRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *IrrigationServer) FindAllProgramRuns(c echo.Context) error {
+	programRead, err := s.findProgramRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.RunReadQuery.FindAllByProgramID(programRead.UID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	runs, ok := queryResult.Result.([]storage.IrrigationRunRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.IrrigationRunRead)
+	data["data"] = runs
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// startRun opens the valve of the area and closes it once the duration of the program is elapsed.
+// When the actuator can't open the valve, the run is failed right away.
+// The caller holds the lock of the program.
+func (s *IrrigationServer) startRun(program *domain.IrrigationProgram, date time.Time) error {
+	err := program.StartRun(date)
+	if err != nil {
+		return err
+	}
+
+	err = s.saveProgram(program)
+	if err != nil {
+		return err
+	}
+
+	programUID := program.UID
+	areaUID := program.AreaUID
+	run := *program.CurrentRun
+
+	err = s.Actuator.Open(areaUID)
+	if err != nil {
+		log.Error(err)
+
+		return s.endRun(programUID, run.UID, err)
+	}
+
+	time.AfterFunc(time.Duration(run.Duration)*s.DurationUnit, func() {
+		actuatorErr := s.Actuator.Close(areaUID)
+
+		unlock := s.lockProgram(programUID)
+		defer unlock()
+
+		err := s.endRun(programUID, run.UID, actuatorErr)
+		if err != nil {
+			log.Error(err)
+		}
+	})
+
+	return nil
+}
+
+// endRun completes the run of the program, or fails it when the actuator returned an error.
+// The caller holds the lock of the program.
+func (s *IrrigationServer) endRun(programUID, runUID uuid.UUID, actuatorErr error) error {
+	program, err := s.findProgramByUID(programUID)
+	if err != nil {
+		return err
+	}
+
+	// The run could have already been ended by the scheduler
+	if program.CurrentRun == nil || program.CurrentRun.UID != runUID {
+		return nil
+	}
+
+	if actuatorErr != nil {
+		err = program.FailRun(actuatorErr.Error(), time.Now())
+	} else {
+		err = program.CompleteRun(time.Now())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return s.saveProgram(program)
+}
+
+func (s *IrrigationServer) saveProgram(program *domain.IrrigationProgram) error {
+	err := <-s.ProgramEventRepo.Save(program.UID, program.Version, program.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(program)
+
+	program.Version += len(program.UncommittedChanges)
+	program.UncommittedChanges = []interface{}{}
+
+	return nil
+}
+
+// parseSchedule reads the schedule of the program from the form.
+// The fields which are not sent keep the value of the given schedule.
+func (s *IrrigationServer) parseSchedule(c echo.Context, schedule domain.ProgramSchedule) (domain.ProgramSchedule, error) {
+	if c.FormValue("start_times") != "" {
+		schedule.StartTimes = strings.Split(c.FormValue("start_times"), ",")
+	}
+
+	if c.FormValue("duration") != "" {
+		duration, err := strconv.Atoi(c.FormValue("duration"))
+		if err != nil {
+			return schedule, NewRequestValidationError(NUMERIC, "duration")
+		}
+
+		schedule.Duration = duration
+	}
+
+	if c.FormValue("days") != "" {
+		schedule.Days = strings.Split(c.FormValue("days"), ",")
+	}
+
+	return schedule, nil
+}
+
+func (s *IrrigationServer) findProgramRead(id string) (storage.IrrigationProgramRead, error) {
+	programUID, err := uuid.FromString(id)
+	if err != nil {
+		return storage.IrrigationProgramRead{}, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	queryResult := <-s.ProgramReadQuery.FindByID(programUID)
+	if queryResult.Error != nil {
+		return storage.IrrigationProgramRead{}, queryResult.Error
+	}
+
+	programRead, ok := queryResult.Result.(storage.IrrigationProgramRead)
+	if !ok {
+		return storage.IrrigationProgramRead{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if programRead.UID == (uuid.UUID{}) {
+		return storage.IrrigationProgramRead{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return programRead, nil
+}
+
+// findProgram locks the program and rebuilds it from its events.
+// The returned function releases the lock once the changes of the program are saved.
+func (s *IrrigationServer) findProgram(id string) (*domain.IrrigationProgram, func(), error) {
+	programRead, err := s.findProgramRead(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unlock := s.lockProgram(programRead.UID)
+
+	program, err := s.findProgramByUID(programRead.UID)
+	if err != nil {
+		unlock()
+		return nil, nil, err
+	}
+
+	return program, unlock, nil
+}
+
+func (s *IrrigationServer) lockProgram(programUID uuid.UUID) func() {
+	s.programLocksLock.Lock()
+	lock, ok := s.programLocks[programUID]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.programLocks[programUID] = lock
+	}
+	s.programLocksLock.Unlock()
+
+	lock.Lock()
+
+	return lock.Unlock
+}
+
+func (s *IrrigationServer) findProgramByUID(uid uuid.UUID) (*domain.IrrigationProgram, error) {
+	eventQueryResult := <-s.ProgramEventQuery.FindAllByID(uid)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.IrrigationProgramEvent)
+	if !ok {
+		return nil, errors.New("Internal server error. Error type assertion")
+	}
+
+	return repository.NewIrrigationProgramFromHistory(events), nil
+}
+
+func (s *IrrigationServer) publishUncommittedEvents(entity interface{}) error {
+	switch e := entity.(type) {
+	case *domain.IrrigationProgram:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	"github.com/Tanibox/tania-core/src/irrigation/query"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (s *IrrigationServer) SaveToIrrigationProgramReadModel(event interface{}) error {
+	programRead := &storage.IrrigationProgramRead{}
+
+	switch e := event.(type) {
+	case domain.IrrigationProgramCreated:
+		area, err := s.findArea(e.AreaUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		programRead.UID = e.UID
+		programRead.Name = e.Name
+		programRead.Area = storage.ProgramArea{
+			UID:  e.AreaUID,
+			Name: area.Name,
+		}
+		programRead.FarmUID = e.FarmUID
+		programRead.Schedule = storage.ProgramSchedule(e.Schedule)
+		programRead.IsActive = true
+		programRead.CreatedDate = e.CreatedDate
+
+	case domain.IrrigationProgramChanged:
+		programRead = s.findProgramReadForEvent(e.ProgramUID)
+
+		programRead.Name = e.Name
+		programRead.Schedule = storage.ProgramSchedule(e.Schedule)
+
+	case domain.IrrigationProgramActivated:
+		programRead = s.findProgramReadForEvent(e.ProgramUID)
+
+		programRead.IsActive = true
+
+	case domain.IrrigationProgramDeactivated:
+		programRead = s.findProgramReadForEvent(e.ProgramUID)
+
+		programRead.IsActive = false
+
+	case domain.IrrigationRunStarted:
+		programRead = s.findProgramReadForEvent(e.ProgramUID)
+
+		startedDate := e.StartedDate
+		programRead.IsRunning = true
+		programRead.LastRunDate = &startedDate
+
+	case domain.IrrigationRunCompleted:
+		programRead = s.findProgramReadForEvent(e.ProgramUID)
+
+		programRead.IsRunning = false
+
+	case domain.IrrigationRunFailed:
+		programRead = s.findProgramReadForEvent(e.ProgramUID)
+
+		programRead.IsRunning = false
+
+	}
+
+	err := <-s.ProgramReadRepo.Save(programRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+func (s *IrrigationServer) SaveToIrrigationRunReadModel(event interface{}) error {
+	runRead := &storage.IrrigationRunRead{}
+
+	switch e := event.(type) {
+	case domain.IrrigationRunStarted:
+		runRead.UID = e.UID
+		runRead.ProgramUID = e.ProgramUID
+		runRead.AreaUID = e.AreaUID
+		runRead.Duration = e.Duration
+		runRead.Status = domain.IrrigationRunStatusRunning
+		runRead.StartedDate = e.StartedDate
+
+	case domain.IrrigationRunCompleted:
+		runRead = s.findRunReadForEvent(e.UID)
+
+		completedDate := e.CompletedDate
+		runRead.Status = domain.IrrigationRunStatusCompleted
+		runRead.EndedDate = &completedDate
+
+	case domain.IrrigationRunFailed:
+		runRead = s.findRunReadForEvent(e.UID)
+
+		failedDate := e.FailedDate
+		runRead.Status = domain.IrrigationRunStatusFailed
+		runRead.Reason = e.Reason
+		runRead.EndedDate = &failedDate
+
+	}
+
+	err := <-s.RunReadRepo.Save(runRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+func (s *IrrigationServer) findProgramReadForEvent(uid uuid.UUID) *storage.IrrigationProgramRead {
+	queryResult := <-s.ProgramReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+	}
+
+	p, ok := queryResult.Result.(storage.IrrigationProgramRead)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+	}
+
+	return &p
+}
+
+func (s *IrrigationServer) findRunReadForEvent(uid uuid.UUID) *storage.IrrigationRunRead {
+	queryResult := <-s.RunReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+	}
+
+	r, ok := queryResult.Result.(storage.IrrigationRunRead)
+	if !ok {
+		log.Error(errors.New("Internal server error. Error type assertion"))
+	}
+
+	return &r
+}
+
+func (s *IrrigationServer) findArea(uid uuid.UUID) (query.AreaQueryResult, error) {
+	queryResult := <-s.AreaQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return query.AreaQueryResult{}, queryResult.Error
+	}
+
+	result, ok := queryResult.Result.(query.AreaQueryResult)
+	if !ok {
+		return query.AreaQueryResult{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return result, nil
+}
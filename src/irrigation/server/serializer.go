@@ -0,0 +1,33 @@
+package server
+
+import (
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	"github.com/Tanibox/tania-core/src/irrigation/storage"
+)
+
+func MapToIrrigationProgramRead(s *IrrigationServer, program domain.IrrigationProgram) storage.IrrigationProgramRead {
+	programRead := storage.IrrigationProgramRead{
+		UID:  program.UID,
+		Name: program.Name,
+		Area: storage.ProgramArea{
+			UID: program.AreaUID,
+		},
+		FarmUID:     program.FarmUID,
+		Schedule:    storage.ProgramSchedule(program.Schedule),
+		IsActive:    program.IsActive,
+		IsRunning:   program.CurrentRun != nil,
+		CreatedDate: program.CreatedDate,
+	}
+
+	if !program.LastRunDate.IsZero() {
+		lastRunDate := program.LastRunDate
+		programRead.LastRunDate = &lastRunDate
+	}
+
+	area, err := s.findArea(program.AreaUID)
+	if err == nil {
+		programRead.Area.Name = area.Name
+	}
+
+	return programRead
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	deadlock "github.com/sasha-s/go-deadlock"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEventStorage struct {
+	Lock                    *deadlock.RWMutex
+	IrrigationProgramEvents []IrrigationProgramEvent
+}
+
+func CreateIrrigationProgramEventStorage() *IrrigationProgramEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("IRRIGATION PROGRAM EVENT STORAGE DEADLOCK!")
+	}
+
+	return &IrrigationProgramEventStorage{Lock: &rwMutex}
+}
+
+type IrrigationProgramReadStorage struct {
+	Lock                     *deadlock.RWMutex
+	IrrigationProgramReadMap map[uuid.UUID]IrrigationProgramRead
+}
+
+func CreateIrrigationProgramReadStorage() *IrrigationProgramReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("IRRIGATION PROGRAM READ STORAGE DEADLOCK!")
+	}
+
+	return &IrrigationProgramReadStorage{IrrigationProgramReadMap: make(map[uuid.UUID]IrrigationProgramRead), Lock: &rwMutex}
+}
+
+type IrrigationRunReadStorage struct {
+	Lock                 *deadlock.RWMutex
+	IrrigationRunReadMap map[uuid.UUID]IrrigationRunRead
+}
+
+func CreateIrrigationRunReadStorage() *IrrigationRunReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("IRRIGATION RUN READ STORAGE DEADLOCK!")
+	}
+
+	return &IrrigationRunReadStorage{IrrigationRunReadMap: make(map[uuid.UUID]IrrigationRunRead), Lock: &rwMutex}
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/irrigation/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type IrrigationProgramEvent struct {
+	ProgramUID  uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type IrrigationProgramRead struct {
+	UID         uuid.UUID       `json:"uid"`
+	Name        string          `json:"name"`
+	Area        ProgramArea     `json:"area"`
+	FarmUID     uuid.UUID       `json:"farm_id"`
+	Schedule    ProgramSchedule `json:"schedule"`
+	IsActive    bool            `json:"is_active"`
+	IsRunning   bool            `json:"is_running"`
+	LastRunDate *time.Time      `json:"last_run_date"`
+	CreatedDate time.Time       `json:"created_date"`
+}
+
+type ProgramArea struct {
+	UID  uuid.UUID `json:"uid"`
+	Name string    `json:"name"`
+}
+
+type ProgramSchedule domain.ProgramSchedule
+
+// IrrigationRunRead is a watering of the area by the program, in progress or ended
+type IrrigationRunRead struct {
+	UID         uuid.UUID  `json:"uid"`
+	ProgramUID  uuid.UUID  `json:"program_id"`
+	AreaUID     uuid.UUID  `json:"area_id"`
+	Duration    int        `json:"duration"`
+	Status      string     `json:"status"`
+	Reason      string     `json:"reason"`
+	StartedDate time.Time  `json:"started_date"`
+	EndedDate   *time.Time `json:"ended_date"`
+}
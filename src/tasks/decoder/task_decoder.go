@@ -163,6 +163,126 @@ func (w *TaskEventWrapper) UnmarshalJSON(b []byte) error {
 
 		w.Data = e
 
+	case domain.TaskAssignedCode:
+		e := domain.TaskAssigned{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskUnassignedCode:
+		e := domain.TaskUnassigned{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskReassignedCode:
+		e := domain.TaskReassigned{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskCommentAddedCode:
+		e := domain.TaskCommentAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemAddedCode:
+		e := domain.TaskChecklistItemAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemCompletedCode:
+		e := domain.TaskChecklistItemCompleted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemUncompletedCode:
+		e := domain.TaskChecklistItemUncompleted{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskChecklistItemRemovedCode:
+		e := domain.TaskChecklistItemRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskAttachmentAddedCode:
+		e := domain.TaskAttachmentAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskAttachmentRemovedCode:
+		e := domain.TaskAttachmentRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskPrerequisiteAddedCode:
+		e := domain.TaskPrerequisiteAdded{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
+	case domain.TaskPrerequisiteRemovedCode:
+		e := domain.TaskPrerequisiteRemoved{}
+
+		_, err := Decode(f, &mapped, &e)
+		if err != nil {
+			return err
+		}
+
+		w.Data = e
+
 	}
 
 	return nil
@@ -3,6 +3,7 @@ package service
 import (
 	domain "github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/Tanibox/tania-core/src/tasks/query"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -13,6 +14,7 @@ type TaskServiceSqlLite struct {
 	AreaQuery      query.AreaQuery
 	MaterialQuery  query.MaterialQuery
 	ReservoirQuery query.ReservoirQuery
+	TaskReadQuery  query.TaskReadQuery
 }
 
 func (s TaskServiceSqlLite) FindAreaByID(uid uuid.UUID) domain.ServiceResult {
@@ -122,3 +124,34 @@ func (s TaskServiceSqlLite) FindReservoirByID(uid uuid.UUID) domain.ServiceResul
 		Result: reservoir,
 	}
 }
+
+func (s TaskServiceSqlLite) FindTaskByID(uid uuid.UUID) domain.ServiceResult {
+	result := <-s.TaskReadQuery.FindByID(uid)
+
+	if result.Error != nil {
+		return domain.ServiceResult{
+			Error: result.Error,
+		}
+	}
+
+	task, ok := result.Result.(storage.TaskRead)
+	if !ok {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidPrerequisiteCode},
+		}
+	}
+
+	if task.UID != uid {
+		return domain.ServiceResult{
+			Error: domain.TaskError{Code: domain.TaskErrorInvalidPrerequisiteCode},
+		}
+	}
+
+	return domain.ServiceResult{
+		Result: query.TaskQueryResult{
+			UID:           task.UID,
+			Status:        task.Status,
+			Prerequisites: task.Prerequisites,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"github.com/Tanibox/tania-core/src/tasks/query"
 	uuid "github.com/satori/go.uuid"
 	"time"
 )
@@ -10,6 +11,7 @@ type TaskService interface {
 	FindCropByID(uid uuid.UUID) ServiceResult
 	FindMaterialByID(uid uuid.UUID) ServiceResult
 	FindReservoirByID(uid uuid.UUID) ServiceResult
+	FindTaskByID(uid uuid.UUID) ServiceResult
 }
 
 // ServiceResult is the container for service result
@@ -19,26 +21,55 @@ type ServiceResult struct {
 }
 
 type Task struct {
-	UID           uuid.UUID  `json:"uid"`
-	Title         string     `json:"title"`
-	Description   string     `json:"description"`
-	CreatedDate   time.Time  `json:"created_date"`
-	DueDate       *time.Time `json:"due_date, omitempty"`
-	CompletedDate *time.Time `json:"completed_date"`
-	CancelledDate *time.Time `json:"cancelled_date"`
-	Priority      string     `json:"priority"`
-	Status        string     `json:"status"`
-	Domain        string     `json:"domain"`
-	DomainDetails TaskDomain `json:"domain_details"`
-	Category      string     `json:"category"`
-	IsDue         bool       `json:"is_due"`
-	AssetID       *uuid.UUID `json:"asset_id"`
+	UID           uuid.UUID           `json:"uid"`
+	Title         string              `json:"title"`
+	Description   string              `json:"description"`
+	CreatedDate   time.Time           `json:"created_date"`
+	DueDate       *time.Time          `json:"due_date, omitempty"`
+	CompletedDate *time.Time          `json:"completed_date"`
+	CancelledDate *time.Time          `json:"cancelled_date"`
+	Priority      string              `json:"priority"`
+	Status        string              `json:"status"`
+	Domain        string              `json:"domain"`
+	DomainDetails TaskDomain          `json:"domain_details"`
+	Category      string              `json:"category"`
+	IsDue         bool                `json:"is_due"`
+	AssetID       *uuid.UUID          `json:"asset_id"`
+	AssigneeUID   *uuid.UUID          `json:"assignee_uid"`
+	Comments      []TaskComment       `json:"comments"`
+	Checklist     []TaskChecklistItem `json:"checklist"`
+	Attachments   []TaskAttachment    `json:"attachments"`
+	Prerequisites []uuid.UUID         `json:"prerequisites"`
 
 	// Events
 	Version            int
 	UncommittedChanges []interface{}
 }
 
+type TaskComment struct {
+	UID         uuid.UUID  `json:"uid"`
+	ParentUID   *uuid.UUID `json:"parent_uid"`
+	AuthorUID   uuid.UUID  `json:"author_uid"`
+	Content     string     `json:"content"`
+	CreatedDate time.Time  `json:"created_date"`
+}
+
+type TaskChecklistItem struct {
+	UID           uuid.UUID  `json:"uid"`
+	Name          string     `json:"name"`
+	IsCompleted   bool       `json:"is_completed"`
+	CompletedDate *time.Time `json:"completed_date"`
+	CreatedDate   time.Time  `json:"created_date"`
+}
+
+type TaskAttachment struct {
+	UID         uuid.UUID `json:"uid"`
+	Filename    string    `json:"filename"`
+	MimeType    string    `json:"mime_type"`
+	Size        int       `json:"size"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
 // CreateTask
 func CreateTask(taskService TaskService, title string, description string, duedate *time.Time, priority string, taskdomain TaskDomain, taskcategory string, assetid *uuid.UUID) (*Task, error) {
 	// add validation
@@ -197,15 +228,36 @@ func (t *Task) SetTaskAsDue(taskService TaskService) {
 	})
 }
 
-// CompleteTask
-func (t *Task) CompleteTask(taskService TaskService) {
+// CompleteTask closes the task. The materialQuantity is the quantity of the task's material
+// used to finish it, which will be taken out from the inventory.
+func (t *Task) CompleteTask(taskService TaskService, materialQuantity float32) error {
+	for _, v := range t.Prerequisites {
+		prerequisite, err := findPrerequisiteTask(taskService, v)
+		if err != nil {
+			return err
+		}
+
+		if prerequisite.Status == TaskStatusCreated {
+			return TaskError{TaskErrorPrerequisitesOpenCode}
+		}
+	}
+
+	materialID, err := validateMaterialConsumption(taskService, t.DomainDetails, materialQuantity)
+	if err != nil {
+		return err
+	}
+
 	completedTime := time.Now()
 
 	t.TrackChange(taskService, TaskCompleted{
-		UID:           t.UID,
-		Status:        TaskCompletedCode,
-		CompletedDate: &completedTime,
+		UID:              t.UID,
+		Status:           TaskCompletedCode,
+		CompletedDate:    &completedTime,
+		MaterialUID:      materialID,
+		MaterialQuantity: materialQuantity,
 	})
+
+	return nil
 }
 
 // CompleteTask
@@ -219,6 +271,293 @@ func (t *Task) CancelTask(taskService TaskService) {
 	})
 }
 
+// AssignTask assigns an unassigned task to a user
+func (t *Task) AssignTask(taskService TaskService, assigneeUID uuid.UUID) (*Task, error) {
+	err := validateTaskAssignable(t, assigneeUID)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	if t.AssigneeUID != nil {
+		return &Task{}, TaskError{TaskErrorAlreadyAssignedCode}
+	}
+
+	t.TrackChange(taskService, TaskAssigned{
+		UID:          t.UID,
+		AssigneeUID:  assigneeUID,
+		AssignedDate: time.Now(),
+	})
+
+	return t, nil
+}
+
+// ReassignTask moves an assigned task to another user
+func (t *Task) ReassignTask(taskService TaskService, assigneeUID uuid.UUID) (*Task, error) {
+	err := validateTaskAssignable(t, assigneeUID)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	if t.AssigneeUID == nil {
+		return &Task{}, TaskError{TaskErrorNotAssignedCode}
+	}
+
+	if *t.AssigneeUID == assigneeUID {
+		return &Task{}, TaskError{TaskErrorAlreadyAssignedCode}
+	}
+
+	t.TrackChange(taskService, TaskReassigned{
+		UID:                 t.UID,
+		PreviousAssigneeUID: *t.AssigneeUID,
+		AssigneeUID:         assigneeUID,
+		AssignedDate:        time.Now(),
+	})
+
+	return t, nil
+}
+
+// UnassignTask removes the current assignee of the task
+func (t *Task) UnassignTask(taskService TaskService) (*Task, error) {
+	if t.AssigneeUID == nil {
+		return &Task{}, TaskError{TaskErrorNotAssignedCode}
+	}
+
+	t.TrackChange(taskService, TaskUnassigned{
+		UID:                 t.UID,
+		PreviousAssigneeUID: *t.AssigneeUID,
+	})
+
+	return t, nil
+}
+
+// AddComment adds a comment to the task.
+// A comment can reply to another comment of the same task by giving its UID as parentUID.
+func (t *Task) AddComment(taskService TaskService, authorUID uuid.UUID, content string, parentUID *uuid.UUID) (*Task, error) {
+	if authorUID == (uuid.UUID{}) {
+		return &Task{}, TaskError{TaskErrorCommentAuthorEmptyCode}
+	}
+
+	if content == "" {
+		return &Task{}, TaskError{TaskErrorCommentContentEmptyCode}
+	}
+
+	if parentUID != nil {
+		if _, err := t.findComment(*parentUID); err != nil {
+			return &Task{}, err
+		}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskCommentAdded{
+		UID:         t.UID,
+		CommentUID:  uid,
+		ParentUID:   parentUID,
+		AuthorUID:   authorUID,
+		Content:     content,
+		CreatedDate: time.Now(),
+	})
+
+	return t, nil
+}
+
+// AddChecklistItem adds a new uncompleted item to the task checklist
+func (t *Task) AddChecklistItem(taskService TaskService, name string) (*Task, error) {
+	if name == "" {
+		return &Task{}, TaskError{TaskErrorChecklistItemNameEmptyCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskChecklistItemAdded{
+		UID:         t.UID,
+		ItemUID:     uid,
+		Name:        name,
+		CreatedDate: time.Now(),
+	})
+
+	return t, nil
+}
+
+// CompleteChecklistItem marks a checklist item as done
+func (t *Task) CompleteChecklistItem(taskService TaskService, itemUID uuid.UUID) (*Task, error) {
+	item, err := t.findChecklistItem(itemUID)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	if item.IsCompleted {
+		return &Task{}, TaskError{TaskErrorChecklistItemAlreadyCompletedCode}
+	}
+
+	completedDate := time.Now()
+
+	t.TrackChange(taskService, TaskChecklistItemCompleted{
+		UID:           t.UID,
+		ItemUID:       itemUID,
+		CompletedDate: &completedDate,
+	})
+
+	return t, nil
+}
+
+// UncompleteChecklistItem marks a completed checklist item as not done
+func (t *Task) UncompleteChecklistItem(taskService TaskService, itemUID uuid.UUID) (*Task, error) {
+	item, err := t.findChecklistItem(itemUID)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	if !item.IsCompleted {
+		return &Task{}, TaskError{TaskErrorChecklistItemNotCompletedCode}
+	}
+
+	t.TrackChange(taskService, TaskChecklistItemUncompleted{
+		UID:     t.UID,
+		ItemUID: itemUID,
+	})
+
+	return t, nil
+}
+
+// RemoveChecklistItem removes an item from the task checklist
+func (t *Task) RemoveChecklistItem(taskService TaskService, itemUID uuid.UUID) (*Task, error) {
+	if _, err := t.findChecklistItem(itemUID); err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskChecklistItemRemoved{
+		UID:     t.UID,
+		ItemUID: itemUID,
+	})
+
+	return t, nil
+}
+
+// AddAttachment records a file which has been uploaded for the task
+func (t *Task) AddAttachment(taskService TaskService, filename, mimeType string, size int) (*Task, error) {
+	if filename == "" {
+		return &Task{}, TaskError{TaskErrorAttachmentInvalidFilenameCode}
+	}
+
+	if mimeType == "" {
+		return &Task{}, TaskError{TaskErrorAttachmentInvalidMimeTypeCode}
+	}
+
+	if size <= 0 {
+		return &Task{}, TaskError{TaskErrorAttachmentInvalidSizeCode}
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskAttachmentAdded{
+		UID:           t.UID,
+		AttachmentUID: uid,
+		Filename:      filename,
+		MimeType:      mimeType,
+		Size:          size,
+		CreatedDate:   time.Now(),
+	})
+
+	return t, nil
+}
+
+// RemoveAttachment removes an attachment from the task
+func (t *Task) RemoveAttachment(taskService TaskService, attachmentUID uuid.UUID) (*Task, error) {
+	found := false
+	for _, v := range t.Attachments {
+		if v.UID == attachmentUID {
+			found = true
+		}
+	}
+
+	if !found {
+		return &Task{}, TaskError{TaskErrorAttachmentNotFoundCode}
+	}
+
+	t.TrackChange(taskService, TaskAttachmentRemoved{
+		UID:           t.UID,
+		AttachmentUID: attachmentUID,
+	})
+
+	return t, nil
+}
+
+// AddPrerequisite declares another task which has to be finished before this task can be completed
+func (t *Task) AddPrerequisite(taskService TaskService, prerequisiteUID uuid.UUID) (*Task, error) {
+	if t.Status == TaskStatusCompleted || t.Status == TaskStatusCancelled {
+		return &Task{}, TaskError{TaskErrorTaskClosedCode}
+	}
+
+	for _, v := range t.Prerequisites {
+		if v == prerequisiteUID {
+			return &Task{}, TaskError{TaskErrorPrerequisiteAlreadyExistsCode}
+		}
+	}
+
+	err := validatePrerequisite(taskService, t.UID, prerequisiteUID)
+	if err != nil {
+		return &Task{}, err
+	}
+
+	t.TrackChange(taskService, TaskPrerequisiteAdded{
+		UID:             t.UID,
+		PrerequisiteUID: prerequisiteUID,
+	})
+
+	return t, nil
+}
+
+// RemovePrerequisite removes a prerequisite task
+func (t *Task) RemovePrerequisite(taskService TaskService, prerequisiteUID uuid.UUID) (*Task, error) {
+	found := false
+	for _, v := range t.Prerequisites {
+		if v == prerequisiteUID {
+			found = true
+		}
+	}
+
+	if !found {
+		return &Task{}, TaskError{TaskErrorPrerequisiteNotFoundCode}
+	}
+
+	t.TrackChange(taskService, TaskPrerequisiteRemoved{
+		UID:             t.UID,
+		PrerequisiteUID: prerequisiteUID,
+	})
+
+	return t, nil
+}
+
+func (t *Task) findComment(commentUID uuid.UUID) (TaskComment, error) {
+	for _, v := range t.Comments {
+		if v.UID == commentUID {
+			return v, nil
+		}
+	}
+
+	return TaskComment{}, TaskError{TaskErrorCommentNotFoundCode}
+}
+
+func (t *Task) findChecklistItem(itemUID uuid.UUID) (TaskChecklistItem, error) {
+	for _, v := range t.Checklist {
+		if v.UID == itemUID {
+			return v, nil
+		}
+	}
+
+	return TaskChecklistItem{}, TaskError{TaskErrorChecklistItemNotFoundCode}
+}
+
 // Event Tracking
 
 func (state *Task) TrackChange(taskService TaskService, event interface{}) error {
@@ -266,6 +605,76 @@ func (state *Task) Transition(taskService TaskService, event interface{}) error
 		state.Status = TaskStatusCompleted
 	case TaskDue:
 		state.IsDue = true
+	case TaskAssigned:
+		assigneeUID := e.AssigneeUID
+		state.AssigneeUID = &assigneeUID
+	case TaskReassigned:
+		assigneeUID := e.AssigneeUID
+		state.AssigneeUID = &assigneeUID
+	case TaskUnassigned:
+		state.AssigneeUID = nil
+	case TaskCommentAdded:
+		state.Comments = append(state.Comments, TaskComment{
+			UID:         e.CommentUID,
+			ParentUID:   e.ParentUID,
+			AuthorUID:   e.AuthorUID,
+			Content:     e.Content,
+			CreatedDate: e.CreatedDate,
+		})
+	case TaskChecklistItemAdded:
+		state.Checklist = append(state.Checklist, TaskChecklistItem{
+			UID:         e.ItemUID,
+			Name:        e.Name,
+			CreatedDate: e.CreatedDate,
+		})
+	case TaskChecklistItemCompleted:
+		for i, v := range state.Checklist {
+			if v.UID == e.ItemUID {
+				state.Checklist[i].IsCompleted = true
+				state.Checklist[i].CompletedDate = e.CompletedDate
+			}
+		}
+	case TaskChecklistItemUncompleted:
+		for i, v := range state.Checklist {
+			if v.UID == e.ItemUID {
+				state.Checklist[i].IsCompleted = false
+				state.Checklist[i].CompletedDate = nil
+			}
+		}
+	case TaskChecklistItemRemoved:
+		checklist := []TaskChecklistItem{}
+		for _, v := range state.Checklist {
+			if v.UID != e.ItemUID {
+				checklist = append(checklist, v)
+			}
+		}
+		state.Checklist = checklist
+	case TaskAttachmentAdded:
+		state.Attachments = append(state.Attachments, TaskAttachment{
+			UID:         e.AttachmentUID,
+			Filename:    e.Filename,
+			MimeType:    e.MimeType,
+			Size:        e.Size,
+			CreatedDate: e.CreatedDate,
+		})
+	case TaskAttachmentRemoved:
+		attachments := []TaskAttachment{}
+		for _, v := range state.Attachments {
+			if v.UID != e.AttachmentUID {
+				attachments = append(attachments, v)
+			}
+		}
+		state.Attachments = attachments
+	case TaskPrerequisiteAdded:
+		state.Prerequisites = append(state.Prerequisites, e.PrerequisiteUID)
+	case TaskPrerequisiteRemoved:
+		prerequisites := []uuid.UUID{}
+		for _, v := range state.Prerequisites {
+			if v != e.PrerequisiteUID {
+				prerequisites = append(prerequisites, v)
+			}
+		}
+		state.Prerequisites = prerequisites
 	}
 
 	return nil
@@ -344,6 +753,104 @@ func validateTaskCategory(taskcategory string) error {
 	return nil
 }
 
+// validateTaskAssignable
+func validateTaskAssignable(t *Task, assigneeUID uuid.UUID) error {
+	if assigneeUID == (uuid.UUID{}) {
+		return TaskError{TaskErrorAssigneeEmptyCode}
+	}
+
+	if t.Status == TaskStatusCompleted || t.Status == TaskStatusCancelled {
+		return TaskError{TaskErrorTaskClosedCode}
+	}
+
+	return nil
+}
+
+// validatePrerequisite checks that the prerequisite task exists
+// and that depending on it doesn't create a dependency cycle
+func validatePrerequisite(taskService TaskService, taskUID uuid.UUID, prerequisiteUID uuid.UUID) error {
+	if prerequisiteUID == taskUID {
+		return TaskError{TaskErrorPrerequisiteCycleCode}
+	}
+
+	// Walk through the prerequisites of the prerequisite.
+	// If we find this task on the way, then the new dependency closes a cycle.
+	visited := map[uuid.UUID]bool{}
+	queue := []uuid.UUID{prerequisiteUID}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		task, err := findPrerequisiteTask(taskService, current)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range task.Prerequisites {
+			if v == taskUID {
+				return TaskError{TaskErrorPrerequisiteCycleCode}
+			}
+
+			queue = append(queue, v)
+		}
+	}
+
+	return nil
+}
+
+// validateMaterialConsumption checks that the material of the task has enough stock
+// for the quantity used. It returns the material which will be consumed, if any.
+func validateMaterialConsumption(taskService TaskService, domainDetails TaskDomain, quantity float32) (*uuid.UUID, error) {
+	if quantity < 0 {
+		return nil, TaskError{TaskErrorInvalidMaterialQuantityCode}
+	}
+
+	if quantity == 0 {
+		return nil, nil
+	}
+
+	materialID := getTaskDomainMaterialID(domainDetails)
+	if materialID == nil {
+		return nil, TaskError{TaskErrorMaterialNotSetCode}
+	}
+
+	serviceResult := taskService.FindMaterialByID(*materialID)
+	if serviceResult.Error != nil {
+		return nil, serviceResult.Error
+	}
+
+	material, ok := serviceResult.Result.(query.TaskMaterialQueryResult)
+	if !ok {
+		return nil, TaskError{TaskErrorInvalidInventoryIDCode}
+	}
+
+	if material.Quantity < quantity {
+		return nil, TaskError{TaskErrorMaterialInsufficientCode}
+	}
+
+	return materialID, nil
+}
+
+func findPrerequisiteTask(taskService TaskService, uid uuid.UUID) (query.TaskQueryResult, error) {
+	serviceResult := taskService.FindTaskByID(uid)
+	if serviceResult.Error != nil {
+		return query.TaskQueryResult{}, serviceResult.Error
+	}
+
+	task, ok := serviceResult.Result.(query.TaskQueryResult)
+	if !ok {
+		return query.TaskQueryResult{}, TaskError{TaskErrorInvalidPrerequisiteCode}
+	}
+
+	return task, nil
+}
+
 // validateAssetID
 func validateAssetID(taskService TaskService, assetid *uuid.UUID, taskdomain string) error {
 
@@ -69,6 +69,20 @@ func (d TaskDomainReservoir) Code() string {
 	return TaskDomainReservoirCode
 }
 
+// getTaskDomainMaterialID returns the material used by the task domain, if any
+func getTaskDomainMaterialID(d TaskDomain) *uuid.UUID {
+	switch v := d.(type) {
+	case TaskDomainArea:
+		return v.MaterialID
+	case TaskDomainCrop:
+		return v.MaterialID
+	case TaskDomainReservoir:
+		return v.MaterialID
+	}
+
+	return nil
+}
+
 // CreateTaskDomainArea
 func CreateTaskDomainArea(taskService TaskService, category string, materialID *uuid.UUID) (TaskDomainArea, error) {
 
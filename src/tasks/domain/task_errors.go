@@ -41,6 +41,41 @@ const (
 
 	// Task General Errors
 	TaskErrorTaskNotFoundCode
+
+	// Assignment Errors
+	TaskErrorAssigneeEmptyCode
+	TaskErrorAlreadyAssignedCode
+	TaskErrorNotAssignedCode
+	TaskErrorTaskClosedCode
+
+	// Comment Errors
+	TaskErrorCommentAuthorEmptyCode
+	TaskErrorCommentContentEmptyCode
+	TaskErrorCommentNotFoundCode
+
+	// Checklist Errors
+	TaskErrorChecklistItemNameEmptyCode
+	TaskErrorChecklistItemNotFoundCode
+	TaskErrorChecklistItemAlreadyCompletedCode
+	TaskErrorChecklistItemNotCompletedCode
+
+	// Attachment Errors
+	TaskErrorAttachmentInvalidFilenameCode
+	TaskErrorAttachmentInvalidMimeTypeCode
+	TaskErrorAttachmentInvalidSizeCode
+	TaskErrorAttachmentNotFoundCode
+
+	// Prerequisite Errors
+	TaskErrorInvalidPrerequisiteCode
+	TaskErrorPrerequisiteAlreadyExistsCode
+	TaskErrorPrerequisiteNotFoundCode
+	TaskErrorPrerequisiteCycleCode
+	TaskErrorPrerequisitesOpenCode
+
+	// Material Consumption Errors
+	TaskErrorInvalidMaterialQuantityCode
+	TaskErrorMaterialNotSetCode
+	TaskErrorMaterialInsufficientCode
 )
 
 // TaskError is a custom error from Go built-in error
@@ -88,6 +123,52 @@ func (e TaskError) Error() string {
 		return "Task area reference is invalid."
 	case TaskErrorTaskNotFoundCode:
 		return "Task not found"
+	case TaskErrorAssigneeEmptyCode:
+		return "Task assignee is required."
+	case TaskErrorAlreadyAssignedCode:
+		return "Task is already assigned to this user."
+	case TaskErrorNotAssignedCode:
+		return "Task is not assigned to anyone."
+	case TaskErrorTaskClosedCode:
+		return "Task has already been completed or cancelled."
+	case TaskErrorCommentAuthorEmptyCode:
+		return "Comment author is required."
+	case TaskErrorCommentContentEmptyCode:
+		return "Comment content is required."
+	case TaskErrorCommentNotFoundCode:
+		return "Comment not found."
+	case TaskErrorChecklistItemNameEmptyCode:
+		return "Checklist item name is required."
+	case TaskErrorChecklistItemNotFoundCode:
+		return "Checklist item not found."
+	case TaskErrorChecklistItemAlreadyCompletedCode:
+		return "Checklist item has already been completed."
+	case TaskErrorChecklistItemNotCompletedCode:
+		return "Checklist item has not been completed yet."
+	case TaskErrorAttachmentInvalidFilenameCode:
+		return "Attachment filename is invalid."
+	case TaskErrorAttachmentInvalidMimeTypeCode:
+		return "Attachment mime type is invalid."
+	case TaskErrorAttachmentInvalidSizeCode:
+		return "Attachment size is invalid."
+	case TaskErrorAttachmentNotFoundCode:
+		return "Attachment not found."
+	case TaskErrorInvalidPrerequisiteCode:
+		return "Task prerequisite reference is invalid."
+	case TaskErrorPrerequisiteAlreadyExistsCode:
+		return "Task already has this prerequisite."
+	case TaskErrorPrerequisiteNotFoundCode:
+		return "Task prerequisite not found."
+	case TaskErrorPrerequisiteCycleCode:
+		return "Task prerequisite creates a dependency cycle."
+	case TaskErrorPrerequisitesOpenCode:
+		return "Task cannot be completed while its prerequisites are still open."
+	case TaskErrorInvalidMaterialQuantityCode:
+		return "Material quantity is invalid."
+	case TaskErrorMaterialNotSetCode:
+		return "Task doesn't have any material to consume."
+	case TaskErrorMaterialInsufficientCode:
+		return "Material stock is not sufficient for the quantity used."
 	default:
 		return "Unrecognized Task Error Code"
 	}
@@ -18,6 +18,19 @@ const (
 	TaskCompletedCode          = "TaskCompleted"
 	TaskCancelledCode          = "TaskCancelled"
 	TaskDueCode                = "TaskDue"
+	TaskAssignedCode           = "TaskAssigned"
+	TaskUnassignedCode         = "TaskUnassigned"
+	TaskReassignedCode         = "TaskReassigned"
+
+	TaskCommentAddedCode             = "TaskCommentAdded"
+	TaskChecklistItemAddedCode       = "TaskChecklistItemAdded"
+	TaskChecklistItemCompletedCode   = "TaskChecklistItemCompleted"
+	TaskChecklistItemUncompletedCode = "TaskChecklistItemUncompleted"
+	TaskChecklistItemRemovedCode     = "TaskChecklistItemRemoved"
+	TaskAttachmentAddedCode          = "TaskAttachmentAdded"
+	TaskAttachmentRemovedCode        = "TaskAttachmentRemoved"
+	TaskPrerequisiteAddedCode        = "TaskPrerequisiteAdded"
+	TaskPrerequisiteRemovedCode      = "TaskPrerequisiteRemoved"
 )
 
 type TaskCreated struct {
@@ -71,9 +84,11 @@ type TaskAssetIDChanged struct {
 }
 
 type TaskCompleted struct {
-	UID           uuid.UUID  `json:"uid"`
-	Status        string     `json:"status"`
-	CompletedDate *time.Time `json:"completed_date"`
+	UID              uuid.UUID  `json:"uid"`
+	Status           string     `json:"status"`
+	CompletedDate    *time.Time `json:"completed_date"`
+	MaterialUID      *uuid.UUID `json:"material_uid"`
+	MaterialQuantity float32    `json:"material_quantity"`
 }
 
 type TaskCancelled struct {
@@ -85,3 +100,77 @@ type TaskCancelled struct {
 type TaskDue struct {
 	UID uuid.UUID `json:"uid"`
 }
+
+type TaskAssigned struct {
+	UID          uuid.UUID `json:"uid"`
+	AssigneeUID  uuid.UUID `json:"assignee_uid"`
+	AssignedDate time.Time `json:"assigned_date"`
+}
+
+type TaskUnassigned struct {
+	UID                 uuid.UUID `json:"uid"`
+	PreviousAssigneeUID uuid.UUID `json:"previous_assignee_uid"`
+}
+
+type TaskReassigned struct {
+	UID                 uuid.UUID `json:"uid"`
+	PreviousAssigneeUID uuid.UUID `json:"previous_assignee_uid"`
+	AssigneeUID         uuid.UUID `json:"assignee_uid"`
+	AssignedDate        time.Time `json:"assigned_date"`
+}
+
+type TaskCommentAdded struct {
+	UID         uuid.UUID  `json:"uid"`
+	CommentUID  uuid.UUID  `json:"comment_uid"`
+	ParentUID   *uuid.UUID `json:"parent_uid"`
+	AuthorUID   uuid.UUID  `json:"author_uid"`
+	Content     string     `json:"content"`
+	CreatedDate time.Time  `json:"created_date"`
+}
+
+type TaskChecklistItemAdded struct {
+	UID         uuid.UUID `json:"uid"`
+	ItemUID     uuid.UUID `json:"item_uid"`
+	Name        string    `json:"name"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
+type TaskChecklistItemCompleted struct {
+	UID           uuid.UUID  `json:"uid"`
+	ItemUID       uuid.UUID  `json:"item_uid"`
+	CompletedDate *time.Time `json:"completed_date"`
+}
+
+type TaskChecklistItemUncompleted struct {
+	UID     uuid.UUID `json:"uid"`
+	ItemUID uuid.UUID `json:"item_uid"`
+}
+
+type TaskChecklistItemRemoved struct {
+	UID     uuid.UUID `json:"uid"`
+	ItemUID uuid.UUID `json:"item_uid"`
+}
+
+type TaskAttachmentAdded struct {
+	UID           uuid.UUID `json:"uid"`
+	AttachmentUID uuid.UUID `json:"attachment_uid"`
+	Filename      string    `json:"filename"`
+	MimeType      string    `json:"mime_type"`
+	Size          int       `json:"size"`
+	CreatedDate   time.Time `json:"created_date"`
+}
+
+type TaskAttachmentRemoved struct {
+	UID           uuid.UUID `json:"uid"`
+	AttachmentUID uuid.UUID `json:"attachment_uid"`
+}
+
+type TaskPrerequisiteAdded struct {
+	UID             uuid.UUID `json:"uid"`
+	PrerequisiteUID uuid.UUID `json:"prerequisite_uid"`
+}
+
+type TaskPrerequisiteRemoved struct {
+	UID             uuid.UUID `json:"uid"`
+	PrerequisiteUID uuid.UUID `json:"prerequisite_uid"`
+}
@@ -29,6 +29,10 @@ func (m TaskServiceMock) FindReservoirByID(uid uuid.UUID) ServiceResult {
 	args := m.Called(uid)
 	return args.Get(0).(ServiceResult)
 }
+func (m TaskServiceMock) FindTaskByID(uid uuid.UUID) ServiceResult {
+	args := m.Called(uid)
+	return args.Get(0).(ServiceResult)
+}
 
 func TestCreateTask(t *testing.T) {
 	taskServiceMock := new(TaskServiceMock)
@@ -112,3 +116,224 @@ func TestCreateTask(t *testing.T) {
 
 	assert.Equal(t, TaskError{TaskErrorInvalidAssetIDCode}, err)
 }
+
+func TestAssignTask(t *testing.T) {
+	taskServiceMock := new(TaskServiceMock)
+
+	taskdomain, _ := CreateTaskDomainGeneral()
+	task, err := CreateTask(
+		taskServiceMock, "My Task", "My Description", nil, "NORMAL", taskdomain, "SANITATION", nil)
+	assert.Nil(t, err)
+
+	userUID, _ := uuid.NewV4()
+	otherUserUID, _ := uuid.NewV4()
+
+	// empty assignee
+	_, err = task.AssignTask(taskServiceMock, uuid.UUID{})
+	assert.Equal(t, TaskError{TaskErrorAssigneeEmptyCode}, err)
+
+	// reassign an unassigned task
+	_, err = task.ReassignTask(taskServiceMock, userUID)
+	assert.Equal(t, TaskError{TaskErrorNotAssignedCode}, err)
+
+	// assign
+	_, err = task.AssignTask(taskServiceMock, userUID)
+	assert.Nil(t, err)
+	assert.Equal(t, userUID, *task.AssigneeUID)
+
+	// assign an assigned task
+	_, err = task.AssignTask(taskServiceMock, otherUserUID)
+	assert.Equal(t, TaskError{TaskErrorAlreadyAssignedCode}, err)
+
+	// reassign to the same user
+	_, err = task.ReassignTask(taskServiceMock, userUID)
+	assert.Equal(t, TaskError{TaskErrorAlreadyAssignedCode}, err)
+
+	// reassign
+	_, err = task.ReassignTask(taskServiceMock, otherUserUID)
+	assert.Nil(t, err)
+	assert.Equal(t, otherUserUID, *task.AssigneeUID)
+
+	// unassign
+	_, err = task.UnassignTask(taskServiceMock)
+	assert.Nil(t, err)
+	assert.Nil(t, task.AssigneeUID)
+
+	_, err = task.UnassignTask(taskServiceMock)
+	assert.Equal(t, TaskError{TaskErrorNotAssignedCode}, err)
+
+	// completed task can't be assigned
+	task.CompleteTask(taskServiceMock, 0)
+	_, err = task.AssignTask(taskServiceMock, userUID)
+	assert.Equal(t, TaskError{TaskErrorTaskClosedCode}, err)
+
+	assert.Len(t, task.UncommittedChanges, 5)
+}
+
+func TestTaskChecklistAndComments(t *testing.T) {
+	taskServiceMock := new(TaskServiceMock)
+
+	taskdomain, _ := CreateTaskDomainGeneral()
+	task, err := CreateTask(
+		taskServiceMock, "My Task", "My Description", nil, "NORMAL", taskdomain, "SANITATION", nil)
+	assert.Nil(t, err)
+
+	userUID, _ := uuid.NewV4()
+	notExistUID, _ := uuid.NewV4()
+
+	// comments
+	_, err = task.AddComment(taskServiceMock, userUID, "", nil)
+	assert.Equal(t, TaskError{TaskErrorCommentContentEmptyCode}, err)
+
+	_, err = task.AddComment(taskServiceMock, userUID, "Reply", &notExistUID)
+	assert.Equal(t, TaskError{TaskErrorCommentNotFoundCode}, err)
+
+	_, err = task.AddComment(taskServiceMock, userUID, "First comment", nil)
+	assert.Nil(t, err)
+
+	parentUID := task.Comments[0].UID
+	_, err = task.AddComment(taskServiceMock, userUID, "Reply", &parentUID)
+	assert.Nil(t, err)
+	assert.Len(t, task.Comments, 2)
+	assert.Equal(t, parentUID, *task.Comments[1].ParentUID)
+
+	// checklist
+	_, err = task.AddChecklistItem(taskServiceMock, "")
+	assert.Equal(t, TaskError{TaskErrorChecklistItemNameEmptyCode}, err)
+
+	_, err = task.AddChecklistItem(taskServiceMock, "Sanitize tray")
+	assert.Nil(t, err)
+
+	itemUID := task.Checklist[0].UID
+
+	_, err = task.UncompleteChecklistItem(taskServiceMock, itemUID)
+	assert.Equal(t, TaskError{TaskErrorChecklistItemNotCompletedCode}, err)
+
+	_, err = task.CompleteChecklistItem(taskServiceMock, itemUID)
+	assert.Nil(t, err)
+	assert.True(t, task.Checklist[0].IsCompleted)
+	assert.NotNil(t, task.Checklist[0].CompletedDate)
+
+	_, err = task.CompleteChecklistItem(taskServiceMock, itemUID)
+	assert.Equal(t, TaskError{TaskErrorChecklistItemAlreadyCompletedCode}, err)
+
+	_, err = task.RemoveChecklistItem(taskServiceMock, notExistUID)
+	assert.Equal(t, TaskError{TaskErrorChecklistItemNotFoundCode}, err)
+
+	_, err = task.RemoveChecklistItem(taskServiceMock, itemUID)
+	assert.Nil(t, err)
+	assert.Len(t, task.Checklist, 0)
+
+	// attachments
+	_, err = task.AddAttachment(taskServiceMock, "manual.pdf", "application/pdf", 0)
+	assert.Equal(t, TaskError{TaskErrorAttachmentInvalidSizeCode}, err)
+
+	_, err = task.AddAttachment(taskServiceMock, "manual.pdf", "application/pdf", 1024)
+	assert.Nil(t, err)
+	assert.Len(t, task.Attachments, 1)
+
+	_, err = task.RemoveAttachment(taskServiceMock, task.Attachments[0].UID)
+	assert.Nil(t, err)
+	assert.Len(t, task.Attachments, 0)
+}
+
+func TestTaskPrerequisites(t *testing.T) {
+	taskServiceMock := new(TaskServiceMock)
+
+	taskdomain, _ := CreateTaskDomainGeneral()
+	task, err := CreateTask(
+		taskServiceMock, "My Task", "My Description", nil, "NORMAL", taskdomain, "SANITATION", nil)
+	assert.Nil(t, err)
+
+	prerequisiteUID, _ := uuid.NewV4()
+	dependentUID, _ := uuid.NewV4()
+	notExistUID, _ := uuid.NewV4()
+
+	taskServiceMock.On("FindTaskByID", prerequisiteUID).Return(ServiceResult{
+		Result: query.TaskQueryResult{UID: prerequisiteUID, Status: TaskStatusCreated},
+	}).Once()
+	taskServiceMock.On("FindTaskByID", dependentUID).Return(ServiceResult{
+		Result: query.TaskQueryResult{UID: dependentUID, Status: TaskStatusCreated, Prerequisites: []uuid.UUID{task.UID}},
+	})
+	taskServiceMock.On("FindTaskByID", notExistUID).Return(ServiceResult{
+		Error: TaskError{TaskErrorInvalidPrerequisiteCode},
+	})
+
+	// self dependency
+	_, err = task.AddPrerequisite(taskServiceMock, task.UID)
+	assert.Equal(t, TaskError{TaskErrorPrerequisiteCycleCode}, err)
+
+	// task doesn't exist
+	_, err = task.AddPrerequisite(taskServiceMock, notExistUID)
+	assert.Equal(t, TaskError{TaskErrorInvalidPrerequisiteCode}, err)
+
+	// dependent task already depends on this task
+	_, err = task.AddPrerequisite(taskServiceMock, dependentUID)
+	assert.Equal(t, TaskError{TaskErrorPrerequisiteCycleCode}, err)
+
+	_, err = task.AddPrerequisite(taskServiceMock, prerequisiteUID)
+	assert.Nil(t, err)
+	assert.Equal(t, []uuid.UUID{prerequisiteUID}, task.Prerequisites)
+
+	_, err = task.AddPrerequisite(taskServiceMock, prerequisiteUID)
+	assert.Equal(t, TaskError{TaskErrorPrerequisiteAlreadyExistsCode}, err)
+
+	// prerequisite is still open
+	taskServiceMock.On("FindTaskByID", prerequisiteUID).Return(ServiceResult{
+		Result: query.TaskQueryResult{UID: prerequisiteUID, Status: TaskStatusCreated},
+	}).Once()
+
+	err = task.CompleteTask(taskServiceMock, 0)
+	assert.Equal(t, TaskError{TaskErrorPrerequisitesOpenCode}, err)
+	assert.Equal(t, TaskStatusCreated, task.Status)
+
+	// prerequisite is completed
+	taskServiceMock.On("FindTaskByID", prerequisiteUID).Return(ServiceResult{
+		Result: query.TaskQueryResult{UID: prerequisiteUID, Status: TaskStatusCompleted},
+	}).Once()
+
+	err = task.CompleteTask(taskServiceMock, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, TaskStatusCompleted, task.Status)
+}
+
+func TestCompleteTaskWithMaterialConsumption(t *testing.T) {
+	taskServiceMock := new(TaskServiceMock)
+
+	materialID, _ := uuid.NewV4()
+	taskServiceMock.On("FindMaterialByID", materialID).Return(ServiceResult{
+		Result: query.TaskMaterialQueryResult{UID: materialID, Quantity: 5},
+	})
+
+	taskdomain, err := CreateTaskDomainReservoir(taskServiceMock, TaskCategoryNutrient, &materialID)
+	assert.Nil(t, err)
+
+	task, err := CreateTask(
+		taskServiceMock, "Add nutrient", "My Description", nil, "NORMAL", taskdomain, TaskCategoryNutrient, nil)
+	assert.Nil(t, err)
+
+	generalDomain, _ := CreateTaskDomainGeneral()
+	generalTask, err := CreateTask(
+		taskServiceMock, "My Task", "My Description", nil, "NORMAL", generalDomain, "SANITATION", nil)
+	assert.Nil(t, err)
+
+	// task without material
+	err = generalTask.CompleteTask(taskServiceMock, 1)
+	assert.Equal(t, TaskError{TaskErrorMaterialNotSetCode}, err)
+
+	err = task.CompleteTask(taskServiceMock, -1)
+	assert.Equal(t, TaskError{TaskErrorInvalidMaterialQuantityCode}, err)
+
+	err = task.CompleteTask(taskServiceMock, 6)
+	assert.Equal(t, TaskError{TaskErrorMaterialInsufficientCode}, err)
+	assert.Equal(t, TaskStatusCreated, task.Status)
+
+	err = task.CompleteTask(taskServiceMock, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, TaskStatusCompleted, task.Status)
+
+	event, ok := task.UncommittedChanges[len(task.UncommittedChanges)-1].(TaskCompleted)
+	assert.True(t, ok)
+	assert.Equal(t, materialID, *event.MaterialUID)
+	assert.Equal(t, float32(2), event.MaterialQuantity)
+}
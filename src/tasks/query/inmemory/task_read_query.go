@@ -123,7 +123,7 @@ func (s TaskReadQueryInMemory) FindTasksWithFilter(params map[string]string, pag
 											end_date, err := time.Parse(time.RFC3339Nano, end)
 
 											if err == nil {
-												if !checkWithinTimeRange(start_date, end_date, *val.DueDate) {
+												if val.DueDate == nil || !checkWithinTimeRange(start_date, end_date, *val.DueDate) {
 													is_match = false
 												}
 											}
@@ -135,6 +135,13 @@ func (s TaskReadQueryInMemory) FindTasksWithFilter(params map[string]string, pag
 					}
 				}
 			}
+			// Assignee
+			if value, _ := params["assignee"]; value != "" {
+				assigneeUID, _ := uuid.FromString(value)
+				if val.AssigneeUID == nil || *val.AssigneeUID != assigneeUID {
+					is_match = false
+				}
+			}
 			if is_match {
 				tasks = append(tasks, val)
 			}
@@ -231,7 +238,7 @@ func (s TaskReadQueryInMemory) CountTasksWithFilter(params map[string]string) <-
                       end_date, err := time.Parse(time.RFC3339Nano, end)
 
                       if err == nil {
-                        if !checkWithinTimeRange(start_date, end_date, *val.DueDate) {
+                        if val.DueDate == nil || !checkWithinTimeRange(start_date, end_date, *val.DueDate) {
                           is_match = false
                         }
                       }
@@ -243,6 +250,13 @@ func (s TaskReadQueryInMemory) CountTasksWithFilter(params map[string]string) <-
           }
         }
       }
+      // Assignee
+      if value, _ := params["assignee"]; value != "" {
+        assigneeUID, _ := uuid.FromString(value)
+        if val.AssigneeUID == nil || *val.AssigneeUID != assigneeUID {
+          is_match = false
+        }
+      }
       if is_match {
         tasks = append(tasks, val)
       }
@@ -36,6 +36,7 @@ type taskReadQueryResult struct {
 	Category             string
 	IsDue                int
 	AssetID              uuid.NullUUID
+	AssigneeUID          uuid.NullUUID
 }
 
 func (r TaskReadQueryMysql) FindAll(page, limit int) <-chan query.QueryResult {
@@ -145,6 +146,11 @@ func (s TaskReadQueryMysql) FindTasksWithFilter(params map[string]string, page,
 			sql += " AND ASSET_ID = ? "
 			args = append(args, assetID)
 		}
+		if value, _ := params["assignee"]; value != "" {
+			assigneeUID, _ := uuid.FromString(value)
+			sql += " AND ASSIGNEE_UID = ? "
+			args = append(args, assigneeUID.Bytes())
+		}
 
     if page != 0 && limit != 0 {
       sql += " LIMIT ? OFFSET ?"
@@ -237,6 +243,11 @@ func (q TaskReadQueryMysql) CountTasksWithFilter(params map[string]string) <-cha
       sql += " AND ASSET_ID = ? "
       args = append(args, assetID)
     }
+    if value, _ := params["assignee"]; value != "" {
+      assigneeUID, _ := uuid.FromString(value)
+      sql += " AND ASSIGNEE_UID = ? "
+      args = append(args, assigneeUID.Bytes())
+    }
 
     err := q.DB.QueryRow(sql, args...).Scan(&total)
     if err != nil {
@@ -257,7 +268,7 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		&rowsData.UID, &rowsData.Title, &rowsData.Description, &rowsData.CreatedDate,
 		&rowsData.DueDate, &rowsData.CompletedDate, &rowsData.CancelledDate,
 		&rowsData.Priority, &rowsData.Status, &rowsData.DomainCode, &rowsData.DomainDataMaterialID,
-		&rowsData.DomainDataAreaID, &rowsData.Category, &rowsData.IsDue, &rowsData.AssetID,
+		&rowsData.DomainDataAreaID, &rowsData.Category, &rowsData.IsDue, &rowsData.AssetID, &rowsData.AssigneeUID,
 	)
 
 	if err != nil {
@@ -304,12 +315,17 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		assetUID = &rowsData.AssetID.UUID
 	}
 
+	var assigneeUID *uuid.UUID
+	if rowsData.AssigneeUID.Valid {
+		assigneeUID = &rowsData.AssigneeUID.UUID
+	}
+
 	isDue := false
 	if rowsData.IsDue == 1 {
 		isDue = true
 	}
 
-	return storage.TaskRead{
+	taskRead := storage.TaskRead{
 		UID:           taskUID,
 		Title:         rowsData.Title,
 		Description:   rowsData.Description,
@@ -324,5 +340,203 @@ func (s TaskReadQueryMysql) populateQueryResult(rows *sql.Rows) (storage.TaskRea
 		Category:      rowsData.Category,
 		IsDue:         isDue,
 		AssetID:       assetUID,
-	}, nil
+		AssigneeUID:   assigneeUID,
+	}
+
+	err = s.populateTaskComments(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	err = s.populateTaskChecklist(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	err = s.populateTaskAttachments(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	err = s.populateTaskPrerequisites(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	return taskRead, nil
+}
+
+func (s TaskReadQueryMysql) populateTaskComments(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rowsData := struct {
+		UID         []byte
+		TaskUID     []byte
+		ParentUID   uuid.NullUUID
+		AuthorUID   []byte
+		Content     string
+		CreatedDate time.Time
+	}{}
+
+	rows, err := s.DB.Query(`SELECT * FROM TASK_READ_COMMENT WHERE TASK_UID = ? ORDER BY CREATED_DATE ASC`, uid.Bytes())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	comments := []storage.TaskComment{}
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.ParentUID,
+			&rowsData.AuthorUID, &rowsData.Content, &rowsData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		commentUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		var parentUID *uuid.UUID
+		if rowsData.ParentUID.Valid {
+			uid := rowsData.ParentUID.UUID
+			parentUID = &uid
+		}
+
+		authorUID, err := uuid.FromBytes(rowsData.AuthorUID)
+		if err != nil {
+			return err
+		}
+
+		comments = append(comments, storage.TaskComment{
+			UID:         commentUID,
+			ParentUID:   parentUID,
+			AuthorUID:   authorUID,
+			Content:     rowsData.Content,
+			CreatedDate: rowsData.CreatedDate,
+		})
+	}
+
+	taskRead.Comments = comments
+
+	return nil
+}
+
+func (s TaskReadQueryMysql) populateTaskChecklist(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rowsData := struct {
+		UID           []byte
+		TaskUID       []byte
+		Name          string
+		IsCompleted   int
+		CompletedDate *time.Time
+		CreatedDate   time.Time
+	}{}
+
+	rows, err := s.DB.Query(`SELECT * FROM TASK_READ_CHECKLIST WHERE TASK_UID = ? ORDER BY CREATED_DATE ASC`, uid.Bytes())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	checklist := []storage.TaskChecklistItem{}
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.Name,
+			&rowsData.IsCompleted, &rowsData.CompletedDate, &rowsData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		itemUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		checklist = append(checklist, storage.TaskChecklistItem{
+			UID:           itemUID,
+			Name:          rowsData.Name,
+			IsCompleted:   rowsData.IsCompleted == 1,
+			CompletedDate: rowsData.CompletedDate,
+			CreatedDate:   rowsData.CreatedDate,
+		})
+	}
+
+	taskRead.Checklist = checklist
+
+	return nil
+}
+
+func (s TaskReadQueryMysql) populateTaskAttachments(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rowsData := struct {
+		UID         []byte
+		TaskUID     []byte
+		Filename    string
+		MimeType    string
+		Size        int
+		CreatedDate time.Time
+	}{}
+
+	rows, err := s.DB.Query(`SELECT * FROM TASK_READ_ATTACHMENT WHERE TASK_UID = ? ORDER BY CREATED_DATE ASC`, uid.Bytes())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	attachments := []storage.TaskAttachment{}
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.Filename,
+			&rowsData.MimeType, &rowsData.Size, &rowsData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		attachmentUID, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		attachments = append(attachments, storage.TaskAttachment{
+			UID:         attachmentUID,
+			Filename:    rowsData.Filename,
+			MimeType:    rowsData.MimeType,
+			Size:        rowsData.Size,
+			CreatedDate: rowsData.CreatedDate,
+		})
+	}
+
+	taskRead.Attachments = attachments
+
+	return nil
+}
+
+func (s TaskReadQueryMysql) populateTaskPrerequisites(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rows, err := s.DB.Query(`SELECT PREREQUISITE_UID FROM TASK_READ_PREREQUISITE WHERE TASK_UID = ?`, uid.Bytes())
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	prerequisites := []uuid.UUID{}
+	for rows.Next() {
+		prerequisiteID := []byte{}
+
+		err = rows.Scan(&prerequisiteID)
+		if err != nil {
+			return err
+		}
+
+		prerequisiteUID, err := uuid.FromBytes(prerequisiteID)
+		if err != nil {
+			return err
+		}
+
+		prerequisites = append(prerequisites, prerequisiteUID)
+	}
+
+	taskRead.Prerequisites = prerequisites
+
+	return nil
 }
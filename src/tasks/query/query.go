@@ -75,6 +75,14 @@ type TaskMaterialQueryResult struct {
 	TypeCode         string    `json:"type"`
 	DetailedTypeCode string    `json:"detailed_type"`
 	Name             string    `json:"name"`
+	Quantity         float32   `json:"quantity"`
+	QuantityUnit     string    `json:"quantity_unit"`
+}
+
+type TaskQueryResult struct {
+	UID           uuid.UUID   `json:"uid"`
+	Status        string      `json:"status"`
+	Prerequisites []uuid.UUID `json:"prerequisites"`
 }
 
 type TaskReservoirQueryResult struct {
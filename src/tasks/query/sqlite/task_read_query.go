@@ -36,6 +36,7 @@ type taskReadQueryResult struct {
 	Category             string
 	IsDue                bool
 	AssetID              sql.NullString
+	AssigneeUID          sql.NullString
 }
 
 func (r TaskReadQuerySqlite) FindAll(page, limit int) <-chan query.QueryResult {
@@ -146,6 +147,11 @@ func (s TaskReadQuerySqlite) FindTasksWithFilter(params map[string]string, page,
 			sql += " AND ASSET_ID = ? "
 			args = append(args, assetID)
 		}
+		if value, _ := params["assignee"]; value != "" {
+			assigneeUID, _ := uuid.FromString(value)
+			sql += " AND ASSIGNEE_UID = ? "
+			args = append(args, assigneeUID)
+		}
 
     if page != 0 && limit != 0 {
       sql += " LIMIT ? OFFSET ?"
@@ -240,6 +246,11 @@ func (q TaskReadQuerySqlite) CountTasksWithFilter(params map[string]string) <-ch
       sql += " AND ASSET_ID = ? "
       args = append(args, assetID)
     }
+    if value, _ := params["assignee"]; value != "" {
+      assigneeUID, _ := uuid.FromString(value)
+      sql += " AND ASSIGNEE_UID = ? "
+      args = append(args, assigneeUID)
+    }
 
     err := q.DB.QueryRow(sql, args...).Scan(&total)
     if err != nil {
@@ -261,7 +272,7 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 		&rowsData.DueDate, &rowsData.CompletedDate, &rowsData.CancelledDate,
 		&rowsData.Priority, &rowsData.Status, &rowsData.DomainCode, &rowsData.DomainDataMaterialID,
 		&rowsData.DomainDataAreaID,
-		&rowsData.Category, &rowsData.IsDue, &rowsData.AssetID,
+		&rowsData.Category, &rowsData.IsDue, &rowsData.AssetID, &rowsData.AssigneeUID,
 	)
 
 	if err != nil {
@@ -375,7 +386,17 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 		assetUID = &uid
 	}
 
-	return storage.TaskRead{
+	var assigneeUID *uuid.UUID
+	if rowsData.AssigneeUID.Valid && rowsData.AssigneeUID.String != "" {
+		uid, err := uuid.FromString(rowsData.AssigneeUID.String)
+		if err != nil {
+			return storage.TaskRead{}, err
+		}
+
+		assigneeUID = &uid
+	}
+
+	taskRead := storage.TaskRead{
 		UID:           taskUID,
 		Title:         rowsData.Title,
 		Description:   rowsData.Description,
@@ -390,5 +411,232 @@ func (s TaskReadQuerySqlite) populateQueryResult(rows *sql.Rows) (storage.TaskRe
 		Category:      rowsData.Category,
 		IsDue:         rowsData.IsDue,
 		AssetID:       assetUID,
-	}, nil
+		AssigneeUID:   assigneeUID,
+	}
+
+	err = s.populateTaskComments(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	err = s.populateTaskChecklist(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	err = s.populateTaskAttachments(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	err = s.populateTaskPrerequisites(taskUID, &taskRead)
+	if err != nil {
+		return storage.TaskRead{}, err
+	}
+
+	return taskRead, nil
+}
+
+func (s TaskReadQuerySqlite) populateTaskComments(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rowsData := struct {
+		UID         string
+		TaskUID     string
+		ParentUID   sql.NullString
+		AuthorUID   string
+		Content     string
+		CreatedDate string
+	}{}
+
+	rows, err := s.DB.Query(`SELECT * FROM TASK_READ_COMMENT WHERE TASK_UID = ? ORDER BY CREATED_DATE ASC`, uid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	comments := []storage.TaskComment{}
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.ParentUID,
+			&rowsData.AuthorUID, &rowsData.Content, &rowsData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		commentUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		var parentUID *uuid.UUID
+		if rowsData.ParentUID.Valid && rowsData.ParentUID.String != "" {
+			uid, err := uuid.FromString(rowsData.ParentUID.String)
+			if err != nil {
+				return err
+			}
+
+			parentUID = &uid
+		}
+
+		authorUID, err := uuid.FromString(rowsData.AuthorUID)
+		if err != nil {
+			return err
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return err
+		}
+
+		comments = append(comments, storage.TaskComment{
+			UID:         commentUID,
+			ParentUID:   parentUID,
+			AuthorUID:   authorUID,
+			Content:     rowsData.Content,
+			CreatedDate: createdDate,
+		})
+	}
+
+	taskRead.Comments = comments
+
+	return nil
+}
+
+func (s TaskReadQuerySqlite) populateTaskChecklist(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rowsData := struct {
+		UID           string
+		TaskUID       string
+		Name          string
+		IsCompleted   bool
+		CompletedDate sql.NullString
+		CreatedDate   string
+	}{}
+
+	rows, err := s.DB.Query(`SELECT * FROM TASK_READ_CHECKLIST WHERE TASK_UID = ? ORDER BY CREATED_DATE ASC`, uid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	checklist := []storage.TaskChecklistItem{}
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.Name,
+			&rowsData.IsCompleted, &rowsData.CompletedDate, &rowsData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		itemUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		var completedDate *time.Time
+		if rowsData.CompletedDate.Valid && rowsData.CompletedDate.String != "" {
+			d, err := time.Parse(time.RFC3339, rowsData.CompletedDate.String)
+			if err != nil {
+				return err
+			}
+
+			completedDate = &d
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return err
+		}
+
+		checklist = append(checklist, storage.TaskChecklistItem{
+			UID:           itemUID,
+			Name:          rowsData.Name,
+			IsCompleted:   rowsData.IsCompleted,
+			CompletedDate: completedDate,
+			CreatedDate:   createdDate,
+		})
+	}
+
+	taskRead.Checklist = checklist
+
+	return nil
+}
+
+func (s TaskReadQuerySqlite) populateTaskAttachments(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rowsData := struct {
+		UID         string
+		TaskUID     string
+		Filename    string
+		MimeType    string
+		Size        int
+		CreatedDate string
+	}{}
+
+	rows, err := s.DB.Query(`SELECT * FROM TASK_READ_ATTACHMENT WHERE TASK_UID = ? ORDER BY CREATED_DATE ASC`, uid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	attachments := []storage.TaskAttachment{}
+	for rows.Next() {
+		err = rows.Scan(
+			&rowsData.UID, &rowsData.TaskUID, &rowsData.Filename,
+			&rowsData.MimeType, &rowsData.Size, &rowsData.CreatedDate,
+		)
+		if err != nil {
+			return err
+		}
+
+		attachmentUID, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return err
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return err
+		}
+
+		attachments = append(attachments, storage.TaskAttachment{
+			UID:         attachmentUID,
+			Filename:    rowsData.Filename,
+			MimeType:    rowsData.MimeType,
+			Size:        rowsData.Size,
+			CreatedDate: createdDate,
+		})
+	}
+
+	taskRead.Attachments = attachments
+
+	return nil
+}
+
+func (s TaskReadQuerySqlite) populateTaskPrerequisites(uid uuid.UUID, taskRead *storage.TaskRead) error {
+	rows, err := s.DB.Query(`SELECT PREREQUISITE_UID FROM TASK_READ_PREREQUISITE WHERE TASK_UID = ?`, uid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	prerequisites := []uuid.UUID{}
+	for rows.Next() {
+		prerequisiteID := ""
+
+		err = rows.Scan(&prerequisiteID)
+		if err != nil {
+			return err
+		}
+
+		prerequisiteUID, err := uuid.FromString(prerequisiteID)
+		if err != nil {
+			return err
+		}
+
+		prerequisites = append(prerequisites, prerequisiteUID)
+	}
+
+	taskRead.Prerequisites = prerequisites
+
+	return nil
 }
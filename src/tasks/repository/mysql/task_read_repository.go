@@ -32,16 +32,21 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 			}
 		}
 
+		var assigneeUID []byte
+		if taskRead.AssigneeUID != nil {
+			assigneeUID = taskRead.AssigneeUID.Bytes()
+		}
+
 		res, err := f.DB.Exec(`UPDATE TASK_READ SET
 			TITLE = ?, DESCRIPTION = ?, CREATED_DATE = ?, DUE_DATE = ?,
 			COMPLETED_DATE = ?, CANCELLED_DATE = ?, PRIORITY = ?, STATUS = ?,
 			DOMAIN_CODE = ?, DOMAIN_DATA_MATERIAL_ID = ?, DOMAIN_DATA_AREA_ID = ?,
-			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?
+			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?, ASSIGNEE_UID = ?
 			WHERE UID = ?`,
 			taskRead.Title, taskRead.Description, taskRead.CreatedDate, taskRead.DueDate,
 			taskRead.CompletedDate, taskRead.CancelledDate, taskRead.Priority, taskRead.Status,
 			taskRead.Domain, domainDataMaterialID, domainDataAreaID,
-			taskRead.Category, taskRead.IsDue, taskRead.AssetID.Bytes(),
+			taskRead.Category, taskRead.IsDue, taskRead.AssetID.Bytes(), assigneeUID,
 			taskRead.UID.Bytes())
 
 		if err != nil {
@@ -60,12 +65,81 @@ func (f *TaskReadRepositoryMysql) Save(taskRead *storage.TaskRead) <-chan error
 			_, err := f.DB.Exec(`INSERT INTO TASK_READ (
 				UID, TITLE, DESCRIPTION, CREATED_DATE, DUE_DATE,
 				COMPLETED_DATE, CANCELLED_DATE, PRIORITY, STATUS,
-				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID)
+				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID, ASSIGNEE_UID)
 				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				taskRead.UID.Bytes(), taskRead.Title, taskRead.Description, taskRead.CreatedDate, taskRead.DueDate,
 				taskRead.CompletedDate, taskRead.CancelledDate, taskRead.Priority, taskRead.Status,
 				taskRead.Domain, domainDataMaterialID, domainDataAreaID,
-				taskRead.Category, taskRead.IsDue, taskRead.AssetID.Bytes())
+				taskRead.Category, taskRead.IsDue, taskRead.AssetID.Bytes(), assigneeUID)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// Comments, checklist and attachments are rewritten on every save,
+		// so removed items are removed from the read model as well
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_COMMENT WHERE TASK_UID = ?`, taskRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Comments {
+			var parentUID []byte
+			if v.ParentUID != nil {
+				parentUID = v.ParentUID.Bytes()
+			}
+
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_COMMENT (
+				UID, TASK_UID, PARENT_UID, AUTHOR_UID, CONTENT, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				v.UID.Bytes(), taskRead.UID.Bytes(), parentUID, v.AuthorUID.Bytes(), v.Content, v.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_CHECKLIST WHERE TASK_UID = ?`, taskRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Checklist {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_CHECKLIST (
+				UID, TASK_UID, NAME, IS_COMPLETED, COMPLETED_DATE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				v.UID.Bytes(), taskRead.UID.Bytes(), v.Name, v.IsCompleted, v.CompletedDate, v.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_ATTACHMENT WHERE TASK_UID = ?`, taskRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Attachments {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_ATTACHMENT (
+				UID, TASK_UID, FILENAME, MIMETYPE, SIZE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				v.UID.Bytes(), taskRead.UID.Bytes(), v.Filename, v.MimeType, v.Size, v.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_PREREQUISITE WHERE TASK_UID = ?`, taskRead.UID.Bytes())
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Prerequisites {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_PREREQUISITE (TASK_UID, PREREQUISITE_UID)
+				VALUES (?, ?)`, taskRead.UID.Bytes(), v.Bytes())
 
 			if err != nil {
 				result <- err
@@ -55,12 +55,12 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			TITLE = ?, DESCRIPTION = ?, CREATED_DATE = ?, DUE_DATE = ?,
 			COMPLETED_DATE = ?, CANCELLED_DATE = ?, PRIORITY = ?, STATUS = ?,
 			DOMAIN_CODE = ?, DOMAIN_DATA_MATERIAL_ID = ?, DOMAIN_DATA_AREA_ID = ?,
-			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?
+			CATEGORY = ?, IS_DUE = ?, ASSET_ID = ?, ASSIGNEE_UID = ?
 			WHERE UID = ?`,
 			taskRead.Title, taskRead.Description, taskRead.CreatedDate.Format(time.RFC3339), dueDate,
 			completedDate, cancelledDate, taskRead.Priority, taskRead.Status,
 			taskRead.Domain, domainDataMaterialID, domainDataAreaID, taskRead.Category, taskRead.IsDue, taskRead.AssetID,
-			taskRead.UID)
+			taskRead.AssigneeUID, taskRead.UID)
 
 		if err != nil {
 			result <- err
@@ -78,11 +78,82 @@ func (f *TaskReadRepositorySqlite) Save(taskRead *storage.TaskRead) <-chan error
 			_, err := f.DB.Exec(`INSERT INTO TASK_READ (
 				UID, TITLE, DESCRIPTION, CREATED_DATE, DUE_DATE,
 				COMPLETED_DATE, CANCELLED_DATE, PRIORITY, STATUS,
-				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID)
-				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				DOMAIN_CODE, DOMAIN_DATA_MATERIAL_ID, DOMAIN_DATA_AREA_ID, CATEGORY, IS_DUE, ASSET_ID, ASSIGNEE_UID)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 				taskRead.UID, taskRead.Title, taskRead.Description, taskRead.CreatedDate.Format(time.RFC3339), dueDate,
 				completedDate, cancelledDate, taskRead.Priority, taskRead.Status,
-				taskRead.Domain, domainDataMaterialID, domainDataAreaID, taskRead.Category, taskRead.IsDue, taskRead.AssetID)
+				taskRead.Domain, domainDataMaterialID, domainDataAreaID, taskRead.Category, taskRead.IsDue, taskRead.AssetID,
+				taskRead.AssigneeUID)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		// Comments, checklist and attachments are rewritten on every save,
+		// so removed items are removed from the read model as well
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_COMMENT WHERE TASK_UID = ?`, taskRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Comments {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_COMMENT (
+				UID, TASK_UID, PARENT_UID, AUTHOR_UID, CONTENT, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				v.UID, taskRead.UID, v.ParentUID, v.AuthorUID, v.Content, v.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_CHECKLIST WHERE TASK_UID = ?`, taskRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Checklist {
+			var itemCompletedDate *string
+			if v.CompletedDate != nil && !v.CompletedDate.IsZero() {
+				d := v.CompletedDate.Format(time.RFC3339)
+				itemCompletedDate = &d
+			}
+
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_CHECKLIST (
+				UID, TASK_UID, NAME, IS_COMPLETED, COMPLETED_DATE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				v.UID, taskRead.UID, v.Name, v.IsCompleted, itemCompletedDate, v.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_ATTACHMENT WHERE TASK_UID = ?`, taskRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Attachments {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_ATTACHMENT (
+				UID, TASK_UID, FILENAME, MIMETYPE, SIZE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				v.UID, taskRead.UID, v.Filename, v.MimeType, v.Size, v.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		_, err = f.DB.Exec(`DELETE FROM TASK_READ_PREREQUISITE WHERE TASK_UID = ?`, taskRead.UID)
+		if err != nil {
+			result <- err
+		}
+
+		for _, v := range taskRead.Prerequisites {
+			_, err := f.DB.Exec(`INSERT INTO TASK_READ_PREREQUISITE (TASK_UID, PREREQUISITE_UID)
+				VALUES (?, ?)`, taskRead.UID, v)
 
 			if err != nil {
 				result <- err
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+)
+
+const (
+	CalendarComponentEvent = "VEVENT"
+	CalendarComponentTodo  = "VTODO"
+
+	calendarDateFormat     = "20060102"
+	calendarDateTimeFormat = "20060102T150405Z"
+
+	// RFC 5545 recommends lines to be no longer than 75 octets, excluding the line break
+	calendarMaxLineLength = 75
+)
+
+// TaskCalendarDay groups the tasks which are due on the same day
+type TaskCalendarDay struct {
+	Date  string             `json:"date"`
+	Tasks []storage.TaskRead `json:"tasks"`
+}
+
+// GroupTasksByDueDay returns one entry for every day between start and end.
+// Tasks without due date are left out.
+func GroupTasksByDueDay(tasks []storage.TaskRead, start, end time.Time) []TaskCalendarDay {
+	days := []TaskCalendarDay{}
+	index := make(map[string]int)
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		index[date] = len(days)
+		days = append(days, TaskCalendarDay{Date: date, Tasks: []storage.TaskRead{}})
+	}
+
+	for _, v := range tasks {
+		if v.DueDate == nil {
+			continue
+		}
+
+		i, ok := index[v.DueDate.In(start.Location()).Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+
+		days[i].Tasks = append(days[i].Tasks, v)
+	}
+
+	return days
+}
+
+// BuildTaskICalendar writes the tasks with due date as an RFC 5545 calendar.
+// The component should be either CalendarComponentEvent or CalendarComponentTodo.
+// Events are shown as all day events on the due date, which is the most supported
+// format for phone calendars. Todos keep the exact due time.
+func BuildTaskICalendar(tasks []storage.TaskRead, component string, stamp time.Time) string {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Tania//Tania Tasks//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Tania Tasks",
+	}
+
+	for _, v := range tasks {
+		if v.DueDate == nil {
+			continue
+		}
+
+		lines = append(lines,
+			"BEGIN:"+component,
+			"UID:"+v.UID.String()+"@tania",
+			"DTSTAMP:"+stamp.UTC().Format(calendarDateTimeFormat),
+			"CREATED:"+v.CreatedDate.UTC().Format(calendarDateTimeFormat),
+			"SUMMARY:"+escapeCalendarText(v.Title),
+		)
+
+		if v.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeCalendarText(v.Description))
+		}
+
+		if v.Category != "" {
+			lines = append(lines, "CATEGORIES:"+escapeCalendarText(v.Category))
+		}
+
+		lines = append(lines, "PRIORITY:"+calendarPriority(v.Priority))
+
+		switch component {
+		case CalendarComponentTodo:
+			lines = append(lines,
+				"DUE:"+v.DueDate.UTC().Format(calendarDateTimeFormat),
+				"STATUS:"+calendarTodoStatus(v.Status),
+			)
+
+			if v.CompletedDate != nil {
+				lines = append(lines, "COMPLETED:"+v.CompletedDate.UTC().Format(calendarDateTimeFormat))
+			}
+		default:
+			lines = append(lines,
+				"DTSTART;VALUE=DATE:"+v.DueDate.Format(calendarDateFormat),
+				"DTEND;VALUE=DATE:"+v.DueDate.AddDate(0, 0, 1).Format(calendarDateFormat),
+				"TRANSP:TRANSPARENT",
+			)
+
+			if v.Status == domain.TaskStatusCancelled {
+				lines = append(lines, "STATUS:CANCELLED")
+			}
+		}
+
+		lines = append(lines, "END:"+component)
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	var buffer bytes.Buffer
+	for _, v := range lines {
+		buffer.WriteString(foldCalendarLine(v))
+	}
+
+	return buffer.String()
+}
+
+func calendarPriority(priority string) string {
+	// 1 is the highest priority and 9 the lowest. 5 is the medium one.
+	if priority == domain.TaskPriorityUrgent {
+		return "1"
+	}
+
+	return "5"
+}
+
+func calendarTodoStatus(status string) string {
+	switch status {
+	case domain.TaskStatusCompleted:
+		return "COMPLETED"
+	case domain.TaskStatusCancelled:
+		return "CANCELLED"
+	default:
+		return "NEEDS-ACTION"
+	}
+}
+
+func escapeCalendarText(text string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+
+	return replacer.Replace(text)
+}
+
+// foldCalendarLine splits the long content lines and ends them with CRLF.
+// The continuation lines start with a single space.
+func foldCalendarLine(line string) string {
+	var buffer bytes.Buffer
+	length := 0
+
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if length+size > calendarMaxLineLength {
+			buffer.WriteString("\r\n ")
+			length = 1
+		}
+
+		buffer.WriteRune(r)
+		length += size
+	}
+
+	buffer.WriteString("\r\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,125 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupTasksByDueDay(t *testing.T) {
+	// Given
+	start := time.Date(2018, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2018, time.March, 3, 0, 0, 0, 0, time.UTC)
+
+	firstDue := time.Date(2018, time.March, 1, 9, 0, 0, 0, time.UTC)
+	lastDue := time.Date(2018, time.March, 3, 23, 0, 0, 0, time.UTC)
+	outsideDue := time.Date(2018, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	tasks := []storage.TaskRead{
+		{Title: "First", DueDate: &firstDue},
+		{Title: "No due date"},
+		{Title: "Last", DueDate: &lastDue},
+		{Title: "Outside", DueDate: &outsideDue},
+	}
+
+	// When
+	days := GroupTasksByDueDay(tasks, start, end)
+
+	// Then
+	assert.Len(t, days, 3)
+	assert.Equal(t, "2018-03-01", days[0].Date)
+	assert.Equal(t, "2018-03-02", days[1].Date)
+	assert.Equal(t, "2018-03-03", days[2].Date)
+
+	assert.Len(t, days[0].Tasks, 1)
+	assert.Equal(t, "First", days[0].Tasks[0].Title)
+	assert.NotNil(t, days[1].Tasks)
+	assert.Empty(t, days[1].Tasks)
+	assert.Len(t, days[2].Tasks, 1)
+	assert.Equal(t, "Last", days[2].Tasks[0].Title)
+}
+
+func TestEscapeCalendarText(t *testing.T) {
+	assert.Equal(t, `Water\, feed\; prune`, escapeCalendarText("Water, feed; prune"))
+	assert.Equal(t, `C:\\tania`, escapeCalendarText(`C:\tania`))
+	assert.Equal(t, `first\nsecond\nthird`, escapeCalendarText("first\r\nsecond\nthird"))
+}
+
+func TestFoldCalendarLine(t *testing.T) {
+	// Short lines are only terminated with CRLF
+	assert.Equal(t, "SUMMARY:Water\r\n", foldCalendarLine("SUMMARY:Water"))
+
+	// Long lines are split at 75 octets and continued with a single space
+	line := "DESCRIPTION:" + strings.Repeat("a", 100)
+	folded := foldCalendarLine(line)
+
+	assert.True(t, strings.HasSuffix(folded, "\r\n"))
+
+	parts := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+	assert.Len(t, parts, 2)
+	assert.Len(t, parts[0], 75)
+	assert.True(t, strings.HasPrefix(parts[1], " "))
+	assert.Equal(t, line, parts[0]+strings.TrimPrefix(parts[1], " "))
+
+	// Multi-byte characters are never split across lines
+	line = "SUMMARY:" + strings.Repeat("é", 50)
+	folded = foldCalendarLine(line)
+
+	for _, v := range strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n") {
+		assert.True(t, len(v) <= 75)
+		assert.True(t, strings.HasPrefix(v, "SUMMARY:") || strings.HasPrefix(v, " "))
+	}
+	assert.Equal(t, line, strings.Replace(strings.TrimSuffix(folded, "\r\n"), "\r\n ", "", -1))
+}
+
+func TestBuildTaskICalendar(t *testing.T) {
+	// Given
+	taskUID, _ := uuid.NewV4()
+	created := time.Date(2018, time.February, 20, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2018, time.March, 1, 9, 30, 0, 0, time.UTC)
+	stamp := time.Date(2018, time.February, 25, 10, 0, 0, 0, time.UTC)
+
+	tasks := []storage.TaskRead{
+		{
+			UID:         taskUID,
+			Title:       "Water, then feed",
+			Description: "Use the\nnew hose",
+			Category:    "AREA",
+			Priority:    domain.TaskPriorityUrgent,
+			Status:      domain.TaskStatusCreated,
+			CreatedDate: created,
+			DueDate:     &due,
+		},
+		{Title: "No due date", CreatedDate: created},
+	}
+
+	// When
+	event := BuildTaskICalendar(tasks, CalendarComponentEvent, stamp)
+	todo := BuildTaskICalendar(tasks, CalendarComponentTodo, stamp)
+
+	// Then
+	assert.True(t, strings.HasPrefix(event, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(event, "END:VCALENDAR\r\n"))
+	assert.Equal(t, 1, strings.Count(event, "BEGIN:VEVENT\r\n"))
+	assert.Contains(t, event, "UID:"+taskUID.String()+"@tania\r\n")
+	assert.Contains(t, event, "DTSTAMP:20180225T100000Z\r\n")
+	assert.Contains(t, event, "CREATED:20180220T080000Z\r\n")
+	assert.Contains(t, event, `SUMMARY:Water\, then feed`+"\r\n")
+	assert.Contains(t, event, `DESCRIPTION:Use the\nnew hose`+"\r\n")
+	assert.Contains(t, event, "CATEGORIES:AREA\r\n")
+	assert.Contains(t, event, "PRIORITY:1\r\n")
+	assert.Contains(t, event, "DTSTART;VALUE=DATE:20180301\r\n")
+	assert.Contains(t, event, "DTEND;VALUE=DATE:20180302\r\n")
+	assert.NotContains(t, event, "No due date")
+	assert.NotContains(t, event, "VTODO")
+
+	assert.Equal(t, 1, strings.Count(todo, "BEGIN:VTODO\r\n"))
+	assert.Contains(t, todo, "DUE:20180301T093000Z\r\n")
+	assert.Contains(t, todo, "STATUS:NEEDS-ACTION\r\n")
+	assert.NotContains(t, todo, "DTSTART")
+}
@@ -21,6 +21,39 @@ func MapTaskToTaskRead(task *domain.Task) *storage.TaskRead {
 		Category:      task.Category,
 		IsDue:         task.IsDue,
 		AssetID:       task.AssetID,
+		AssigneeUID:   task.AssigneeUID,
+		Prerequisites: task.Prerequisites,
 	}
+
+	for _, v := range task.Comments {
+		taskRead.Comments = append(taskRead.Comments, storage.TaskComment{
+			UID:         v.UID,
+			ParentUID:   v.ParentUID,
+			AuthorUID:   v.AuthorUID,
+			Content:     v.Content,
+			CreatedDate: v.CreatedDate,
+		})
+	}
+
+	for _, v := range task.Checklist {
+		taskRead.Checklist = append(taskRead.Checklist, storage.TaskChecklistItem{
+			UID:           v.UID,
+			Name:          v.Name,
+			IsCompleted:   v.IsCompleted,
+			CompletedDate: v.CompletedDate,
+			CreatedDate:   v.CreatedDate,
+		})
+	}
+
+	for _, v := range task.Attachments {
+		taskRead.Attachments = append(taskRead.Attachments, storage.TaskAttachment{
+			UID:         v.UID,
+			Filename:    v.Filename,
+			MimeType:    v.MimeType,
+			Size:        v.Size,
+			CreatedDate: v.CreatedDate,
+		})
+	}
+
 	return taskRead
 }
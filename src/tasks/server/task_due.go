@@ -0,0 +1,68 @@
+package server
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/tasks/domain"
+	"github.com/Tanibox/tania-core/src/tasks/storage"
+	"github.com/labstack/gommon/log"
+)
+
+// DueInterval is how often the open tasks are checked for a passed due date
+const DueInterval = time.Minute
+
+// StartDueScheduler sets the open tasks as due once their due date has passed,
+// so the task due alerts don't wait for someone to set the task as due by hand.
+func (s *TaskServer) StartDueScheduler() {
+	go func() {
+		ticker := time.NewTicker(DueInterval)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			err := s.SetDueTasks(now)
+			if err != nil {
+				log.Error(err)
+			}
+		}
+	}()
+}
+
+// SetDueTasks sets the open tasks whose due date is before the date as due
+func (s *TaskServer) SetDueTasks(date time.Time) error {
+	params := map[string]string{
+		"is_due": "false",
+		"status": domain.TaskStatusCreated,
+	}
+
+	result := <-s.TaskReadQuery.FindTasksWithFilter(params, 0, 0)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	tasks, ok := result.Result.([]storage.TaskRead)
+	if !ok {
+		return errors.New("Internal server error. Error type assertion")
+	}
+
+	for _, v := range tasks {
+		if v.DueDate == nil || !v.DueDate.Before(date) {
+			continue
+		}
+
+		task, err := s.buildTaskFromID(v.UID)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		task.SetTaskAsDue(s.TaskService)
+
+		err = s.persistTask(task)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+
+	return nil
+}
@@ -3,13 +3,17 @@ package server
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Tanibox/tania-core/config"
 	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
 	"github.com/Tanibox/tania-core/src/eventbus"
 	cropstorage "github.com/Tanibox/tania-core/src/growth/storage"
+	"github.com/Tanibox/tania-core/src/helper/filehelper"
 	"github.com/Tanibox/tania-core/src/helper/paginationhelper"
+	"github.com/Tanibox/tania-core/src/helper/stringhelper"
 	"github.com/Tanibox/tania-core/src/helper/structhelper"
 	"github.com/Tanibox/tania-core/src/tasks/domain"
 	service "github.com/Tanibox/tania-core/src/tasks/domain/service"
@@ -23,6 +27,7 @@ import (
 	repoSqlite "github.com/Tanibox/tania-core/src/tasks/repository/sqlite"
 	"github.com/Tanibox/tania-core/src/tasks/storage"
 	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -34,6 +39,7 @@ type TaskServer struct {
 	TaskReadQuery  query.TaskReadQuery
 	TaskService    domain.TaskService
 	EventBus       eventbus.TaniaEventBus
+	File           filehelper.File
 }
 
 // NewTaskServer initializes TaskServer's dependencies and create new TaskServer struct
@@ -49,6 +55,7 @@ func NewTaskServer(
 
 	taskServer := &TaskServer{
 		EventBus: bus,
+		File:     filehelper.LocalFile{},
 	}
 
 	switch *config.Config.TaniaPersistenceEngine {
@@ -69,6 +76,7 @@ func NewTaskServer(
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			TaskReadQuery:  taskServer.TaskReadQuery,
 		}
 
 	case config.DB_SQLITE:
@@ -88,6 +96,7 @@ func NewTaskServer(
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			TaskReadQuery:  taskServer.TaskReadQuery,
 		}
 
 	case config.DB_MYSQL:
@@ -107,6 +116,7 @@ func NewTaskServer(
 			AreaQuery:      areaQuery,
 			MaterialQuery:  materialReadQuery,
 			ReservoirQuery: reservoirQuery,
+			TaskReadQuery:  taskServer.TaskReadQuery,
 		}
 
 	}
@@ -128,6 +138,20 @@ func (s *TaskServer) InitSubscriber() {
 	s.EventBus.Subscribe(domain.TaskCancelledCode, s.SaveToTaskReadModel)
 	s.EventBus.Subscribe(domain.TaskCompletedCode, s.SaveToTaskReadModel)
 	s.EventBus.Subscribe(domain.TaskDueCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskAssignedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskReassignedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskUnassignedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskCommentAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemCompletedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemUncompletedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskChecklistItemRemovedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskAttachmentAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskAttachmentRemovedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskPrerequisiteAddedCode, s.SaveToTaskReadModel)
+	s.EventBus.Subscribe(domain.TaskPrerequisiteRemovedCode, s.SaveToTaskReadModel)
+
+	s.EventBus.SubscribeAsync("AlertTriggered", s.CreateAlertTask)
 }
 
 // Mount defines the TaskServer's endpoints with its handlers
@@ -136,15 +160,36 @@ func (s *TaskServer) Mount(g *echo.Group) {
 
 	g.GET("", s.FindAllTasks)
 	g.GET("/search", s.FindFilteredTasks)
+	g.GET("/mine", s.FindMyTasks)
+	g.GET("/calendar", s.GetTaskCalendar)
 	g.GET("/:id", s.FindTaskByID)
 	g.PUT("/:id", s.UpdateTask)
 	g.PUT("/:id/cancel", s.CancelTask)
 	g.PUT("/:id/complete", s.CompleteTask)
+	g.PUT("/:id/assign", s.AssignTask)
+	g.PUT("/:id/unassign", s.UnassignTask)
+	g.POST("/:id/comments", s.AddTaskComment)
+	g.POST("/:id/checklist", s.AddTaskChecklistItem)
+	g.PUT("/:id/checklist/:item_id/complete", s.CompleteTaskChecklistItem)
+	g.PUT("/:id/checklist/:item_id/uncomplete", s.UncompleteTaskChecklistItem)
+	g.DELETE("/:id/checklist/:item_id", s.RemoveTaskChecklistItem)
+	g.POST("/:id/attachments", s.UploadTaskAttachment)
+	g.GET("/:id/attachments/:attachment_id", s.GetTaskAttachment)
+	g.DELETE("/:id/attachments/:attachment_id", s.RemoveTaskAttachment)
+	g.POST("/:id/prerequisites", s.AddTaskPrerequisite)
+	g.DELETE("/:id/prerequisites/:prerequisite_id", s.RemoveTaskPrerequisite)
 	// As we don't have an async task right now to check for Due state,
 	// I'm adding a rest call to be able to manually do that. We can remove it in the future
 	g.PUT("/:id/due", s.SetTaskAsDue)
 }
 
+// MountFeed defines the read-only calendar feeds under the tasks path of the API group.
+// Calendar applications can't send the Authorization header, so these endpoints
+// are added with their own middlewares, which authenticate the user's calendar token instead.
+func (s *TaskServer) MountFeed(g *echo.Group, m ...echo.MiddlewareFunc) {
+	g.GET("/tasks/calendar.ics", s.GetTaskICalendar, m...)
+}
+
 func (s TaskServer) FindAllTasks(c echo.Context) error {
 	data := make(map[string]interface{})
 
@@ -169,6 +214,7 @@ func (s TaskServer) FindAllTasks(c echo.Context) error {
 	taskList := []storage.TaskRead{}
 	for _, v := range tasks {
 		s.AppendTaskDomainDetails(&v)
+		s.AppendTaskBlockedStatus(&v)
 		taskList = append(taskList, v)
 	}
 
@@ -191,8 +237,116 @@ func (s TaskServer) FindAllTasks(c echo.Context) error {
 }
 
 func (s TaskServer) FindFilteredTasks(c echo.Context) error {
+	return s.findTasksWithFilter(c, taskFilterParams(c))
+}
+
+// FindMyTasks lists the tasks assigned to the authenticated user
+func (s TaskServer) FindMyTasks(c echo.Context) error {
+	userUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	queryparams := taskFilterParams(c)
+	queryparams["assignee"] = userUID.String()
+
+	return s.findTasksWithFilter(c, queryparams)
+}
+
+// GetTaskCalendar lists the tasks due between `start` and `end` (YYYY-MM-DD, inclusive) grouped by day.
+// It accepts the same filters as FindFilteredTasks.
+func (s TaskServer) GetTaskCalendar(c echo.Context) error {
 	data := make(map[string]interface{})
 
+	start, end, err := parseCalendarRange(c.QueryParam("start"), c.QueryParam("end"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryparams := taskFilterParams(c)
+	queryparams["due_start"] = start.Format(time.RFC3339Nano)
+	queryparams["due_end"] = end.AddDate(0, 0, 1).Add(-time.Nanosecond).Format(time.RFC3339Nano)
+
+	result := <-s.TaskReadQuery.FindTasksWithFilter(queryparams, 0, 0)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	tasks, ok := result.Result.([]storage.TaskRead)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	taskList := []storage.TaskRead{}
+	for _, v := range tasks {
+		s.AppendTaskDomainDetails(&v)
+		s.AppendTaskBlockedStatus(&v)
+		taskList = append(taskList, v)
+	}
+
+	data["data"] = GroupTasksByDueDay(taskList, start, end)
+	data["start"] = start.Format("2006-01-02")
+	data["end"] = end.Format("2006-01-02")
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// GetTaskICalendar exports the tasks with due date as an iCalendar feed,
+// so they can be subscribed from calendar applications.
+// It accepts the same filters as FindFilteredTasks and `component` (event or todo).
+func (s TaskServer) GetTaskICalendar(c echo.Context) error {
+	component := CalendarComponentEvent
+	switch c.QueryParam("component") {
+	case "", "event":
+	case "todo":
+		component = CalendarComponentTodo
+	default:
+		return Error(c, NewRequestValidationError(INVALID_OPTION, "component"))
+	}
+
+	result := <-s.TaskReadQuery.FindTasksWithFilter(taskFilterParams(c), 0, 0)
+	if result.Error != nil {
+		return Error(c, result.Error)
+	}
+
+	tasks, ok := result.Result.([]storage.TaskRead)
+	if !ok {
+		return echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, "inline; filename=\"tasks.ics\"")
+
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", []byte(BuildTaskICalendar(tasks, component, time.Now())))
+}
+
+func parseCalendarRange(start, end string) (time.Time, time.Time, error) {
+	if start == "" {
+		return time.Time{}, time.Time{}, NewRequestValidationError(REQUIRED, "start")
+	}
+
+	if end == "" {
+		return time.Time{}, time.Time{}, NewRequestValidationError(REQUIRED, "end")
+	}
+
+	startDate, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return time.Time{}, time.Time{}, NewRequestValidationError(PARSE_FAILED, "start")
+	}
+
+	endDate, err := time.Parse("2006-01-02", end)
+	if err != nil {
+		return time.Time{}, time.Time{}, NewRequestValidationError(PARSE_FAILED, "end")
+	}
+
+	// Keep the range to one year at most so the response stays small
+	if endDate.Before(startDate) || endDate.After(startDate.AddDate(1, 0, 0)) {
+		return time.Time{}, time.Time{}, NewRequestValidationError(INVALID_OPTION, "end")
+	}
+
+	return startDate, endDate, nil
+}
+
+func taskFilterParams(c echo.Context) map[string]string {
 	queryparams := make(map[string]string)
 	queryparams["is_due"] = c.QueryParam("is_due")
 	queryparams["priority"] = c.QueryParam("priority")
@@ -202,6 +356,13 @@ func (s TaskServer) FindFilteredTasks(c echo.Context) error {
 	queryparams["category"] = c.QueryParam("category")
 	queryparams["due_start"] = c.QueryParam("due_start")
 	queryparams["due_end"] = c.QueryParam("due_end")
+	queryparams["assignee"] = c.QueryParam("assignee")
+
+	return queryparams
+}
+
+func (s TaskServer) findTasksWithFilter(c echo.Context, queryparams map[string]string) error {
+	data := make(map[string]interface{})
 
 	page := c.QueryParam("page")
 	limit := c.QueryParam("limit")
@@ -225,6 +386,7 @@ func (s TaskServer) FindFilteredTasks(c echo.Context) error {
 	taskList := []storage.TaskRead{}
 	for _, v := range tasks {
 		s.AppendTaskDomainDetails(&v)
+		s.AppendTaskBlockedStatus(&v)
 		taskList = append(taskList, v)
 	}
 
@@ -293,6 +455,21 @@ func (s *TaskServer) SaveTask(c echo.Context) error {
 		return Error(c, err)
 	}
 
+	prerequisites := c.FormValue("prerequisites")
+	if len(prerequisites) != 0 {
+		for _, v := range strings.Split(prerequisites, ",") {
+			prerequisiteUID, err := uuid.FromString(strings.TrimSpace(v))
+			if err != nil {
+				return Error(c, NewRequestValidationError(PARSE_FAILED, "prerequisites"))
+			}
+
+			_, err = task.AddPrerequisite(s.TaskService, prerequisiteUID)
+			if err != nil {
+				return Error(c, err)
+			}
+		}
+	}
+
 	err = <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
 	if err != nil {
 		return Error(c, err)
@@ -303,6 +480,7 @@ func (s *TaskServer) SaveTask(c echo.Context) error {
 
 	taskRead := MapTaskToTaskRead(task)
 	s.AppendTaskDomainDetails(taskRead)
+	s.AppendTaskBlockedStatus(taskRead)
 
 	data["data"] = *taskRead
 
@@ -403,11 +581,39 @@ func (s *TaskServer) FindTaskByID(c echo.Context) error {
 	}
 
 	s.AppendTaskDomainDetails(&task)
+	s.AppendTaskBlockedStatus(&task)
 
 	data["task"] = task
 	return c.JSON(http.StatusOK, data)
 }
 
+// AppendTaskBlockedStatus marks the task as blocked
+// when it is still open and one of its prerequisites is still open too
+func (s *TaskServer) AppendTaskBlockedStatus(task *storage.TaskRead) {
+	task.IsBlocked = false
+
+	if task.Status != domain.TaskStatusCreated {
+		return
+	}
+
+	for _, v := range task.Prerequisites {
+		result := <-s.TaskReadQuery.FindByID(v)
+		if result.Error != nil {
+			continue
+		}
+
+		prerequisite, ok := result.Result.(storage.TaskRead)
+		if !ok {
+			continue
+		}
+
+		if prerequisite.UID == v && prerequisite.Status == domain.TaskStatusCreated {
+			task.IsBlocked = true
+			return
+		}
+	}
+}
+
 func (s *TaskServer) AppendTaskDomainDetails(task *storage.TaskRead) error {
 
 	switch task.Domain {
@@ -544,6 +750,7 @@ func (s *TaskServer) UpdateTask(c echo.Context) error {
 	read := MapTaskToTaskRead(updatedTask)
 
 	s.AppendTaskDomainDetails(read)
+	s.AppendTaskBlockedStatus(read)
 
 	data["data"] = *read
 
@@ -645,6 +852,7 @@ func (s *TaskServer) CancelTask(c echo.Context) error {
 	read := MapTaskToTaskRead(updatedTask)
 
 	s.AppendTaskDomainDetails(read)
+	s.AppendTaskBlockedStatus(read)
 
 	data["data"] = *read
 
@@ -690,7 +898,19 @@ func (s *TaskServer) CompleteTask(c echo.Context) error {
 		return Error(c, err)
 	}
 
-	updatedTask.CompleteTask(s.TaskService)
+	materialQuantity := float32(0)
+	if quantity := c.FormValue("material_quantity"); quantity != "" {
+		q, err := strconv.ParseFloat(quantity, 32)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "material_quantity"))
+		}
+		materialQuantity = float32(q)
+	}
+
+	err = updatedTask.CompleteTask(s.TaskService, materialQuantity)
+	if err != nil {
+		return Error(c, err)
+	}
 
 	// Save new TaskEvent
 	err = <-s.TaskEventRepo.Save(updatedTask.UID, updatedTask.Version, updatedTask.UncommittedChanges)
@@ -703,6 +923,7 @@ func (s *TaskServer) CompleteTask(c echo.Context) error {
 	read := MapTaskToTaskRead(updatedTask)
 
 	s.AppendTaskDomainDetails(read)
+	s.AppendTaskBlockedStatus(read)
 
 	data["data"] = *read
 
@@ -750,12 +971,447 @@ func (s *TaskServer) SetTaskAsDue(c echo.Context) error {
 	read := MapTaskToTaskRead(task)
 
 	s.AppendTaskDomainDetails(read)
+	s.AppendTaskBlockedStatus(read)
 
 	data["data"] = *read
 
 	return c.JSON(http.StatusOK, data)
 }
 
+// AssignTask assigns the task to the user given in `assignee_uid`.
+// If the task already has an assignee, it will be reassigned instead.
+func (s *TaskServer) AssignTask(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	assigneeValue := c.FormValue("assignee_uid")
+	if assigneeValue == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "assignee_uid"))
+	}
+
+	assigneeUID, err := uuid.FromString(assigneeValue)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "assignee_uid"))
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	if task.AssigneeUID == nil {
+		_, err = task.AssignTask(s.TaskService, assigneeUID)
+	} else {
+		_, err = task.ReassignTask(s.TaskService, assigneeUID)
+	}
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+// UnassignTask removes the assignee of the task
+func (s *TaskServer) UnassignTask(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.UnassignTask(s.TaskService)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+// AddTaskComment adds a comment from the authenticated user to the task.
+// Giving `parent_uid` makes the comment a reply of another comment.
+func (s *TaskServer) AddTaskComment(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	authorUID, ok := c.Get("USER_UID").(uuid.UUID)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"data": "Unauthorized"})
+	}
+
+	parentPtr := (*uuid.UUID)(nil)
+	if parentUID := c.FormValue("parent_uid"); parentUID != "" {
+		parent, err := uuid.FromString(parentUID)
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "parent_uid"))
+		}
+		parentPtr = &parent
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.AddComment(s.TaskService, authorUID, c.FormValue("content"), parentPtr)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+func (s *TaskServer) AddTaskChecklistItem(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.AddChecklistItem(s.TaskService, c.FormValue("name"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+func (s *TaskServer) CompleteTaskChecklistItem(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	itemUID, err := uuid.FromString(c.Param("item_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.CompleteChecklistItem(s.TaskService, itemUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+func (s *TaskServer) UncompleteTaskChecklistItem(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	itemUID, err := uuid.FromString(c.Param("item_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.UncompleteChecklistItem(s.TaskService, itemUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+func (s *TaskServer) RemoveTaskChecklistItem(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	itemUID, err := uuid.FromString(c.Param("item_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.RemoveChecklistItem(s.TaskService, itemUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+func (s *TaskServer) AddTaskPrerequisite(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	prerequisiteID := c.FormValue("prerequisite_uid")
+	if prerequisiteID == "" {
+		return Error(c, NewRequestValidationError(REQUIRED, "prerequisite_uid"))
+	}
+
+	prerequisiteUID, err := uuid.FromString(prerequisiteID)
+	if err != nil {
+		return Error(c, NewRequestValidationError(PARSE_FAILED, "prerequisite_uid"))
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.AddPrerequisite(s.TaskService, prerequisiteUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+func (s *TaskServer) RemoveTaskPrerequisite(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	prerequisiteUID, err := uuid.FromString(c.Param("prerequisite_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.RemovePrerequisite(s.TaskService, prerequisiteUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.saveTask(c, task)
+}
+
+// UploadTaskAttachment stores the uploaded `attachment` file
+// in the task upload path and attaches it to the task
+func (s *TaskServer) UploadTaskAttachment(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	attachment, err := c.FormFile("attachment")
+	if err != nil {
+		return Error(c, NewRequestValidationError(REQUIRED, "attachment"))
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Process //
+	_, err = task.AddAttachment(
+		s.TaskService,
+		attachment.Filename,
+		attachment.Header.Get("Content-Type"),
+		int(attachment.Size),
+	)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The file is stored under the attachment UID, so uploads with the same filename don't overwrite each other
+	attachmentUID := task.Attachments[len(task.Attachments)-1].UID
+
+	err = s.File.Upload(attachment, taskAttachmentPath(task.UID, attachmentUID))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The file would be orphaned if the task doesn't keep the attachment
+	err = s.persistTask(task)
+	if err != nil {
+		if errRemove := s.File.Remove(taskAttachmentPath(task.UID, attachmentUID)); errRemove != nil {
+			log.Error(errRemove)
+		}
+
+		return Error(c, err)
+	}
+
+	return s.taskResponse(c, task)
+}
+
+func (s *TaskServer) GetTaskAttachment(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	attachmentUID, err := uuid.FromString(c.Param("attachment_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Validate //
+	taskRead, err := s.getTaskReadFromID(uid)
+	if err != nil {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "id"))
+	}
+
+	found := storage.TaskAttachment{}
+	for _, v := range taskRead.Attachments {
+		if v.UID == attachmentUID {
+			found = v
+		}
+	}
+
+	if found == (storage.TaskAttachment{}) {
+		return Error(c, NewRequestValidationError(NOT_FOUND, "attachment_id"))
+	}
+
+	// Process //
+	// Attachments are uploaded by users, so they are always downloaded
+	// instead of being rendered in the origin of the API
+	if found.MimeType != "" {
+		c.Response().Header().Set(echo.HeaderContentType, found.MimeType)
+	}
+	c.Response().Header().Set(echo.HeaderXContentTypeOptions, "nosniff")
+
+	return c.Attachment(taskAttachmentPath(taskRead.UID, found.UID), found.Filename)
+}
+
+func (s *TaskServer) RemoveTaskAttachment(c echo.Context) error {
+	uid, err := uuid.FromString(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	attachmentUID, err := uuid.FromString(c.Param("attachment_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	task, err := s.buildTaskFromID(uid)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	_, err = task.RemoveAttachment(s.TaskService, attachmentUID)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// The file is only removed once the task no longer refers to it
+	err = s.persistTask(task)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = s.File.Remove(taskAttachmentPath(task.UID, attachmentUID))
+	if err != nil {
+		log.Error(err)
+	}
+
+	return s.taskResponse(c, task)
+}
+
+// taskAttachmentPath returns the path of the attachment file.
+// Attachments are grouped by task and stored under their own UID, the original filename is kept in the task.
+func taskAttachmentPath(taskUID, attachmentUID uuid.UUID) string {
+	return stringhelper.Join(*config.Config.UploadPathTask, "/", taskUID.String(), "/", attachmentUID.String())
+}
+
+// saveTask persists the uncommitted events of the task, publishes them
+// and responds with the updated task
+func (s *TaskServer) saveTask(c echo.Context, task *domain.Task) error {
+	err := s.persistTask(task)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	return s.taskResponse(c, task)
+}
+
+// persistTask saves the uncommitted events of the task and publishes them
+func (s *TaskServer) persistTask(task *domain.Task) error {
+	// Save new TaskEvent
+	err := <-s.TaskEventRepo.Save(task.UID, task.Version, task.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(task)
+
+	return nil
+}
+
+// taskResponse responds with the updated task
+func (s *TaskServer) taskResponse(c echo.Context, task *domain.Task) error {
+	data := make(map[string]storage.TaskRead)
+
+	read := MapTaskToTaskRead(task)
+
+	s.AppendTaskDomainDetails(read)
+	s.AppendTaskBlockedStatus(read)
+
+	data["data"] = *read
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// buildTaskFromID checks that the task exists in the read model
+// and rebuilds its aggregate from the event history
+func (s *TaskServer) buildTaskFromID(uid uuid.UUID) (*domain.Task, error) {
+	readResult := <-s.TaskReadQuery.FindByID(uid)
+	if readResult.Error != nil {
+		return nil, readResult.Error
+	}
+
+	taskRead, ok := readResult.Result.(storage.TaskRead)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if taskRead.UID != uid {
+		return nil, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	eventQueryResult := <-s.TaskEventQuery.FindAllByTaskID(uid)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.TaskEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	return repository.BuildTaskFromEventHistory(s.TaskService, events), nil
+}
+
 func (s *TaskServer) publishUncommittedEvents(entity interface{}) error {
 
 	switch e := entity.(type) {
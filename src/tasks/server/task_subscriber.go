@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	alertdomain "github.com/Tanibox/tania-core/src/alerts/domain"
 	"github.com/Tanibox/tania-core/src/tasks/domain"
 	"github.com/Tanibox/tania-core/src/tasks/storage"
 	"github.com/labstack/echo"
@@ -126,6 +127,184 @@ func (s *TaskServer) SaveToTaskReadModel(event interface{}) error {
 		taskReadFromRepo.IsDue = true
 		taskRead = taskReadFromRepo
 
+	case domain.TaskAssigned:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		assigneeUID := e.AssigneeUID
+		taskReadFromRepo.AssigneeUID = &assigneeUID
+		taskRead = taskReadFromRepo
+
+	case domain.TaskReassigned:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		assigneeUID := e.AssigneeUID
+		taskReadFromRepo.AssigneeUID = &assigneeUID
+		taskRead = taskReadFromRepo
+
+	case domain.TaskUnassigned:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.AssigneeUID = nil
+		taskRead = taskReadFromRepo
+
+	case domain.TaskCommentAdded:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.Comments = append(taskReadFromRepo.Comments, storage.TaskComment{
+			UID:         e.CommentUID,
+			ParentUID:   e.ParentUID,
+			AuthorUID:   e.AuthorUID,
+			Content:     e.Content,
+			CreatedDate: e.CreatedDate,
+		})
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemAdded:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.Checklist = append(taskReadFromRepo.Checklist, storage.TaskChecklistItem{
+			UID:         e.ItemUID,
+			Name:        e.Name,
+			CreatedDate: e.CreatedDate,
+		})
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemCompleted:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		for i, v := range taskReadFromRepo.Checklist {
+			if v.UID == e.ItemUID {
+				taskReadFromRepo.Checklist[i].IsCompleted = true
+				taskReadFromRepo.Checklist[i].CompletedDate = e.CompletedDate
+			}
+		}
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemUncompleted:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		for i, v := range taskReadFromRepo.Checklist {
+			if v.UID == e.ItemUID {
+				taskReadFromRepo.Checklist[i].IsCompleted = false
+				taskReadFromRepo.Checklist[i].CompletedDate = nil
+			}
+		}
+		taskRead = taskReadFromRepo
+
+	case domain.TaskChecklistItemRemoved:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		checklist := []storage.TaskChecklistItem{}
+		for _, v := range taskReadFromRepo.Checklist {
+			if v.UID != e.ItemUID {
+				checklist = append(checklist, v)
+			}
+		}
+		taskReadFromRepo.Checklist = checklist
+		taskRead = taskReadFromRepo
+
+	case domain.TaskAttachmentAdded:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.Attachments = append(taskReadFromRepo.Attachments, storage.TaskAttachment{
+			UID:         e.AttachmentUID,
+			Filename:    e.Filename,
+			MimeType:    e.MimeType,
+			Size:        e.Size,
+			CreatedDate: e.CreatedDate,
+		})
+		taskRead = taskReadFromRepo
+
+	case domain.TaskAttachmentRemoved:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		attachments := []storage.TaskAttachment{}
+		for _, v := range taskReadFromRepo.Attachments {
+			if v.UID != e.AttachmentUID {
+				attachments = append(attachments, v)
+			}
+		}
+		taskReadFromRepo.Attachments = attachments
+		taskRead = taskReadFromRepo
+
+	case domain.TaskPrerequisiteAdded:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		taskReadFromRepo.Prerequisites = append(taskReadFromRepo.Prerequisites, e.PrerequisiteUID)
+		taskRead = taskReadFromRepo
+
+	case domain.TaskPrerequisiteRemoved:
+
+		// Get TaskRead By UID
+		taskReadFromRepo, err := s.getTaskReadFromID(e.UID)
+		if err != nil {
+			return err
+		}
+
+		prerequisites := []uuid.UUID{}
+		for _, v := range taskReadFromRepo.Prerequisites {
+			if v != e.PrerequisiteUID {
+				prerequisites = append(prerequisites, v)
+			}
+		}
+		taskReadFromRepo.Prerequisites = prerequisites
+		taskRead = taskReadFromRepo
+
 	default:
 		return errors.New("Unknown task event")
 	}
@@ -153,3 +332,69 @@ func (s *TaskServer) getTaskReadFromID(uid uuid.UUID) (*storage.TaskRead, error)
 		return &taskReadFromRepo, nil
 	}
 }
+
+// CreateAlertTask creates the task asked by the rule of a triggered alert.
+// The task is attached to the alerted asset when it belongs to the task domain.
+func (s *TaskServer) CreateAlertTask(event interface{}) error {
+	// TODO:
+	// We cannot listen to this events without refer to the original struct.
+	// This is considered as domain boundary leak.
+	e, ok := event.(alertdomain.AlertTriggered)
+	if !ok {
+		return nil
+	}
+
+	if e.TaskDomain == "" {
+		return nil
+	}
+
+	var taskDomain domain.TaskDomain
+	var err error
+	switch e.TaskDomain {
+	case domain.TaskDomainAreaCode:
+		taskDomain, err = domain.CreateTaskDomainArea(s.TaskService, e.TaskCategory, nil)
+	case domain.TaskDomainCropCode:
+		taskDomain, err = domain.CreateTaskDomainCrop(s.TaskService, e.TaskCategory, nil, nil)
+	case domain.TaskDomainFinanceCode:
+		taskDomain, err = domain.CreateTaskDomainFinance()
+	case domain.TaskDomainGeneralCode:
+		taskDomain, err = domain.CreateTaskDomainGeneral()
+	case domain.TaskDomainInventoryCode:
+		taskDomain, err = domain.CreateTaskDomainInventory()
+	case domain.TaskDomainReservoirCode:
+		taskDomain, err = domain.CreateTaskDomainReservoir(s.TaskService, e.TaskCategory, nil)
+	default:
+		err = domain.TaskError{Code: domain.TaskErrorInvalidDomainCode}
+	}
+	if err != nil {
+		return err
+	}
+
+	var assetID *uuid.UUID
+	if e.AssetType == e.TaskDomain && e.AssetUID != (uuid.UUID{}) {
+		assetID = &e.AssetUID
+	}
+
+	task, err := domain.CreateTask(
+		s.TaskService,
+		e.RuleName,
+		e.Message,
+		nil,
+		domain.TaskPriorityUrgent,
+		taskDomain,
+		e.TaskCategory,
+		assetID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = <-s.TaskEventRepo.Save(task.UID, 0, task.UncommittedChanges)
+	if err != nil {
+		return err
+	}
+
+	s.publishUncommittedEvents(task)
+
+	return nil
+}
@@ -15,20 +15,50 @@ type TaskEvent struct {
 }
 
 type TaskRead struct {
-	Title         string            `json:"title"`
-	UID           uuid.UUID         `json:"uid"`
-	Description   string            `json:"description"`
-	CreatedDate   time.Time         `json:"created_date"`
-	DueDate       *time.Time        `json:"due_date, omitempty"`
-	CompletedDate *time.Time        `json:"completed_date"`
-	CancelledDate *time.Time        `json:"cancelled_date"`
-	Priority      string            `json:"priority"`
-	Status        string            `json:"status"`
-	Domain        string            `json:"domain"`
-	DomainDetails domain.TaskDomain `json:"domain_details"`
-	Category      string            `json:"category"`
-	IsDue         bool              `json:"is_due"`
-	AssetID       *uuid.UUID        `json:"asset_id"`
+	Title         string              `json:"title"`
+	UID           uuid.UUID           `json:"uid"`
+	Description   string              `json:"description"`
+	CreatedDate   time.Time           `json:"created_date"`
+	DueDate       *time.Time          `json:"due_date, omitempty"`
+	CompletedDate *time.Time          `json:"completed_date"`
+	CancelledDate *time.Time          `json:"cancelled_date"`
+	Priority      string              `json:"priority"`
+	Status        string              `json:"status"`
+	Domain        string              `json:"domain"`
+	DomainDetails domain.TaskDomain   `json:"domain_details"`
+	Category      string              `json:"category"`
+	IsDue         bool                `json:"is_due"`
+	AssetID       *uuid.UUID          `json:"asset_id"`
+	AssigneeUID   *uuid.UUID          `json:"assignee_uid"`
+	Comments      []TaskComment       `json:"comments"`
+	Checklist     []TaskChecklistItem `json:"checklist"`
+	Attachments   []TaskAttachment    `json:"attachments"`
+	Prerequisites []uuid.UUID         `json:"prerequisites"`
+	IsBlocked     bool                `json:"is_blocked"`
+}
+
+type TaskComment struct {
+	UID         uuid.UUID  `json:"uid"`
+	ParentUID   *uuid.UUID `json:"parent_uid"`
+	AuthorUID   uuid.UUID  `json:"author_uid"`
+	Content     string     `json:"content"`
+	CreatedDate time.Time  `json:"created_date"`
+}
+
+type TaskChecklistItem struct {
+	UID           uuid.UUID  `json:"uid"`
+	Name          string     `json:"name"`
+	IsCompleted   bool       `json:"is_completed"`
+	CompletedDate *time.Time `json:"completed_date"`
+	CreatedDate   time.Time  `json:"created_date"`
+}
+
+type TaskAttachment struct {
+	UID         uuid.UUID `json:"uid"`
+	Filename    string    `json:"filename"`
+	MimeType    string    `json:"mime_type"`
+	Size        int       `json:"size"`
+	CreatedDate time.Time `json:"created_date"`
 }
 
 // Implements TaskDomain interface in domain
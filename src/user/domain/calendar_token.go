@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// GenerateCalendarToken creates the read-only token used to subscribe to the calendar feeds.
+// Only its hash is stored, so the token can't be shown again after it is created.
+func GenerateCalendarToken() (string, string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := hex.EncodeToString(b)
+
+	return token, HashCalendarToken(token), nil
+}
+
+// HashCalendarToken returns the hash of the token as it is stored
+func HashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
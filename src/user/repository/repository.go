@@ -25,6 +25,11 @@ type UserAuthRepository interface {
 	Save(userAuth *storage.UserAuth) <-chan error
 }
 
+type UserCalendarTokenRepository interface {
+	Save(calendarToken *storage.UserCalendarToken) <-chan error
+	Delete(userUID uuid.UUID) <-chan error
+}
+
 func NewUserFromHistory(events []storage.UserEvent) *domain.User {
 	state := &domain.User{}
 	for _, v := range events {
@@ -0,0 +1,48 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/user/repository"
+	"github.com/Tanibox/tania-core/src/user/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type UserCalendarTokenRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewUserCalendarTokenRepositorySqlite(db *sql.DB) repository.UserCalendarTokenRepository {
+	return &UserCalendarTokenRepositorySqlite{DB: db}
+}
+
+// Save replaces the previous token of the user, so it can't be used anymore
+func (s *UserCalendarTokenRepositorySqlite) Save(calendarToken *storage.UserCalendarToken) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := s.DB.Exec(`INSERT OR REPLACE INTO USER_CALENDAR_TOKEN
+			(USER_UID, TOKEN_HASH, CREATED_DATE)
+			VALUES (?, ?, ?)`,
+			calendarToken.UserUID, calendarToken.TokenHash, calendarToken.CreatedDate.Format(time.RFC3339))
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
+
+func (s *UserCalendarTokenRepositorySqlite) Delete(userUID uuid.UUID) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		_, err := s.DB.Exec(`DELETE FROM USER_CALENDAR_TOKEN WHERE USER_UID = ?`, userUID)
+
+		result <- err
+		close(result)
+	}()
+
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/Tanibox/tania-core/src/eventbus"
 	"github.com/Tanibox/tania-core/src/helper/structhelper"
@@ -28,6 +29,8 @@ type UserServer struct {
 	UserAuthQuery  query.UserAuthQuery
 	UserService    domain.UserService
 	EventBus       eventbus.TaniaEventBus
+
+	UserCalendarTokenRepo repository.UserCalendarTokenRepository
 }
 
 // NewUserServer initializes UserServer's dependencies and create new UserServer struct
@@ -43,6 +46,8 @@ func NewUserServer(
 	userAuthRepo := repoSqlite.NewUserAuthRepositorySqlite(db)
 	userAuthQuery := querySqlite.NewUserAuthQuerySqlite(db)
 
+	userCalendarTokenRepo := repoSqlite.NewUserCalendarTokenRepositorySqlite(db)
+
 	userService := service.UserServiceImpl{UserReadQuery: userReadQuery}
 
 	userServer := UserServer{
@@ -54,6 +59,8 @@ func NewUserServer(
 		UserAuthQuery:  userAuthQuery,
 		UserService:    userService,
 		EventBus:       eventBus,
+
+		UserCalendarTokenRepo: userCalendarTokenRepo,
 	}
 
 	userServer.InitSubscriber()
@@ -69,6 +76,8 @@ func (s *UserServer) InitSubscriber() {
 // Mount defines the UserServer's endpoints with its handlers
 func (s *UserServer) Mount(g *echo.Group) {
 	g.POST("/change_password", s.ChangePassword)
+	g.POST("/calendar_token", s.CreateCalendarToken)
+	g.DELETE("/calendar_token", s.RevokeCalendarToken)
 }
 
 func (s *UserServer) ChangePassword(c echo.Context) error {
@@ -134,6 +143,76 @@ func (s *UserServer) ChangePassword(c echo.Context) error {
 
 }
 
+// CreateCalendarToken issues the read-only token used to subscribe to the calendar feeds.
+// Creating a new token revokes the previous one. The token is only shown in this response.
+func (s *UserServer) CreateCalendarToken(c echo.Context) error {
+	userUID, err := s.currentUserUID(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	token, tokenHash, err := domain.GenerateCalendarToken()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	calendarToken := storage.UserCalendarToken{
+		UserUID:     userUID,
+		TokenHash:   tokenHash,
+		CreatedDate: time.Now(),
+	}
+
+	err = <-s.UserCalendarTokenRepo.Save(&calendarToken)
+	if err != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string]interface{})
+	data["data"] = calendarToken
+	data["token"] = token
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// RevokeCalendarToken removes the calendar token, the subscribed feeds stop working
+func (s *UserServer) RevokeCalendarToken(c echo.Context) error {
+	userUID, err := s.currentUserUID(c)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.UserCalendarTokenRepo.Delete(userUID)
+	if err != nil {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"data": "Calendar token revoked"})
+}
+
+// currentUserUID returns the authenticated user.
+// In demo mode there is no authentication, so the default `tania` user is used.
+func (s *UserServer) currentUserUID(c echo.Context) (uuid.UUID, error) {
+	if userUID, ok := c.Get("USER_UID").(uuid.UUID); ok {
+		return userUID, nil
+	}
+
+	queryResult := <-s.UserReadQuery.FindByUsername("tania")
+	if queryResult.Error != nil {
+		return uuid.UUID{}, queryResult.Error
+	}
+
+	userRead, ok := queryResult.Result.(storage.UserRead)
+	if !ok {
+		return uuid.UUID{}, errors.New("Error type assertion")
+	}
+
+	if userRead.UID == (uuid.UUID{}) {
+		return uuid.UUID{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return userRead.UID, nil
+}
+
 func (s *UserServer) publishUncommittedEvents(entity interface{}) error {
 	switch e := entity.(type) {
 	case *domain.User:
@@ -28,3 +28,10 @@ type UserAuth struct {
 	CreatedDate  time.Time `json:"created_date"`
 	LastUpdated  time.Time `json:"last_updated"`
 }
+
+// UserCalendarToken is the read-only token used by calendar applications to fetch the feeds
+type UserCalendarToken struct {
+	UserUID     uuid.UUID `json:"uid"`
+	TokenHash   string    `json:"-"`
+	CreatedDate time.Time `json:"created_date"`
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	WeatherKindObservation = "OBSERVATION"
+	WeatherKindForecast    = "FORECAST"
+)
+
+// DailyWeather is the weather of one day at a farm location.
+// A value is nil when the provider has no data for it.
+type DailyWeather struct {
+	Date               time.Time `json:"date"`
+	Kind               string    `json:"kind"`
+	TemperatureMin     *float32  `json:"temperature_min"`
+	TemperatureMax     *float32  `json:"temperature_max"`
+	Precipitation      *float32  `json:"precipitation"`
+	Humidity           *float32  `json:"humidity"`
+	WindSpeedMax       *float32  `json:"wind_speed_max"`
+	Evapotranspiration *float32  `json:"evapotranspiration"`
+	Radiation          *float32  `json:"radiation"`
+}
+
+// WeatherProvider fetches the daily weather of a location, from the first to the last day included.
+// Temperatures are in Celsius, precipitation and evapotranspiration in millimetres,
+// humidity in percent, wind speed in km/h and the shortwave radiation sum in MJ/m².
+type WeatherProvider interface {
+	FetchDaily(latitude, longitude float64, from, to time.Time) ([]DailyWeather, error)
+}
+
+// ParseLocation converts the latitude and longitude of a farm,
+// which are stored as text, to coordinates
+func ParseLocation(latitude, longitude string) (float64, float64, error) {
+	if strings.TrimSpace(latitude) == "" || strings.TrimSpace(longitude) == "" {
+		return 0, 0, WeatherError{WeatherErrorFarmLocationEmptyCode}
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latitude), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return 0, 0, WeatherError{WeatherErrorInvalidLatitudeCode}
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(longitude), 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return 0, 0, WeatherError{WeatherErrorInvalidLongitudeCode}
+	}
+
+	return lat, lon, nil
+}
+
+// Day truncates the date to the start of its day in UTC, which is how the days are keyed
+func Day(date time.Time) time.Time {
+	y, m, d := date.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// KindAt tells whether the weather of the day is already observed at the date,
+// or still a forecast because the day is not over yet
+func KindAt(day, date time.Time) string {
+	if Day(day).AddDate(0, 0, 1).After(date) {
+		return WeatherKindForecast
+	}
+
+	return WeatherKindObservation
+}
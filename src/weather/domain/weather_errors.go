@@ -0,0 +1,28 @@
+package domain
+
+const (
+	WeatherErrorFarmLocationEmptyCode = iota
+	WeatherErrorInvalidLatitudeCode
+	WeatherErrorInvalidLongitudeCode
+	WeatherErrorInvalidDateRangeCode
+)
+
+// WeatherError is a custom error from Go built-in error
+type WeatherError struct {
+	Code int
+}
+
+func (e WeatherError) Error() string {
+	switch e.Code {
+	case WeatherErrorFarmLocationEmptyCode:
+		return "Farm has no location to fetch the weather for"
+	case WeatherErrorInvalidLatitudeCode:
+		return "Farm latitude is invalid"
+	case WeatherErrorInvalidLongitudeCode:
+		return "Farm longitude is invalid"
+	case WeatherErrorInvalidDateRangeCode:
+		return "The start date must not be after the end date"
+	default:
+		return "Unrecognized Weather Error Code"
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLocation(t *testing.T) {
+	// When
+	lat, lon, err := ParseLocation("-6.2088", " 106.8456")
+	_, _, errEmpty := ParseLocation("", "106.8456")
+	_, _, errLatitude := ParseLocation("91", "106.8456")
+	_, _, errLongitude := ParseLocation("-6.2088", "east")
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, -6.2088, lat)
+	assert.Equal(t, 106.8456, lon)
+	assert.Equal(t, WeatherError{WeatherErrorFarmLocationEmptyCode}, errEmpty)
+	assert.Equal(t, WeatherError{WeatherErrorInvalidLatitudeCode}, errLatitude)
+	assert.Equal(t, WeatherError{WeatherErrorInvalidLongitudeCode}, errLongitude)
+}
+
+func TestKindAt(t *testing.T) {
+	// Given
+	date := time.Date(2018, time.March, 10, 14, 30, 0, 0, time.UTC)
+
+	// Then
+	assert.Equal(t, WeatherKindObservation, KindAt(date.AddDate(0, 0, -1), date))
+	assert.Equal(t, WeatherKindForecast, KindAt(date, date))
+	assert.Equal(t, WeatherKindForecast, KindAt(date.AddDate(0, 0, 3), date))
+	assert.Equal(t, time.Date(2018, time.March, 10, 0, 0, 0, 0, time.UTC), Day(date))
+}
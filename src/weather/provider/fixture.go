@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"os"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/weather/domain"
+)
+
+// FixtureProvider serves the weather from a file in the Open-Meteo response format,
+// for the demo mode and for farms without internet access. The days of the file are
+// replayed one after the other over the requested days, whatever the location is.
+type FixtureProvider struct {
+	Path string
+}
+
+func NewFixtureProvider(path string) FixtureProvider {
+	return FixtureProvider{Path: path}
+}
+
+func (p FixtureProvider) FetchDaily(latitude, longitude float64, from, to time.Time) ([]domain.DailyWeather, error) {
+	from = domain.Day(from)
+	to = domain.Day(to)
+	if from.After(to) {
+		return nil, domain.WeatherError{Code: domain.WeatherErrorInvalidDateRangeCode}
+	}
+
+	file, err := os.Open(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	fixtures, err := decodeOpenMeteo(file)
+	if err != nil {
+		return nil, err
+	}
+
+	weather := []domain.DailyWeather{}
+	if len(fixtures) == 0 {
+		return weather, nil
+	}
+
+	// Replaying by the day number keeps the weather of a day the same between fetches
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		i := int(date.Unix()/86400) % len(fixtures)
+		if i < 0 {
+			i += len(fixtures)
+		}
+
+		day := fixtures[i]
+		day.Date = date
+
+		weather = append(weather, day)
+	}
+
+	return weather, nil
+}
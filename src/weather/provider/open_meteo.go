@@ -0,0 +1,141 @@
+// Package provider holds the weather providers Tania can fetch the daily weather of a farm from.
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/weather/domain"
+)
+
+const (
+	OpenMeteo = "openmeteo"
+	Fixture   = "fixture"
+
+	// OpenMeteoURL is the forecast endpoint of Open-Meteo. It also serves the past three months.
+	OpenMeteoURL = "https://api.open-meteo.com/v1/forecast"
+)
+
+// dailyVariables are the Open-Meteo daily variables, in the order of the DailyWeather fields
+var dailyVariables = []string{
+	"temperature_2m_min",
+	"temperature_2m_max",
+	"precipitation_sum",
+	"relative_humidity_2m_mean",
+	"wind_speed_10m_max",
+	"et0_fao_evapotranspiration",
+	"shortwave_radiation_sum",
+}
+
+// OpenMeteoProvider fetches the weather from an Open-Meteo compatible HTTP API
+type OpenMeteoProvider struct {
+	URL    string
+	Client *http.Client
+}
+
+func NewOpenMeteoProvider(apiURL string) OpenMeteoProvider {
+	if apiURL == "" {
+		apiURL = OpenMeteoURL
+	}
+
+	return OpenMeteoProvider{
+		URL:    apiURL,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p OpenMeteoProvider) FetchDaily(latitude, longitude float64, from, to time.Time) ([]domain.DailyWeather, error) {
+	from = domain.Day(from)
+	to = domain.Day(to)
+	if from.After(to) {
+		return nil, domain.WeatherError{Code: domain.WeatherErrorInvalidDateRangeCode}
+	}
+
+	params := url.Values{}
+	params.Set("latitude", strconv.FormatFloat(latitude, 'f', -1, 64))
+	params.Set("longitude", strconv.FormatFloat(longitude, 'f', -1, 64))
+	params.Set("daily", strings.Join(dailyVariables, ","))
+	params.Set("timezone", "UTC")
+	params.Set("start_date", from.Format("2006-01-02"))
+	params.Set("end_date", to.Format("2006-01-02"))
+
+	resp, err := p.Client.Get(p.URL + "?" + params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("Weather provider responded with %s", resp.Status)
+	}
+
+	return decodeOpenMeteo(resp.Body)
+}
+
+// openMeteoResponse is the part of the Open-Meteo response we use.
+// The daily values are parallel arrays, indexed like the days.
+type openMeteoResponse struct {
+	Daily map[string]json.RawMessage `json:"daily"`
+}
+
+func decodeOpenMeteo(r io.Reader) ([]domain.DailyWeather, error) {
+	resp := openMeteoResponse{}
+	err := json.NewDecoder(r).Decode(&resp)
+	if err != nil {
+		return nil, err
+	}
+
+	days := []string{}
+	err = json.Unmarshal(resp.Daily["time"], &days)
+	if err != nil {
+		return nil, fmt.Errorf("Weather provider response has no days: %s", err)
+	}
+
+	values := make([][]*float32, len(dailyVariables))
+	for i, variable := range dailyVariables {
+		raw, ok := resp.Daily[variable]
+		if !ok {
+			continue
+		}
+
+		err = json.Unmarshal(raw, &values[i])
+		if err != nil {
+			return nil, fmt.Errorf("Weather provider response has invalid %s: %s", variable, err)
+		}
+	}
+
+	weather := []domain.DailyWeather{}
+	for i, day := range days {
+		date, err := time.Parse("2006-01-02", day)
+		if err != nil {
+			return nil, err
+		}
+
+		weather = append(weather, domain.DailyWeather{
+			Date:               date,
+			TemperatureMin:     valueAt(values[0], i),
+			TemperatureMax:     valueAt(values[1], i),
+			Precipitation:      valueAt(values[2], i),
+			Humidity:           valueAt(values[3], i),
+			WindSpeedMax:       valueAt(values[4], i),
+			Evapotranspiration: valueAt(values[5], i),
+			Radiation:          valueAt(values[6], i),
+		})
+	}
+
+	return weather, nil
+}
+
+func valueAt(values []*float32, i int) *float32 {
+	if i >= len(values) {
+		return nil
+	}
+
+	return values[i]
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenMeteoFetchDaily(t *testing.T) {
+	// Given
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`{"daily": {
+			"time": ["2018-03-01", "2018-03-02"],
+			"temperature_2m_min": [12.5, null],
+			"temperature_2m_max": [24.1, 25.3],
+			"precipitation_sum": [0.4, 3]
+		}}`))
+	}))
+	defer server.Close()
+
+	p := NewOpenMeteoProvider(server.URL)
+	from := time.Date(2018, time.March, 1, 8, 0, 0, 0, time.UTC)
+
+	// When
+	weather, err := p.FetchDaily(-6.2088, 106.8456, from, from.AddDate(0, 0, 1))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"-6.2088"}, query["latitude"])
+	assert.Equal(t, []string{"2018-03-01"}, query["start_date"])
+	assert.Equal(t, []string{"2018-03-02"}, query["end_date"])
+	assert.Len(t, weather, 2)
+	assert.Equal(t, time.Date(2018, time.March, 2, 0, 0, 0, 0, time.UTC), weather[1].Date)
+	assert.Equal(t, float32(12.5), *weather[0].TemperatureMin)
+	assert.Nil(t, weather[1].TemperatureMin)
+	assert.Equal(t, float32(3), *weather[1].Precipitation)
+	assert.Nil(t, weather[0].Humidity)
+}
+
+func TestFixtureFetchDaily(t *testing.T) {
+	// Given
+	p := NewFixtureProvider("fixtures/open_meteo_daily.json")
+	from := time.Date(2018, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	// When
+	weather, err := p.FetchDaily(0, 0, from, from.AddDate(0, 0, 29))
+	again, _ := p.FetchDaily(0, 0, from.AddDate(0, 0, 10), from.AddDate(0, 0, 10))
+
+	// Then
+	assert.Nil(t, err)
+	assert.Len(t, weather, 30)
+	assert.Equal(t, from.AddDate(0, 0, 29), weather[29].Date)
+	assert.NotNil(t, weather[29].TemperatureMax)
+	assert.Equal(t, weather[10], again[0])
+}
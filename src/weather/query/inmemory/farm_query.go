@@ -0,0 +1,64 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/weather/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FarmQueryInMemory struct {
+	Storage *storage.FarmReadStorage
+}
+
+func NewFarmQueryInMemory(s *storage.FarmReadStorage) query.FarmQuery {
+	return FarmQueryInMemory{Storage: s}
+}
+
+func (s FarmQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		farm := query.FarmQueryResult{}
+		if val, ok := s.Storage.FarmReadMap[uid]; ok {
+			farm = mapFarmRead(val)
+		}
+
+		result <- query.QueryResult{Result: farm}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s FarmQueryInMemory) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		s.Storage.Lock.RLock()
+		defer s.Storage.Lock.RUnlock()
+
+		farms := []query.FarmQueryResult{}
+		for _, val := range s.Storage.FarmReadMap {
+			farms = append(farms, mapFarmRead(val))
+		}
+
+		result <- query.QueryResult{Result: farms}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func mapFarmRead(farm storage.FarmRead) query.FarmQueryResult {
+	return query.FarmQueryResult{
+		UID:       farm.UID,
+		Name:      farm.Name,
+		Latitude:  farm.Latitude,
+		Longitude: farm.Longitude,
+	}
+}
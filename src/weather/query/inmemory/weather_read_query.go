@@ -0,0 +1,44 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/weather/query"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WeatherReadQueryInMemory struct {
+	Storage *storage.WeatherReadStorage
+}
+
+func NewWeatherReadQueryInMemory(s *storage.WeatherReadStorage) query.WeatherReadQuery {
+	return &WeatherReadQueryInMemory{Storage: s}
+}
+
+func (f *WeatherReadQueryInMemory) FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		weather := []storage.WeatherRead{}
+		for _, v := range f.Storage.WeatherReads {
+			if v.FarmUID == farmUID && !v.Date.Before(from) && !v.Date.After(to) {
+				weather = append(weather, v)
+			}
+		}
+
+		sort.Slice(weather, func(i, j int) bool {
+			return weather[i].Date.Before(weather[j].Date)
+		})
+
+		result <- query.QueryResult{Result: weather}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,117 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/weather/query"
+	uuid "github.com/satori/go.uuid"
+)
+
+type FarmQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewFarmQueryMysql(db *sql.DB) query.FarmQuery {
+	return FarmQueryMysql{DB: db}
+}
+
+type farmReadResult struct {
+	UID       []byte
+	Name      string
+	Latitude  sql.NullString
+	Longitude sql.NullString
+}
+
+func (s FarmQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rowsData := farmReadResult{}
+		farm := query.FarmQueryResult{}
+
+		err := s.DB.QueryRow(`SELECT UID, NAME, LATITUDE, LONGITUDE
+			FROM FARM_READ WHERE UID = ?`, uid.Bytes()).Scan(
+			&rowsData.UID, &rowsData.Name, &rowsData.Latitude, &rowsData.Longitude,
+		)
+
+		if err == sql.ErrNoRows {
+			result <- query.QueryResult{Result: farm}
+			close(result)
+			return
+		}
+
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		farm, err = mapFarmRead(rowsData)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: farm}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (s FarmQueryMysql) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		farms := []query.FarmQueryResult{}
+
+		rows, err := s.DB.Query(`SELECT UID, NAME, LATITUDE, LONGITUDE FROM FARM_READ`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			rowsData := farmReadResult{}
+			err := rows.Scan(&rowsData.UID, &rowsData.Name, &rowsData.Latitude, &rowsData.Longitude)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			farm, err := mapFarmRead(rowsData)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+				close(result)
+				return
+			}
+
+			farms = append(farms, farm)
+		}
+
+		result <- query.QueryResult{Result: farms}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func mapFarmRead(rowsData farmReadResult) (query.FarmQueryResult, error) {
+	farmUID, err := uuid.FromBytes(rowsData.UID)
+	if err != nil {
+		return query.FarmQueryResult{}, err
+	}
+
+	return query.FarmQueryResult{
+		UID:       farmUID,
+		Name:      rowsData.Name,
+		Latitude:  rowsData.Latitude.String,
+		Longitude: rowsData.Longitude.String,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/weather/query"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WeatherReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewWeatherReadQueryMysql(db *sql.DB) query.WeatherReadQuery {
+	return &WeatherReadQueryMysql{DB: db}
+}
+
+type weatherReadResult struct {
+	FarmUID            []byte
+	Date               time.Time
+	Kind               string
+	TemperatureMin     sql.NullFloat64
+	TemperatureMax     sql.NullFloat64
+	Precipitation      sql.NullFloat64
+	Humidity           sql.NullFloat64
+	WindSpeedMax       sql.NullFloat64
+	Evapotranspiration sql.NullFloat64
+	Radiation          sql.NullFloat64
+	FetchedDate        time.Time
+}
+
+func (f *WeatherReadQueryMysql) FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		weather := []storage.WeatherRead{}
+
+		rows, err := f.DB.Query(`SELECT * FROM WEATHER_READ
+			WHERE FARM_UID = ? AND DATE >= ? AND DATE <= ?
+			ORDER BY DATE ASC`,
+			farmUID.Bytes(), from, to)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		for rows.Next() {
+			rowsData := weatherReadResult{}
+			err := rows.Scan(
+				&rowsData.FarmUID, &rowsData.Date, &rowsData.Kind,
+				&rowsData.TemperatureMin, &rowsData.TemperatureMax, &rowsData.Precipitation,
+				&rowsData.Humidity, &rowsData.WindSpeedMax, &rowsData.Evapotranspiration,
+				&rowsData.Radiation, &rowsData.FetchedDate,
+			)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			weatherRead, err := mapWeatherRead(rowsData)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			weather = append(weather, weatherRead)
+		}
+
+		result <- query.QueryResult{Result: weather}
+		close(result)
+	}()
+
+	return result
+}
+
+func mapWeatherRead(rowsData weatherReadResult) (storage.WeatherRead, error) {
+	farmUID, err := uuid.FromBytes(rowsData.FarmUID)
+	if err != nil {
+		return storage.WeatherRead{}, err
+	}
+
+	return storage.WeatherRead{
+		FarmUID: farmUID,
+		DailyWeather: storage.DailyWeather{
+			Date:               rowsData.Date.UTC(),
+			Kind:               rowsData.Kind,
+			TemperatureMin:     nullFloat32(rowsData.TemperatureMin),
+			TemperatureMax:     nullFloat32(rowsData.TemperatureMax),
+			Precipitation:      nullFloat32(rowsData.Precipitation),
+			Humidity:           nullFloat32(rowsData.Humidity),
+			WindSpeedMax:       nullFloat32(rowsData.WindSpeedMax),
+			Evapotranspiration: nullFloat32(rowsData.Evapotranspiration),
+			Radiation:          nullFloat32(rowsData.Radiation),
+		},
+		FetchedDate: rowsData.FetchedDate,
+	}, nil
+}
+
+func nullFloat32(v sql.NullFloat64) *float32 {
+	if !v.Valid {
+		return nil
+	}
+
+	f := float32(v.Float64)
+	return &f
+}
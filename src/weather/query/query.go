@@ -0,0 +1,30 @@
+package query
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type QueryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type WeatherReadQuery interface {
+	FindAllByFarmID(farmUID uuid.UUID, from, to time.Time) <-chan QueryResult
+}
+
+type FarmQuery interface {
+	FindByID(farmUID uuid.UUID) <-chan QueryResult
+	FindAll() <-chan QueryResult
+}
+
+// QUERY RESULTS
+
+type FarmQueryResult struct {
+	UID       uuid.UUID `json:"uid"`
+	Name      string    `json:"name"`
+	Latitude  string    `json:"latitude"`
+	Longitude string    `json:"longitude"`
+}
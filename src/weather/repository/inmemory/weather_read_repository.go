@@ -0,0 +1,41 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/weather/repository"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+)
+
+type WeatherReadRepositoryInMemory struct {
+	Storage *storage.WeatherReadStorage
+}
+
+func NewWeatherReadRepositoryInMemory(s *storage.WeatherReadStorage) repository.WeatherReadRepository {
+	return &WeatherReadRepositoryInMemory{Storage: s}
+}
+
+func (f *WeatherReadRepositoryInMemory) Save(weatherRead *storage.WeatherRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		found := false
+		for i, v := range f.Storage.WeatherReads {
+			if v.FarmUID == weatherRead.FarmUID && v.Date.Equal(weatherRead.Date) {
+				f.Storage.WeatherReads[i] = *weatherRead
+				found = true
+			}
+		}
+
+		if !found {
+			f.Storage.WeatherReads = append(f.Storage.WeatherReads, *weatherRead)
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,77 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/weather/repository"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+)
+
+type WeatherReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewWeatherReadRepositoryMysql(db *sql.DB) repository.WeatherReadRepository {
+	return &WeatherReadRepositoryMysql{DB: db}
+}
+
+func (f *WeatherReadRepositoryMysql) Save(weatherRead *storage.WeatherRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM WEATHER_READ WHERE FARM_UID = ? AND DATE = ?`,
+			weatherRead.FarmUID.Bytes(), weatherRead.Date).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE WEATHER_READ SET
+				KIND = ?, TEMPERATURE_MIN = ?, TEMPERATURE_MAX = ?, PRECIPITATION = ?,
+				HUMIDITY = ?, WIND_SPEED_MAX = ?, EVAPOTRANSPIRATION = ?, RADIATION = ?, FETCHED_DATE = ?
+				WHERE FARM_UID = ? AND DATE = ?`,
+				weatherRead.Kind,
+				weatherRead.TemperatureMin,
+				weatherRead.TemperatureMax,
+				weatherRead.Precipitation,
+				weatherRead.Humidity,
+				weatherRead.WindSpeedMax,
+				weatherRead.Evapotranspiration,
+				weatherRead.Radiation,
+				weatherRead.FetchedDate,
+				weatherRead.FarmUID.Bytes(),
+				weatherRead.Date)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO WEATHER_READ
+				(FARM_UID, DATE, KIND, TEMPERATURE_MIN, TEMPERATURE_MAX, PRECIPITATION,
+				HUMIDITY, WIND_SPEED_MAX, EVAPOTRANSPIRATION, RADIATION, FETCHED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				weatherRead.FarmUID.Bytes(),
+				weatherRead.Date,
+				weatherRead.Kind,
+				weatherRead.TemperatureMin,
+				weatherRead.TemperatureMax,
+				weatherRead.Precipitation,
+				weatherRead.Humidity,
+				weatherRead.WindSpeedMax,
+				weatherRead.Evapotranspiration,
+				weatherRead.Radiation,
+				weatherRead.FetchedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
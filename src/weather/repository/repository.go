@@ -0,0 +1,10 @@
+package repository
+
+import (
+	"github.com/Tanibox/tania-core/src/weather/storage"
+)
+
+// WeatherReadRepository saves the weather of a farm's day, replacing the one already saved for that day
+type WeatherReadRepository interface {
+	Save(weatherRead *storage.WeatherRead) <-chan error
+}
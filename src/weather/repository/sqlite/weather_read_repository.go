@@ -0,0 +1,81 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/weather/repository"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+)
+
+type WeatherReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewWeatherReadRepositorySqlite(db *sql.DB) repository.WeatherReadRepository {
+	return &WeatherReadRepositorySqlite{DB: db}
+}
+
+func (f *WeatherReadRepositorySqlite) Save(weatherRead *storage.WeatherRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		// Dates are stored in UTC so the days can be filtered by comparing the strings
+		date := weatherRead.Date.UTC().Format(time.RFC3339)
+
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM WEATHER_READ WHERE FARM_UID = ? AND DATE = ?`,
+			weatherRead.FarmUID, date).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE WEATHER_READ SET
+				KIND = ?, TEMPERATURE_MIN = ?, TEMPERATURE_MAX = ?, PRECIPITATION = ?,
+				HUMIDITY = ?, WIND_SPEED_MAX = ?, EVAPOTRANSPIRATION = ?, RADIATION = ?, FETCHED_DATE = ?
+				WHERE FARM_UID = ? AND DATE = ?`,
+				weatherRead.Kind,
+				weatherRead.TemperatureMin,
+				weatherRead.TemperatureMax,
+				weatherRead.Precipitation,
+				weatherRead.Humidity,
+				weatherRead.WindSpeedMax,
+				weatherRead.Evapotranspiration,
+				weatherRead.Radiation,
+				weatherRead.FetchedDate.Format(time.RFC3339),
+				weatherRead.FarmUID,
+				date)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO WEATHER_READ
+				(FARM_UID, DATE, KIND, TEMPERATURE_MIN, TEMPERATURE_MAX, PRECIPITATION,
+				HUMIDITY, WIND_SPEED_MAX, EVAPOTRANSPIRATION, RADIATION, FETCHED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				weatherRead.FarmUID,
+				date,
+				weatherRead.Kind,
+				weatherRead.TemperatureMin,
+				weatherRead.TemperatureMax,
+				weatherRead.Precipitation,
+				weatherRead.Humidity,
+				weatherRead.WindSpeedMax,
+				weatherRead.Evapotranspiration,
+				weatherRead.Radiation,
+				weatherRead.FetchedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,261 @@
+package server
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	assetsstorage "github.com/Tanibox/tania-core/src/assets/storage"
+	"github.com/Tanibox/tania-core/src/weather/domain"
+	"github.com/Tanibox/tania-core/src/weather/provider"
+	"github.com/Tanibox/tania-core/src/weather/query"
+	queryInMem "github.com/Tanibox/tania-core/src/weather/query/inmemory"
+	queryMysql "github.com/Tanibox/tania-core/src/weather/query/mysql"
+	querySqlite "github.com/Tanibox/tania-core/src/weather/query/sqlite"
+	"github.com/Tanibox/tania-core/src/weather/repository"
+	repoInMem "github.com/Tanibox/tania-core/src/weather/repository/inmemory"
+	repoMysql "github.com/Tanibox/tania-core/src/weather/repository/mysql"
+	repoSqlite "github.com/Tanibox/tania-core/src/weather/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/weather/storage"
+	"github.com/labstack/echo"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// FetchInterval is how often the weather of the farms is fetched
+	FetchInterval = 6 * time.Hour
+
+	// ObservationDays is how many past days are fetched again,
+	// so their forecasts are replaced by what was observed
+	ObservationDays = 7
+
+	// ForecastDays is how many days are forecasted, today included
+	ForecastDays = 7
+)
+
+// WeatherServer ties the routes and handlers with injected dependencies
+type WeatherServer struct {
+	WeatherReadRepo  repository.WeatherReadRepository
+	WeatherReadQuery query.WeatherReadQuery
+	FarmQuery        query.FarmQuery
+	Provider         domain.WeatherProvider
+}
+
+// NewWeatherServer initializes WeatherServer's dependencies and create new WeatherServer struct
+func NewWeatherServer(
+	db *sql.DB,
+	farmReadStorage *assetsstorage.FarmReadStorage,
+	weatherReadStorage *storage.WeatherReadStorage,
+) (*WeatherServer, error) {
+	weatherServer := &WeatherServer{
+		Provider: NewWeatherProvider(),
+	}
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DB_INMEMORY:
+		weatherServer.WeatherReadRepo = repoInMem.NewWeatherReadRepositoryInMemory(weatherReadStorage)
+		weatherServer.WeatherReadQuery = queryInMem.NewWeatherReadQueryInMemory(weatherReadStorage)
+
+		weatherServer.FarmQuery = queryInMem.NewFarmQueryInMemory(farmReadStorage)
+
+	case config.DB_SQLITE:
+		weatherServer.WeatherReadRepo = repoSqlite.NewWeatherReadRepositorySqlite(db)
+		weatherServer.WeatherReadQuery = querySqlite.NewWeatherReadQuerySqlite(db)
+
+		weatherServer.FarmQuery = querySqlite.NewFarmQuerySqlite(db)
+
+	case config.DB_MYSQL:
+		weatherServer.WeatherReadRepo = repoMysql.NewWeatherReadRepositoryMysql(db)
+		weatherServer.WeatherReadQuery = queryMysql.NewWeatherReadQueryMysql(db)
+
+		weatherServer.FarmQuery = queryMysql.NewFarmQueryMysql(db)
+	}
+
+	return weatherServer, nil
+}
+
+// NewWeatherProvider returns the configured weather provider.
+// Open-Meteo is used unless the fixture provider is chosen.
+func NewWeatherProvider() domain.WeatherProvider {
+	if *config.Config.WeatherProvider == provider.Fixture {
+		return provider.NewFixtureProvider(*config.Config.WeatherFixturePath)
+	}
+
+	return provider.NewOpenMeteoProvider(*config.Config.WeatherAPIURL)
+}
+
+// Mount defines the WeatherServer's endpoints with its handlers
+func (s *WeatherServer) Mount(g *echo.Group) {
+	g.GET("/farms/:id", s.FindFarmWeather)
+	g.POST("/farms/:id/fetch", s.FetchFarmWeather)
+}
+
+// StartScheduler fetches the weather of all farms now, then every FetchInterval.
+// It is started once the server is mounted, not by NewWeatherServer.
+func (s *WeatherServer) StartScheduler() {
+	go func() {
+		s.FetchAllFarms(time.Now())
+
+		ticker := time.NewTicker(FetchInterval)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			s.FetchAllFarms(now)
+		}
+	}()
+}
+
+// FetchAllFarms fetches the weather of every farm which has a location.
+// A farm failing doesn't stop the others from being fetched.
+func (s *WeatherServer) FetchAllFarms(date time.Time) {
+	queryResult := <-s.FarmQuery.FindAll()
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return
+	}
+
+	farms, ok := queryResult.Result.([]query.FarmQueryResult)
+	if !ok {
+		log.Error("Error type assertion")
+		return
+	}
+
+	for _, farm := range farms {
+		_, err := s.fetchFarm(farm, date)
+		if err == (domain.WeatherError{Code: domain.WeatherErrorFarmLocationEmptyCode}) {
+			continue
+		}
+
+		if err != nil {
+			log.Error("Fetching the weather of farm ", farm.UID, ": ", err)
+		}
+	}
+}
+
+// fetchFarm saves the observations of the last days and the forecasts of the next days
+func (s *WeatherServer) fetchFarm(farm query.FarmQueryResult, date time.Time) ([]storage.WeatherRead, error) {
+	latitude, longitude, err := domain.ParseLocation(farm.Latitude, farm.Longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	today := domain.Day(date)
+	days, err := s.Provider.FetchDaily(
+		latitude,
+		longitude,
+		today.AddDate(0, 0, -ObservationDays),
+		today.AddDate(0, 0, ForecastDays-1),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	weather := []storage.WeatherRead{}
+	for _, day := range days {
+		day.Date = domain.Day(day.Date)
+		day.Kind = domain.KindAt(day.Date, date)
+
+		weatherRead := storage.WeatherRead{
+			FarmUID:      farm.UID,
+			DailyWeather: storage.DailyWeather(day),
+			FetchedDate:  date,
+		}
+
+		err := <-s.WeatherReadRepo.Save(&weatherRead)
+		if err != nil {
+			return nil, err
+		}
+
+		weather = append(weather, weatherRead)
+	}
+
+	return weather, nil
+}
+
+// FindFarmWeather lists the daily weather of a farm. By default it is the last 30 days and the forecasts.
+func (s *WeatherServer) FindFarmWeather(c echo.Context) error {
+	farm, err := s.findFarm(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	today := domain.Day(time.Now())
+
+	to := today.AddDate(0, 0, ForecastDays-1)
+	if c.QueryParam("to") != "" {
+		to, err = time.Parse(time.RFC3339, c.QueryParam("to"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "to"))
+		}
+	}
+
+	from := today.AddDate(0, 0, -30)
+	if c.QueryParam("from") != "" {
+		from, err = time.Parse(time.RFC3339, c.QueryParam("from"))
+		if err != nil {
+			return Error(c, NewRequestValidationError(PARSE_FAILED, "from"))
+		}
+	}
+
+	if from.After(to) {
+		return Error(c, domain.WeatherError{Code: domain.WeatherErrorInvalidDateRangeCode})
+	}
+
+	queryResult := <-s.WeatherReadQuery.FindAllByFarmID(farm.UID, from, to)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	weather, ok := queryResult.Result.([]storage.WeatherRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.WeatherRead)
+	data["data"] = weather
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// FetchFarmWeather fetches the weather of a farm right away, like after its location has been changed
+func (s *WeatherServer) FetchFarmWeather(c echo.Context) error {
+	farm, err := s.findFarm(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	weather, err := s.fetchFarm(farm, time.Now())
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string][]storage.WeatherRead)
+	data["data"] = weather
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *WeatherServer) findFarm(id string) (query.FarmQueryResult, error) {
+	farmUID, err := uuid.FromString(id)
+	if err != nil {
+		return query.FarmQueryResult{}, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	queryResult := <-s.FarmQuery.FindByID(farmUID)
+	if queryResult.Error != nil {
+		return query.FarmQueryResult{}, queryResult.Error
+	}
+
+	farm, ok := queryResult.Result.(query.FarmQueryResult)
+	if !ok {
+		return query.FarmQueryResult{}, echo.NewHTTPError(http.StatusInternalServerError, "Internal server error")
+	}
+
+	if farm.UID == (uuid.UUID{}) {
+		return query.FarmQueryResult{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return farm, nil
+}
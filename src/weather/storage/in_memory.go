@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	deadlock "github.com/sasha-s/go-deadlock"
+)
+
+type WeatherReadStorage struct {
+	Lock         *deadlock.RWMutex
+	WeatherReads []WeatherRead
+}
+
+func CreateWeatherReadStorage() *WeatherReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("WEATHER READ STORAGE DEADLOCK!")
+	}
+
+	return &WeatherReadStorage{WeatherReads: []WeatherRead{}, Lock: &rwMutex}
+}
@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/weather/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type DailyWeather domain.DailyWeather
+
+// WeatherRead is the weather of a day at a farm. A farm has one row per day,
+// the forecast of a day is replaced by its observation once the day is over.
+type WeatherRead struct {
+	FarmUID uuid.UUID `json:"farm_id"`
+	DailyWeather
+	FetchedDate time.Time `json:"fetched_date"`
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	DeliveryStatusPending   = "PENDING"
+	DeliveryStatusSucceeded = "SUCCEEDED"
+	DeliveryStatusFailed    = "FAILED"
+
+	// MaxDeliveryAttempts is how many times a delivery is attempted before it is given up
+	MaxDeliveryAttempts = 8
+
+	// DeliveryBackoff is the wait after the first failed attempt. It doubles after each next one.
+	DeliveryBackoff = 30 * time.Second
+
+	// MaxDeliveryResponseBodyLength is how much of the response body is kept in the delivery log
+	MaxDeliveryResponseBodyLength = 1024
+
+	// Headers of the requests posted to the webhooks
+	HeaderEvent     = "X-Tania-Event"
+	HeaderDelivery  = "X-Tania-Delivery"
+	HeaderSignature = "X-Tania-Signature"
+)
+
+// credentialFieldParts mark the fields holding a credential, like Password, NewPassword, Secret or TokenHash
+var credentialFieldParts = []string{"password", "secret", "token"}
+
+// StripCredentials converts the event to its JSON data without the credential fields, at any depth,
+// so a password hash, a webhook secret or a device token hash is never delivered whatever the event is
+func StripCredentials(event interface{}) (interface{}, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the numbers as they were marshalled instead of converting them to float64
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var data interface{}
+	err = decoder.Decode(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	return stripCredentials(data), nil
+}
+
+func stripCredentials(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isCredentialField(key) {
+				delete(v, key)
+				continue
+			}
+
+			v[key] = stripCredentials(value)
+		}
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = stripCredentials(value)
+		}
+	}
+
+	return data
+}
+
+func isCredentialField(name string) bool {
+	name = strings.ToLower(name)
+	for _, v := range credentialFieldParts {
+		if strings.Contains(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Envelope is the body posted to the webhooks. Data is the event as the bus published it,
+// without its credential fields.
+type Envelope struct {
+	UID          uuid.UUID   `json:"uid"`
+	EventName    string      `json:"event_name"`
+	OccurredDate time.Time   `json:"occurred_date"`
+	Data         interface{} `json:"data"`
+}
+
+// Delivery is the log of posting one event to one webhook, with all its attempts.
+// The payload is kept as it was first sent, so a redelivery posts the same body.
+type Delivery struct {
+	UID             uuid.UUID  `json:"uid"`
+	WebhookUID      uuid.UUID  `json:"webhook_id"`
+	EventName       string     `json:"event_name"`
+	Payload         string     `json:"payload"`
+	Status          string     `json:"status"`
+	Attempts        int        `json:"attempts"`
+	NextAttemptDate *time.Time `json:"next_attempt_date"`
+	LastAttemptDate *time.Time `json:"last_attempt_date"`
+	ResponseStatus  int        `json:"response_status"`
+	ResponseBody    string     `json:"response_body"`
+	Error           string     `json:"error"`
+	CreatedDate     time.Time  `json:"created_date"`
+}
+
+// CreateDelivery creates a pending delivery to attempt right away
+func CreateDelivery(webhookUID uuid.UUID, eventName, payload string) (*Delivery, error) {
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &Delivery{
+		UID:             uid,
+		WebhookUID:      webhookUID,
+		EventName:       eventName,
+		Payload:         payload,
+		Status:          DeliveryStatusPending,
+		NextAttemptDate: &now,
+		CreatedDate:     now,
+	}, nil
+}
+
+// RecordAttempt logs an attempt of the delivery. A 2xx response succeeds it. Otherwise the next attempt
+// is scheduled with an exponential backoff, until MaxDeliveryAttempts fails it for good.
+func (d *Delivery) RecordAttempt(date time.Time, responseStatus int, responseBody string, attemptErr error) {
+	d.Attempts++
+	d.LastAttemptDate = &date
+	d.ResponseStatus = responseStatus
+	d.Error = ""
+
+	if len(responseBody) > MaxDeliveryResponseBodyLength {
+		responseBody = responseBody[:MaxDeliveryResponseBodyLength]
+	}
+	d.ResponseBody = responseBody
+
+	if attemptErr != nil {
+		d.Error = attemptErr.Error()
+	}
+
+	if attemptErr == nil && responseStatus >= 200 && responseStatus < 300 {
+		d.Status = DeliveryStatusSucceeded
+		d.NextAttemptDate = nil
+		return
+	}
+
+	if d.Attempts >= MaxDeliveryAttempts {
+		d.Status = DeliveryStatusFailed
+		d.NextAttemptDate = nil
+		return
+	}
+
+	next := date.Add(DeliveryBackoff * time.Duration(1<<uint(d.Attempts-1)))
+	d.Status = DeliveryStatusPending
+	d.NextAttemptDate = &next
+}
+
+// Sign computes the HMAC-SHA256 of the body with the secret of the webhook.
+// It is sent in the X-Tania-Signature header as sha256=<hex>, so receivers can verify where the body comes from.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
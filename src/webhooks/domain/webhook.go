@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/validationhelper"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Webhook is a subscription of an external system, like an ERP or a chat bot, to the domain events of Tania.
+// The events are posted to its URL, signed with its secret.
+type Webhook struct {
+	UID         uuid.UUID
+	URL         string
+	Secret      string
+	Events      []string
+	IsActive    bool
+	CreatedDate time.Time
+
+	// Events
+	Version            int
+	UncommittedChanges []interface{}
+}
+
+const (
+	// WebhookEventAll subscribes a webhook to every event
+	WebhookEventAll = "*"
+
+	// WebhookSecretMinLength is the shortest secret a webhook can be signed with
+	WebhookSecretMinLength = 16
+)
+
+// Subscribes tells whether the webhook subscribes to the event, named the way structhelper.GetName names it
+func Subscribes(events []string, eventName string) bool {
+	for _, v := range events {
+		if v == WebhookEventAll || v == eventName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (state *Webhook) TrackChange(event interface{}) {
+	state.UncommittedChanges = append(state.UncommittedChanges, event)
+	state.Transition(event)
+}
+
+func (state *Webhook) Transition(event interface{}) {
+	switch e := event.(type) {
+	case WebhookCreated:
+		state.UID = e.UID
+		state.URL = e.URL
+		state.Secret = e.Secret
+		state.Events = e.Events
+		state.IsActive = true
+		state.CreatedDate = e.CreatedDate
+
+	case WebhookChanged:
+		state.URL = e.URL
+		state.Secret = e.Secret
+		state.Events = e.Events
+
+	case WebhookActivated:
+		state.IsActive = true
+
+	case WebhookDeactivated:
+		state.IsActive = false
+
+	}
+}
+
+// CreateWebhook registers a new active webhook
+func CreateWebhook(webhookURL, secret string, events []string) (*Webhook, error) {
+	err := validateWebhook(webhookURL, secret, events)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := &Webhook{}
+
+	initial.TrackChange(WebhookCreated{
+		UID:         uid,
+		URL:         webhookURL,
+		Secret:      secret,
+		Events:      events,
+		CreatedDate: time.Now(),
+	})
+
+	return initial, nil
+}
+
+// Change replaces the URL, secret and events of the webhook
+func (w *Webhook) Change(webhookURL, secret string, events []string) error {
+	err := validateWebhook(webhookURL, secret, events)
+	if err != nil {
+		return err
+	}
+
+	w.TrackChange(WebhookChanged{
+		WebhookUID: w.UID,
+		URL:        webhookURL,
+		Secret:     secret,
+		Events:     events,
+	})
+
+	return nil
+}
+
+func (w *Webhook) Activate() error {
+	if w.IsActive {
+		return WebhookError{WebhookErrorAlreadyActiveCode}
+	}
+
+	w.TrackChange(WebhookActivated{WebhookUID: w.UID})
+
+	return nil
+}
+
+func (w *Webhook) Deactivate() error {
+	if !w.IsActive {
+		return WebhookError{WebhookErrorAlreadyInactiveCode}
+	}
+
+	w.TrackChange(WebhookDeactivated{WebhookUID: w.UID})
+
+	return nil
+}
+
+func validateWebhook(webhookURL, secret string, events []string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return WebhookError{WebhookErrorInvalidURLCode}
+	}
+
+	if len(secret) < WebhookSecretMinLength {
+		return WebhookError{WebhookErrorSecretTooShortCode}
+	}
+
+	if len(events) == 0 {
+		return WebhookError{WebhookErrorEventsEmptyCode}
+	}
+
+	for _, v := range events {
+		if v != WebhookEventAll && !validationhelper.IsAlphanumeric(v) {
+			return WebhookError{WebhookErrorInvalidEventNameCode}
+		}
+	}
+
+	return nil
+}
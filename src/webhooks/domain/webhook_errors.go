@@ -0,0 +1,34 @@
+package domain
+
+const (
+	WebhookErrorInvalidURLCode = iota
+	WebhookErrorSecretTooShortCode
+	WebhookErrorEventsEmptyCode
+	WebhookErrorInvalidEventNameCode
+	WebhookErrorAlreadyActiveCode
+	WebhookErrorAlreadyInactiveCode
+)
+
+// WebhookError is a custom error from Go built-in error
+type WebhookError struct {
+	Code int
+}
+
+func (e WebhookError) Error() string {
+	switch e.Code {
+	case WebhookErrorInvalidURLCode:
+		return "Webhook URL should be a HTTP or HTTPS URL"
+	case WebhookErrorSecretTooShortCode:
+		return "Webhook secret should have at least 16 characters"
+	case WebhookErrorEventsEmptyCode:
+		return "Webhook should subscribe to at least one event"
+	case WebhookErrorInvalidEventNameCode:
+		return "Event name should be alphanumeric, or * for every event"
+	case WebhookErrorAlreadyActiveCode:
+		return "Webhook is already active"
+	case WebhookErrorAlreadyInactiveCode:
+		return "Webhook is already inactive"
+	default:
+		return "Unrecognized Webhook Error Code"
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookCreated struct {
+	UID         uuid.UUID
+	URL         string
+	Secret      string
+	Events      []string
+	CreatedDate time.Time
+}
+
+type WebhookChanged struct {
+	WebhookUID uuid.UUID
+	URL        string
+	Secret     string
+	Events     []string
+}
+
+type WebhookActivated struct {
+	WebhookUID uuid.UUID
+}
+
+type WebhookDeactivated struct {
+	WebhookUID uuid.UUID
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	// When
+	webhook, err := CreateWebhook("https://erp.example.com/tania", "0123456789abcdef", []string{"CropBatchHarvested", "TaskDone"})
+	_, errURL := CreateWebhook("ftp://erp.example.com", "0123456789abcdef", []string{WebhookEventAll})
+	_, errSecret := CreateWebhook("https://erp.example.com/tania", "short", []string{WebhookEventAll})
+	_, errEvents := CreateWebhook("https://erp.example.com/tania", "0123456789abcdef", []string{})
+	_, errEventName := CreateWebhook("https://erp.example.com/tania", "0123456789abcdef", []string{"Task.Done"})
+
+	// Then
+	assert.Nil(t, err)
+	assert.True(t, webhook.IsActive)
+	assert.Equal(t, WebhookError{WebhookErrorInvalidURLCode}, errURL)
+	assert.Equal(t, WebhookError{WebhookErrorSecretTooShortCode}, errSecret)
+	assert.Equal(t, WebhookError{WebhookErrorEventsEmptyCode}, errEvents)
+	assert.Equal(t, WebhookError{WebhookErrorInvalidEventNameCode}, errEventName)
+
+	assert.True(t, Subscribes(webhook.Events, "TaskDone"))
+	assert.False(t, Subscribes(webhook.Events, "MaterialCreated"))
+	assert.True(t, Subscribes([]string{WebhookEventAll}, "MaterialCreated"))
+}
+
+func TestStripCredentials(t *testing.T) {
+	// Given
+	type device struct {
+		Name      string
+		TokenHash string
+	}
+
+	event := struct {
+		UID         int64
+		NewPassword []byte
+		Secret      string
+		Devices     []device
+	}{
+		UID:         9007199254740993,
+		NewPassword: []byte("hash"),
+		Secret:      "0123456789abcdef",
+		Devices:     []device{{Name: "Sensor", TokenHash: "hash"}},
+	}
+
+	// When
+	data, err := StripCredentials(event)
+
+	// Then
+	assert.Nil(t, err)
+
+	b, _ := json.Marshal(data)
+	assert.JSONEq(t, `{"UID": 9007199254740993, "Devices": [{"Name": "Sensor"}]}`, string(b))
+}
+
+func TestDeliveryAttempts(t *testing.T) {
+	// Given
+	webhookUID, _ := uuid.NewV4()
+	delivery, _ := CreateDelivery(webhookUID, "TaskDone", `{"event_name":"TaskDone"}`)
+	start := time.Now()
+
+	// When
+	delivery.RecordAttempt(start, 500, "", nil)
+
+	// Then
+	assert.Equal(t, DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, start.Add(30*time.Second), *delivery.NextAttemptDate)
+
+	// When
+	delivery.RecordAttempt(start, 0, "", errors.New("connection refused"))
+
+	// Then
+	assert.Equal(t, start.Add(60*time.Second), *delivery.NextAttemptDate)
+	assert.Equal(t, "connection refused", delivery.Error)
+
+	// When
+	for delivery.Status == DeliveryStatusPending {
+		delivery.RecordAttempt(start, 502, "", nil)
+	}
+
+	// Then
+	assert.Equal(t, DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, MaxDeliveryAttempts, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptDate)
+
+	// When
+	succeeded, _ := CreateDelivery(webhookUID, "TaskDone", "{}")
+	succeeded.RecordAttempt(start, 204, "", nil)
+
+	// Then
+	assert.Equal(t, DeliveryStatusSucceeded, succeeded.Status)
+	assert.Nil(t, succeeded.NextAttemptDate)
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '{"a":1}' | openssl dgst -sha256 -hmac 0123456789abcdef
+	assert.Equal(t,
+		"sha256=1c76fbb930d3aa8b1633728fae212c68ca68a6752bb319b98be6fe17e86cf5b8",
+		Sign("0123456789abcdef", []byte(`{"a":1}`)),
+	)
+}
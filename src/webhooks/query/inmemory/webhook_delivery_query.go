@@ -0,0 +1,86 @@
+package inmemory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookDeliveryQueryInMemory struct {
+	Storage *storage.WebhookDeliveryStorage
+}
+
+func NewWebhookDeliveryQueryInMemory(s *storage.WebhookDeliveryStorage) query.WebhookDeliveryQuery {
+	return &WebhookDeliveryQueryInMemory{Storage: s}
+}
+
+func (f *WebhookDeliveryQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.WebhookDeliveryMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookDeliveryQueryInMemory) FindAllByWebhookID(webhookUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		deliveries := []storage.WebhookDelivery{}
+		for _, v := range f.Storage.WebhookDeliveryMap {
+			if v.WebhookUID == webhookUID {
+				deliveries = append(deliveries, v)
+			}
+		}
+
+		sort.Slice(deliveries, func(i, j int) bool {
+			return deliveries[i].CreatedDate.After(deliveries[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: deliveries}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookDeliveryQueryInMemory) FindAllDue(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		deliveries := []storage.WebhookDelivery{}
+		for _, v := range f.Storage.WebhookDeliveryMap {
+			if v.Status == domain.DeliveryStatusPending && v.NextAttemptDate != nil && !v.NextAttemptDate.After(date) {
+				deliveries = append(deliveries, v)
+			}
+		}
+
+		sort.Slice(deliveries, func(i, j int) bool {
+			return deliveries[i].NextAttemptDate.Before(*deliveries[j].NextAttemptDate)
+		})
+
+		result <- query.QueryResult{Result: deliveries}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,43 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookEventQueryInMemory struct {
+	Storage *storage.WebhookEventStorage
+}
+
+func NewWebhookEventQueryInMemory(s *storage.WebhookEventStorage) query.WebhookEventQuery {
+	return &WebhookEventQueryInMemory{Storage: s}
+}
+
+func (f *WebhookEventQueryInMemory) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		events := []storage.WebhookEvent{}
+		for _, v := range f.Storage.WebhookEvents {
+			if v.WebhookUID == uid {
+				events = append(events, v)
+			}
+		}
+
+		sort.Slice(events, func(i, j int) bool {
+			return events[i].Version < events[j].Version
+		})
+
+		result <- query.QueryResult{Result: events}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,82 @@
+package inmemory
+
+import (
+	"sort"
+
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookReadQueryInMemory struct {
+	Storage *storage.WebhookReadStorage
+}
+
+func NewWebhookReadQueryInMemory(s *storage.WebhookReadStorage) query.WebhookReadQuery {
+	return &WebhookReadQueryInMemory{Storage: s}
+}
+
+func (f *WebhookReadQueryInMemory) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		result <- query.QueryResult{Result: f.Storage.WebhookReadMap[uid]}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookReadQueryInMemory) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		webhooks := []storage.WebhookRead{}
+		for _, v := range f.Storage.WebhookReadMap {
+			webhooks = append(webhooks, v)
+		}
+
+		sort.Slice(webhooks, func(i, j int) bool {
+			return webhooks[i].CreatedDate.Before(webhooks[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: webhooks}
+
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookReadQueryInMemory) FindAllActive() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		f.Storage.Lock.RLock()
+		defer f.Storage.Lock.RUnlock()
+
+		webhooks := []storage.WebhookRead{}
+		for _, v := range f.Storage.WebhookReadMap {
+			if v.IsActive {
+				webhooks = append(webhooks, v)
+			}
+		}
+
+		sort.Slice(webhooks, func(i, j int) bool {
+			return webhooks[i].CreatedDate.Before(webhooks[j].CreatedDate)
+		})
+
+		result <- query.QueryResult{Result: webhooks}
+
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,172 @@
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookDeliveryQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewWebhookDeliveryQueryMysql(db *sql.DB) query.WebhookDeliveryQuery {
+	return &WebhookDeliveryQueryMysql{DB: db}
+}
+
+type webhookDeliveryResult struct {
+	UID             []byte
+	WebhookUID      []byte
+	EventName       string
+	Payload         string
+	Status          string
+	Attempts        int
+	NextAttemptDate *time.Time
+	LastAttemptDate *time.Time
+	ResponseStatus  int
+	ResponseBody    string
+	Error           string
+	CreatedDate     time.Time
+}
+
+func (f *WebhookDeliveryQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_DELIVERY WHERE UID = ?`, uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deliveries, err := scanWebhookDeliveries(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		delivery := storage.WebhookDelivery{}
+		if len(deliveries) > 0 {
+			delivery = deliveries[0]
+		}
+
+		result <- query.QueryResult{Result: delivery}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookDeliveryQueryMysql) FindAllByWebhookID(webhookUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_DELIVERY
+			WHERE WEBHOOK_UID = ?
+			ORDER BY CREATED_DATE DESC`, webhookUID.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deliveries, err := scanWebhookDeliveries(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: deliveries}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookDeliveryQueryMysql) FindAllDue(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_DELIVERY
+			WHERE STATUS = ? AND NEXT_ATTEMPT_DATE <= ?
+			ORDER BY NEXT_ATTEMPT_DATE ASC`, domain.DeliveryStatusPending, date)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deliveries, err := scanWebhookDeliveries(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: deliveries}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]storage.WebhookDelivery, error) {
+	defer rows.Close()
+
+	deliveries := []storage.WebhookDelivery{}
+	for rows.Next() {
+		rowsData := webhookDeliveryResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.WebhookUID,
+			&rowsData.EventName,
+			&rowsData.Payload,
+			&rowsData.Status,
+			&rowsData.Attempts,
+			&rowsData.NextAttemptDate,
+			&rowsData.LastAttemptDate,
+			&rowsData.ResponseStatus,
+			&rowsData.ResponseBody,
+			&rowsData.Error,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookUID, err := uuid.FromBytes(rowsData.WebhookUID)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, storage.WebhookDelivery{
+			UID:             uid,
+			WebhookUID:      webhookUID,
+			EventName:       rowsData.EventName,
+			Payload:         rowsData.Payload,
+			Status:          rowsData.Status,
+			Attempts:        rowsData.Attempts,
+			NextAttemptDate: rowsData.NextAttemptDate,
+			LastAttemptDate: rowsData.LastAttemptDate,
+			ResponseStatus:  rowsData.ResponseStatus,
+			ResponseBody:    rowsData.ResponseBody,
+			Error:           rowsData.Error,
+			CreatedDate:     rowsData.CreatedDate,
+		})
+	}
+
+	return deliveries, rows.Err()
+}
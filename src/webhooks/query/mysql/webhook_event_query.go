@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/decoder"
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookEventQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewWebhookEventQueryMysql(db *sql.DB) query.WebhookEventQuery {
+	return &WebhookEventQueryMysql{DB: db}
+}
+
+func (f *WebhookEventQueryMysql) FindAllByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		events := []storage.WebhookEvent{}
+
+		rows, err := f.DB.Query("SELECT * FROM WEBHOOK_EVENT WHERE WEBHOOK_UID = ? ORDER BY VERSION ASC", uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+		}
+
+		rowsData := struct {
+			ID          int
+			WebhookUID  []byte
+			Version     int
+			CreatedDate time.Time
+			Event       []byte
+		}{}
+
+		for rows.Next() {
+			rows.Scan(&rowsData.ID, &rowsData.WebhookUID, &rowsData.Version, &rowsData.CreatedDate, &rowsData.Event)
+
+			wrapper := decoder.WebhookEventWrapper{}
+			err := json.Unmarshal(rowsData.Event, &wrapper)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			webhookUID, err := uuid.FromBytes(rowsData.WebhookUID)
+			if err != nil {
+				result <- query.QueryResult{Error: err}
+			}
+
+			createdDate := rowsData.CreatedDate
+
+			events = append(events, storage.WebhookEvent{
+				WebhookUID:  webhookUID,
+				Version:     rowsData.Version,
+				CreatedDate: createdDate,
+				Event:       wrapper.EventData,
+			})
+		}
+
+		result <- query.QueryResult{Result: events}
+		close(result)
+	}()
+
+	return result
+}
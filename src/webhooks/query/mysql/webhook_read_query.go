@@ -0,0 +1,152 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookReadQueryMysql struct {
+	DB *sql.DB
+}
+
+func NewWebhookReadQueryMysql(db *sql.DB) query.WebhookReadQuery {
+	return &WebhookReadQueryMysql{DB: db}
+}
+
+type webhookReadResult struct {
+	UID         []byte
+	URL         string
+	Secret      string
+	Events      string
+	IsActive    bool
+	CreatedDate time.Time
+}
+
+func (f *WebhookReadQueryMysql) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_READ WHERE UID = ?`, uid.Bytes())
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhooks, err := scanWebhookReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhook := storage.WebhookRead{}
+		if len(webhooks) > 0 {
+			webhook = webhooks[0]
+		}
+
+		result <- query.QueryResult{Result: webhook}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookReadQueryMysql) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_READ ORDER BY CREATED_DATE ASC`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhooks, err := scanWebhookReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: webhooks}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookReadQueryMysql) FindAllActive() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_READ
+			WHERE IS_ACTIVE = 1
+			ORDER BY CREATED_DATE ASC`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhooks, err := scanWebhookReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: webhooks}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanWebhookReads(rows *sql.Rows) ([]storage.WebhookRead, error) {
+	defer rows.Close()
+
+	webhooks := []storage.WebhookRead{}
+	for rows.Next() {
+		rowsData := webhookReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.URL,
+			&rowsData.Secret,
+			&rowsData.Events,
+			&rowsData.IsActive,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromBytes(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		events := []string{}
+		if rowsData.Events != "" {
+			events = strings.Split(rowsData.Events, ",")
+		}
+
+		webhooks = append(webhooks, storage.WebhookRead{
+			UID:         uid,
+			URL:         rowsData.URL,
+			Secret:      rowsData.Secret,
+			Events:      events,
+			IsActive:    rowsData.IsActive,
+			CreatedDate: rowsData.CreatedDate,
+		})
+	}
+
+	return webhooks, rows.Err()
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+type QueryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type WebhookEventQuery interface {
+	FindAllByID(webhookUID uuid.UUID) <-chan QueryResult
+}
+
+type WebhookReadQuery interface {
+	FindByID(webhookUID uuid.UUID) <-chan QueryResult
+	FindAll() <-chan QueryResult
+	FindAllActive() <-chan QueryResult
+}
+
+type WebhookDeliveryQuery interface {
+	FindByID(deliveryUID uuid.UUID) <-chan QueryResult
+
+	// FindAllByWebhookID finds the deliveries of a webhook, the latest first
+	FindAllByWebhookID(webhookUID uuid.UUID) <-chan QueryResult
+
+	// FindAllDue finds the pending deliveries whose next attempt is due at the date
+	FindAllDue(date time.Time) <-chan QueryResult
+}
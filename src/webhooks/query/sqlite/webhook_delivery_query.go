@@ -0,0 +1,200 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookDeliveryQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewWebhookDeliveryQuerySqlite(db *sql.DB) query.WebhookDeliveryQuery {
+	return &WebhookDeliveryQuerySqlite{DB: db}
+}
+
+type webhookDeliveryResult struct {
+	UID             string
+	WebhookUID      string
+	EventName       string
+	Payload         string
+	Status          string
+	Attempts        int
+	NextAttemptDate sql.NullString
+	LastAttemptDate sql.NullString
+	ResponseStatus  int
+	ResponseBody    string
+	Error           string
+	CreatedDate     string
+}
+
+func (f *WebhookDeliveryQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_DELIVERY WHERE UID = ?`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deliveries, err := scanWebhookDeliveries(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		delivery := storage.WebhookDelivery{}
+		if len(deliveries) > 0 {
+			delivery = deliveries[0]
+		}
+
+		result <- query.QueryResult{Result: delivery}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookDeliveryQuerySqlite) FindAllByWebhookID(webhookUID uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_DELIVERY
+			WHERE WEBHOOK_UID = ?
+			ORDER BY CREATED_DATE DESC`, webhookUID)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deliveries, err := scanWebhookDeliveries(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: deliveries}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookDeliveryQuerySqlite) FindAllDue(date time.Time) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_DELIVERY
+			WHERE STATUS = ? AND NEXT_ATTEMPT_DATE <= ?
+			ORDER BY NEXT_ATTEMPT_DATE ASC`, domain.DeliveryStatusPending, date.UTC().Format(time.RFC3339))
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		deliveries, err := scanWebhookDeliveries(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: deliveries}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanWebhookDeliveries(rows *sql.Rows) ([]storage.WebhookDelivery, error) {
+	defer rows.Close()
+
+	deliveries := []storage.WebhookDelivery{}
+	for rows.Next() {
+		rowsData := webhookDeliveryResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.WebhookUID,
+			&rowsData.EventName,
+			&rowsData.Payload,
+			&rowsData.Status,
+			&rowsData.Attempts,
+			&rowsData.NextAttemptDate,
+			&rowsData.LastAttemptDate,
+			&rowsData.ResponseStatus,
+			&rowsData.ResponseBody,
+			&rowsData.Error,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		webhookUID, err := uuid.FromString(rowsData.WebhookUID)
+		if err != nil {
+			return nil, err
+		}
+
+		nextAttemptDate, err := parseNullDate(rowsData.NextAttemptDate)
+		if err != nil {
+			return nil, err
+		}
+
+		lastAttemptDate, err := parseNullDate(rowsData.LastAttemptDate)
+		if err != nil {
+			return nil, err
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, storage.WebhookDelivery{
+			UID:             uid,
+			WebhookUID:      webhookUID,
+			EventName:       rowsData.EventName,
+			Payload:         rowsData.Payload,
+			Status:          rowsData.Status,
+			Attempts:        rowsData.Attempts,
+			NextAttemptDate: nextAttemptDate,
+			LastAttemptDate: lastAttemptDate,
+			ResponseStatus:  rowsData.ResponseStatus,
+			ResponseBody:    rowsData.ResponseBody,
+			Error:           rowsData.Error,
+			CreatedDate:     createdDate,
+		})
+	}
+
+	return deliveries, rows.Err()
+}
+
+func parseNullDate(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &date, nil
+}
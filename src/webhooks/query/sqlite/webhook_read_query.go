@@ -0,0 +1,157 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookReadQuerySqlite struct {
+	DB *sql.DB
+}
+
+func NewWebhookReadQuerySqlite(db *sql.DB) query.WebhookReadQuery {
+	return &WebhookReadQuerySqlite{DB: db}
+}
+
+type webhookReadResult struct {
+	UID         string
+	URL         string
+	Secret      string
+	Events      string
+	IsActive    bool
+	CreatedDate string
+}
+
+func (f *WebhookReadQuerySqlite) FindByID(uid uuid.UUID) <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_READ WHERE UID = ?`, uid)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhooks, err := scanWebhookReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhook := storage.WebhookRead{}
+		if len(webhooks) > 0 {
+			webhook = webhooks[0]
+		}
+
+		result <- query.QueryResult{Result: webhook}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookReadQuerySqlite) FindAll() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_READ ORDER BY CREATED_DATE ASC`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhooks, err := scanWebhookReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: webhooks}
+		close(result)
+	}()
+
+	return result
+}
+
+func (f *WebhookReadQuerySqlite) FindAllActive() <-chan query.QueryResult {
+	result := make(chan query.QueryResult)
+
+	go func() {
+		rows, err := f.DB.Query(`SELECT * FROM WEBHOOK_READ
+			WHERE IS_ACTIVE = 1
+			ORDER BY CREATED_DATE ASC`)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		webhooks, err := scanWebhookReads(rows)
+		if err != nil {
+			result <- query.QueryResult{Error: err}
+			close(result)
+			return
+		}
+
+		result <- query.QueryResult{Result: webhooks}
+		close(result)
+	}()
+
+	return result
+}
+
+func scanWebhookReads(rows *sql.Rows) ([]storage.WebhookRead, error) {
+	defer rows.Close()
+
+	webhooks := []storage.WebhookRead{}
+	for rows.Next() {
+		rowsData := webhookReadResult{}
+
+		err := rows.Scan(
+			&rowsData.UID,
+			&rowsData.URL,
+			&rowsData.Secret,
+			&rowsData.Events,
+			&rowsData.IsActive,
+			&rowsData.CreatedDate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		uid, err := uuid.FromString(rowsData.UID)
+		if err != nil {
+			return nil, err
+		}
+
+		createdDate, err := time.Parse(time.RFC3339, rowsData.CreatedDate)
+		if err != nil {
+			return nil, err
+		}
+
+		events := []string{}
+		if rowsData.Events != "" {
+			events = strings.Split(rowsData.Events, ",")
+		}
+
+		webhooks = append(webhooks, storage.WebhookRead{
+			UID:         uid,
+			URL:         rowsData.URL,
+			Secret:      rowsData.Secret,
+			Events:      events,
+			IsActive:    rowsData.IsActive,
+			CreatedDate: createdDate,
+		})
+	}
+
+	return webhooks, rows.Err()
+}
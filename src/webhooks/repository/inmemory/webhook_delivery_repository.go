@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+type WebhookDeliveryRepositoryInMemory struct {
+	Storage *storage.WebhookDeliveryStorage
+}
+
+func NewWebhookDeliveryRepositoryInMemory(s *storage.WebhookDeliveryStorage) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryInMemory{Storage: s}
+}
+
+func (f *WebhookDeliveryRepositoryInMemory) Save(delivery *storage.WebhookDelivery) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.WebhookDeliveryMap[delivery.UID] = *delivery
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
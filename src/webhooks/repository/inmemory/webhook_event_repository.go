@@ -0,0 +1,39 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookEventRepositoryInMemory struct {
+	Storage *storage.WebhookEventStorage
+}
+
+func NewWebhookEventRepositoryInMemory(s *storage.WebhookEventStorage) repository.WebhookEventRepository {
+	return &WebhookEventRepositoryInMemory{Storage: s}
+}
+
+func (f *WebhookEventRepositoryInMemory) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		for _, v := range events {
+			latestVersion++
+			f.Storage.WebhookEvents = append(f.Storage.WebhookEvents, storage.WebhookEvent{
+				WebhookUID: uid,
+				Version:    latestVersion,
+				Event:      v,
+			})
+		}
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
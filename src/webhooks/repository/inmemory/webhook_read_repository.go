@@ -0,0 +1,31 @@
+package inmemory
+
+import (
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+type WebhookReadRepositoryInMemory struct {
+	Storage *storage.WebhookReadStorage
+}
+
+func NewWebhookReadRepositoryInMemory(s *storage.WebhookReadStorage) repository.WebhookReadRepository {
+	return &WebhookReadRepositoryInMemory{Storage: s}
+}
+
+func (f *WebhookReadRepositoryInMemory) Save(webhookRead *storage.WebhookRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		f.Storage.Lock.Lock()
+		defer f.Storage.Lock.Unlock()
+
+		f.Storage.WebhookReadMap[webhookRead.UID] = *webhookRead
+
+		result <- nil
+
+		close(result)
+	}()
+
+	return result
+}
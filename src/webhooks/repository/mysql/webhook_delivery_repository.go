@@ -0,0 +1,74 @@
+package mysql
+
+import (
+	"database/sql"
+
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+type WebhookDeliveryRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewWebhookDeliveryRepositoryMysql(db *sql.DB) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositoryMysql{DB: db}
+}
+
+func (f *WebhookDeliveryRepositoryMysql) Save(delivery *storage.WebhookDelivery) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM WEBHOOK_DELIVERY WHERE UID = ?`, delivery.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE WEBHOOK_DELIVERY SET
+				STATUS = ?, ATTEMPTS = ?, NEXT_ATTEMPT_DATE = ?, LAST_ATTEMPT_DATE = ?,
+				RESPONSE_STATUS = ?, RESPONSE_BODY = ?, ERROR_MESSAGE = ?
+				WHERE UID = ?`,
+				delivery.Status,
+				delivery.Attempts,
+				delivery.NextAttemptDate,
+				delivery.LastAttemptDate,
+				delivery.ResponseStatus,
+				delivery.ResponseBody,
+				delivery.Error,
+				delivery.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO WEBHOOK_DELIVERY
+				(UID, WEBHOOK_UID, EVENT_NAME, PAYLOAD, STATUS, ATTEMPTS, NEXT_ATTEMPT_DATE,
+				LAST_ATTEMPT_DATE, RESPONSE_STATUS, RESPONSE_BODY, ERROR_MESSAGE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				delivery.UID.Bytes(),
+				delivery.WebhookUID.Bytes(),
+				delivery.EventName,
+				delivery.Payload,
+				delivery.Status,
+				delivery.Attempts,
+				delivery.NextAttemptDate,
+				delivery.LastAttemptDate,
+				delivery.ResponseStatus,
+				delivery.ResponseBody,
+				delivery.Error,
+				delivery.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,65 @@
+package mysql
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+type WebhookReadRepositoryMysql struct {
+	DB *sql.DB
+}
+
+func NewWebhookReadRepositoryMysql(db *sql.DB) repository.WebhookReadRepository {
+	return &WebhookReadRepositoryMysql{DB: db}
+}
+
+func (f *WebhookReadRepositoryMysql) Save(webhookRead *storage.WebhookRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM WEBHOOK_READ WHERE UID = ?`, webhookRead.UID.Bytes()).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE WEBHOOK_READ SET
+				URL = ?, SECRET = ?, EVENTS = ?, IS_ACTIVE = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				webhookRead.URL,
+				webhookRead.Secret,
+				strings.Join(webhookRead.Events, ","),
+				webhookRead.IsActive,
+				webhookRead.CreatedDate,
+				webhookRead.UID.Bytes())
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO WEBHOOK_READ
+				(UID, URL, SECRET, EVENTS, IS_ACTIVE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				webhookRead.UID.Bytes(),
+				webhookRead.URL,
+				webhookRead.Secret,
+				strings.Join(webhookRead.Events, ","),
+				webhookRead.IsActive,
+				webhookRead.CreatedDate)
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
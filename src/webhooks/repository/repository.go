@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RepositoryResult is a struct to wrap repository result
+// so its easy to use it in channel
+type RepositoryResult struct {
+	Result interface{}
+	Error  error
+}
+
+type WebhookEventRepository interface {
+	Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error
+}
+
+type WebhookReadRepository interface {
+	Save(webhookRead *storage.WebhookRead) <-chan error
+}
+
+type WebhookDeliveryRepository interface {
+	Save(delivery *storage.WebhookDelivery) <-chan error
+}
+
+func NewWebhookFromHistory(events []storage.WebhookEvent) *domain.Webhook {
+	state := &domain.Webhook{}
+	for _, v := range events {
+		state.Transition(v.Event)
+		state.Version++
+	}
+	return state
+}
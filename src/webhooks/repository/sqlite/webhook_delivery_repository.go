@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+type WebhookDeliveryRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewWebhookDeliveryRepositorySqlite(db *sql.DB) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryRepositorySqlite{DB: db}
+}
+
+func (f *WebhookDeliveryRepositorySqlite) Save(delivery *storage.WebhookDelivery) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		var nextAttemptDate, lastAttemptDate *string
+		if delivery.NextAttemptDate != nil {
+			d := delivery.NextAttemptDate.UTC().Format(time.RFC3339)
+			nextAttemptDate = &d
+		}
+		if delivery.LastAttemptDate != nil {
+			d := delivery.LastAttemptDate.UTC().Format(time.RFC3339)
+			lastAttemptDate = &d
+		}
+
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM WEBHOOK_DELIVERY WHERE UID = ?`, delivery.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE WEBHOOK_DELIVERY SET
+				STATUS = ?, ATTEMPTS = ?, NEXT_ATTEMPT_DATE = ?, LAST_ATTEMPT_DATE = ?,
+				RESPONSE_STATUS = ?, RESPONSE_BODY = ?, ERROR_MESSAGE = ?
+				WHERE UID = ?`,
+				delivery.Status,
+				delivery.Attempts,
+				nextAttemptDate,
+				lastAttemptDate,
+				delivery.ResponseStatus,
+				delivery.ResponseBody,
+				delivery.Error,
+				delivery.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO WEBHOOK_DELIVERY
+				(UID, WEBHOOK_UID, EVENT_NAME, PAYLOAD, STATUS, ATTEMPTS, NEXT_ATTEMPT_DATE,
+				LAST_ATTEMPT_DATE, RESPONSE_STATUS, RESPONSE_BODY, ERROR_MESSAGE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				delivery.UID,
+				delivery.WebhookUID,
+				delivery.EventName,
+				delivery.Payload,
+				delivery.Status,
+				delivery.Attempts,
+				nextAttemptDate,
+				lastAttemptDate,
+				delivery.ResponseStatus,
+				delivery.ResponseBody,
+				delivery.Error,
+				delivery.CreatedDate.UTC().Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,57 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	"github.com/Tanibox/tania-core/src/webhooks/decoder"
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookEventRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewWebhookEventRepositorySqlite(db *sql.DB) repository.WebhookEventRepository {
+	return &WebhookEventRepositorySqlite{DB: db}
+}
+
+func (f *WebhookEventRepositorySqlite) Save(uid uuid.UUID, latestVersion int, events []interface{}) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		for _, v := range events {
+			latestVersion++
+
+			stmt, err := f.DB.Prepare(`INSERT INTO WEBHOOK_EVENT
+				(WEBHOOK_UID, VERSION, CREATED_DATE, EVENT)
+				VALUES (?, ?, ?, ?)`)
+
+			if err != nil {
+				result <- err
+			}
+
+			e, err := json.Marshal(decoder.EventWrapper{
+				EventName: structhelper.GetName(v),
+				EventData: v,
+			})
+
+			if err != nil {
+				panic(err)
+			}
+
+			_, err = stmt.Exec(uid, latestVersion, time.Now().Format(time.RFC3339), e)
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
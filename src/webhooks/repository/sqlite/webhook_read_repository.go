@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+type WebhookReadRepositorySqlite struct {
+	DB *sql.DB
+}
+
+func NewWebhookReadRepositorySqlite(db *sql.DB) repository.WebhookReadRepository {
+	return &WebhookReadRepositorySqlite{DB: db}
+}
+
+func (f *WebhookReadRepositorySqlite) Save(webhookRead *storage.WebhookRead) <-chan error {
+	result := make(chan error)
+
+	go func() {
+		count := 0
+		err := f.DB.QueryRow(`SELECT COUNT(*) FROM WEBHOOK_READ WHERE UID = ?`, webhookRead.UID).Scan(&count)
+		if err != nil {
+			result <- err
+		}
+
+		if count > 0 {
+			_, err = f.DB.Exec(`UPDATE WEBHOOK_READ SET
+				URL = ?, SECRET = ?, EVENTS = ?, IS_ACTIVE = ?, CREATED_DATE = ?
+				WHERE UID = ?`,
+				webhookRead.URL,
+				webhookRead.Secret,
+				strings.Join(webhookRead.Events, ","),
+				webhookRead.IsActive,
+				webhookRead.CreatedDate.Format(time.RFC3339),
+				webhookRead.UID)
+
+			if err != nil {
+				result <- err
+			}
+
+		} else {
+			_, err = f.DB.Exec(`INSERT INTO WEBHOOK_READ
+				(UID, URL, SECRET, EVENTS, IS_ACTIVE, CREATED_DATE)
+				VALUES (?, ?, ?, ?, ?, ?)`,
+				webhookRead.UID,
+				webhookRead.URL,
+				webhookRead.Secret,
+				strings.Join(webhookRead.Events, ","),
+				webhookRead.IsActive,
+				webhookRead.CreatedDate.Format(time.RFC3339))
+
+			if err != nil {
+				result <- err
+			}
+		}
+
+		result <- nil
+		close(result)
+	}()
+
+	return result
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+// StartRetryScheduler attempts the deliveries due for a retry every RetryInterval.
+// It is started once the server is mounted, not by NewWebhookServer.
+func (s *WebhookServer) StartRetryScheduler() {
+	go func() {
+		ticker := time.NewTicker(RetryInterval)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			s.RetryDueDeliveries(now)
+		}
+	}()
+}
+
+// RetryDueDeliveries attempts again the pending deliveries whose next attempt is due.
+// The deliveries of an inactive webhook wait for it to be activated again.
+func (s *WebhookServer) RetryDueDeliveries(date time.Time) {
+	queryResult := <-s.WebhookDeliveryQuery.FindAllDue(date)
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return
+	}
+
+	deliveries, ok := queryResult.Result.([]storage.WebhookDelivery)
+	if !ok {
+		log.Error("Error type assertion")
+		return
+	}
+
+	for _, v := range deliveries {
+		webhook, err := s.getWebhookRead(v.WebhookUID)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		if !webhook.IsActive {
+			continue
+		}
+
+		delivery := domain.Delivery(v)
+
+		err = s.attempt(&delivery, webhook)
+		if err != nil {
+			log.Error("Retrying delivery ", delivery.UID, " to webhook ", webhook.UID, ": ", err)
+		}
+	}
+}
+
+// attempt posts the payload of the delivery to the webhook, signed with its current secret,
+// and saves the outcome in the delivery log. The returned error is only about saving it,
+// a failed post is recorded in the delivery.
+func (s *WebhookServer) attempt(delivery *domain.Delivery, webhook storage.WebhookRead) error {
+	if !s.lockDelivery(delivery.UID) {
+		return nil
+	}
+	defer s.unlockDelivery(delivery.UID)
+
+	responseStatus, responseBody, err := s.post(delivery, webhook)
+	delivery.RecordAttempt(time.Now(), responseStatus, responseBody, err)
+
+	d := storage.WebhookDelivery(*delivery)
+
+	return <-s.WebhookDeliveryRepo.Save(&d)
+}
+
+func (s *WebhookServer) post(delivery *domain.Delivery, webhook storage.WebhookRead) (int, string, error) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(domain.HeaderEvent, delivery.EventName)
+	req.Header.Set(domain.HeaderDelivery, delivery.UID.String())
+	req.Header.Set(domain.HeaderSignature, domain.Sign(webhook.Secret, body))
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(io.LimitReader(resp.Body, domain.MaxDeliveryResponseBodyLength))
+	if err != nil {
+		return resp.StatusCode, "", err
+	}
+
+	return resp.StatusCode, string(responseBody), nil
+}
+
+func (s *WebhookServer) lockDelivery(uid uuid.UUID) bool {
+	s.inFlightLock.Lock()
+	defer s.inFlightLock.Unlock()
+
+	if s.inFlight[uid] {
+		return false
+	}
+
+	s.inFlight[uid] = true
+
+	return true
+}
+
+func (s *WebhookServer) unlockDelivery(uid uuid.UUID) {
+	s.inFlightLock.Lock()
+	defer s.inFlightLock.Unlock()
+
+	delete(s.inFlight, uid)
+}
@@ -0,0 +1,17 @@
+package server
+
+import (
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+)
+
+func MapToWebhookRead(webhook domain.Webhook) storage.WebhookRead {
+	return storage.WebhookRead{
+		UID:         webhook.UID,
+		URL:         webhook.URL,
+		Secret:      webhook.Secret,
+		Events:      webhook.Events,
+		IsActive:    webhook.IsActive,
+		CreatedDate: webhook.CreatedDate,
+	}
+}
@@ -0,0 +1,407 @@
+package server
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tanibox/tania-core/config"
+	"github.com/Tanibox/tania-core/src/eventbus"
+	"github.com/Tanibox/tania-core/src/helper/structhelper"
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/query"
+	queryInMem "github.com/Tanibox/tania-core/src/webhooks/query/inmemory"
+	queryMysql "github.com/Tanibox/tania-core/src/webhooks/query/mysql"
+	querySqlite "github.com/Tanibox/tania-core/src/webhooks/query/sqlite"
+	"github.com/Tanibox/tania-core/src/webhooks/repository"
+	repoInMem "github.com/Tanibox/tania-core/src/webhooks/repository/inmemory"
+	repoMysql "github.com/Tanibox/tania-core/src/webhooks/repository/mysql"
+	repoSqlite "github.com/Tanibox/tania-core/src/webhooks/repository/sqlite"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	"github.com/labstack/echo"
+	uuid "github.com/satori/go.uuid"
+)
+
+// RetryInterval is how often the deliveries due for a retry are attempted again
+const RetryInterval = 30 * time.Second
+
+// WebhookServer ties the routes and handlers with injected dependencies
+type WebhookServer struct {
+	WebhookEventRepo     repository.WebhookEventRepository
+	WebhookEventQuery    query.WebhookEventQuery
+	WebhookReadRepo      repository.WebhookReadRepository
+	WebhookReadQuery     query.WebhookReadQuery
+	WebhookDeliveryRepo  repository.WebhookDeliveryRepository
+	WebhookDeliveryQuery query.WebhookDeliveryQuery
+	Client               *http.Client
+	EventBus             eventbus.TaniaEventBus
+
+	// inFlight holds the deliveries being attempted, so the retry scheduler
+	// doesn't attempt one again while its first attempt is still waiting for a response
+	inFlightLock sync.Mutex
+	inFlight     map[uuid.UUID]bool
+}
+
+// NewWebhookServer initializes WebhookServer's dependencies and create new WebhookServer struct
+func NewWebhookServer(
+	db *sql.DB,
+	bus eventbus.TaniaEventBus,
+	webhookEventStorage *storage.WebhookEventStorage,
+	webhookReadStorage *storage.WebhookReadStorage,
+	webhookDeliveryStorage *storage.WebhookDeliveryStorage,
+) (*WebhookServer, error) {
+	webhookServer := &WebhookServer{
+		Client:   &http.Client{Timeout: 10 * time.Second},
+		EventBus: bus,
+		inFlight: make(map[uuid.UUID]bool),
+	}
+
+	switch *config.Config.TaniaPersistenceEngine {
+	case config.DB_INMEMORY:
+		webhookServer.WebhookEventRepo = repoInMem.NewWebhookEventRepositoryInMemory(webhookEventStorage)
+		webhookServer.WebhookEventQuery = queryInMem.NewWebhookEventQueryInMemory(webhookEventStorage)
+		webhookServer.WebhookReadRepo = repoInMem.NewWebhookReadRepositoryInMemory(webhookReadStorage)
+		webhookServer.WebhookReadQuery = queryInMem.NewWebhookReadQueryInMemory(webhookReadStorage)
+		webhookServer.WebhookDeliveryRepo = repoInMem.NewWebhookDeliveryRepositoryInMemory(webhookDeliveryStorage)
+		webhookServer.WebhookDeliveryQuery = queryInMem.NewWebhookDeliveryQueryInMemory(webhookDeliveryStorage)
+
+	case config.DB_SQLITE:
+		webhookServer.WebhookEventRepo = repoSqlite.NewWebhookEventRepositorySqlite(db)
+		webhookServer.WebhookEventQuery = querySqlite.NewWebhookEventQuerySqlite(db)
+		webhookServer.WebhookReadRepo = repoSqlite.NewWebhookReadRepositorySqlite(db)
+		webhookServer.WebhookReadQuery = querySqlite.NewWebhookReadQuerySqlite(db)
+		webhookServer.WebhookDeliveryRepo = repoSqlite.NewWebhookDeliveryRepositorySqlite(db)
+		webhookServer.WebhookDeliveryQuery = querySqlite.NewWebhookDeliveryQuerySqlite(db)
+
+	case config.DB_MYSQL:
+		webhookServer.WebhookEventRepo = repoMysql.NewWebhookEventRepositoryMysql(db)
+		webhookServer.WebhookEventQuery = queryMysql.NewWebhookEventQueryMysql(db)
+		webhookServer.WebhookReadRepo = repoMysql.NewWebhookReadRepositoryMysql(db)
+		webhookServer.WebhookReadQuery = queryMysql.NewWebhookReadQueryMysql(db)
+		webhookServer.WebhookDeliveryRepo = repoMysql.NewWebhookDeliveryRepositoryMysql(db)
+		webhookServer.WebhookDeliveryQuery = queryMysql.NewWebhookDeliveryQueryMysql(db)
+	}
+
+	webhookServer.InitSubscriber()
+
+	return webhookServer, nil
+}
+
+// InitSubscriber defines the mapping of which event this domain listen with their handler
+func (s *WebhookServer) InitSubscriber() {
+	s.EventBus.Subscribe("WebhookCreated", s.SaveToWebhookReadModel)
+	s.EventBus.Subscribe("WebhookChanged", s.SaveToWebhookReadModel)
+	s.EventBus.Subscribe("WebhookActivated", s.SaveToWebhookReadModel)
+	s.EventBus.Subscribe("WebhookDeactivated", s.SaveToWebhookReadModel)
+
+	// Every event of every module is delivered to the webhooks subscribed to it
+	s.EventBus.SubscribeAll(s.DispatchEvent)
+}
+
+// Mount defines the WebhookServer's endpoints with its handlers
+func (s *WebhookServer) Mount(g *echo.Group) {
+	g.POST("", s.SaveWebhook)
+	g.GET("", s.FindAllWebhooks)
+	g.GET("/:id", s.FindWebhookByID)
+	g.PUT("/:id", s.UpdateWebhook)
+	g.PUT("/:id/activate", s.ActivateWebhook)
+	g.PUT("/:id/deactivate", s.DeactivateWebhook)
+	g.GET("/:id/deliveries", s.FindAllDeliveries)
+	g.GET("/:id/deliveries/:delivery_id", s.FindDeliveryByID)
+	g.POST("/:id/deliveries/:delivery_id/redeliver", s.Redeliver)
+}
+
+func (s *WebhookServer) SaveWebhook(c echo.Context) error {
+	// Process //
+	webhook, err := domain.CreateWebhook(c.FormValue("url"), c.FormValue("secret"), parseEvents(c.FormValue("events")))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Persists //
+	err = <-s.WebhookEventRepo.Save(webhook.UID, 0, webhook.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	// Trigger Events
+	s.publishUncommittedEvents(webhook)
+
+	data := make(map[string]storage.WebhookRead)
+	data["data"] = MapToWebhookRead(*webhook)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *WebhookServer) FindAllWebhooks(c echo.Context) error {
+	queryResult := <-s.WebhookReadQuery.FindAll()
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	webhooks, ok := queryResult.Result.([]storage.WebhookRead)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.WebhookRead)
+	data["data"] = webhooks
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *WebhookServer) FindWebhookByID(c echo.Context) error {
+	webhookRead, err := s.findWebhookRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.WebhookRead)
+	data["data"] = webhookRead
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// UpdateWebhook changes the webhook. The fields which are not sent keep their current value.
+func (s *WebhookServer) UpdateWebhook(c echo.Context) error {
+	webhook, err := s.findWebhook(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	webhookURL := webhook.URL
+	if c.FormValue("url") != "" {
+		webhookURL = c.FormValue("url")
+	}
+
+	secret := webhook.Secret
+	if c.FormValue("secret") != "" {
+		secret = c.FormValue("secret")
+	}
+
+	events := webhook.Events
+	if c.FormValue("events") != "" {
+		events = parseEvents(c.FormValue("events"))
+	}
+
+	err = webhook.Change(webhookURL, secret, events)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.WebhookEventRepo.Save(webhook.UID, webhook.Version, webhook.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(webhook)
+
+	data := make(map[string]storage.WebhookRead)
+	data["data"] = MapToWebhookRead(*webhook)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *WebhookServer) ActivateWebhook(c echo.Context) error {
+	webhook, err := s.findWebhook(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = webhook.Activate()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.WebhookEventRepo.Save(webhook.UID, webhook.Version, webhook.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(webhook)
+
+	data := make(map[string]storage.WebhookRead)
+	data["data"] = MapToWebhookRead(*webhook)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *WebhookServer) DeactivateWebhook(c echo.Context) error {
+	webhook, err := s.findWebhook(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = webhook.Deactivate()
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = <-s.WebhookEventRepo.Save(webhook.UID, webhook.Version, webhook.UncommittedChanges)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	s.publishUncommittedEvents(webhook)
+
+	data := make(map[string]storage.WebhookRead)
+	data["data"] = MapToWebhookRead(*webhook)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// FindAllDeliveries lists the delivery log of the webhook, the latest first
+func (s *WebhookServer) FindAllDeliveries(c echo.Context) error {
+	webhookRead, err := s.findWebhookRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	queryResult := <-s.WebhookDeliveryQuery.FindAllByWebhookID(webhookRead.UID)
+	if queryResult.Error != nil {
+		return Error(c, queryResult.Error)
+	}
+
+	deliveries, ok := queryResult.Result.([]storage.WebhookDelivery)
+	if !ok {
+		return Error(c, echo.NewHTTPError(http.StatusBadRequest, "Internal server error"))
+	}
+
+	data := make(map[string][]storage.WebhookDelivery)
+	data["data"] = deliveries
+
+	return c.JSON(http.StatusOK, data)
+}
+
+func (s *WebhookServer) FindDeliveryByID(c echo.Context) error {
+	delivery, err := s.findDelivery(c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.WebhookDelivery)
+	data["data"] = delivery
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// Redeliver posts the payload of a past delivery again, as a new delivery attempted right away.
+// The envelope keeps its UID, so the receiver can tell it already got the event.
+func (s *WebhookServer) Redeliver(c echo.Context) error {
+	webhookRead, err := s.findWebhookRead(c.Param("id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	previous, err := s.findDelivery(c.Param("id"), c.Param("delivery_id"))
+	if err != nil {
+		return Error(c, err)
+	}
+
+	delivery, err := domain.CreateDelivery(webhookRead.UID, previous.EventName, previous.Payload)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	err = s.attempt(delivery, webhookRead)
+	if err != nil {
+		return Error(c, err)
+	}
+
+	data := make(map[string]storage.WebhookDelivery)
+	data["data"] = storage.WebhookDelivery(*delivery)
+
+	return c.JSON(http.StatusOK, data)
+}
+
+// parseEvents splits the comma separated event names
+func parseEvents(value string) []string {
+	events := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			events = append(events, v)
+		}
+	}
+
+	return events
+}
+
+func (s *WebhookServer) findWebhookRead(id string) (storage.WebhookRead, error) {
+	webhookUID, err := uuid.FromString(id)
+	if err != nil {
+		return storage.WebhookRead{}, NewRequestValidationError(PARSE_FAILED, "id")
+	}
+
+	queryResult := <-s.WebhookReadQuery.FindByID(webhookUID)
+	if queryResult.Error != nil {
+		return storage.WebhookRead{}, queryResult.Error
+	}
+
+	webhookRead, ok := queryResult.Result.(storage.WebhookRead)
+	if !ok {
+		return storage.WebhookRead{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if webhookRead.UID == (uuid.UUID{}) {
+		return storage.WebhookRead{}, NewRequestValidationError(NOT_FOUND, "id")
+	}
+
+	return webhookRead, nil
+}
+
+func (s *WebhookServer) findWebhook(id string) (*domain.Webhook, error) {
+	webhookRead, err := s.findWebhookRead(id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventQueryResult := <-s.WebhookEventQuery.FindAllByID(webhookRead.UID)
+	if eventQueryResult.Error != nil {
+		return nil, eventQueryResult.Error
+	}
+
+	events, ok := eventQueryResult.Result.([]storage.WebhookEvent)
+	if !ok {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	return repository.NewWebhookFromHistory(events), nil
+}
+
+// findDelivery finds the delivery, which has to be one of the webhook
+func (s *WebhookServer) findDelivery(webhookID, deliveryID string) (storage.WebhookDelivery, error) {
+	deliveryUID, err := uuid.FromString(deliveryID)
+	if err != nil {
+		return storage.WebhookDelivery{}, NewRequestValidationError(PARSE_FAILED, "delivery_id")
+	}
+
+	queryResult := <-s.WebhookDeliveryQuery.FindByID(deliveryUID)
+	if queryResult.Error != nil {
+		return storage.WebhookDelivery{}, queryResult.Error
+	}
+
+	delivery, ok := queryResult.Result.(storage.WebhookDelivery)
+	if !ok {
+		return storage.WebhookDelivery{}, echo.NewHTTPError(http.StatusBadRequest, "Internal server error")
+	}
+
+	if delivery.UID == (uuid.UUID{}) || delivery.WebhookUID.String() != webhookID {
+		return storage.WebhookDelivery{}, NewRequestValidationError(NOT_FOUND, "delivery_id")
+	}
+
+	return delivery, nil
+}
+
+func (s *WebhookServer) publishUncommittedEvents(entity interface{}) error {
+	switch e := entity.(type) {
+	case *domain.Webhook:
+		for _, v := range e.UncommittedChanges {
+			name := structhelper.GetName(v)
+			s.EventBus.Publish(name, v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	"github.com/Tanibox/tania-core/src/webhooks/storage"
+	"github.com/labstack/gommon/log"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (s *WebhookServer) SaveToWebhookReadModel(event interface{}) error {
+	webhookRead := &storage.WebhookRead{}
+
+	switch e := event.(type) {
+	case domain.WebhookCreated:
+		webhookRead.UID = e.UID
+		webhookRead.URL = e.URL
+		webhookRead.Secret = e.Secret
+		webhookRead.Events = e.Events
+		webhookRead.IsActive = true
+		webhookRead.CreatedDate = e.CreatedDate
+
+	case domain.WebhookChanged:
+		w, err := s.getWebhookRead(e.WebhookUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		webhookRead = &w
+
+		webhookRead.URL = e.URL
+		webhookRead.Secret = e.Secret
+		webhookRead.Events = e.Events
+
+	case domain.WebhookActivated:
+		w, err := s.getWebhookRead(e.WebhookUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		webhookRead = &w
+
+		webhookRead.IsActive = true
+
+	case domain.WebhookDeactivated:
+		w, err := s.getWebhookRead(e.WebhookUID)
+		if err != nil {
+			log.Error(err)
+		}
+
+		webhookRead = &w
+
+		webhookRead.IsActive = false
+
+	}
+
+	err := <-s.WebhookReadRepo.Save(webhookRead)
+	if err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// DispatchEvent is called by the event bus with every published event. The envelope is marshalled
+// right away, while the event is as it was published, then it is delivered in its own goroutine
+// so the publisher doesn't wait for the webhooks to respond.
+func (s *WebhookServer) DispatchEvent(eventName string, event interface{}) {
+	data, err := domain.StripCredentials(event)
+	if err != nil {
+		log.Error("Stripping the credentials of ", eventName, ": ", err)
+		return
+	}
+
+	uid, err := uuid.NewV4()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	payload, err := json.Marshal(domain.Envelope{
+		UID:          uid,
+		EventName:    eventName,
+		OccurredDate: time.Now(),
+		Data:         data,
+	})
+	if err != nil {
+		log.Error("Marshalling the webhook envelope of ", eventName, ": ", err)
+		return
+	}
+
+	go s.deliverEvent(eventName, string(payload))
+}
+
+// deliverEvent creates a delivery of the event for each active webhook subscribed to it, and attempts it
+func (s *WebhookServer) deliverEvent(eventName, payload string) {
+	queryResult := <-s.WebhookReadQuery.FindAllActive()
+	if queryResult.Error != nil {
+		log.Error(queryResult.Error)
+		return
+	}
+
+	webhooks, ok := queryResult.Result.([]storage.WebhookRead)
+	if !ok {
+		log.Error("Error type assertion")
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if !domain.Subscribes(webhook.Events, eventName) {
+			continue
+		}
+
+		delivery, err := domain.CreateDelivery(webhook.UID, eventName, payload)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+
+		err = s.attempt(delivery, webhook)
+		if err != nil {
+			log.Error("Delivering ", eventName, " to webhook ", webhook.UID, ": ", err)
+		}
+	}
+}
+
+func (s *WebhookServer) getWebhookRead(uid uuid.UUID) (storage.WebhookRead, error) {
+	queryResult := <-s.WebhookReadQuery.FindByID(uid)
+	if queryResult.Error != nil {
+		return storage.WebhookRead{}, queryResult.Error
+	}
+
+	webhookRead, ok := queryResult.Result.(storage.WebhookRead)
+	if !ok {
+		return storage.WebhookRead{}, errors.New("Internal server error. Error type assertion")
+	}
+
+	return webhookRead, nil
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	deadlock "github.com/sasha-s/go-deadlock"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookEventStorage struct {
+	Lock          *deadlock.RWMutex
+	WebhookEvents []WebhookEvent
+}
+
+func CreateWebhookEventStorage() *WebhookEventStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("WEBHOOK EVENT STORAGE DEADLOCK!")
+	}
+
+	return &WebhookEventStorage{Lock: &rwMutex}
+}
+
+type WebhookReadStorage struct {
+	Lock           *deadlock.RWMutex
+	WebhookReadMap map[uuid.UUID]WebhookRead
+}
+
+func CreateWebhookReadStorage() *WebhookReadStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("WEBHOOK READ STORAGE DEADLOCK!")
+	}
+
+	return &WebhookReadStorage{WebhookReadMap: make(map[uuid.UUID]WebhookRead), Lock: &rwMutex}
+}
+
+type WebhookDeliveryStorage struct {
+	Lock               *deadlock.RWMutex
+	WebhookDeliveryMap map[uuid.UUID]WebhookDelivery
+}
+
+func CreateWebhookDeliveryStorage() *WebhookDeliveryStorage {
+	rwMutex := deadlock.RWMutex{}
+	deadlock.Opts.DeadlockTimeout = time.Second * 10
+	deadlock.Opts.OnPotentialDeadlock = func() {
+		fmt.Println("WEBHOOK DELIVERY STORAGE DEADLOCK!")
+	}
+
+	return &WebhookDeliveryStorage{WebhookDeliveryMap: make(map[uuid.UUID]WebhookDelivery), Lock: &rwMutex}
+}
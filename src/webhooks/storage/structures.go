@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/Tanibox/tania-core/src/webhooks/domain"
+	uuid "github.com/satori/go.uuid"
+)
+
+type WebhookEvent struct {
+	WebhookUID  uuid.UUID
+	Version     int
+	CreatedDate time.Time
+	Event       interface{}
+}
+
+type WebhookRead struct {
+	UID         uuid.UUID `json:"uid"`
+	URL         string    `json:"url"`
+	Secret      string    `json:"-"`
+	Events      []string  `json:"events"`
+	IsActive    bool      `json:"is_active"`
+	CreatedDate time.Time `json:"created_date"`
+}
+
+type WebhookDelivery domain.Delivery